@@ -3,6 +3,8 @@ package api
 import (
 	"net/http"
 	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
 func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
@@ -25,6 +27,8 @@ func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 //nolint:dupl
 func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
 	switch {
+	case strings.HasPrefix(path, "/ob/listingschedule"):
+		i.POSTListingSchedule(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.POSTListing(w, r)
 	case strings.HasPrefix(path, "/ob/follow"):
@@ -41,6 +45,10 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTSettings(w, r)
 	case strings.HasPrefix(path, "/ob/inventory"):
 		i.POSTInventory(w, r)
+	case strings.HasPrefix(path, "/ob/lowstockthresholds"):
+		i.POSTLowStockThresholds(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.POSTAPIToken(w, r)
 	case strings.HasPrefix(path, "/ob/avatar"):
 		i.POSTAvatar(w, r)
 	case strings.HasPrefix(path, "/ob/header"):
@@ -65,6 +73,8 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		blockingStartupMiddleware(i, w, r, i.POSTOpenDispute)
 	case strings.HasPrefix(path, "/ob/closedispute"):
 		blockingStartupMiddleware(i, w, r, i.POSTCloseDispute)
+	case strings.HasPrefix(path, "/ob/disputeevidence"):
+		blockingStartupMiddleware(i, w, r, i.POSTDisputeEvidence)
 	case strings.HasPrefix(path, "/ob/releasefunds"):
 		blockingStartupMiddleware(i, w, r, i.POSTReleaseFunds)
 	case strings.HasPrefix(path, "/ob/releaseescrow"):
@@ -91,8 +101,24 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTShutdown(w, r)
 	case strings.HasPrefix(path, "/ob/estimatetotal"):
 		i.POSTEstimateTotal(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptions"):
+		blockingStartupMiddleware(i, w, r, i.POSTSubscription)
+	case strings.HasPrefix(path, "/ob/pausesubscription"):
+		i.POSTPauseSubscription(w, r)
+	case strings.HasPrefix(path, "/ob/resumesubscription"):
+		i.POSTResumeSubscription(w, r)
+	case strings.HasPrefix(path, "/ob/cancelsubscription"):
+		i.POSTCancelSubscription(w, r)
+	case strings.HasPrefix(path, "/ob/cart/estimate"):
+		i.POSTEstimateCart(w, r)
+	case strings.HasPrefix(path, "/ob/cart"):
+		blockingStartupMiddleware(i, w, r, i.POSTCart)
 	case strings.HasPrefix(path, "/ob/fetchratings"):
 		i.POSTFetchRatings(w, r)
+	case strings.HasPrefix(path, "/ob/ratingreply"):
+		i.POSTRatingReply(w, r)
+	case strings.HasPrefix(path, "/ob/announcementsubscriptions"):
+		i.POSTAnnouncementSubscription(w, r)
 	case strings.HasPrefix(path, "/ob/sales"):
 		i.POSTSales(w, r)
 	case strings.HasPrefix(path, "/ob/purchases"):
@@ -153,10 +179,28 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETFollowers(w, r)
 	case strings.HasPrefix(path, "/ob/following"):
 		i.GETFollowing(w, r)
+	case strings.HasPrefix(path, "/ob/inventoryreservations"):
+		i.GETInventoryReservations(w, r)
 	case strings.HasPrefix(path, "/ob/inventory"):
 		i.GETInventory(w, r)
+	case strings.HasPrefix(path, "/ob/lowstockthresholds"):
+		i.GETLowStockThresholds(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.GETAPITokens(w, r)
+	case strings.HasPrefix(path, "/ob/peerbans"):
+		i.GETPeerBans(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptions"):
+		i.GETSubscriptions(w, r)
+	case strings.HasPrefix(path, "/ob/crowdfund"):
+		i.GETCrowdFund(w, r)
 	case strings.HasPrefix(path, "/ob/profile"):
 		i.GETProfile(w, r)
+	case strings.HasPrefix(path, "/ob/exportlistings"):
+		i.GETExportListings(w, r)
+	case strings.HasPrefix(path, "/ob/search"):
+		i.GETSearch(w, r)
+	case strings.HasPrefix(path, "/ob/listingschedules"):
+		i.GETListingSchedules(w, r)
 	case strings.HasPrefix(path, "/ob/listings"):
 		i.GETListings(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
@@ -167,10 +211,14 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETIsFollowing(w, r)
 	case strings.HasPrefix(path, "/ob/order"):
 		i.GETOrder(w, r)
+	case strings.HasPrefix(path, "/ob/moderatorstats"):
+		i.GETModeratorStats(w, r)
 	case strings.HasPrefix(path, "/ob/moderators"):
 		i.GETModerators(w, r)
 	case strings.HasPrefix(path, "/ob/chatmessages"):
 		i.GETChatMessages(w, r)
+	case strings.HasPrefix(path, "/ob/chatattachment"):
+		i.GETChatAttachment(w, r)
 	case strings.HasPrefix(path, "/ob/chatconversations"):
 		i.GETChatConversations(w, r)
 	case strings.HasPrefix(path, "/ob/notifications"):
@@ -199,6 +247,8 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETRating(w, r)
 	case strings.HasPrefix(path, "/ob/healthcheck"):
 		i.GETHealthCheck(w, r)
+	case strings.HasPrefix(path, "/ob/metrics"):
+		i.GETMetrics(w, r)
 	case strings.HasPrefix(path, "/wallet/status"):
 		i.GETWalletStatus(w, r)
 	case strings.HasPrefix(path, "/ob/ipns"):
@@ -211,6 +261,10 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETPosts(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.GETPost(w, r)
+	case strings.HasPrefix(path, "/ob/feed"):
+		i.GETFeed(w, r)
+	case strings.HasPrefix(path, "/ob/announcementsubscriptions"):
+		i.GETAnnouncementSubscriptions(w, r)
 	case strings.HasPrefix(path, "/ob/scanofflinemessages"):
 		i.GETScanOfflineMessages(w, r)
 	default:
@@ -233,6 +287,8 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 	switch {
 	case strings.HasPrefix(path, "/ob/moderator"):
 		i.DELETEModerator(w, r)
+	case strings.HasPrefix(path, "/ob/listingschedule"):
+		i.DELETEListingSchedule(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.DELETEListing(w, r)
 	case strings.HasPrefix(path, "/ob/chatmessage"):
@@ -245,6 +301,16 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 		i.DELETEBlockNode(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.DELETEPost(w, r)
+	case strings.HasPrefix(path, "/ob/lowstockthreshold"):
+		i.DELETELowStockThreshold(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.DELETEAPIToken(w, r)
+	case strings.HasPrefix(path, "/ob/peerbans"):
+		i.DELETEPeerBan(w, r)
+	case strings.HasPrefix(path, "/ob/ratingreply"):
+		i.DELETERatingReply(w, r)
+	case strings.HasPrefix(path, "/ob/announcementsubscriptions"):
+		i.DELETEAnnouncementSubscription(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -269,6 +335,119 @@ func gatewayAllowedPath(path, method string) bool {
 	return false
 }
 
+// apiRoleAllowedPath returns true if an API user with the role may call the
+// route. Owners may call every route, other roles only the routes in
+// apiRoleRoutes for their role and for readonly. HEAD requests are granted
+// along with GET.
+func apiRoleAllowedPath(role repo.APIRole, path, method string) bool {
+	if role == repo.APIRoleOwner {
+		return true
+	}
+	if method == "HEAD" {
+		method = "GET"
+	}
+	for _, r := range []repo.APIRole{repo.APIRoleReadOnly, role} {
+		for _, p := range apiRoleRoutes[r][method] {
+			if strings.HasPrefix(path, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// apiRoleRoutes are the routes each role may call by method. The readonly
+// routes only query the node and are open to every role. None of them reveal
+// the node's keys, settings or API credentials, move funds or administer the
+// node.
+var apiRoleRoutes = map[repo.APIRole]map[string][]string{
+	repo.APIRoleReadOnly: {
+		"GET": {
+			"/ob/status", "/ob/peers", "/ob/config", "/ob/closestpeers", "/ob/exchangerate", "/ob/followers",
+			"/ob/following", "/ob/followsme", "/ob/isfollowing", "/ob/profile", "/ob/inventory", "/ob/lowstockthresholds",
+			"/ob/listing", "/ob/exportlistings", "/ob/crowdfund", "/ob/search", "/ob/subscriptions", "/ob/order",
+			"/ob/sales", "/ob/purchases", "/ob/cases", "/ob/case", "/ob/moderatorstats", "/ob/moderators",
+			"/ob/notifications", "/ob/image", "/ob/avatar", "/ob/header", "/ob/ratings", "/ob/rating", "/ob/healthcheck",
+			"/ob/metrics", "/ob/ipns", "/ob/resolveipns", "/ob/peerinfo", "/ob/post", "/ob/feed",
+			"/ob/announcementsubscriptions", "/wallet/currencies", "/wallet/balance", "/wallet/transactions",
+			"/wallet/estimatefee", "/wallet/fees", "/wallet/status",
+		},
+		"POST": {
+			"/ob/fetchprofiles", "/ob/fetchratings", "/ob/estimatetotal", "/ob/cart/estimate", "/ob/sales", "/ob/purchases",
+			"/ob/cases", "/ob/verifymessage", "/ob/hashmessage",
+		},
+	},
+	repo.APIRoleClerk: {
+		"GET": {"/ob/chatmessages", "/ob/chatattachment", "/ob/chatconversations"},
+		"POST": {
+			"/ob/orderfulfillment", "/ob/chat", "/ob/groupchat", "/ob/markchatasread",
+			"/ob/marknotificationasread", "/ob/marknotificationsasread",
+		},
+	},
+	repo.APIRoleModerator: {
+		"GET": {"/ob/chatmessages", "/ob/chatattachment", "/ob/chatconversations"},
+		"POST": {
+			"/ob/closedispute", "/ob/chat", "/ob/groupchat", "/ob/markchatasread",
+			"/ob/marknotificationasread", "/ob/marknotificationsasread",
+		},
+	},
+}
+
+// apiTokenAllowedPath returns true if one of the token's scopes grants the
+// request. HEAD requests are granted along with GET.
+func apiTokenAllowedPath(token *repo.APIToken, path, method string) bool {
+	if method == "HEAD" {
+		method = "GET"
+	}
+	for _, scope := range token.Scopes {
+		for _, p := range apiTokenScopeRoutes[scope][method] {
+			if strings.HasPrefix(path, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// apiTokenScopeRoutes are the routes each API token scope grants by method.
+// No scope grants access to the node's settings, keys or API credentials.
+var apiTokenScopeRoutes = map[repo.APITokenScope]map[string][]string{
+	repo.APITokenScopeListingsRead: {
+		"GET": {"/ob/listing", "/ob/inventory", "/ob/lowstockthresholds", "/ob/exportlistings", "/ob/crowdfund"},
+	},
+	repo.APITokenScopeListingsWrite: {
+		"POST": {
+			"/ob/listing", "/ob/inventory", "/ob/lowstockthresholds", "/ob/images", "/ob/importlistings",
+			"/ob/bulkupdatecurrency", "/ob/bulkupdateprices",
+		},
+		"PUT":    {"/ob/listing"},
+		"DELETE": {"/ob/listing", "/ob/lowstockthreshold"},
+	},
+	repo.APITokenScopeOrdersRead: {
+		"GET":  {"/ob/order", "/ob/sales", "/ob/purchases", "/ob/cases", "/ob/case", "/ob/subscriptions"},
+		"POST": {"/ob/sales", "/ob/purchases", "/ob/cases"},
+	},
+	repo.APITokenScopeOrdersWrite: {
+		"POST": {
+			"/ob/orderconfirmation", "/ob/ordercancel", "/ob/orderfulfillment", "/ob/ordercompletion",
+			"/ob/refund", "/ob/opendispute", "/ob/closedispute", "/ob/disputeevidence", "/ob/releasefunds",
+			"/ob/releaseescrow", "/ob/resendordermessage",
+		},
+	},
+	repo.APITokenScopeWalletRead: {
+		"GET": {
+			"/wallet/currencies", "/wallet/address", "/wallet/balance", "/wallet/transactions",
+			"/wallet/estimatefee", "/wallet/fees", "/wallet/status",
+		},
+	},
+	repo.APITokenScopeWalletSpend: {
+		"POST": {"/wallet/spend", "/wallet/bumpfee", "/ob/orderspend"},
+	},
+	repo.APITokenScopeMetricsRead: {
+		"GET": {"/ob/metrics", "/ob/healthcheck"},
+	},
+}
+
 func blockingStartupMiddleware(i *jsonAPIHandler, w http.ResponseWriter, r *http.Request, requestFunc func(w http.ResponseWriter, r *http.Request)) {
 	i.node.Service.WaitForReady()
 	requestFunc(w, r)
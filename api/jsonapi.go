@@ -5,11 +5,13 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
+	"database/sql"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"mime"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -24,6 +26,7 @@ import (
 
 	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
 	files "gx/ipfs/QmQmhotPUzVrMEWNK3x1R5jQ5ZHWyL7tVUrmRPjrBrvyCb/go-ipfs-files"
+	"gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus/promhttp"
 	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
 	ipns "gx/ipfs/QmUwMnKKjH3JwGKNVZ3TcP37W93xzqNA4ECFFiMo6sXkkc/go-ipns"
 	iface "gx/ipfs/QmXLwxifxwfc2bAwq6rdjbYqAsGzWsDE9RM5TWMGtykyj6/interface-go-ipfs-core"
@@ -36,6 +39,7 @@ import (
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/schema"
@@ -59,6 +63,7 @@ type JSONAPIConfig struct {
 	Cookie        http.Cookie
 	Username      string
 	Password      string
+	Metrics       bool
 }
 
 type jsonAPIHandler struct {
@@ -90,6 +95,7 @@ func newJSONAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config
 			Cookie:        authCookie,
 			Username:      config.Username,
 			Password:      config.Password,
+			Metrics:       config.Metrics,
 		},
 		node: node,
 	}
@@ -127,34 +133,27 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if i.config.Authenticated {
-		if i.config.Username == "" || i.config.Password == "" {
-			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
-			if err != nil {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-			if i.config.Cookie.Value != cookie.Value {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-		} else {
-
-			if r.Method == "OPTIONS" {
-				w.WriteHeader(http.StatusOK)
-				fmt.Fprint(w, "200 - OK")
-				return
-			}
+		if r.Method == "OPTIONS" && i.config.Username != "" && i.config.Password != "" {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, "200 - OK")
+			return
+		}
 
-			username, password, ok := r.BasicAuth()
-			h := sha256.Sum256([]byte(password))
-			password = hex.EncodeToString(h[:])
-			if !ok || username != i.config.Username || !strings.EqualFold(password, i.config.Password) {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
+		var allowed bool
+		if bearer, ok := bearerToken(r); ok {
+			token, err := i.node.AuthenticateAPIToken(bearer)
+			if err != nil && err != core.ErrInvalidAPIToken {
+				log.Errorf("reading api token: %s", err.Error())
 			}
+			allowed = err == nil && apiTokenAllowedPath(token, u.Path, r.Method)
+		} else {
+			role, ok := i.authenticate(r)
+			allowed = ok && apiRoleAllowedPath(role, u.Path, r.Method)
+		}
+		if !allowed {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "403 - Forbidden")
+			return
 		}
 	}
 
@@ -177,6 +176,11 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	start := time.Now()
+	defer func() {
+		metrics.APIRequestDuration.WithLabelValues(r.Method, metrics.Route(u.Path)).Observe(time.Since(start).Seconds())
+	}()
+
 	w.Header().Add("Content-Type", "application/json")
 	switch r.Method {
 	case "GET":
@@ -194,6 +198,46 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// authenticate returns the role of the caller. The auth cookie and the
+// credentials in the config belong to the node's owner. Other callers must
+// use the basic auth credentials of an API user from the datastore.
+func (i *jsonAPIHandler) authenticate(r *http.Request) (repo.APIRole, bool) {
+	ownerCredentials := i.config.Username != "" && i.config.Password != ""
+	if !ownerCredentials {
+		if cookie, err := r.Cookie("OpenBazaar_Auth_Cookie"); err == nil && i.config.Cookie.Value == cookie.Value {
+			return repo.APIRoleOwner, true
+		}
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	h := sha256.Sum256([]byte(password))
+	password = hex.EncodeToString(h[:])
+	if ownerCredentials && username == i.config.Username {
+		return repo.APIRoleOwner, strings.EqualFold(password, i.config.Password)
+	}
+
+	user, err := i.node.Datastore.APIUsers().Get(username)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorf("reading api user (%s): %s", username, err.Error())
+		}
+		return "", false
+	}
+	return user.Role, strings.EqualFold(password, user.PasswordHash)
+}
+
+// bearerToken returns the API token from the request's Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer ")), true
+}
+
 func ErrorResponse(w http.ResponseWriter, errorCode int, reason string) {
 	reason = strings.Replace(reason, `"`, `'`, -1)
 	err := APIError{false, reason}
@@ -524,7 +568,22 @@ func (i *jsonAPIHandler) POSTListing(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusConflict, err.Error())
 		return
 	}
-	slug, err := i.node.CreateListing(listingData)
+	var slug string
+	if publishAt := r.URL.Query().Get("publishAt"); publishAt != "" {
+		t, err := time.Parse(time.RFC3339, publishAt)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "publishAt must be an RFC3339 timestamp")
+			return
+		}
+		republishHours, err := parseRepublishHours(r.URL.Query().Get("republishHours"))
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		slug, err = i.node.CreateScheduledListing(listingData, t, republishHours)
+	} else {
+		slug, err = i.node.CreateListing(listingData)
+	}
 	if err != nil {
 		if err == repo.ErrListingAlreadyExists {
 			ErrorResponse(w, http.StatusConflict, "Listing already exists. Use PUT.")
@@ -893,6 +952,10 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateWebhookSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -959,6 +1022,10 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateWebhookSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -1040,6 +1107,10 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateWebhookSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -1352,6 +1423,98 @@ func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
+func (i *jsonAPIHandler) GETInventoryReservations(w http.ResponseWriter, r *http.Request) {
+	type reservation struct {
+		OrderID   string    `json:"orderId"`
+		Slug      string    `json:"slug"`
+		Variant   int       `json:"variant"`
+		Quantity  string    `json:"quantity"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+	reservations, err := i.node.Datastore.InventoryReservations().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := []reservation{}
+	for _, res := range reservations {
+		ret = append(ret, reservation{
+			OrderID:   res.OrderID,
+			Slug:      res.Slug,
+			Variant:   res.Variant,
+			Quantity:  res.Quantity.String(),
+			Timestamp: res.Timestamp,
+		})
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTLowStockThresholds(w http.ResponseWriter, r *http.Request) {
+	type threshold struct {
+		Slug      string `json:"slug"`
+		Threshold string `json:"threshold"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var thresholds []threshold
+	err := decoder.Decode(&thresholds)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for _, t := range thresholds {
+		q, ok := new(big.Int).SetString(t.Threshold, 10)
+		if !ok || q.Sign() < 0 || t.Slug == "" {
+			ErrorResponse(w, http.StatusBadRequest, "error parsing threshold")
+			return
+		}
+		err = i.node.Datastore.Inventory().PutLowStockThreshold(t.Slug, q)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETLowStockThresholds(w http.ResponseWriter, r *http.Request) {
+	thresholds, err := i.node.Datastore.Inventory().GetAllLowStockThresholds()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := make(map[string]string, len(thresholds))
+	for slug, t := range thresholds {
+		ret[slug] = t.String()
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) DELETELowStockThreshold(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.Inventory().GetLowStockThreshold(slug); err == sql.ErrNoRows {
+		ErrorResponse(w, http.StatusNotFound, "Low stock threshold not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.Datastore.Inventory().DeleteLowStockThreshold(slug); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
 func (i *jsonAPIHandler) PUTModerator(w http.ResponseWriter, r *http.Request) {
 	profilePath := path.Join(i.node.RepoPath, "root", "profile.json")
 	_, ferr := os.Stat(profilePath)
@@ -1375,6 +1538,12 @@ func (i *jsonAPIHandler) PUTModerator(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Publish the moderator statistics
+	if _, err := i.node.UpdateModeratorStats(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
@@ -1404,6 +1573,12 @@ func (i *jsonAPIHandler) DELETEModerator(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Remove the published moderator statistics
+	if _, err := i.node.UpdateModeratorStats(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
@@ -1774,23 +1949,43 @@ func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderID string `json:"orderId"`
+	type refundItem struct {
+		Index    uint32 `json:"index"`
+		Quantity string `json:"quantity"`
+	}
+	type orderRefund struct {
+		OrderID string       `json:"orderId"`
+		Amount  string       `json:"amount"`
+		Items   []refundItem `json:"items"`
 	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can orderRefund
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	var amount *big.Int
+	if can.Amount != "" {
+		a, ok := new(big.Int).SetString(can.Amount, 10)
+		if !ok {
+			ErrorResponse(w, http.StatusBadRequest, "amount must be a whole number in the base units of the payment currency")
+			return
+		}
+		amount = a
+	}
+	items := make([]*pb.Refund_Item, 0, len(can.Items))
+	for _, it := range can.Items {
+		items = append(items, &pb.Refund_Item{Index: it.Index, BigQuantity: it.Quantity})
+	}
 	contract, state, _, records, _, _, err := i.node.Datastore.Sales().GetByOrderId(can.OrderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
 	}
-	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, or PARTIALLY_FULFILLED")
+	moderated := contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED
+	if !core.CanRefund(state, amount != nil || len(items) > 0, moderated) {
+		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, or PARTIALLY_FULFILLED, or FULFILLED for a partial refund of a direct payment")
 		return
 	}
 
@@ -1809,8 +2004,15 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 		//contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
 
-	err = i.node.RefundOrder(contract, records)
-	if err != nil {
+	if amount == nil && len(items) == 0 {
+		err = i.node.RefundOrder(contract, records)
+	} else {
+		err = i.node.PartialRefundOrder(contract, records, amount, items)
+	}
+	if err == core.ErrRefundAmountInvalid || err == core.ErrRefundAmountAndItems || err == core.ErrRefundFulfilledOrder || err == core.ErrRefundPending {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -1821,6 +2023,18 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("async")
 	async, _ := strconv.ParseBool(query)
 	include := r.URL.Query().Get("include")
+	filter, err := moderatorFilterFromQuery(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sortBy := r.URL.Query().Get("sortBy")
+	if sortBy != "" {
+		if err := core.ValidateModeratorSortKey(sortBy); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
 
 	ctx := context.Background()
 	if !async {
@@ -1850,8 +2064,51 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 			mods = append(mods, id)
 		}
 		var resp string
-		removeDuplicates(mods)
-		if strings.ToLower(include) == "profile" {
+		mods = removeDuplicates(mods)
+		if !filter.IsEmpty() || sortBy != "" || strings.ToLower(include) == "stats" {
+			withStats := filter.NeedsStats() || sortBy != "" || strings.ToLower(include) == "stats"
+			var (
+				lock    sync.Mutex
+				wg      sync.WaitGroup
+				results []*pb.ModeratorWithStats
+			)
+			for _, mod := range mods {
+				wg.Add(1)
+				go func(m string) {
+					defer wg.Done()
+					moderator, err := i.node.FetchModerator(m, withStats, false)
+					if err != nil || !filter.Matches(moderator) {
+						return
+					}
+					lock.Lock()
+					defer lock.Unlock()
+					results = append(results, moderator)
+				}(mod)
+			}
+			wg.Wait()
+			if sortBy != "" {
+				if err := core.SortModerators(results, sortBy); err != nil {
+					ErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+			}
+			m := jsonpb.Marshaler{
+				EnumsAsInts:  false,
+				EmitDefaults: true,
+				Indent:       "    ",
+				OrigName:     false,
+			}
+			var items []string
+			for _, result := range results {
+				itemJSON, err := m.MarshalToString(result)
+				if err != nil {
+					ErrorResponse(w, http.StatusInternalServerError, err.Error())
+					return
+				}
+				items = append(items, itemJSON)
+			}
+			resp = "[" + strings.Join(items, ",") + "]"
+		} else if strings.ToLower(include) == "profile" {
 			var withProfiles []string
 			var wg sync.WaitGroup
 			for _, mod := range mods {
@@ -1948,6 +2205,13 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 					found[pid] = true
 					foundMu.Unlock()
 
+					if !filter.IsEmpty() {
+						moderator, err := i.node.FetchModerator(pid, filter.NeedsStats(), false)
+						if err != nil || !filter.Matches(moderator) {
+							return
+						}
+					}
+
 					if strings.ToLower(include) == "profile" {
 						profile, err := i.node.FetchProfile(pid, false)
 						if err != nil {
@@ -1987,6 +2251,89 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// moderatorFilterFromQuery reads the moderator filter from the GETModerators
+// query parameters
+func moderatorFilterFromQuery(query url.Values) (core.ModeratorFilter, error) {
+	filter := core.ModeratorFilter{
+		Language: query.Get("language"),
+		Currency: query.Get("currency"),
+		FeeType:  query.Get("feeType"),
+	}
+	if filter.FeeType != "" {
+		if _, ok := pb.Moderator_Fee_FeeType_value[strings.ToUpper(filter.FeeType)]; !ok {
+			return filter, fmt.Errorf("unknown fee type %s", filter.FeeType)
+		}
+	}
+	if v := query.Get("minCasesHandled"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return filter, fmt.Errorf("invalid minCasesHandled: %s", err.Error())
+		}
+		filter.MinCasesHandled = uint32(n)
+	}
+	if v := query.Get("maxOpenCases"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return filter, fmt.Errorf("invalid maxOpenCases: %s", err.Error())
+		}
+		maxOpen := uint32(n)
+		filter.MaxOpenCases = &maxOpen
+	}
+	if v := query.Get("maxMedianResolutionSeconds"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid maxMedianResolutionSeconds: %s", err.Error())
+		}
+		filter.MaxMedianResolutionSeconds = n
+	}
+	return filter, nil
+}
+
+func (i *jsonAPIHandler) GETModeratorStats(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if peerID == "" || peerID == "moderatorstats" || peerID == i.node.IpfsNode.Identity.Pretty() {
+		stats, err := i.node.ComputeModeratorStats()
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		signed, err := i.node.SignModeratorStats(stats)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		out, err := m.MarshalToString(&pb.ModeratorWithStats{PeerId: i.node.IpfsNode.Identity.Pretty(), Stats: signed})
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponseM(w, out, new(pb.ModeratorWithStats))
+		return
+	}
+
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	signed, verified, err := i.node.FetchModeratorStats(peerID, useCache)
+	if err == core.ErrModeratorStatsMismatch {
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	out, err := m.MarshalToString(&pb.ModeratorWithStats{PeerId: peerID, Stats: signed, VerifiedResolutions: verified})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.ModeratorWithStats))
+}
+
 func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	var fulfill pb.OrderFulfillment
@@ -2021,7 +2368,10 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 		return
 	}
 	err = i.node.FulfillOrder(&fulfill, contract, records)
-	if err != nil {
+	if err == core.ErrCrowdFundPledgePending {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -2107,7 +2457,10 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 	}
 
 	err = i.node.CompleteOrder(&or, contract, records)
-	if err != nil {
+	if err == core.ErrCrowdFundPledgePending {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -2116,8 +2469,9 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 
 func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
 	type dispute struct {
-		OrderID string `json:"orderId"`
-		Claim   string `json:"claim"`
+		OrderID  string            `json:"orderId"`
+		Claim    string            `json:"claim"`
+		Evidence []json.RawMessage `json:"evidence"`
 	}
 	decoder := json.NewDecoder(r.Body)
 	var d dispute
@@ -2126,6 +2480,11 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	evidence, err := unmarshalDisputeEvidenceItems(d.Evidence)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	var (
 		isSale   bool
 		contract *pb.RicardianContract
@@ -2176,11 +2535,24 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if len(evidence) > 0 {
+		if err := i.node.ValidateDisputeEvidenceItems(d.OrderID, evidence); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if len(evidence) > 0 {
+		if _, err := i.node.SubmitDisputeEvidence(d.OrderID, evidence); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, "dispute opened but sending evidence failed: "+err.Error())
+			return
+		}
+	}
 	SanitizedResponse(w, `{}`)
 }
 
@@ -2216,6 +2588,11 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+	go func() {
+		if err := i.node.PublishModeratorStats(); err != nil {
+			log.Errorf("publishing moderator statistics: %s", err.Error())
+		}
+	}()
 	SanitizedResponse(w, `{}`)
 }
 
@@ -2261,6 +2638,12 @@ func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	}
 	resp.UnreadChatMessages = uint64(unread)
 
+	resp.Evidence, err = i.node.Datastore.DisputeEvidence().GetByCaseID(orderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
 		EmitDefaults: true,
@@ -2482,7 +2865,11 @@ func (i *jsonAPIHandler) POSTVerifyMessage(w http.ResponseWriter, r *http.Reques
 
 func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var chat repo.ChatMessage
+	var chat struct {
+		repo.ChatMessage
+		Attachments        []repo.ChatAttachmentFile `json:"attachments"`
+		EncryptAttachments bool                      `json:"encryptAttachments"`
+	}
 	err := decoder.Decode(&chat)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2496,6 +2883,10 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
 		return
 	}
+	if err := core.ValidateChatAttachmentFiles(chat.Attachments); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	t := time.Now()
 	ts, err := ptypes.TimestampProto(t)
@@ -2504,7 +2895,7 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	var flag pb.Chat_Flag
-	if chat.Message == "" {
+	if chat.Message == "" && len(chat.Attachments) == 0 {
 		flag = pb.Chat_TYPING
 	} else {
 		flag = pb.Chat_MESSAGE
@@ -2521,12 +2912,25 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var (
+		sent   map[string][]*pb.Chat_Attachment
+		stored []repo.ChatAttachment
+	)
+	if len(chat.Attachments) > 0 {
+		sent, stored, err = i.node.AddChatAttachments([]string{chat.PeerId}, chat.Attachments, chat.EncryptAttachments)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
 	chatPb := &pb.Chat{
-		MessageId: msgID.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
-		Timestamp: ts,
-		Flag:      flag,
+		MessageId:   msgID.B58String(),
+		Subject:     chat.Subject,
+		Message:     chat.Message,
+		Timestamp:   ts,
+		Flag:        flag,
+		Attachments: sent[chat.PeerId],
 	}
 	err = i.node.SendChat(chat.PeerId, chatPb)
 	if err != nil {
@@ -2540,6 +2944,12 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if len(stored) > 0 {
+			if err := i.node.Datastore.Chat().PutAttachments(msgID.B58String(), stored); err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
 	}
 	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
 }
@@ -2564,6 +2974,10 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
 		return
 	}
+	if err := core.ValidateChatAttachmentFiles(chat.Attachments); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	t := time.Now()
 	ts, err := ptypes.TimestampProto(t)
@@ -2572,7 +2986,7 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	var flag pb.Chat_Flag
-	if chat.Message == "" {
+	if chat.Message == "" && len(chat.Attachments) == 0 {
 		flag = pb.Chat_TYPING
 	} else {
 		flag = pb.Chat_MESSAGE
@@ -2589,27 +3003,46 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	chatPb := &pb.Chat{
-		MessageId: msgID.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
-		Timestamp: ts,
-		Flag:      flag,
-	}
-	for _, pid := range chat.PeerIds {
-		err = i.node.SendChat(pid, chatPb)
+	var (
+		sent   map[string][]*pb.Chat_Attachment
+		stored []repo.ChatAttachment
+	)
+	if len(chat.Attachments) > 0 {
+		sent, stored, err = i.node.AddChatAttachments(chat.PeerIds, chat.Attachments, chat.EncryptAttachments)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	for _, pid := range chat.PeerIds {
+		chatPb := &pb.Chat{
+			MessageId:   msgID.B58String(),
+			Subject:     chat.Subject,
+			Message:     chat.Message,
+			Timestamp:   ts,
+			Flag:        flag,
+			Attachments: sent[pid],
+		}
+		err = i.node.SendChat(pid, chatPb)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 	}
 	// Put to database
-	if chatPb.Flag == pb.Chat_MESSAGE {
+	if flag == pb.Chat_MESSAGE {
 		err = i.node.Datastore.Chat().Put(msgID.B58String(), "", chat.Subject, chat.Message, t, false, true)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if len(stored) > 0 {
+			if err := i.node.Datastore.Chat().PutAttachments(msgID.B58String(), stored); err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
 	}
 	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
 }
@@ -2642,6 +3075,25 @@ func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, string(ret))
 }
 
+func (i *jsonAPIHandler) GETChatAttachment(w http.ResponseWriter, r *http.Request) {
+	_, hash := path.Split(r.URL.Path)
+	attachment, data, err := i.node.GetChatAttachment(hash)
+	if err == core.ErrChatAttachmentNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	// The content is chosen by the sender so the browser mustn't sniff or
+	// render it as part of the UI
+	w.Header().Set("Content-Type", attachment.MimeType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	http.ServeContent(w, r, "", time.Now(), bytes.NewReader(data))
+}
+
 func (i *jsonAPIHandler) GETChatConversations(w http.ResponseWriter, r *http.Request) {
 	conversations := i.node.Datastore.Chat().GetConversations()
 	ret, err := json.MarshalIndent(conversations, "", "    ")
@@ -3594,6 +4046,55 @@ func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Reques
 	fmt.Fprintf(w, "%s", amount.String())
 }
 
+func (i *jsonAPIHandler) POSTEstimateCart(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data repo.CartData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	estimate, err := i.node.EstimateCartTotal(&data)
+	if err == core.ErrEmptyCart {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ser, err := json.MarshalIndent(estimate, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) POSTCart(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data repo.CartData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	checkout, err := i.node.CheckoutCart(&data)
+	switch {
+	case err == core.ErrEmptyCart || err == core.ErrInsufficientFunds:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		RenderJSONOrStringError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ser, err := json.MarshalIndent(checkout, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
 func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 	urlPath, slug := path.Split(r.URL.Path)
 	_, peerID := path.Split(urlPath[:len(urlPath)-1])
@@ -3688,6 +4189,8 @@ func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
 		return
 	}
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	i.node.AttachRatingReply(rating, useCache)
 	ret, err := json.MarshalIndent(rating, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -3727,6 +4230,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 				if !valid || err != nil {
 					return
 				}
+				i.node.AttachRatingReply(rating, true)
 				m := jsonpb.Marshaler{
 					EnumsAsInts:  false,
 					EmitDefaults: true,
@@ -3810,6 +4314,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 					respondWithError(err.Error())
 					return
 				}
+				i.node.AttachRatingReply(rating, true)
 				resp := new(pb.RatingWithID)
 				resp.Id = id
 				resp.RatingId = rid
@@ -3837,21 +4342,268 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 }
 
 func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Request) {
-	file, _, err := r.FormFile("file")
+	file, header, err := r.FormFile("file")
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 	defer file.Close()
 
-	// TODO: add the import listings function call
+	format := r.FormValue("format")
+	if format == "" {
+		format = core.ListingImportFormatFromFilename(header.Filename)
+	}
+	report, err := i.node.ImportListings(file, format)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	// Republish to IPNS
-	if err := i.node.SeedNode(); err != nil {
+	if len(report.Created) > 0 || len(report.Updated) > 0 {
+		// Republish to IPNS
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	ret, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, "{}")
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETExportListings(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = core.ListingExportFormatCSV
+	}
+	format = strings.ToLower(format)
+
+	var contentType string
+	switch format {
+	case core.ListingExportFormatCSV:
+		contentType = "text/csv"
+	case core.ListingExportFormatZip:
+		contentType = "application/zip"
+	default:
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown export format (%s)", format))
+		return
+	}
+
+	// Buffer the export so a failure can still be returned as an error response
+	var buf bytes.Buffer
+	if err := i.node.ExportListings(&buf, format); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"listings.%s\"", format))
+	w.Write(buf.Bytes())
+}
+
+func (i *jsonAPIHandler) POSTListingSchedule(w http.ResponseWriter, r *http.Request) {
+	var schedule struct {
+		Slug           string     `json:"slug"`
+		PublishAt      *time.Time `json:"publishAt"`
+		RepublishHours uint32     `json:"republishHours"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if schedule.Slug == "" {
+		ErrorResponse(w, http.StatusBadRequest, "slug must be set")
+		return
+	}
+	ret, err := i.node.ScheduleListing(schedule.Slug, schedule.PublishAt, schedule.RepublishHours)
+	if os.IsNotExist(err) {
+		ErrorResponse(w, http.StatusNotFound, "Listing not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) GETListingSchedules(w http.ResponseWriter, r *http.Request) {
+	schedules, err := i.node.Datastore.ListingSchedules().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if schedules == nil {
+		schedules = []repo.ListingSchedule{}
+	}
+	ret, err := json.MarshalIndent(schedules, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEListingSchedule(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	err := i.node.DeleteListingSchedule(slug)
+	if err == sql.ErrNoRows {
+		ErrorResponse(w, http.StatusNotFound, "Listing schedule not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// parseRepublishHours parses the optional republishHours query parameter
+func parseRepublishHours(s string) (uint32, error) {
+	if s == "" {
+		return 0, nil
+	}
+	hours, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("republishHours must be a whole number of hours")
+	}
+	return uint32(hours), nil
+}
+
+func (i *jsonAPIHandler) POSTAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name    string    `json:"name"`
+		Scopes  []string  `json:"scopes"`
+		Expires time.Time `json:"expires"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	scopes := make([]repo.APITokenScope, 0, len(req.Scopes))
+	for _, name := range req.Scopes {
+		scope, err := repo.ParseAPITokenScope(name)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		scopes = append(scopes, scope)
+	}
+	token, bearer, err := i.node.CreateAPIToken(req.Name, scopes, req.Expires)
+	if err == core.ErrAPITokenNoScopes || err == core.ErrAPITokenExpired {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	// The bearer token is only ever returned here
+	ret, err := json.MarshalIndent(struct {
+		*repo.APIToken
+		Token string `json:"token"`
+	}{token, bearer}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETAPITokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := i.node.Datastore.APITokens().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if tokens == nil {
+		tokens = []repo.APIToken{}
+	}
+	ret, err := json.MarshalIndent(tokens, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEAPIToken(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	err := i.node.RevokeAPIToken(id)
+	if err == sql.ErrNoRows {
+		ErrorResponse(w, http.StatusNotFound, "API token not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETSearch(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		ErrorResponse(w, http.StatusBadRequest, "search query (q) must not be empty")
+		return
+	}
+
+	var scopes []string
+	if s := r.URL.Query().Get("scope"); s != "" {
+		for _, scope := range strings.Split(s, ",") {
+			scope = strings.ToLower(strings.TrimSpace(scope))
+			known := false
+			for _, k := range repo.SearchScopes {
+				if scope == k {
+					known = true
+					break
+				}
+			}
+			if !known {
+				ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown search scope (%s)", scope))
+				return
+			}
+			scopes = append(scopes, scope)
+		}
+	}
+
+	limit, offset := 50, 0
+	var err error
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if limit, err = strconv.Atoi(l); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if offset, err = strconv.Atoi(o); err != nil || offset < 0 {
+			ErrorResponse(w, http.StatusBadRequest, "offset must be a non-negative integer")
+			return
+		}
+	}
+
+	results, total, err := i.node.Datastore.Search().Search(query, scopes, limit, offset)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	type searchResponse struct {
+		Query   string              `json:"query"`
+		Total   int                 `json:"total"`
+		Results []repo.SearchResult `json:"results"`
+	}
+	ret, err := json.MarshalIndent(searchResponse{query, total, results}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
 }
 
 func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -4258,6 +5010,9 @@ func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := i.node.AnnouncePost(signedPost); err != nil {
+		log.Errorf("announcing post (%s): %s", signedPost.Post.Slug, err.Error())
+	}
 	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, signedPost.Post.Slug))
 }
 
@@ -4325,6 +5080,9 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := i.node.AnnouncePost(signedPost); err != nil {
+		log.Errorf("announcing post (%s): %s", signedPost.Post.Slug, err.Error())
+	}
 	SanitizedResponse(w, `{}`)
 }
 
@@ -4532,3 +5290,404 @@ func (i *jsonAPIHandler) POSTHashMessage(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, fmt.Sprintf(`{"hash": "%s"}`,
 		messageHash.B58String()))
 }
+
+func (i *jsonAPIHandler) POSTSubscription(w http.ResponseWriter, r *http.Request) {
+	var data struct {
+		repo.PurchaseData
+		SpendCap string `json:"spendCap"`
+		FeeLevel string `json:"feeLevel"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	subscription, err := i.node.Subscribe(&data.PurchaseData, data.SpendCap, data.FeeLevel)
+	switch {
+	case err == core.ErrSubscriptionItems || err == core.ErrSubscriptionSpendCap || err == core.ErrNotSubscriptionListing:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		RenderJSONOrStringError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret, err := json.MarshalIndent(subscription, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETSubscriptions(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	var (
+		ret []byte
+		err error
+	)
+	if id != "" && id != "subscriptions" {
+		subscription, err := i.node.Datastore.Subscriptions().Get(id)
+		if err == sql.ErrNoRows {
+			ErrorResponse(w, http.StatusNotFound, "Subscription not found.")
+			return
+		} else if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret, err = json.MarshalIndent(subscription, "", "    ")
+	} else {
+		subscriptions, err := i.node.Datastore.Subscriptions().GetAll()
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if subscriptions == nil {
+			subscriptions = []repo.Subscription{}
+		}
+		ret, err = json.MarshalIndent(subscriptions, "", "    ")
+	}
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTPauseSubscription(w http.ResponseWriter, r *http.Request) {
+	i.changeSubscription(w, r, i.node.PauseSubscription)
+}
+
+func (i *jsonAPIHandler) POSTResumeSubscription(w http.ResponseWriter, r *http.Request) {
+	i.changeSubscription(w, r, i.node.ResumeSubscription)
+}
+
+func (i *jsonAPIHandler) POSTCancelSubscription(w http.ResponseWriter, r *http.Request) {
+	i.changeSubscription(w, r, i.node.CancelSubscription)
+}
+
+// changeSubscription applies change to the subscription with the ID given
+// in the request body and responds with the updated subscription
+func (i *jsonAPIHandler) changeSubscription(w http.ResponseWriter, r *http.Request, change func(id string) (*repo.Subscription, error)) {
+	var data struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	subscription, err := change(data.ID)
+	switch {
+	case err == sql.ErrNoRows:
+		ErrorResponse(w, http.StatusNotFound, "Subscription not found.")
+		return
+	case err == core.ErrSubscriptionCanceled:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(subscription, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// GETCrowdFund returns the progress of one of the node's crowdfunding
+// listings and the pledges it has received
+func (i *jsonAPIHandler) GETCrowdFund(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	if slug == "" || slug == "crowdfund" {
+		ErrorResponse(w, http.StatusBadRequest, "slug is required")
+		return
+	}
+	progress, err := i.node.GetCrowdFundProgress(slug)
+	if os.IsNotExist(err) {
+		ErrorResponse(w, http.StatusNotFound, "Listing not found.")
+		return
+	} else if err == core.ErrNotCrowdFundListing {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	pledges, err := i.node.Datastore.CrowdFundPledges().GetBySlug(slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if pledges == nil {
+		pledges = []repo.CrowdFundPledge{}
+	}
+	ret, err := json.MarshalIndent(struct {
+		*repo.CrowdFundProgress
+		Pledges []repo.CrowdFundPledge `json:"pledges"`
+	}{progress, pledges}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// GETMetrics serves the node's metrics in the Prometheus text format. It is
+// only available when metrics are enabled in the JSON-API config.
+func (i *jsonAPIHandler) GETMetrics(w http.ResponseWriter, r *http.Request) {
+	if !i.config.Metrics {
+		ErrorResponse(w, http.StatusNotFound, "metrics are disabled")
+		return
+	}
+	promhttp.HandlerFor(metrics.Gatherer(), promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
+
+func (i *jsonAPIHandler) GETPeerBans(w http.ResponseWriter, r *http.Request) {
+	bans, err := i.node.GetPeerBans()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if bans == nil {
+		bans = []repo.PeerBan{}
+	}
+	ret, err := json.MarshalIndent(bans, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEPeerBan(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.LiftPeerBan(pid); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// unmarshalDisputeEvidenceItems parses the evidence items in an API request
+func unmarshalDisputeEvidenceItems(raw []json.RawMessage) ([]*pb.DisputeEvidence_Item, error) {
+	items := make([]*pb.DisputeEvidence_Item, 0, len(raw))
+	for _, r := range raw {
+		item := new(pb.DisputeEvidence_Item)
+		if err := jsonpb.UnmarshalString(string(r), item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (i *jsonAPIHandler) POSTDisputeEvidence(w http.ResponseWriter, r *http.Request) {
+	type submission struct {
+		OrderID string            `json:"orderId"`
+		Items   []json.RawMessage `json:"items"`
+	}
+	var s submission
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := unmarshalDisputeEvidenceItems(s.Items)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	evidence, err := i.node.SubmitDisputeEvidence(s.OrderID, items)
+	switch {
+	case err == core.ErrOrderNotFound:
+		ErrorResponse(w, http.StatusNotFound, "Order not found")
+		return
+	case err == core.ErrDisputeNotOpen:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(evidence)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.DisputeEvidence))
+}
+
+func (i *jsonAPIHandler) POSTRatingReply(w http.ResponseWriter, r *http.Request) {
+	type ratingReply struct {
+		RatingID string `json:"ratingId"`
+		Message  string `json:"message"`
+	}
+	var rr ratingReply
+	if err := json.NewDecoder(r.Body).Decode(&rr); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reply, err := i.node.ReplyToRating(rr.RatingID, rr.Message)
+	switch {
+	case err == core.ErrRatingNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(reply)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.RatingReply))
+}
+
+func (i *jsonAPIHandler) DELETERatingReply(w http.ResponseWriter, r *http.Request) {
+	_, ratingID := path.Split(r.URL.Path)
+	err := i.node.DeleteRatingReply(ratingID)
+	switch {
+	case err == core.ErrRatingNotFound || err == core.ErrRatingReplyNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// GETFeed returns the cached posts of the peers the node follows, newest
+// first. The results can be filtered by tag and by a comma separated list of
+// channels and paged with limit and offsetId.
+func (i *jsonAPIHandler) GETFeed(w http.ResponseWriter, r *http.Request) {
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		limit = "-1"
+	}
+	l, err := strconv.Atoi(limit)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	offsetID := r.URL.Query().Get("offsetId")
+	tag := r.URL.Query().Get("tag")
+	var channels []string
+	for _, c := range strings.Split(r.URL.Query().Get("channels"), ",") {
+		if c != "" {
+			channels = append(channels, c)
+		}
+	}
+
+	posts, total, err := i.node.Datastore.FeedPosts().GetAll(offsetID, l, tag, channels)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	type feedData struct {
+		Total int               `json:"total"`
+		Posts []json.RawMessage `json:"posts"`
+	}
+	payload := feedData{total, []json.RawMessage{}}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	for _, post := range posts {
+		out, err := m.MarshalToString(post)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		payload.Posts = append(payload.Posts, json.RawMessage(out))
+	}
+	ret, err := json.MarshalIndent(payload, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETAnnouncementSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subs, err := i.node.Datastore.AnnouncementSubscriptions().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if subs == nil {
+		subs = []repo.AnnouncementSubscription{}
+	}
+	ret, err := json.MarshalIndent(subs, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTAnnouncementSubscription(w http.ResponseWriter, r *http.Request) {
+	var data repo.AnnouncementSubscription
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sub, err := i.node.SubscribeAnnouncements(data.Kind, data.Name)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(sub, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// DELETEAnnouncementSubscription unsubscribes from the channel or peer in
+// the path, /ob/announcementsubscriptions/{kind}/{name}
+func (i *jsonAPIHandler) DELETEAnnouncementSubscription(w http.ResponseWriter, r *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/ob/announcementsubscriptions/"), "/", 2)
+	if len(parts) != 2 {
+		ErrorResponse(w, http.StatusBadRequest, "the kind and name of the subscription are required")
+		return
+	}
+	err := i.node.UnsubscribeAnnouncements(parts[0], parts[1])
+	switch {
+	case err == core.ErrAnnouncementSubscriptionNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 	"gx/ipfs/Qmc85NSvmSG4Frn9Vb2cBc1rMyULH6D3TNVEfCzSKoUpip/go-multiaddr-net"
 
@@ -219,6 +220,26 @@ func httpGet(endpoint string) ([]byte, error) {
 	return ioutil.ReadAll(resp.Body)
 }
 
+func httpPost(endpoint string, body []byte) ([]byte, error) {
+	req, err := buildRequest("POST", endpoint, string(body))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("POST %s returned %d: %s", endpoint, resp.StatusCode, b)
+	}
+	return b, nil
+}
+
 func jsonFor(t *testing.T, fixture proto.Message) string {
 	m := jsonpb.Marshaler{}
 
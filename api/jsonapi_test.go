@@ -1,12 +1,15 @@
 package api
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +20,8 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/openbazaar-go/test"
 	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func TestMain(m *testing.M) {
@@ -112,7 +117,11 @@ func TestSettingsSetModerator(t *testing.T) {
 			"QmeRfQcEiefLYgEFRsNqn1WjjrLjrJVAddt85htU1Up32y"
 	],
 	"termsAndConditions": "Terms and Conditions",
-	"version": ""
+	"version": "",
+	"webhookSettings": {
+			"endpoints": [],
+			"notifications": false
+	}
 }`
 	runAPITests(t, apiTests{
 		{"POST", "/ob/settings", string(jsonSettings), 200, string(jsonSettings)},
@@ -685,6 +694,54 @@ func TestListings(t *testing.T) {
 	})
 }
 
+func TestListingSchedules(t *testing.T) {
+	goodListingJSON := jsonFor(t, factory.NewListing("ron-swanson-tshirt"))
+
+	runAPITests(t, apiTests{
+		{"GET", "/ob/listingschedules", "", 200, `[]`},
+		{"POST", "/ob/listingschedule", `{"slug": "ron-swanson-tshirt", "republishHours": 24}`, 404, errorResponseJSON(errors.New("Listing not found."))},
+		{"POST", "/ob/listing?publishAt=tomorrow", goodListingJSON, 400, errorResponseJSON(errors.New("publishAt must be an RFC3339 timestamp"))},
+		{"POST", "/ob/listing?publishAt=2037-01-01T00:00:00Z&republishHours=24", goodListingJSON, 200, `{"slug": "ron-swanson-tshirt"}`},
+		{"GET", "/ob/listings", "", 200, `[]`},
+		{"DELETE", "/ob/listingschedule/ron-swanson-tshirt", "", 200, `{}`},
+		{"DELETE", "/ob/listingschedule/ron-swanson-tshirt", "", 404, errorResponseJSON(errors.New("Listing schedule not found."))},
+		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 200, `{}`},
+	})
+}
+
+func TestLowStockThresholds(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/lowstockthresholds", "", 200, `{}`},
+		{"GET", "/ob/inventoryreservations", "", 200, `[]`},
+		{"POST", "/ob/lowstockthresholds", `[{"slug": "ron-swanson-tshirt", "threshold": "-1"}]`, 400, errorResponseJSON(errors.New("error parsing threshold"))},
+		{"POST", "/ob/lowstockthresholds", `[{"slug": "ron-swanson-tshirt", "threshold": "3"}]`, 200, `{}`},
+		{"GET", "/ob/lowstockthresholds", "", 200, `{"ron-swanson-tshirt": "3"}`},
+		{"DELETE", "/ob/lowstockthreshold/ron-swanson-tshirt", "", 200, `{}`},
+		{"DELETE", "/ob/lowstockthreshold/ron-swanson-tshirt", "", 404, errorResponseJSON(errors.New("Low stock threshold not found."))},
+		{"GET", "/ob/lowstockthresholds", "", 200, `{}`},
+	})
+}
+
+func TestSearch(t *testing.T) {
+	listing := factory.NewListing("pawnee-mug")
+	listing.Item.Title = "Pawnee Goddesses Mug"
+	listing.Item.Tags = []string{"crockery"}
+
+	runAPITests(t, apiTests{
+		{"GET", "/ob/search", "", 400, errorResponseJSON(errors.New("search query (q) must not be empty"))},
+		{"GET", "/ob/search?q=mug&scope=listings,bogus", "", 400, errorResponseJSON(errors.New("unknown search scope (bogus)"))},
+		{"GET", "/ob/search?q=goddess", "", 200, `{"query": "goddess", "total": 0, "results": []}`},
+
+		{"POST", "/ob/listing", jsonFor(t, listing), 200, `{"slug": "pawnee-mug"}`},
+		{"GET", "/ob/search?q=goddess&scope=listings", "", 200, `{"query": "goddess", "total": 1, "results": [{"scope": "listings", "id": "pawnee-mug", "title": "Pawnee Goddesses Mug", "snippet": "Pawnee Goddesses Mug"}]}`},
+		{"GET", "/ob/search?q=crock", "", 200, `{"query": "crock", "total": 1, "results": [{"scope": "listings", "id": "pawnee-mug", "title": "Pawnee Goddesses Mug", "snippet": "crockery tshirts"}]}`},
+		{"GET", "/ob/search?q=goddess&scope=chats", "", 200, `{"query": "goddess", "total": 0, "results": []}`},
+
+		{"DELETE", "/ob/listing/pawnee-mug", "", 200, `{}`},
+		{"GET", "/ob/search?q=goddess", "", 200, `{"query": "goddess", "total": 0, "results": []}`},
+	})
+}
+
 func TestCryptoListings(t *testing.T) {
 	listing := factory.NewCryptoListing("crypto")
 	updatedListing := *listing
@@ -1346,3 +1403,426 @@ func TestResendOrderMessage(t *testing.T) {
 //{"POST", "/ob/closedispute", nonexpiredPostJSON, 200, anyResponseJSON},
 //}, dbSetup, nil)
 //}
+
+func TestAPIRoleAllowedPath(t *testing.T) {
+	type roleTest struct {
+		role    repo.APIRole
+		method  string
+		path    string
+		allowed bool
+	}
+	tests := []roleTest{
+		{repo.APIRoleOwner, "POST", "/wallet/spend", true},
+		{repo.APIRoleOwner, "GET", "/wallet/mnemonic", true},
+		{repo.APIRoleReadOnly, "GET", "/ob/sales", true},
+		{repo.APIRoleReadOnly, "GET", "/wallet/mnemonic", false},
+		{repo.APIRoleReadOnly, "GET", "/ob/settings", false},
+		{repo.APIRoleReadOnly, "POST", "/ob/purchases", true},
+		{repo.APIRoleReadOnly, "POST", "/ob/chat", false},
+		{repo.APIRoleClerk, "POST", "/ob/orderfulfillment", true},
+		{repo.APIRoleClerk, "POST", "/ob/fetchprofiles", true},
+		{repo.APIRoleClerk, "POST", "/ob/closedispute", false},
+		{repo.APIRoleClerk, "PUT", "/ob/profile", false},
+		{repo.APIRoleClerk, "DELETE", "/ob/listing/slug", false},
+		{repo.APIRoleModerator, "POST", "/ob/closedispute", true},
+		{repo.APIRoleModerator, "POST", "/ob/orderfulfillment", false},
+		{repo.APIRoleReadOnly, "HEAD", "/ob/listings", true},
+		{repo.APIRoleReadOnly, "GET", "/ob/exportlistings", true},
+		{repo.APIRoleReadOnly, "GET", "/ob/chatmessages", false},
+		{repo.APIRoleClerk, "GET", "/ob/chatmessages", true},
+		{repo.APIRoleModerator, "GET", "/ob/chatconversations", true},
+		{repo.APIRoleOwner, "GET", "/ob/apitokens", true},
+		{repo.APIRoleOwner, "GET", "/ob/scanofflinemessages", true},
+		{repo.APIRoleReadOnly, "GET", "/ob/unknownroute", false},
+	}
+	// Routes which reveal credentials, hand out wallet addresses or
+	// administer the node are for owners only
+	for _, role := range []repo.APIRole{repo.APIRoleReadOnly, repo.APIRoleClerk, repo.APIRoleModerator} {
+		for _, path := range []string{"/wallet/address", "/wallet/mnemonic", "/ob/settings", "/ob/scanofflinemessages", "/ob/apitokens", "/ob/peerbans"} {
+			tests = append(tests, roleTest{role, "GET", path, false})
+		}
+	}
+	for _, tc := range tests {
+		if got := apiRoleAllowedPath(tc.role, tc.path, tc.method); got != tc.allowed {
+			t.Errorf("expected %s %s %s allowed to be %t", tc.role, tc.method, tc.path, tc.allowed)
+		}
+	}
+}
+
+func TestAPIUserRoles(t *testing.T) {
+	repository, err := test.ResetRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := sha256.Sum256([]byte("clerkpass"))
+	user := repo.APIUser{
+		Username:     "clerk",
+		PasswordHash: hex.EncodeToString(hash[:]),
+		Role:         repo.APIRoleClerk,
+		Created:      time.Now(),
+	}
+	if err := repository.DB.APIUsers().Put(user); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method   string
+		path     string
+		password string
+		status   int
+	}{
+		{"GET", "/ob/notifications", "clerkpass", http.StatusOK},
+		{"POST", "/ob/marknotificationsasread", "clerkpass", http.StatusOK},
+		{"GET", "/ob/notifications", "wrongpass", http.StatusForbidden},
+		{"GET", "/wallet/mnemonic", "clerkpass", http.StatusForbidden},
+		{"POST", "/wallet/spend", "clerkpass", http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		req, err := buildRequest(tc.method, tc.path, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(user.Username, tc.password)
+		resp, err := testHTTPClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s %s: expected status %d, got %d", tc.method, tc.path, tc.status, resp.StatusCode)
+		}
+	}
+}
+
+func TestAPITokens(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/apitokens", `{"name":"sync","scopes":["listings:admin"]}`, http.StatusBadRequest, errorResponseJSON(errors.New("unknown API token scope (listings:admin)"))},
+		{"POST", "/ob/apitokens", `{"name":"sync","scopes":[]}`, http.StatusBadRequest, errorResponseJSON(core.ErrAPITokenNoScopes)},
+		{"POST", "/ob/apitokens", `{"name":"sync","scopes":["orders:read"],"expires":"2001-01-01T00:00:00Z"}`, http.StatusBadRequest, errorResponseJSON(core.ErrAPITokenExpired)},
+		{"DELETE", "/ob/apitokens/missing", "", http.StatusNotFound, errorResponseJSON(errors.New("API token not found."))},
+	})
+}
+
+func TestPeerBans(t *testing.T) {
+	banPeer := func(testRepo *test.Repository) error {
+		return testRepo.DB.PeerBans().Put(repo.PeerBan{
+			PeerID:  "QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e",
+			Reason:  "exceeded the chat message rate limit",
+			Created: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expires: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+	runAPITestsWithSetup(t, apiTests{
+		{"GET", "/ob/peerbans", "", http.StatusOK, `[{
+			"peerID": "QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e",
+			"reason": "exceeded the chat message rate limit",
+			"created": "` + time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Local().Format(time.RFC3339) + `",
+			"expires": "` + time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC).Local().Format(time.RFC3339) + `"
+		}]`},
+		{"DELETE", "/ob/peerbans/invalid", "", http.StatusBadRequest, anyResponseJSON},
+		{"DELETE", "/ob/peerbans/QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", "", http.StatusOK, "{}"},
+		{"GET", "/ob/peerbans", "", http.StatusOK, "[]"},
+	}, banPeer, nil)
+}
+
+func TestDisputeEvidence(t *testing.T) {
+	evidence := &pb.DisputeEvidence{
+		OrderId:     "evidence-case",
+		SubmittedBy: "QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e",
+		Timestamp:   &timestamp.Timestamp{Seconds: 1000},
+		Items: []*pb.DisputeEvidence_Item{
+			{Type: pb.DisputeEvidence_Item_IMAGE, ImageHash: "zb2rhj7crUKTQYRGCRATFaQ6YFLTde2YzdqbbhAASkL9uRDXn", Filename: "parcel.jpg"},
+		},
+		Signature: []byte("signature"),
+	}
+	openCase := func(testRepo *test.Repository) error {
+		if err := testRepo.DB.Cases().Put("evidence-case", pb.OrderState_DISPUTED, true, "Never arrived", "BTC", "BTC"); err != nil {
+			return err
+		}
+		if err := testRepo.DB.Cases().UpdateBuyerInfo("evidence-case", factory.NewDisputeableContract(), nil, "", nil); err != nil {
+			return err
+		}
+		return testRepo.DB.DisputeEvidence().Put("evidence-case", evidence)
+	}
+	runAPITestsWithSetup(t, apiTests{
+		{"POST", "/ob/disputeevidence", `{"orderId":"missing","items":[{"type":"STATEMENT","statement":"Never arrived"}]}`, http.StatusNotFound, anyResponseJSON},
+		{"POST", "/ob/disputeevidence", `{"orderId":"missing","items":[{"type":"VIDEO"}]}`, http.StatusBadRequest, anyResponseJSON},
+	}, openCase, nil)
+
+	testRepo, err := test.NewRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testRepo.DB.Cases().Delete("evidence-case")
+	defer testRepo.DB.DisputeEvidence().DeleteByCaseID("evidence-case")
+
+	respBytes, err := httpGet("/ob/case/evidence-case")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := new(pb.CaseRespApi)
+	if err := jsonpb.UnmarshalString(string(respBytes), resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Evidence) != 1 || !proto.Equal(resp.Evidence[0], evidence) {
+		t.Errorf("expected the case to include the evidence, got %v", resp.Evidence)
+	}
+}
+
+func TestRatingReplies(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/ratingreply", `{"ratingId":"invalid","message":"Sorry for the delay"}`, http.StatusNotFound, anyResponseJSON},
+		{"POST", "/ob/ratingreply", `{"ratingId":`, http.StatusBadRequest, anyResponseJSON},
+		{"DELETE", "/ob/ratingreply/invalid", "", http.StatusNotFound, anyResponseJSON},
+	})
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	_, err := test.ResetRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := httpPost("/ob/apitokens", []byte(`{"name":"dashboard","scopes":["orders:read"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created struct {
+		ID     string   `json:"id"`
+		Token  string   `json:"token"`
+		Scopes []string `json:"scopes"`
+	}
+	if err := json.Unmarshal(b, &created); err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.Token == "" || len(created.Scopes) != 1 {
+		t.Fatalf("unexpected token response: %s", b)
+	}
+
+	bearerStatus := func(method, endpoint string) int {
+		req, err := buildRequest(method, endpoint, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+created.Token)
+		resp, err := testHTTPClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	tests := []struct {
+		method   string
+		endpoint string
+		status   int
+	}{
+		{"GET", "/ob/sales", http.StatusOK},
+		{"GET", "/ob/inventory", http.StatusForbidden},
+		{"POST", "/wallet/spend", http.StatusForbidden},
+		{"POST", "/ob/apitokens", http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		if status := bearerStatus(tc.method, tc.endpoint); status != tc.status {
+			t.Errorf("%s %s: expected status %d, got %d", tc.method, tc.endpoint, tc.status, status)
+		}
+	}
+
+	// Revoked tokens are refused
+	req, err := buildRequest("DELETE", "/ob/apitokens/"+created.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected revoke to succeed, got %d", resp.StatusCode)
+	}
+	if status := bearerStatus("GET", "/ob/sales"); status != http.StatusForbidden {
+		t.Errorf("expected revoked token to be refused, got %d", status)
+	}
+}
+
+func TestPartialRefund(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/refund", `{"orderId":"123","amount":"0.5"}`, http.StatusBadRequest, errorResponseJSON(errors.New("amount must be a whole number in the base units of the payment currency"))},
+		{"POST", "/ob/refund", `{"orderId":"123","amount":"500"}`, http.StatusNotFound, errorResponseJSON(errors.New("order not found"))},
+	})
+}
+
+func TestCart(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/cart/estimate", `{"paymentCoin":"TBTC","items":[]}`, http.StatusBadRequest, errorResponseJSON(core.ErrEmptyCart)},
+		{"POST", "/ob/cart", `{"paymentCoin":"TBTC","items":[],"fund":true}`, http.StatusBadRequest, errorResponseJSON(core.ErrEmptyCart)},
+	})
+}
+
+func TestSubscriptions(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/subscriptions", "", 200, `[]`},
+		{"GET", "/ob/subscriptions/missing", "", http.StatusNotFound, errorResponseJSON(errors.New("Subscription not found."))},
+		{"POST", "/ob/subscriptions", `{"paymentCoin":"TBTC","items":[],"spendCap":"1000"}`, http.StatusBadRequest, errorResponseJSON(core.ErrSubscriptionItems)},
+		{"POST", "/ob/pausesubscription", `{"id":"missing"}`, http.StatusNotFound, errorResponseJSON(errors.New("Subscription not found."))},
+		{"POST", "/ob/cancelsubscription", `{"id":"missing"}`, http.StatusNotFound, errorResponseJSON(errors.New("Subscription not found."))},
+	})
+}
+
+func TestCrowdFund(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", jsonFor(t, factory.NewListing("ron-swanson-tshirt")), 200, anyResponseJSON},
+		{"GET", "/ob/crowdfund/ron-swanson-tshirt", "", http.StatusBadRequest, errorResponseJSON(core.ErrNotCrowdFundListing)},
+		{"GET", "/ob/crowdfund/missing", "", http.StatusNotFound, errorResponseJSON(errors.New("Listing not found."))},
+		{"GET", "/ob/crowdfund/", "", http.StatusBadRequest, errorResponseJSON(errors.New("slug is required"))},
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/listings", "", 200, anyResponseJSON},
+	})
+
+	req, err := buildRequest("GET", "/ob/metrics", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("Wanted status 200, got %d", resp.StatusCode)
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") {
+		t.Errorf("expected prometheus text format, got %s", resp.Header.Get("Content-Type"))
+	}
+	expected := `openbazaar_api_request_duration_seconds_count{method="GET",route="/ob/listings"}`
+	if !strings.Contains(string(respBody), expected) {
+		t.Errorf("expected metrics to contain %s", expected)
+	}
+}
+
+func TestFeed(t *testing.T) {
+	dbSetup := func(testRepo *test.Repository) error {
+		posts := map[string]*pb.SignedPost{
+			"a": {Hash: "a", Post: &pb.Post{Slug: "a", Tags: []string{"lamps"}, Timestamp: &timestamp.Timestamp{Seconds: 1000}}},
+			"b": {Hash: "b", Post: &pb.Post{Slug: "b", Channels: []string{"deals"}, Timestamp: &timestamp.Timestamp{Seconds: 3000}}},
+			"c": {Hash: "c", Post: &pb.Post{Slug: "c", Timestamp: &timestamp.Timestamp{Seconds: 2000}}},
+		}
+		for _, post := range posts {
+			if err := testRepo.DB.FeedPosts().Put("QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", post); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	runAPITestsWithSetup(t, apiTests{
+		{"GET", "/ob/feed?limit=x", "", http.StatusBadRequest, anyResponseJSON},
+	}, dbSetup, nil)
+
+	feedHashes := func(endpoint string) (int, []string) {
+		respBody, err := httpGet(endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp struct {
+			Total int
+			Posts []struct {
+				Hash string
+			}
+		}
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			t.Fatal(err)
+		}
+		var hashes []string
+		for _, p := range resp.Posts {
+			hashes = append(hashes, p.Hash)
+		}
+		return resp.Total, hashes
+	}
+	for endpoint, expected := range map[string]string{
+		"/ob/feed":                     "b c a",
+		"/ob/feed?limit=1&offsetId=b":  "c",
+		"/ob/feed?tag=Lamps":           "a",
+		"/ob/feed?channels=news,deals": "b",
+	} {
+		_, hashes := feedHashes(endpoint)
+		if strings.Join(hashes, " ") != expected {
+			t.Errorf("%s: expected posts %s, got %v", endpoint, expected, hashes)
+		}
+	}
+	if total, _ := feedHashes("/ob/feed?limit=1"); total != 3 {
+		t.Errorf("expected 3 posts in total, got %d", total)
+	}
+}
+
+func TestAnnouncementSubscriptions(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/announcementsubscriptions", "", 200, `[]`},
+		{"POST", "/ob/announcementsubscriptions", `{"kind":"tag","name":"deals"}`, 400, errorResponseJSON(core.ErrAnnouncementSubscriptionKind)},
+		{"POST", "/ob/announcementsubscriptions", `{"kind":"peer","name":"invalid"}`, 400, anyResponseJSON},
+		{"POST", "/ob/announcementsubscriptions", `{"kind":"channel","name":"Deals"}`, 200, anyResponseJSON},
+		{"DELETE", "/ob/announcementsubscriptions/channel/news", "", 404, errorResponseJSON(core.ErrAnnouncementSubscriptionNotFound)},
+		{"DELETE", "/ob/announcementsubscriptions/channel", "", 400, anyResponseJSON},
+	})
+
+	respBody, err := httpGet("/ob/announcementsubscriptions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var subs []repo.AnnouncementSubscription
+	if err := json.Unmarshal(respBody, &subs); err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].Kind != "channel" || subs[0].Name != "deals" {
+		t.Errorf("expected a subscription to the deals channel, got %+v", subs)
+	}
+
+	runAPITests(t, apiTests{
+		{"POST", "/ob/announcementsubscriptions", `{"kind":"channel","name":"deals"}`, 200, anyResponseJSON},
+		{"DELETE", "/ob/announcementsubscriptions/channel/deals", "", 200, `{}`},
+		{"GET", "/ob/announcementsubscriptions", "", 200, `[]`},
+	})
+}
+
+func TestChatAttachments(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/chatattachment/QmfQkD8pBSBCBxWEwFSu4XaDVSWK6bjnNuaWZjMyQbyDub", "", 404, errorResponseJSON(core.ErrChatAttachmentNotFound)},
+		{"POST", "/ob/chat", `{"peerId":"QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e","message":"photo","attachments":[{"filename":"damage.png","data":""}]}`, 400, errorResponseJSON(errors.New("attachment 0: file is empty"))},
+		{"POST", "/ob/chat", `{"peerId":"QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e","attachments":[{"filename":"../damage.png","data":"aGVsbG8="}]}`, 400, errorResponseJSON(errors.New("attachment 0: filename must not contain a path"))},
+		{"POST", "/ob/groupchat", `{"peerIds":["QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e"],"subject":"order1","attachments":[{"filename":"note.txt","mimeType":"not a type","data":"aGVsbG8="}]}`, 400, errorResponseJSON(errors.New("attachment 0: invalid mime type"))},
+	})
+}
+
+func TestModeratorStats(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/moderators?sortBy=rating", "", 400, errorResponseJSON(core.ErrModeratorSortKey)},
+		{"GET", "/ob/moderators?feeType=weird", "", 400, errorResponseJSON(errors.New("unknown fee type weird"))},
+		{"GET", "/ob/moderators?minCasesHandled=-1", "", 400, anyResponseJSON},
+	})
+
+	respBody, err := httpGet("/ob/moderatorstats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := new(pb.ModeratorWithStats)
+	if err := jsonpb.UnmarshalString(string(respBody), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Stats == nil || resp.Stats.Stats == nil || resp.Stats.Stats.ModeratorID == nil || len(resp.Stats.Signature) == 0 {
+		t.Fatalf("expected the node's signed statistics, got %s", respBody)
+	}
+	if resp.PeerId != resp.Stats.Stats.ModeratorID.PeerID {
+		t.Errorf("expected the statistics of %s, got %s", resp.PeerId, resp.Stats.Stats.ModeratorID.PeerID)
+	}
+}
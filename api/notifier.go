@@ -15,11 +15,16 @@ import (
 // which is listened by websocket API, while adding specific handling for
 // each received object.
 type notificationManager struct {
-	node *core.OpenBazaarNode
+	node     *core.OpenBazaarNode
+	webhooks *webhookDispatcher
 }
 
 func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.Notifier {
-	manager := &notificationManager{node: node}
+	manager := &notificationManager{
+		node:     node,
+		webhooks: newWebhookDispatcher(node.Datastore.WebhookDeliveries(), node.Datastore.Settings().Get),
+	}
+	go manager.webhooks.run()
 	nodeBroadcast := make(chan repo.Notifier)
 	go func() {
 		for {
@@ -57,8 +62,7 @@ func (m *notificationManager) sendNotification(n repo.Notifier) {
 	}
 }
 
-// Create list of notifiers based on settings data (currently only SMTP is available)
-// TODO: should be extended to include new notifiers in the list
+// Create list of notifiers based on settings data
 func (m *notificationManager) getNotifiers() []notifier {
 	settings, err := m.node.Datastore.Settings().Get()
 	var notifiers []notifier
@@ -68,15 +72,17 @@ func (m *notificationManager) getNotifiers() []notifier {
 
 	// SMTP notifier
 	conf := settings.SMTPSettings
-
-	profile, err := m.node.GetProfile()
-	if err != nil {
-		return nil
+	if conf != nil && conf.Notifications {
+		profile, err := m.node.GetProfile()
+		if err == nil {
+			conf.OpenBazaarName = profile.Name
+			notifiers = append(notifiers, &smtpNotifier{settings: conf})
+		}
 	}
-	conf.OpenBazaarName = profile.Name
 
-	if conf != nil && conf.Notifications {
-		notifiers = append(notifiers, &smtpNotifier{settings: conf})
+	// Webhook notifier
+	if settings.WebhookSettings != nil && settings.WebhookSettings.Notifications {
+		notifiers = append(notifiers, &webhookNotifier{settings: settings.WebhookSettings, dispatcher: m.webhooks})
 	}
 	return notifiers
 }
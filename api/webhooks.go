@@ -0,0 +1,240 @@
+package api
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// webhookMaxAttempts is the number of times a delivery is tried before it is dropped
+	webhookMaxAttempts = 10
+	// webhookInitialBackoff is the delay before the first retry. It doubles on each failure.
+	webhookInitialBackoff = time.Second * 30
+	// webhookMaxBackoff caps the delay between two attempts
+	webhookMaxBackoff = time.Hour * 6
+	// webhookPollInterval is how often the store is checked for due retries
+	webhookPollInterval = time.Second * 30
+	// webhookBatchSize is the number of due deliveries loaded at once
+	webhookBatchSize = 50
+
+	webhookSignatureHeader = "X-OpenBazaar-Signature"
+	webhookEventHeader     = "X-OpenBazaar-Event"
+	webhookDeliveryHeader  = "X-OpenBazaar-Delivery"
+)
+
+// webhookNotifier queues a delivery for every configured endpoint which
+// accepts the notification type. Deliveries are persisted so that pending
+// retries survive a restart.
+type webhookNotifier struct {
+	settings   *repo.WebhookSettings
+	dispatcher *webhookDispatcher
+}
+
+func (notifier *webhookNotifier) notify(n repo.Notifier) error {
+	var endpoints []repo.WebhookEndpoint
+	for _, e := range notifier.settings.Endpoints {
+		if webhookAccepts(e, n.GetType()) {
+			endpoints = append(endpoints, e)
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	body, err := n.Data()
+	if err != nil {
+		return fmt.Errorf("marshal webhook notification: %s", err.Error())
+	}
+	now := notifier.dispatcher.now()
+	for _, e := range endpoints {
+		d := repo.WebhookDelivery{
+			ID:               repo.NewNotificationID(),
+			URL:              e.URL,
+			NotificationType: n.GetType(),
+			Body:             body,
+			NextAttemptAt:    now,
+			CreatedAt:        now,
+		}
+		if err := notifier.dispatcher.store.Put(d); err != nil {
+			return fmt.Errorf("queue webhook delivery: %s", err.Error())
+		}
+	}
+	notifier.dispatcher.wake()
+	return nil
+}
+
+// webhookDispatcher sends queued deliveries and reschedules failed ones
+// with exponential backoff
+type webhookDispatcher struct {
+	store    repo.WebhookDeliveryStore
+	settings func() (repo.SettingsData, error)
+	client   *http.Client
+	now      func() time.Time
+	kick     chan struct{}
+}
+
+func newWebhookDispatcher(store repo.WebhookDeliveryStore, settings func() (repo.SettingsData, error)) *webhookDispatcher {
+	return &webhookDispatcher{
+		store:    store,
+		settings: settings,
+		client:   &http.Client{Timeout: time.Second * 30},
+		now:      time.Now,
+		kick:     make(chan struct{}, 1),
+	}
+}
+
+// run sends due deliveries whenever new ones are queued or the poll interval elapses
+func (d *webhookDispatcher) run() {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	d.process()
+	for {
+		select {
+		case <-ticker.C:
+		case <-d.kick:
+		}
+		d.process()
+	}
+}
+
+func (d *webhookDispatcher) wake() {
+	select {
+	case d.kick <- struct{}{}:
+	default:
+	}
+}
+
+// process attempts every due delivery once
+func (d *webhookDispatcher) process() {
+	deliveries, err := d.store.GetDue(d.now(), webhookBatchSize)
+	if err != nil {
+		log.Errorf("load webhook deliveries: %s", err.Error())
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+	endpoints := make(map[string]repo.WebhookEndpoint)
+	if settings, err := d.settings(); err == nil && settings.WebhookSettings != nil && settings.WebhookSettings.Notifications {
+		for _, e := range settings.WebhookSettings.Endpoints {
+			endpoints[e.URL] = e
+		}
+	}
+	for _, delivery := range deliveries {
+		endpoint, ok := endpoints[delivery.URL]
+		if !ok {
+			// The endpoint was removed or webhooks were turned off since
+			// this delivery was queued
+			if err := d.store.Delete(delivery.ID); err != nil {
+				log.Errorf("delete webhook delivery: %s", err.Error())
+			}
+			continue
+		}
+		d.attempt(endpoint, delivery)
+	}
+}
+
+func (d *webhookDispatcher) attempt(endpoint repo.WebhookEndpoint, delivery repo.WebhookDelivery) {
+	err := d.send(endpoint, delivery)
+	if err == nil {
+		if err := d.store.Delete(delivery.ID); err != nil {
+			log.Errorf("delete webhook delivery: %s", err.Error())
+		}
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= webhookMaxAttempts {
+		log.Errorf("Webhook delivery %s to %s failed after %d attempts: %s", delivery.ID, delivery.URL, delivery.Attempts, err.Error())
+		if err := d.store.Delete(delivery.ID); err != nil {
+			log.Errorf("delete webhook delivery: %s", err.Error())
+		}
+		return
+	}
+	delivery.NextAttemptAt = d.now().Add(webhookBackoff(delivery.Attempts))
+	log.Warningf("Webhook delivery %s to %s failed, retrying at %s: %s", delivery.ID, delivery.URL, delivery.NextAttemptAt.Format(time.RFC3339), err.Error())
+	if err := d.store.Put(delivery); err != nil {
+		log.Errorf("reschedule webhook delivery: %s", err.Error())
+	}
+}
+
+func (d *webhookDispatcher) send(endpoint repo.WebhookEndpoint, delivery repo.WebhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, string(delivery.NotificationType))
+	req.Header.Set(webhookDeliveryHeader, delivery.ID)
+	req.Header.Set(webhookSignatureHeader, "sha256="+webhookSignature(endpoint.Secret, delivery.Body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+// webhookAccepts returns whether the endpoint wants notifications of the
+// given type. Endpoints without type filters receive everything.
+func webhookAccepts(e repo.WebhookEndpoint, t repo.NotificationType) bool {
+	if len(e.Types) == 0 {
+		return true
+	}
+	for _, et := range e.Types {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of the body
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the given retry attempt
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func validateWebhookSettings(s repo.SettingsData) error {
+	if s.WebhookSettings == nil {
+		return nil
+	}
+	for _, e := range s.WebhookSettings.Endpoints {
+		u, err := url.Parse(e.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook url: %s", e.URL)
+		}
+		if e.Secret == "" {
+			return errors.New("webhook endpoints must have a secret")
+		}
+	}
+	if s.WebhookSettings.Notifications && len(s.WebhookSettings.Endpoints) == 0 {
+		return errors.New("at least one webhook endpoint must be set if notifications are turned on")
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := db.NewWebhookDeliveryStore(database, new(sync.Mutex))
+
+	var (
+		mu         sync.Mutex
+		fail       = true
+		received   [][]byte
+		signatures []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		received = append(received, b)
+		signatures = append(signatures, r.Header.Get(webhookSignatureHeader))
+	}))
+	defer server.Close()
+
+	settings := &repo.WebhookSettings{
+		Notifications: true,
+		Endpoints: []repo.WebhookEndpoint{
+			{URL: server.URL, Secret: "s3cret", Types: []repo.NotificationType{repo.NotifierTypeOrderNewNotification}},
+		},
+	}
+	if err := validateWebhookSettings(repo.SettingsData{WebhookSettings: settings}); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	dispatcher := newWebhookDispatcher(store, func() (repo.SettingsData, error) {
+		return repo.SettingsData{WebhookSettings: settings}, nil
+	})
+	dispatcher.now = func() time.Time { return now }
+	notifier := &webhookNotifier{settings: settings, dispatcher: dispatcher}
+
+	// Filtered out by the endpoint's types
+	if err := notifier.notify(repo.FollowNotification{ID: "follow", Type: repo.NotifierTypeFollowNotification}); err != nil {
+		t.Fatal(err)
+	}
+	n := repo.OrderNotification{ID: "order", Type: repo.NotifierTypeOrderNewNotification, OrderId: "abc"}
+	if err := notifier.notify(n); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first attempt fails and is rescheduled with backoff
+	dispatcher.process()
+	due, err := store.GetDue(now.Add(webhookInitialBackoff), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].Attempts != 1 || due[0].LastError == "" {
+		t.Fatalf("expected a single rescheduled delivery, got %v", due)
+	}
+	if early, _ := store.GetDue(now, 10); len(early) != 0 {
+		t.Error("expected failed delivery to wait for backoff")
+	}
+
+	// The retry succeeds and removes the delivery
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+	now = now.Add(webhookInitialBackoff)
+	dispatcher.process()
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 1 {
+		t.Fatalf("expected 1 delivered webhook, got %d", len(received))
+	}
+	expectedBody, _ := n.Data()
+	if string(received[0]) != string(expectedBody) {
+		t.Errorf("unexpected webhook body: %s", received[0])
+	}
+	if signatures[0] != "sha256="+webhookSignature("s3cret", expectedBody) {
+		t.Errorf("unexpected webhook signature: %s", signatures[0])
+	}
+	if remaining, _ := store.GetDue(now.Add(webhookMaxBackoff), 10); len(remaining) != 0 {
+		t.Errorf("expected delivery to be removed, got %v", remaining)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	if webhookBackoff(1) != webhookInitialBackoff {
+		t.Errorf("unexpected first backoff: %s", webhookBackoff(1))
+	}
+	if webhookBackoff(3) != webhookInitialBackoff*4 {
+		t.Errorf("unexpected third backoff: %s", webhookBackoff(3))
+	}
+	if webhookBackoff(webhookMaxAttempts*2) != webhookMaxBackoff {
+		t.Errorf("expected backoff to be capped, got %s", webhookBackoff(webhookMaxAttempts*2))
+	}
+}
+
+func TestValidateWebhookSettings(t *testing.T) {
+	invalid := []repo.WebhookSettings{
+		{Notifications: true},
+		{Endpoints: []repo.WebhookEndpoint{{URL: "ftp://example.com", Secret: "s"}}},
+		{Endpoints: []repo.WebhookEndpoint{{URL: "https://example.com"}}},
+	}
+	for _, s := range invalid {
+		s := s
+		if err := validateWebhookSettings(repo.SettingsData{WebhookSettings: &s}); err == nil {
+			t.Errorf("expected error for webhook settings %v", s)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 TARGETS=${1:-windows/386,windows/amd64,darwin/amd64,linux/386,linux/amd64,linux/arm}
 
 export CGO_ENABLED=1
+export CGO_CFLAGS="${CGO_CFLAGS:--O2 -g} -DSQLITE_ENABLE_FTS5"
 go get github.com/karalabe/xgo
 mkdir dist && cd dist/
 xgo -go=1.11 --targets=$TARGETS ../
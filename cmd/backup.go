@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type Backup struct {
+	Password       string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+	DataDir        string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet        bool   `short:"t" long:"testnet" description:"use the test network"`
+	Output         string `short:"o" long:"output" description:"the file to write the backup to, defaults to a timestamped file in the current directory"`
+	BackupPassword string `long:"backuppassword" description:"the password to encrypt the backup with, prompted for if omitted"`
+}
+
+func (x *Backup) Execute(args []string) error {
+	// Set repo path
+	repoPath, err := repo.GetRepoPath(x.Testnet, x.DataDir)
+	if err != nil {
+		return err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return fmt.Errorf("repo in the data directory '%s' has not been initialized", repoPath)
+	}
+	repoLockFile := filepath.Join(repoPath, fsrepo.LockFile)
+	if _, err := os.Stat(repoLockFile); !os.IsNotExist(err) {
+		return errors.New("cannot back up while the daemon is running, enable scheduled backups in the config instead")
+	}
+
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet, wallet.Bitcoin)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	if sqliteDB.Config().IsEncrypted() {
+		return errors.New("database is encrypted, use --password to unlock it")
+	}
+
+	pw := x.BackupPassword
+	if pw == "" {
+		fmt.Print("Enter a password to encrypt the backup with: ")
+		pw, err = readNewBackupPassword()
+		if err != nil {
+			return err
+		}
+	}
+
+	output := x.Output
+	if output == "" {
+		output = "openbazaar-" + time.Now().UTC().Format("20060102T150405Z") + core.BackupFileExtension
+	}
+	f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	manifest, err := core.WriteBackup(f, repoPath, sqliteDB, x.Testnet, pw)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(output)
+		return err
+	}
+	fmt.Printf("Backed up %d files at repo version %d to %s\n", len(manifest.Files), manifest.RepoVersion, output)
+	return nil
+}
+
+// readNewBackupPassword reads a backup password and its confirmation from
+// the terminal
+func readNewBackupPassword() (string, error) {
+	// nolint:unconvert
+	bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println("")
+	pw := string(bytePassword)
+	if len(pw) < 8 {
+		return "", errors.New("backup password must be at least 8 characters")
+	}
+	fmt.Print("Confirm your password: ")
+	// nolint:unconvert
+	bytePassword, _ = terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println("")
+	if string(bytePassword) != pw {
+		return "", errors.New("passwords do not match")
+	}
+	return pw, nil
+}
+
+// readBackupPasswordFile reads a backup password from the first line of a
+// file. The file must not be accessible to other users.
+func readBackupPasswordFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return "", fmt.Errorf("backup password file %s must only be accessible to its owner (chmod 600)", path)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	pw := strings.SplitN(string(b), "\n", 2)[0]
+	pw = strings.TrimSuffix(pw, "\r")
+	if len(pw) < 8 {
+		return "", fmt.Errorf("backup password in %s must be at least 8 characters", path)
+	}
+	return pw, nil
+}
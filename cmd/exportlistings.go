@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+type ExportListings struct {
+	Password string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+	Format   string `short:"f" long:"format" description:"the export format, either csv or zip" default:"csv"`
+	Output   string `short:"o" long:"output" description:"the file to write the export to, defaults to stdout"`
+}
+
+func (x *ExportListings) Execute(args []string) error {
+	// Set repo path
+	repoPath, err := repo.GetRepoPath(x.Testnet, x.DataDir)
+	if err != nil {
+		return err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return fmt.Errorf("repo in the data directory '%s' has not been initialized", repoPath)
+	}
+
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet, wallet.Bitcoin)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	if sqliteDB.Config().IsEncrypted() {
+		return errors.New("database is encrypted, use --password to unlock it")
+	}
+
+	// The export only reads the listing files from the repo and the
+	// inventory and coupons from the database so the IPFS node is not started
+	node := &core.OpenBazaarNode{
+		RepoPath:  repoPath,
+		Datastore: sqliteDB,
+	}
+
+	var out io.Writer = os.Stdout
+	if x.Output != "" {
+		f, err := os.Create(x.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return node.ExportListings(out, x.Format)
+}
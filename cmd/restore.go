@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +33,7 @@ import (
 
 	ipath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
 
+	obcore "github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -52,6 +54,8 @@ type Restore struct {
 	Tor                bool   `long:"tor" description:"Automatically configure the daemon to run as a Tor hidden service and use Tor exclusively. Requires Tor to be running."`
 	Mnemonic           string `short:"m" long:"mnemonic" description:"specify a mnemonic seed to use to derive the keychain"`
 	WalletCreationDate string `short:"w" long:"walletcreationdate" description:"specify the date the seed was created. if omitted the wallet will sync from the oldest checkpoint."`
+	FromBackup         string `long:"from-backup" description:"restore the database, config and user data from a backup file made by the backup command instead of the network"`
+	BackupPassword     string `long:"backuppassword" description:"the password the backup was encrypted with, prompted for if omitted"`
 }
 
 func (x *Restore) Execute(args []string) error {
@@ -74,6 +78,9 @@ func (x *Restore) Execute(args []string) error {
 	if x.DataDir != "" {
 		repoPath = x.DataDir
 	}
+	if x.FromBackup != "" {
+		return x.restoreFromBackup(repoPath)
+	}
 
 	// Initialize repo if they included a mnemonic
 	creationDate := time.Now()
@@ -324,6 +331,17 @@ func RestoreDirectory(repoPath, directory string, nd *ipfscore.IpfsNode, id *cid
 				return
 			}
 
+			// Subdirectories such as ratings/replies are restored recursively
+			if _, ok := ndi.(files.Directory); ok {
+				if err := os.MkdirAll(path.Join(repoPath, "root", directory, link.Name), os.ModePerm); err != nil {
+					PrintError(err.Error())
+					return
+				}
+				wg.Add(1)
+				RestoreDirectory(repoPath, path.Join(directory, link.Name), nd, &link.Cid, wg)
+				return
+			}
+
 			fmt.Printf("Restoring %s/%s\n", directory, link.Name)
 			f, err := os.Create(path.Join(repoPath, "root", directory, link.Name))
 			if err != nil {
@@ -345,6 +363,46 @@ func RestoreDirectory(repoPath, directory string, nd *ipfscore.IpfsNode, id *cid
 
 }
 
+// restoreFromBackup replaces the repo's database, config and root directory
+// with those in the backup file. A new repo is initialized first if there
+// isn't one so the IPFS repo exists.
+func (x *Restore) restoreFromBackup(repoPath string) error {
+	repoLockFile := filepath.Join(repoPath, fsrepo.LockFile)
+	if _, err := os.Stat(repoLockFile); !os.IsNotExist(err) {
+		return errors.New("cannot restore while the daemon is running")
+	}
+	f, err := os.Open(x.FromBackup)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if !fsrepo.IsInitialized(repoPath) {
+		sqliteDB, err := InitializeRepo(repoPath, "", "", x.Testnet, time.Now(), wallet.Bitcoin)
+		if err != nil {
+			return err
+		}
+		sqliteDB.Close()
+	}
+
+	pw := x.BackupPassword
+	if pw == "" {
+		fmt.Print("Enter the backup password: ")
+		// nolint:unconvert
+		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Println("")
+		pw = string(bytePassword)
+	}
+	manifest, err := obcore.RestoreBackup(f, repoPath, pw, x.Testnet)
+	if err != nil {
+		PrintError(err.Error() + "\n")
+		return err
+	}
+	fmt.Printf("Restored %d files from the backup made %s at repo version %d.\n", len(manifest.Files), manifest.Created.Format(time.RFC3339), manifest.RepoVersion)
+	fmt.Println("The restored database is not encrypted, run encryptdatabase to encrypt it again.")
+	return nil
+}
+
 func PrintError(e string) {
 	os.Stderr.Write([]byte(e))
 }
@@ -5,11 +5,15 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"path"
+	"time"
 
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/wallet-interface"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 
 	"os"
@@ -21,8 +25,13 @@ import (
 )
 
 type SetAPICreds struct {
-	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
-	Testnet bool   `short:"t" long:"testnet" description:"config file is for testnet node"`
+	DataDir    string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet    bool   `short:"t" long:"testnet" description:"config file is for testnet node"`
+	Password   string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+	AddUser    string `long:"add-user" description:"add or update an API user instead of setting the owner credentials"`
+	Role       string `long:"role" description:"the role of the added user: readonly, clerk, moderator or owner" default:"readonly"`
+	RemoveUser string `long:"remove-user" description:"remove an API user"`
+	ListUsers  bool   `long:"list-users" description:"list the API users and their roles"`
 }
 
 func (x *SetAPICreds) Execute(args []string) error {
@@ -61,10 +70,98 @@ func (x *SetAPICreds) Execute(args []string) error {
 		log.Error(err)
 		return err
 	}
+	if x.AddUser != "" || x.RemoveUser != "" || x.ListUsers {
+		if err := x.manageUsers(repoPath, apiCfg); err != nil {
+			return err
+		}
+		if x.AddUser == "" || apiCfg.Authenticated {
+			return nil
+		}
+		// API users can only sign in when authentication is turned on
+		apiCfg.Authenticated = true
+		if len(apiCfg.AllowedIPs) == 0 {
+			apiCfg.AllowedIPs = []string{}
+		}
+		return writeAPIConfig(cfgPath, configJson, apiCfg)
+	}
+
 	reader := bufio.NewReader(os.Stdin)
 	fmt.Print("Enter username: ")
 	username, _ := reader.ReadString('\n')
 
+	pw := readNewPassword()
+	if strings.Contains(username, "\r\n") {
+		apiCfg.Username = strings.Replace(username, "\r\n", "", -1)
+	} else if strings.Contains(username, "\n") {
+		apiCfg.Username = strings.Replace(username, "\n", "", -1)
+	}
+	apiCfg.Authenticated = true
+	h := sha256.Sum256([]byte(pw))
+	apiCfg.Password = hex.EncodeToString(h[:])
+	if len(apiCfg.AllowedIPs) == 0 {
+		apiCfg.AllowedIPs = []string{}
+	}
+
+	return writeAPIConfig(cfgPath, configJson, apiCfg)
+}
+
+// manageUsers adds, removes or lists the API users in the datastore
+func (x *SetAPICreds) manageUsers(repoPath string, apiCfg *schema.APIConfig) error {
+	var role repo.APIRole
+	if x.AddUser != "" {
+		r, err := repo.ParseAPIRole(x.Role)
+		if err != nil {
+			return err
+		}
+		if x.AddUser == apiCfg.Username {
+			return fmt.Errorf("%s is the owner's username", x.AddUser)
+		}
+		role = r
+	}
+
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet, wallet.Bitcoin)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	if sqliteDB.Config().IsEncrypted() {
+		return errors.New("database is encrypted, use --password to unlock it")
+	}
+
+	if x.RemoveUser != "" {
+		if err := sqliteDB.APIUsers().Delete(x.RemoveUser); err != nil {
+			return err
+		}
+		fmt.Printf("Removed API user %s\n", x.RemoveUser)
+	}
+	if x.AddUser != "" {
+		h := sha256.Sum256([]byte(readNewPassword()))
+		user := repo.APIUser{
+			Username:     x.AddUser,
+			PasswordHash: hex.EncodeToString(h[:]),
+			Role:         role,
+			Created:      time.Now(),
+		}
+		if err := sqliteDB.APIUsers().Put(user); err != nil {
+			return err
+		}
+		fmt.Printf("Saved API user %s with role %s\n", user.Username, user.Role)
+	}
+	if x.ListUsers {
+		users, err := sqliteDB.APIUsers().GetAll()
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			fmt.Printf("%s\t%s\t%s\n", u.Username, u.Role, u.Created.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// readNewPassword prompts for a password until it is long enough and has
+// been confirmed
+func readNewPassword() string {
 	var pw string
 	for {
 		fmt.Print("Enter a veerrrry strong password: ")
@@ -93,19 +190,11 @@ func (x *SetAPICreds) Execute(args []string) error {
 			fmt.Println("Quit effin around. Try again.")
 		}
 	}
-	pw = strings.Replace(pw, "'", "''", -1)
-	if strings.Contains(username, "\r\n") {
-		apiCfg.Username = strings.Replace(username, "\r\n", "", -1)
-	} else if strings.Contains(username, "\n") {
-		apiCfg.Username = strings.Replace(username, "\n", "", -1)
-	}
-	apiCfg.Authenticated = true
-	h := sha256.Sum256([]byte(pw))
-	apiCfg.Password = hex.EncodeToString(h[:])
-	if len(apiCfg.AllowedIPs) == 0 {
-		apiCfg.AllowedIPs = []string{}
-	}
+	return strings.Replace(pw, "'", "''", -1)
+}
 
+// writeAPIConfig saves the JSON-API section of the config file
+func writeAPIConfig(cfgPath string, configJson map[string]interface{}, apiCfg *schema.APIConfig) error {
 	configJson["JSON-API"] = apiCfg
 
 	out, err := json.MarshalIndent(configJson, "", "    ")
@@ -31,6 +31,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/api"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/net/service"
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -38,6 +39,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/OpenBazaar/openbazaar-go/storage/dropbox"
+	"github.com/OpenBazaar/openbazaar-go/storage/s3"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
 	"github.com/OpenBazaar/openbazaar-go/wallet"
 	lis "github.com/OpenBazaar/openbazaar-go/wallet/listeners"
@@ -86,7 +88,8 @@ type Start struct {
 	DualStack            bool     `long:"dualstack" description:"Automatically configure the daemon to run as a Tor hidden service IN ADDITION to using the clear internet. Requires Tor to be running. WARNING: this mode is not private"`
 	DisableWallet        bool     `long:"disablewallet" description:"disable the wallet functionality of the node"`
 	DisableExchangeRates bool     `long:"disableexchangerates" description:"disable the exchange rate service to prevent api queries"`
-	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox] default=self-hosted"`
+	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox, s3] default=self-hosted"`
+	BackupPasswordFile   string   `long:"backuppasswordfile" description:"a file only readable by its owner holding the password to encrypt scheduled backups with, prompted for if omitted when backups are enabled in the config"`
 
 	ForceKeyCachePurge bool `long:"forcekeypurge" description:"repair test for issue OpenBazaar/openbazaar-go#1593; use as instructed only"`
 }
@@ -223,11 +226,40 @@ func (x *Start) Execute(args []string) error {
 		log.Error("scan dropbox api token:", err)
 		return err
 	}
+	s3Config, err := schema.GetS3StorageConfig(configFile)
+	if err != nil {
+		log.Error("scan s3 storage config:", err)
+		return err
+	}
 	republishInterval, err := schema.GetRepublishInterval(configFile)
 	if err != nil {
 		log.Error("scan republish interval config:", err)
 		return err
 	}
+	rateLimitsConfig, err := schema.GetRateLimitsConfig(configFile)
+	if err != nil {
+		log.Error("scan rate limits config:", err)
+		return err
+	}
+	backupsConfig, err := schema.GetBackupsConfig(configFile)
+	if err != nil {
+		log.Error("scan backups config:", err)
+		return err
+	}
+	var backupPassword string
+	if backupsConfig.Enabled {
+		if x.BackupPasswordFile != "" {
+			backupPassword, err = readBackupPasswordFile(x.BackupPasswordFile)
+		} else if terminal.IsTerminal(int(syscall.Stdin)) { // nolint:unconvert
+			fmt.Print("Scheduled backups are enabled.\nEnter a password to encrypt them with (will not appear while typing): ")
+			backupPassword, err = readNewBackupPassword()
+		} else {
+			err = errors.New("no --backuppasswordfile was given")
+		}
+		if err != nil {
+			log.Errorf("scheduled backups are enabled but the backup password could not be read, backups will not be written: %s", err)
+		}
+	}
 	walletsConfig, err := schema.GetWalletsConfig(configFile)
 	if err != nil {
 		log.Error("scan wallets config:", err)
@@ -550,6 +582,26 @@ func (x *Start) Execute(args []string) error {
 	}
 	bm := obnet.NewBanManager(blockedNodes)
 
+	// Set up the per-peer rate limits
+	var (
+		rateLimiter          *obnet.RateLimiter
+		rateLimitBanDuration time.Duration
+	)
+	if rateLimitsConfig.Enabled {
+		if rateLimitsConfig.BanDuration != "" {
+			rateLimitBanDuration, err = time.ParseDuration(rateLimitsConfig.BanDuration)
+			if err != nil {
+				log.Error("parse rate limit ban duration:", err)
+				return err
+			}
+		}
+		rateLimiter = obnet.NewRateLimiter(map[string]obnet.RateLimit{
+			obnet.RateLimitChat:   {Rate: rateLimitsConfig.Chat.PerMinute / 60, Burst: rateLimitsConfig.Chat.Burst},
+			obnet.RateLimitFollow: {Rate: rateLimitsConfig.Follow.PerMinute / 60, Burst: rateLimitsConfig.Follow.Burst},
+			obnet.RateLimitStore:  {Rate: rateLimitsConfig.Store.PerMinute / 60, Burst: rateLimitsConfig.Store.Burst},
+		})
+	}
+
 	if x.Testnet {
 		setTestmodeRecordAgingIntervals()
 	}
@@ -576,6 +628,9 @@ func (x *Start) Execute(args []string) error {
 		OfflineMessageFailoverTimeout: 30 * time.Second,
 		Pubsub:                        ps,
 		PushNodes:                     pushNodes,
+		RateLimiter:                   rateLimiter,
+		RateLimitBanDuration:          rateLimitBanDuration,
+		RateLimitBanAfter:             rateLimitsConfig.BanAfter,
 		RegressionTestEnable:          x.Regtest,
 		RepoPath:                      repoPath,
 		RootHash:                      rootHash,
@@ -586,6 +641,12 @@ func (x *Start) Execute(args []string) error {
 	}
 	core.Node.PublishLock.Lock()
 
+	// Restore the bans on peers which exceeded a rate limit
+	if err := core.Node.LoadPeerBans(); err != nil {
+		log.Error("load peer bans:", err)
+		return err
+	}
+
 	// assert reserve wallet is available on startup for later usage
 	_, err = core.Node.ReserveCurrencyConverter()
 	if err != nil {
@@ -612,6 +673,37 @@ func (x *Start) Execute(args []string) error {
 			log.Error(err)
 			return err
 		}
+	} else if x.Storage == "s3" {
+		if usingTor && !usingClearnet {
+			log.Error("s3 can not be used with tor")
+			return errors.New("s3 can not be used with tor")
+		}
+
+		var messageExpiry time.Duration
+		if s3Config.MessageExpiry != "" {
+			messageExpiry, err = time.ParseDuration(s3Config.MessageExpiry)
+			if err != nil {
+				log.Error("parse s3 message expiry:", err)
+				return err
+			}
+		}
+		s3Storage, err := s3.NewS3Storage(s3.Config{
+			Endpoint:        s3Config.Endpoint,
+			Region:          s3Config.Region,
+			Bucket:          s3Config.Bucket,
+			Prefix:          s3Config.Prefix,
+			AccessKeyID:     s3Config.AccessKeyID,
+			SecretAccessKey: s3Config.SecretAccessKey,
+			PathStyle:       s3Config.PathStyle,
+			PublicURL:       s3Config.PublicURL,
+			MessageExpiry:   messageExpiry,
+		})
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		s3Storage.StartCleanup(time.Hour)
+		storage = s3Storage
 	} else {
 		err = errors.New("invalid storage option")
 		log.Error(err)
@@ -626,6 +718,10 @@ func (x *Start) Execute(args []string) error {
 		return errors.New("SSL cert and key files must be set when SSL is enabled")
 	}
 
+	if apiConfig.Metrics {
+		metrics.Registry.MustRegister(core.Node.MetricsCollector())
+	}
+
 	gateway, err := newHTTPGateway(core.Node, ctx, authCookie, *apiConfig, x.NoLogFiles)
 	if err != nil {
 		log.Error(err)
@@ -670,7 +766,21 @@ func (x *Start) Execute(args []string) error {
 		core.Node.StartMessageRetriever()
 		core.Node.StartPointerRepublisher()
 		core.Node.StartRecordAgingNotifier()
+		core.Node.StartListingScheduler()
+		core.Node.StartReservationExpirer()
+		core.Node.StartSubscriptionRenewer()
+		core.Node.StartCrowdFundSettler()
+		core.Node.StartFeedUpdater()
+		core.Node.StartAnnouncementListener()
+		core.Node.StartModeratorStatsPublisher()
 		core.Node.StartInboundMsgScanner()
+		if backupsConfig.Enabled {
+			if backupPassword != "" {
+				// The interval was validated when the config was read
+				backupInterval, _ := time.ParseDuration(backupsConfig.Interval)
+				core.Node.StartBackupScheduler(backupsConfig.Directory, backupInterval, backupsConfig.Keep, backupPassword)
+			}
+		}
 
 		core.Node.PublishLock.Unlock()
 		err = core.Node.UpdateFollow()
@@ -0,0 +1,396 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// AnnouncementMaxAge is how old an announcement may be when it's
+	// received. Older announcements are dropped so they can't be replayed.
+	AnnouncementMaxAge = time.Hour
+
+	// announcementMaxClockSkew is how far in the future an announcement may
+	// be stamped
+	announcementMaxClockSkew = 5 * time.Minute
+)
+
+var (
+	// ErrAnnouncementSubscriptionKind - subscriptions are to either a channel
+	// or a peer
+	ErrAnnouncementSubscriptionKind = errors.New("announcement subscriptions must be to a channel or a peer")
+
+	// ErrAnnouncementSubscriptionNotFound - the node isn't subscribed to the
+	// channel or peer
+	ErrAnnouncementSubscriptionNotFound = errors.New("announcement subscription not found")
+
+	// ErrAnnouncementStale - the announcement is older than AnnouncementMaxAge
+	// or stamped in the future
+	ErrAnnouncementStale = errors.New("announcement is stale")
+)
+
+// announcementTopic returns the pubsub topic of the channel's or peer's
+// announcements
+func announcementTopic(kind, name string) string {
+	return ipfs.AnnouncementTopic(kind + "/" + name)
+}
+
+// normalizeAnnouncementChannel makes channel topics case insensitive
+func normalizeAnnouncementChannel(channel string) string {
+	return strings.ToLower(strings.TrimSpace(channel))
+}
+
+// AnnouncePost tells the node's subscribers, and those of the channels the
+// post is addressed to, about the new or updated post
+func (n *OpenBazaarNode) AnnouncePost(post *pb.SignedPost) error {
+	if n.Pubsub.Publisher == nil {
+		return nil
+	}
+	hash, err := ipfs.GetHashOfFile(n.IpfsNode, path.Join(n.RepoPath, "root", "posts", post.Post.Slug+".json"))
+	if err != nil {
+		return err
+	}
+	a := &pb.Announcement{
+		Type:     pb.Announcement_POST,
+		Hash:     hash,
+		Slug:     post.Post.Slug,
+		Title:    post.Post.Status,
+		Tags:     post.Post.Tags,
+		Channels: post.Post.Channels,
+	}
+	if len(post.Post.Images) > 0 {
+		a.Thumbnail = &pb.Post_Image{Tiny: post.Post.Images[0].Tiny, Small: post.Post.Images[0].Small}
+	}
+
+	topics := []string{announcementTopic(repo.AnnouncementKindPeer, n.IpfsNode.Identity.Pretty())}
+	seen := make(map[string]bool)
+	for _, c := range post.Post.Channels {
+		channel := normalizeAnnouncementChannel(c)
+		if channel == "" || seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		topics = append(topics, announcementTopic(repo.AnnouncementKindChannel, channel))
+	}
+	return n.publishAnnouncement(a, topics)
+}
+
+// AnnounceListing tells the node's subscribers about the new or updated
+// listing
+func (n *OpenBazaarNode) AnnounceListing(l *repo.Listing, hash string) error {
+	if n.Pubsub.Publisher == nil {
+		return nil
+	}
+	a := &pb.Announcement{
+		Type:  pb.Announcement_LISTING,
+		Hash:  hash,
+		Slug:  l.GetSlug(),
+		Title: l.GetTitle(),
+		Tags:  l.GetTags(),
+	}
+	if images := l.GetImages(); len(images) > 0 {
+		a.Thumbnail = &pb.Post_Image{Tiny: images[0].GetTiny(), Small: images[0].GetSmall()}
+	}
+	return n.publishAnnouncement(a, []string{announcementTopic(repo.AnnouncementKindPeer, n.IpfsNode.Identity.Pretty())})
+}
+
+func (n *OpenBazaarNode) publishAnnouncement(a *pb.Announcement, topics []string) error {
+	sa, err := n.SignAnnouncement(a)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(sa)
+	if err != nil {
+		return err
+	}
+	for _, topic := range topics {
+		go func(topic string) {
+			if err := n.Pubsub.Publisher.PublishTopic(context.Background(), topic, data); err != nil {
+				log.Errorf("publishing announcement to %s: %s", topic, err.Error())
+			}
+		}(topic)
+	}
+	return nil
+}
+
+// SignAnnouncement adds the node's identity to the announcement, stamps it
+// unless it already has a timestamp and signs it
+func (n *OpenBazaarNode) SignAnnouncement(a *pb.Announcement) (*pb.SignedAnnouncement, error) {
+	id, err := n.GetNodeID()
+	if err != nil {
+		return nil, err
+	}
+	a.VendorID = id
+	if a.Timestamp == nil {
+		a.Timestamp = ptypes.TimestampNow()
+	}
+	ser, err := proto.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := n.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignedAnnouncement{Announcement: a, Signature: sig}, nil
+}
+
+// ValidateAnnouncement checks the announcement is well formed, recent at now
+// and was signed by the peer it names
+func ValidateAnnouncement(sa *pb.SignedAnnouncement, now time.Time) error {
+	a := sa.Announcement
+	if a == nil || a.VendorID == nil || a.VendorID.Pubkeys == nil {
+		return errors.New("announcement is missing its author")
+	}
+	if _, ok := pb.Announcement_AnnouncementType_name[int32(a.Type)]; !ok {
+		return errors.New("invalid announcement type")
+	}
+	if _, err := cid.Decode(a.Hash); err != nil {
+		return errors.New("invalid announcement hash")
+	}
+	if a.Slug == "" {
+		return errors.New("announcement slug is missing")
+	}
+	if a.Timestamp == nil {
+		return ErrAnnouncementStale
+	}
+	timestamp, err := ptypes.Timestamp(a.Timestamp)
+	if err != nil {
+		return err
+	}
+	if now.Sub(timestamp) > AnnouncementMaxAge || timestamp.Sub(now) > announcementMaxClockSkew {
+		return ErrAnnouncementStale
+	}
+	if err := verifySignature(a, a.VendorID.Pubkeys.Identity, sa.Signature, a.VendorID.PeerID); err != nil {
+		return err
+	}
+	return verifyBitcoinSignature(a.VendorID.Pubkeys.Bitcoin, a.VendorID.BitcoinSig, a.VendorID.PeerID)
+}
+
+// validateAnnouncementSubscription returns the normalized channel or peer ID
+// of the subscription
+func validateAnnouncementSubscription(kind, name string) (string, error) {
+	switch kind {
+	case repo.AnnouncementKindChannel:
+		channel := normalizeAnnouncementChannel(name)
+		if channel == "" {
+			return "", errors.New("channel must not be empty")
+		}
+		if len(channel) > PostChannelsMaxCharacters {
+			return "", ErrPostChannelTooLong
+		}
+		return channel, nil
+	case repo.AnnouncementKindPeer:
+		pid, err := peer.IDB58Decode(name)
+		if err != nil {
+			return "", err
+		}
+		return pid.Pretty(), nil
+	default:
+		return "", ErrAnnouncementSubscriptionKind
+	}
+}
+
+// SubscribeAnnouncements saves the subscription to the channel's or peer's
+// announcements and starts listening for them
+func (n *OpenBazaarNode) SubscribeAnnouncements(kind, name string) (repo.AnnouncementSubscription, error) {
+	name, err := validateAnnouncementSubscription(kind, name)
+	if err != nil {
+		return repo.AnnouncementSubscription{}, err
+	}
+	sub := repo.AnnouncementSubscription{Kind: kind, Name: name, Timestamp: time.Now()}
+	if err := n.Datastore.AnnouncementSubscriptions().Put(sub.Kind, sub.Name, sub.Timestamp); err != nil {
+		return repo.AnnouncementSubscription{}, err
+	}
+	if n.AnnouncementListener != nil {
+		if err := n.AnnouncementListener.listen(sub.Kind, sub.Name); err != nil {
+			return repo.AnnouncementSubscription{}, err
+		}
+	}
+	return sub, nil
+}
+
+// UnsubscribeAnnouncements stops listening for the channel's or peer's
+// announcements
+func (n *OpenBazaarNode) UnsubscribeAnnouncements(kind, name string) error {
+	name, err := validateAnnouncementSubscription(kind, name)
+	if err != nil {
+		return err
+	}
+	subs, err := n.Datastore.AnnouncementSubscriptions().GetAll()
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, sub := range subs {
+		if sub.Kind == kind && sub.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrAnnouncementSubscriptionNotFound
+	}
+	if err := n.Datastore.AnnouncementSubscriptions().Delete(kind, name); err != nil {
+		return err
+	}
+	if n.AnnouncementListener != nil {
+		n.AnnouncementListener.cancel(kind, name)
+	}
+	return nil
+}
+
+type announcementListener struct {
+	node   *OpenBazaarNode
+	logger *logging.Logger
+
+	mx   sync.Mutex
+	seen map[string]time.Time
+}
+
+// StartAnnouncementListener - start listening for the announcements of the
+// channels and peers the node is subscribed to
+func (n *OpenBazaarNode) StartAnnouncementListener() {
+	n.AnnouncementListener = &announcementListener{
+		node:   n,
+		logger: logging.MustGetLogger("announcementListener"),
+		seen:   make(map[string]time.Time),
+	}
+	subs, err := n.Datastore.AnnouncementSubscriptions().GetAll()
+	if err != nil {
+		n.AnnouncementListener.logger.Errorf("loading announcement subscriptions: %s", err.Error())
+		return
+	}
+	for _, sub := range subs {
+		if err := n.AnnouncementListener.listen(sub.Kind, sub.Name); err != nil {
+			n.AnnouncementListener.logger.Errorf("subscribing to %s %s: %s", sub.Kind, sub.Name, err.Error())
+		}
+	}
+}
+
+// listen subscribes to the topic of the channel or peer unless the node
+// already has
+func (l *announcementListener) listen(kind, name string) error {
+	if l.node.Pubsub.Subscriber == nil {
+		return nil
+	}
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	topic := announcementTopic(kind, name)
+	for _, s := range l.node.Pubsub.Subscriber.GetSubscriptions() {
+		if s == topic {
+			return nil
+		}
+	}
+	announcements, err := l.node.Pubsub.Subscriber.SubscribeTopic(context.Background(), topic)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for data := range announcements {
+			if _, err := l.Receive(kind, name, data); err != nil {
+				l.logger.Debugf("discarding announcement on %s: %s", topic, err.Error())
+			}
+		}
+	}()
+	return nil
+}
+
+func (l *announcementListener) cancel(kind, name string) {
+	if l.node.Pubsub.Subscriber == nil {
+		return
+	}
+	l.node.Pubsub.Subscriber.Cancel(announcementTopic(kind, name))
+}
+
+// Receive handles an announcement published to the channel's or peer's topic.
+// The UI is told about valid announcements which weren't received before and
+// posts by followed peers are added to the feed right away.
+func (l *announcementListener) Receive(kind, name string, data []byte) (bool, error) {
+	sa := new(pb.SignedAnnouncement)
+	if err := proto.Unmarshal(data, sa); err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if err := ValidateAnnouncement(sa, now); err != nil {
+		return false, err
+	}
+	a := sa.Announcement
+	peerID := a.VendorID.PeerID
+	switch kind {
+	case repo.AnnouncementKindPeer:
+		if peerID != name {
+			return false, errors.New("announcement was not published by the topic's peer")
+		}
+	case repo.AnnouncementKindChannel:
+		addressed := false
+		for _, c := range a.Channels {
+			if normalizeAnnouncementChannel(c) == name {
+				addressed = true
+				break
+			}
+		}
+		if !addressed {
+			return false, errors.New("announcement is not addressed to the topic's channel")
+		}
+	}
+	if peerID == l.node.IpfsNode.Identity.Pretty() {
+		return false, nil
+	}
+
+	// The same announcement arrives on each topic it was published to
+	l.mx.Lock()
+	for sig, received := range l.seen {
+		if now.Sub(received) > AnnouncementMaxAge+announcementMaxClockSkew {
+			delete(l.seen, sig)
+		}
+	}
+	if _, ok := l.seen[string(sa.Signature)]; ok {
+		l.mx.Unlock()
+		return false, nil
+	}
+	l.seen[string(sa.Signature)] = now
+	l.mx.Unlock()
+
+	notif := repo.Announcement{
+		Type:     strings.ToLower(a.Type.String()),
+		Hash:     a.Hash,
+		PeerID:   peerID,
+		Handle:   a.VendorID.Handle,
+		Slug:     a.Slug,
+		Title:    a.Title,
+		Tags:     a.Tags,
+		Channels: a.Channels,
+	}
+	if a.Thumbnail != nil {
+		notif.Thumbnail = repo.Thumbnail{Tiny: a.Thumbnail.Tiny, Small: a.Thumbnail.Small}
+	}
+	notif.Timestamp, _ = ptypes.Timestamp(a.Timestamp)
+	if l.node.Broadcast != nil {
+		l.node.Broadcast <- notif
+	}
+
+	if a.Type == pb.Announcement_POST && l.node.Datastore.Following().IsFollowing(peerID) {
+		go func() {
+			if _, err := l.node.fetchFeedPost(peerID, a.Hash); err != nil {
+				l.logger.Debugf("fetching announced post %s of %s: %s", a.Hash, peerID, err.Error())
+			}
+		}()
+	}
+	return true, nil
+}
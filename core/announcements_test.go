@@ -0,0 +1,136 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestAnnouncementListener_Receive(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.StartAnnouncementListener()
+	// The test node's identity isn't derived from its private key so the
+	// announcements are signed as the peer which owns the key
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerID := pid.Pretty()
+	nodeID := node.IpfsNode.Identity
+	sign := func(a *pb.Announcement) []byte {
+		node.IpfsNode.Identity = pid
+		defer func() { node.IpfsNode.Identity = nodeID }()
+		sa, err := node.SignAnnouncement(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := proto.Marshal(sa)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	newAnnouncement := func() *pb.Announcement {
+		return &pb.Announcement{
+			Type:     pb.Announcement_POST,
+			Hash:     "QmfQkD8pBSBCBxWEwFSu4XaDVSWK6bjnNuaWZjMyQbyDub",
+			Slug:     "new-drop",
+			Title:    "New lamps just dropped",
+			Channels: []string{"Deals"},
+		}
+	}
+
+	data := sign(newAnnouncement())
+	received, err := node.AnnouncementListener.Receive(repo.AnnouncementKindPeer, peerID, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !received {
+		t.Error("expected the announcement to be received")
+	}
+	if received, err := node.AnnouncementListener.Receive(repo.AnnouncementKindChannel, "deals", data); err != nil || received {
+		t.Errorf("expected the announcement not to be received twice, got %v (%v)", received, err)
+	}
+	if _, err := node.AnnouncementListener.Receive(repo.AnnouncementKindChannel, "news", data); err == nil {
+		t.Error("expected an announcement on the wrong channel to be rejected")
+	}
+	if _, err := node.AnnouncementListener.Receive(repo.AnnouncementKindPeer, nodeID.Pretty(), data); err == nil {
+		t.Error("expected an announcement on another peer's topic to be rejected")
+	}
+
+	sa := new(pb.SignedAnnouncement)
+	if err := proto.Unmarshal(sign(newAnnouncement()), sa); err != nil {
+		t.Fatal(err)
+	}
+	sa.Announcement.Title = "Everything free"
+	tampered, err := proto.Marshal(sa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.AnnouncementListener.Receive(repo.AnnouncementKindPeer, peerID, tampered); err == nil {
+		t.Error("expected a tampered announcement to be rejected")
+	}
+
+	stale := newAnnouncement()
+	stale.Timestamp, _ = ptypes.TimestampProto(time.Now().Add(-2 * core.AnnouncementMaxAge))
+	if _, err := node.AnnouncementListener.Receive(repo.AnnouncementKindPeer, peerID, sign(stale)); err != core.ErrAnnouncementStale {
+		t.Errorf("expected ErrAnnouncementStale, got %v", err)
+	}
+
+	// The node ignores its own announcements
+	own := sign(newAnnouncement())
+	node.IpfsNode.Identity = pid
+	received, err = node.AnnouncementListener.Receive(repo.AnnouncementKindPeer, peerID, own)
+	node.IpfsNode.Identity = nodeID
+	if err != nil || received {
+		t.Errorf("expected the node's own announcement to be ignored, got %v (%v)", received, err)
+	}
+}
+
+func TestOpenBazaarNode_SubscribeAnnouncements(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.StartAnnouncementListener()
+
+	sub, err := node.SubscribeAnnouncements(repo.AnnouncementKindChannel, "  Deals ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.AnnouncementSubscriptions().Delete(repo.AnnouncementKindChannel, "deals")
+	if sub.Name != "deals" {
+		t.Errorf("expected the channel to be normalized, got %s", sub.Name)
+	}
+	if _, err := node.SubscribeAnnouncements("tag", "deals"); err != core.ErrAnnouncementSubscriptionKind {
+		t.Errorf("expected ErrAnnouncementSubscriptionKind, got %v", err)
+	}
+	if _, err := node.SubscribeAnnouncements(repo.AnnouncementKindPeer, "not-a-peer"); err == nil {
+		t.Error("expected an invalid peer ID to be rejected")
+	}
+
+	subs, err := node.Datastore.AnnouncementSubscriptions().GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, s := range subs {
+		if s.Kind == repo.AnnouncementKindChannel && s.Name == "deals" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the subscription to be saved, got %+v", subs)
+	}
+
+	if err := node.UnsubscribeAnnouncements(repo.AnnouncementKindChannel, "DEALS"); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.UnsubscribeAnnouncements(repo.AnnouncementKindChannel, "deals"); err != core.ErrAnnouncementSubscriptionNotFound {
+		t.Errorf("expected ErrAnnouncementSubscriptionNotFound, got %v", err)
+	}
+}
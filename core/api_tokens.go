@@ -0,0 +1,96 @@
+package core
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// DefaultAPITokenLifetime is how long an API token is valid for when it is
+// created without an expiry
+const DefaultAPITokenLifetime = time.Duration(30*24) * time.Hour
+
+var (
+	// ErrInvalidAPIToken is returned when a bearer token is unknown, revoked
+	// or expired
+	ErrInvalidAPIToken = errors.New("invalid API token")
+
+	// ErrAPITokenNoScopes is returned when an API token is created without
+	// any scopes
+	ErrAPITokenNoScopes = errors.New("API token must have at least one scope")
+
+	// ErrAPITokenExpired is returned when an API token is created with an
+	// expiry in the past
+	ErrAPITokenExpired = errors.New("API token expiry must be in the future")
+)
+
+// CreateAPIToken saves a new API token limited to the scopes and returns it
+// along with the bearer token. The bearer token is not stored and can't be
+// retrieved later. A zero expires uses DefaultAPITokenLifetime.
+func (n *OpenBazaarNode) CreateAPIToken(name string, scopes []repo.APITokenScope, expires time.Time) (*repo.APIToken, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", ErrAPITokenNoScopes
+	}
+	now := time.Now()
+	if expires.IsZero() {
+		expires = now.Add(DefaultAPITokenLifetime)
+	} else if !expires.After(now) {
+		return nil, "", ErrAPITokenExpired
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	bearer := hex.EncodeToString(secret)
+	token := repo.APIToken{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		TokenHash: hashAPIToken(bearer),
+		Scopes:    scopes,
+		Created:   now,
+		Expires:   expires,
+	}
+	if err := n.Datastore.APITokens().Put(token); err != nil {
+		return nil, "", err
+	}
+	return &token, bearer, nil
+}
+
+// AuthenticateAPIToken returns the API token for the bearer token or
+// ErrInvalidAPIToken if it is unknown, revoked or expired
+func (n *OpenBazaarNode) AuthenticateAPIToken(bearer string) (*repo.APIToken, error) {
+	token, err := n.Datastore.APITokens().GetByHash(hashAPIToken(bearer))
+	if err == sql.ErrNoRows {
+		return nil, ErrInvalidAPIToken
+	} else if err != nil {
+		return nil, err
+	}
+	if !token.Valid(time.Now()) {
+		return nil, ErrInvalidAPIToken
+	}
+	return token, nil
+}
+
+// RevokeAPIToken stops the API token from being used. It returns
+// sql.ErrNoRows if there is no token with the ID.
+func (n *OpenBazaarNode) RevokeAPIToken(id string) error {
+	if _, err := n.Datastore.APITokens().Get(id); err != nil {
+		return err
+	}
+	return n.Datastore.APITokens().Revoke(id)
+}
+
+func hashAPIToken(bearer string) string {
+	h := sha256.Sum256([]byte(bearer))
+	return hex.EncodeToString(h[:])
+}
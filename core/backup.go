@@ -0,0 +1,674 @@
+package core
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/op/go-logging"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// BackupFormatVersion is the version of the backup archive written by
+	// this node. Backups with a newer version can't be restored.
+	BackupFormatVersion = 1
+
+	// BackupFileExtension is the extension of the backups written by the
+	// backup scheduler
+	BackupFileExtension = ".obbackup"
+
+	backupMagic        = "OBBACKUP"
+	backupManifestFile = "manifest.json"
+	backupChunkSize    = 64 * 1024
+	backupSaltSize     = 16
+	backupPrefixSize   = 4
+
+	// scrypt parameters used to derive the archive key from the password
+	backupScryptN = 32768
+	backupScryptR = 8
+	backupScryptP = 1
+)
+
+var (
+	// ErrBackupPassword - the backup couldn't be decrypted with the password
+	ErrBackupPassword = errors.New("incorrect backup password or corrupted backup")
+
+	// ErrBackupCorrupt - the backup failed its integrity checks
+	ErrBackupCorrupt = errors.New("backup is corrupted")
+
+	// ErrBackupTruncated - the backup ended before its final chunk
+	ErrBackupTruncated = errors.New("backup is truncated")
+)
+
+// BackupManifest describes the contents of a backup. It is the last entry of
+// the archive and lists the SHA-256 hash of every other entry.
+type BackupManifest struct {
+	Version     int               `json:"version"`
+	RepoVersion int               `json:"repoVersion"`
+	Testnet     bool              `json:"testnet"`
+	Created     time.Time         `json:"created"`
+	Files       map[string]string `json:"files"`
+}
+
+// backupDatabaseFile returns the path of the node's database relative to the
+// data directory
+func backupDatabaseFile(testnet bool) string {
+	if testnet {
+		return path.Join("datastore", "testnet.db")
+	}
+	return path.Join("datastore", "mainnet.db")
+}
+
+// Backup writes an encrypted backup of the running node to w
+func (n *OpenBazaarNode) Backup(w io.Writer, password string) (*BackupManifest, error) {
+	n.PublishLock.Lock()
+	defer n.PublishLock.Unlock()
+	return WriteBackup(w, n.RepoPath, n.Datastore, n.TestnetEnable, password)
+}
+
+// WriteBackup writes a password encrypted archive of the node's database,
+// config and root directory to w. The database holds the wallet's keys and
+// transactions too. It's copied unencrypted into the archive so the backup
+// password alone is needed to restore it.
+func WriteBackup(w io.Writer, repoPath string, datastore repo.Datastore, testnet bool, password string) (*BackupManifest, error) {
+	if password == "" {
+		return nil, errors.New("backup password is empty")
+	}
+	repoVer, err := ioutil.ReadFile(path.Join(repoPath, "repover"))
+	if err != nil {
+		return nil, fmt.Errorf("reading repover: %s", err.Error())
+	}
+	repoVersion, err := strconv.Atoi(strings.TrimSpace(string(repoVer)))
+	if err != nil {
+		return nil, fmt.Errorf("parsing repover: %s", err.Error())
+	}
+
+	// Snapshot the database so it can be archived while the node is running
+	tmpPath, err := ioutil.TempDir(repoPath, "backup")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpPath)
+	if err := os.MkdirAll(path.Join(tmpPath, "datastore"), os.ModePerm); err != nil {
+		return nil, err
+	}
+	tmpDB, err := db.Create(tmpPath, "", testnet, wallet.Bitcoin)
+	if err != nil {
+		return nil, err
+	}
+	err = tmpDB.InitTables("")
+	tmpDB.Close()
+	if err != nil {
+		return nil, err
+	}
+	dbFile := backupDatabaseFile(testnet)
+	if err := datastore.Copy(path.Join(tmpPath, dbFile), ""); err != nil {
+		return nil, fmt.Errorf("copying database: %s", err.Error())
+	}
+
+	manifest := &BackupManifest{
+		Version:     BackupFormatVersion,
+		RepoVersion: repoVersion,
+		Testnet:     testnet,
+		Created:     time.Now().UTC(),
+		Files:       make(map[string]string),
+	}
+	ew, err := newBackupWriter(w, password)
+	if err != nil {
+		return nil, err
+	}
+	gw := gzip.NewWriter(ew)
+	tw := tar.NewWriter(gw)
+
+	if err := addBackupFile(tw, manifest, dbFile, path.Join(tmpPath, dbFile)); err != nil {
+		return nil, err
+	}
+	if err := addBackupFile(tw, manifest, "config", path.Join(repoPath, "config")); err != nil {
+		return nil, err
+	}
+	root := path.Join(repoPath, "root")
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(repoPath, p)
+		if err != nil {
+			return err
+		}
+		return addBackupFile(tw, manifest, filepath.ToSlash(rel), p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archiving root directory: %s", err.Error())
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeBackupEntry(tw, backupManifestFile, int64(len(manifestJSON)), bytes.NewReader(manifestJSON), ioutil.Discard); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	if err := ew.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// addBackupFile archives the file at filePath as name and records its hash
+// in the manifest
+func addBackupFile(tw *tar.Writer, manifest *BackupManifest, name, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	if err := writeBackupEntry(tw, name, info.Size(), f, h); err != nil {
+		return err
+	}
+	manifest.Files[name] = hex.EncodeToString(h.Sum(nil))
+	return nil
+}
+
+func writeBackupEntry(tw *tar.Writer, name string, size int64, r io.Reader, h io.Writer) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     size,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.CopyN(io.MultiWriter(tw, h), r, size)
+	return err
+}
+
+// RestoreBackup decrypts the backup read from r and replaces the database,
+// config and root directory in repoPath with its contents once every file
+// has passed its integrity check. The repover is set to the version the
+// backup was made at so the migrations bring it up to date on the next
+// start. The restored database is unencrypted.
+func RestoreBackup(r io.Reader, repoPath, password string, testnet bool) (*BackupManifest, error) {
+	tmpPath, err := ioutil.TempDir(repoPath, "restore")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	dr, err := newBackupReader(r, password)
+	if err != nil {
+		return nil, err
+	}
+	gr, err := gzip.NewReader(dr)
+	if err != nil {
+		return nil, backupReadError(err)
+	}
+	tr := tar.NewReader(gr)
+	var (
+		manifest  *BackupManifest
+		extracted = make(map[string]string)
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, backupReadError(err)
+		}
+		if manifest != nil {
+			return nil, errors.New("backup has entries after its manifest")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("backup entry %s is not a file", hdr.Name)
+		}
+		if hdr.Name == backupManifestFile {
+			manifest = new(BackupManifest)
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, backupReadError(err)
+			}
+			continue
+		}
+		if !validBackupEntry(hdr.Name) {
+			return nil, fmt.Errorf("backup entry %s is not allowed", hdr.Name)
+		}
+		if _, ok := extracted[hdr.Name]; ok {
+			return nil, fmt.Errorf("backup entry %s is duplicated", hdr.Name)
+		}
+		extracted[hdr.Name], err = extractBackupEntry(tr, path.Join(tmpPath, hdr.Name))
+		if err != nil {
+			return nil, backupReadError(err)
+		}
+	}
+	// Make sure the final chunk was read and nothing follows it
+	if _, err := io.Copy(ioutil.Discard, dr); err != nil {
+		return nil, backupReadError(err)
+	}
+
+	if manifest == nil {
+		return nil, errors.New("backup has no manifest")
+	}
+	if err := checkBackupManifest(manifest, extracted, testnet); err != nil {
+		return nil, err
+	}
+
+	dbFile := backupDatabaseFile(manifest.Testnet)
+	if err := os.MkdirAll(path.Join(repoPath, "datastore"), os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(path.Join(tmpPath, dbFile), path.Join(repoPath, dbFile)); err != nil {
+		return nil, fmt.Errorf("restoring database: %s", err.Error())
+	}
+	if err := os.Rename(path.Join(tmpPath, "config"), path.Join(repoPath, "config")); err != nil {
+		return nil, fmt.Errorf("restoring config: %s", err.Error())
+	}
+	if err := os.RemoveAll(path.Join(repoPath, "root")); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(path.Join(tmpPath, "root"), os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(path.Join(tmpPath, "root"), path.Join(repoPath, "root")); err != nil {
+		return nil, fmt.Errorf("restoring root directory: %s", err.Error())
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "repover"), []byte(strconv.Itoa(manifest.RepoVersion)), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("writing repover: %s", err.Error())
+	}
+	return manifest, nil
+}
+
+// backupReadError passes through the errors of the decrypting reader and
+// reports anything else wrong with the archive as corruption
+func backupReadError(err error) error {
+	switch err {
+	case ErrBackupPassword, ErrBackupCorrupt, ErrBackupTruncated:
+		return err
+	case io.ErrUnexpectedEOF:
+		return ErrBackupTruncated
+	}
+	return ErrBackupCorrupt
+}
+
+// validBackupEntry reports whether name is one of the files a backup may
+// restore
+func validBackupEntry(name string) bool {
+	if name != path.Clean(name) || path.IsAbs(name) || strings.HasPrefix(name, "..") {
+		return false
+	}
+	switch name {
+	case "config", backupDatabaseFile(false), backupDatabaseFile(true):
+		return true
+	}
+	return strings.HasPrefix(name, "root/")
+}
+
+// extractBackupEntry writes the entry to filePath and returns its hash
+func extractBackupEntry(r io.Reader, filePath string) (string, error) {
+	if err := os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// checkBackupManifest checks the backup can be restored by this node and
+// that the extracted files are exactly those listed in the manifest
+func checkBackupManifest(manifest *BackupManifest, extracted map[string]string, testnet bool) error {
+	if manifest.Version > BackupFormatVersion {
+		return fmt.Errorf("backup format version %d is newer than this node supports", manifest.Version)
+	}
+	repoVersion, err := strconv.Atoi(repo.RepoVersion)
+	if err != nil {
+		return err
+	}
+	if manifest.RepoVersion > repoVersion {
+		return fmt.Errorf("backup was made by a newer node (repo version %d), upgrade before restoring", manifest.RepoVersion)
+	}
+	if manifest.Testnet != testnet {
+		if manifest.Testnet {
+			return errors.New("backup is of a testnet node, restore it with testnet enabled")
+		}
+		return errors.New("backup is of a mainnet node, restore it with testnet disabled")
+	}
+	if _, ok := manifest.Files[backupDatabaseFile(manifest.Testnet)]; !ok {
+		return errors.New("backup has no database")
+	}
+	if _, ok := manifest.Files["config"]; !ok {
+		return errors.New("backup has no config")
+	}
+	if len(extracted) != len(manifest.Files) {
+		return ErrBackupCorrupt
+	}
+	for name, hash := range manifest.Files {
+		if extracted[name] != hash {
+			return ErrBackupCorrupt
+		}
+	}
+	return nil
+}
+
+// The archive is encrypted with AES-256-GCM under a key derived from the
+// password with scrypt. It starts with a header of the magic, the format
+// version, the scrypt salt and a random nonce prefix, followed by chunks of
+// at most backupChunkSize plaintext bytes. Each chunk is a flag byte, which is
+// set on the last chunk, and the length of the sealed chunk. The nonce of a
+// chunk is the prefix followed by the chunk's index and the header and flag
+// are authenticated with it so chunks can't be reordered, dropped or
+// appended without failing to decrypt.
+
+type backupWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	header  []byte
+	counter uint64
+	buf     []byte
+}
+
+func newBackupWriter(w io.Writer, password string) (*backupWriter, error) {
+	header := make([]byte, len(backupMagic)+1+backupSaltSize+backupPrefixSize)
+	copy(header, backupMagic)
+	header[len(backupMagic)] = BackupFormatVersion
+	if _, err := rand.Read(header[len(backupMagic)+1:]); err != nil {
+		return nil, err
+	}
+	aead, err := newBackupCipher(password, header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &backupWriter{w: w, aead: aead, header: header}, nil
+}
+
+func newBackupCipher(password string, header []byte) (cipher.AEAD, error) {
+	salt := header[len(backupMagic)+1 : len(backupMagic)+1+backupSaltSize]
+	key, err := scrypt.Key([]byte(password), salt, backupScryptN, backupScryptR, backupScryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (bw *backupWriter) Write(p []byte) (int, error) {
+	bw.buf = append(bw.buf, p...)
+	for len(bw.buf) > backupChunkSize {
+		if err := bw.seal(bw.buf[:backupChunkSize], false); err != nil {
+			return 0, err
+		}
+		bw.buf = bw.buf[backupChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Close writes the final chunk. It doesn't close the underlying writer.
+func (bw *backupWriter) Close() error {
+	err := bw.seal(bw.buf, true)
+	bw.buf = nil
+	return err
+}
+
+func (bw *backupWriter) seal(chunk []byte, final bool) error {
+	flag := byte(0)
+	if final {
+		flag = 1
+	}
+	sealed := bw.aead.Seal(nil, backupNonce(bw.header, bw.counter), chunk, append(append([]byte{}, bw.header...), flag))
+	bw.counter++
+
+	prefix := make([]byte, 5)
+	prefix[0] = flag
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(sealed)))
+	if _, err := bw.w.Write(prefix); err != nil {
+		return err
+	}
+	_, err := bw.w.Write(sealed)
+	return err
+}
+
+func backupNonce(header []byte, counter uint64) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce, header[len(header)-backupPrefixSize:])
+	binary.BigEndian.PutUint64(nonce[backupPrefixSize:], counter)
+	return nonce
+}
+
+type backupReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	counter uint64
+	buf     []byte
+	done    bool
+	err     error
+}
+
+func newBackupReader(r io.Reader, password string) (*backupReader, error) {
+	header := make([]byte, len(backupMagic)+1+backupSaltSize+backupPrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.New("not a backup file")
+	}
+	if string(header[:len(backupMagic)]) != backupMagic {
+		return nil, errors.New("not a backup file")
+	}
+	if header[len(backupMagic)] > BackupFormatVersion {
+		return nil, fmt.Errorf("backup format version %d is newer than this node supports", header[len(backupMagic)])
+	}
+	aead, err := newBackupCipher(password, header)
+	if err != nil {
+		return nil, err
+	}
+	return &backupReader{r: r, aead: aead, header: header}, nil
+}
+
+func (br *backupReader) Read(p []byte) (int, error) {
+	for len(br.buf) == 0 {
+		if br.err != nil {
+			return 0, br.err
+		}
+		if br.done {
+			// Anything after the final chunk was appended to the backup
+			if n, _ := io.ReadFull(br.r, make([]byte, 1)); n > 0 {
+				br.err = ErrBackupCorrupt
+			} else {
+				br.err = io.EOF
+			}
+			continue
+		}
+		br.err = br.open()
+	}
+	n := copy(p, br.buf)
+	br.buf = br.buf[n:]
+	return n, nil
+}
+
+func (br *backupReader) open() error {
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(br.r, prefix); err != nil {
+		return ErrBackupTruncated
+	}
+	flag := prefix[0]
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if flag > 1 || int(size) > backupChunkSize+br.aead.Overhead() {
+		return ErrBackupCorrupt
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(br.r, sealed); err != nil {
+		return ErrBackupTruncated
+	}
+	chunk, err := br.aead.Open(nil, backupNonce(br.header, br.counter), sealed, append(append([]byte{}, br.header...), flag))
+	if err != nil {
+		if br.counter == 0 {
+			return ErrBackupPassword
+		}
+		return ErrBackupCorrupt
+	}
+	br.counter++
+	br.done = flag == 1
+	br.buf = chunk
+	return nil
+}
+
+type backupScheduler struct {
+	// PerformTask dependencies
+	node      *OpenBazaarNode
+	directory string
+	keep      int
+	password  string
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartBackupScheduler - start the worker which writes an encrypted backup of
+// the node to directory every interval and removes all but the newest keep
+// backups. A keep of zero keeps every backup.
+func (n *OpenBazaarNode) StartBackupScheduler(directory string, interval time.Duration, keep int, password string) {
+	if directory == "" {
+		directory = path.Join(n.RepoPath, "backups")
+	}
+	n.BackupScheduler = &backupScheduler{
+		node:          n,
+		directory:     directory,
+		keep:          keep,
+		password:      password,
+		intervalDelay: interval,
+		logger:        logging.MustGetLogger("backupScheduler"),
+	}
+	go n.BackupScheduler.Run()
+}
+
+func (scheduler *backupScheduler) Run() {
+	scheduler.watchdogTimer = time.NewTicker(scheduler.intervalDelay)
+	scheduler.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	scheduler.PerformTask()
+	for {
+		select {
+		case <-scheduler.watchdogTimer.C:
+			scheduler.PerformTask()
+		case <-scheduler.stopWorker:
+			scheduler.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (scheduler *backupScheduler) Stop() {
+	scheduler.stopWorker <- true
+	close(scheduler.stopWorker)
+}
+
+func (scheduler *backupScheduler) PerformTask() {
+	backupPath, err := scheduler.node.WriteScheduledBackup(scheduler.directory, scheduler.password, time.Now())
+	if err != nil {
+		scheduler.logger.Errorf("writing backup: %s", err)
+		return
+	}
+	scheduler.logger.Infof("wrote backup %s", backupPath)
+	if err := PruneBackups(scheduler.directory, scheduler.keep); err != nil {
+		scheduler.logger.Errorf("pruning backups: %s", err)
+	}
+}
+
+// WriteScheduledBackup writes a backup named after the time to directory and
+// returns its path. The backup is written to a temporary file first so a
+// failed backup never looks like a complete one.
+func (n *OpenBazaarNode) WriteScheduledBackup(directory, password string, now time.Time) (string, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return "", err
+	}
+	backupPath := path.Join(directory, "openbazaar-"+now.UTC().Format("20060102T150405Z")+BackupFileExtension)
+	f, err := os.OpenFile(backupPath+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	_, err = n.Backup(f, password)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(backupPath + ".tmp")
+		return "", err
+	}
+	return backupPath, os.Rename(backupPath+".tmp", backupPath)
+}
+
+// PruneBackups removes all but the newest keep scheduled backups in
+// directory. A keep of zero keeps every backup.
+func PruneBackups(directory string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, f := range files {
+		if f.Mode().IsRegular() && strings.HasPrefix(f.Name(), "openbazaar-") && strings.HasSuffix(f.Name(), BackupFileExtension) {
+			backups = append(backups, f.Name())
+		}
+	}
+	// The names sort in the order the backups were written
+	sort.Strings(backups)
+	for len(backups) > keep {
+		if err := os.Remove(path.Join(directory, backups[0])); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package core_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+const testBackupPassword = "correct horse backup staple"
+
+func TestBackupAndRestore(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.TestnetEnable = true
+	if err := node.Datastore.Chat().Put("backup-msg", "QmVendor", "", "Back me up", time.Unix(1000, 0), true, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Chat().DeleteMessage("backup-msg")
+	ratingPath := path.Join(node.RepoPath, "root", "ratings", "backup-test.json")
+	if err := ioutil.WriteFile(ratingPath, []byte(`{"ratingData":{}}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(ratingPath)
+
+	var backup bytes.Buffer
+	manifest, err := node.Backup(&backup, testBackupPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.RepoVersion == 0 || !manifest.Testnet || manifest.Files["root/ratings/backup-test.json"] == "" {
+		t.Errorf("unexpected manifest: %+v", manifest)
+	}
+
+	restorePath, err := ioutil.TempDir("", "backup-restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(restorePath)
+	if _, err := core.RestoreBackup(bytes.NewReader(backup.Bytes()), restorePath, testBackupPassword, false); err == nil {
+		t.Error("expected a testnet backup restored to mainnet to be rejected")
+	}
+	restored, err := core.RestoreBackup(bytes.NewReader(backup.Bytes()), restorePath, testBackupPassword, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored.Files) != len(manifest.Files) {
+		t.Errorf("expected %d files to be restored, got %d", len(manifest.Files), len(restored.Files))
+	}
+
+	rating, err := ioutil.ReadFile(path.Join(restorePath, "root", "ratings", "backup-test.json"))
+	if err != nil || string(rating) != `{"ratingData":{}}` {
+		t.Errorf("expected the root directory to be restored, got %q (%v)", rating, err)
+	}
+	repoVer, err := ioutil.ReadFile(path.Join(restorePath, "repover"))
+	if err != nil || string(repoVer) != repo.RepoVersion {
+		t.Errorf("expected repover %s, got %q (%v)", repo.RepoVersion, repoVer, err)
+	}
+	restoredDB, err := db.Create(restorePath, "", true, wallet.Bitcoin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restoredDB.Close()
+	msg, err := restoredDB.Chat().GetMessage("backup-msg")
+	if err != nil || msg.Message != "Back me up" {
+		t.Errorf("expected the database to be restored, got %v (%v)", msg, err)
+	}
+}
+
+func TestRestoreBackupIntegrity(t *testing.T) {
+	node := newBroadcastingNode(t)
+	// An incompressible file makes the backup span several chunks
+	imagePath := path.Join(node.RepoPath, "root", "images", "backup-test.jpg")
+	image := make([]byte, 256*1024)
+	if _, err := rand.Read(image); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(imagePath, image, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(imagePath)
+
+	var backup bytes.Buffer
+	if _, err := core.WriteBackup(&backup, node.RepoPath, node.Datastore, true, testBackupPassword); err != nil {
+		t.Fatal(err)
+	}
+	restorePath, err := ioutil.TempDir("", "backup-restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(restorePath)
+
+	if _, err := core.RestoreBackup(bytes.NewReader(backup.Bytes()), restorePath, "wrong password", true); err != core.ErrBackupPassword {
+		t.Errorf("expected ErrBackupPassword, got %v", err)
+	}
+
+	tampered := append([]byte{}, backup.Bytes()...)
+	tampered[len(tampered)-1] ^= 0xff
+	if _, err := core.RestoreBackup(bytes.NewReader(tampered), restorePath, testBackupPassword, true); err != core.ErrBackupCorrupt {
+		t.Errorf("expected ErrBackupCorrupt, got %v", err)
+	}
+
+	truncated := backup.Bytes()[:backup.Len()-100]
+	if _, err := core.RestoreBackup(bytes.NewReader(truncated), restorePath, testBackupPassword, true); err != core.ErrBackupTruncated {
+		t.Errorf("expected ErrBackupTruncated, got %v", err)
+	}
+
+	appended := append(append([]byte{}, backup.Bytes()...), 0)
+	if _, err := core.RestoreBackup(bytes.NewReader(appended), restorePath, testBackupPassword, true); err != core.ErrBackupCorrupt {
+		t.Errorf("expected ErrBackupCorrupt, got %v", err)
+	}
+
+	if _, err := os.Stat(path.Join(restorePath, "config")); !os.IsNotExist(err) {
+		t.Error("expected nothing to be restored from a bad backup")
+	}
+}
+
+func TestRestoreBackupFromNewerNode(t *testing.T) {
+	node := newBroadcastingNode(t)
+	repoverPath := path.Join(node.RepoPath, "repover")
+	repoVer, err := ioutil.ReadFile(repoverPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ioutil.WriteFile(repoverPath, repoVer, os.ModePerm)
+	if err := ioutil.WriteFile(repoverPath, []byte("999"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	var backup bytes.Buffer
+	if _, err := core.WriteBackup(&backup, node.RepoPath, node.Datastore, true, testBackupPassword); err != nil {
+		t.Fatal(err)
+	}
+	restorePath, err := ioutil.TempDir("", "backup-restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(restorePath)
+	if _, err := core.RestoreBackup(&backup, restorePath, testBackupPassword, true); err == nil {
+		t.Error("expected a backup from a newer repo version to be rejected")
+	}
+}
+
+func TestScheduledBackups(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.TestnetEnable = true
+	backupDir, err := ioutil.TempDir("", "backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(backupDir)
+
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		if _, err := node.WriteScheduledBackup(backupDir, testBackupPassword, start.Add(time.Duration(i)*time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(backupDir, "notes.txt"), []byte("keep me"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := core.PruneBackups(backupDir, 2); err != nil {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	expected := []string{"notes.txt", "openbazaar-20190101T010000Z.obbackup", "openbazaar-20190101T020000Z.obbackup"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %v after pruning, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected %v after pruning, got %v", expected, names)
+			break
+		}
+	}
+}
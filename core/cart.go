@@ -0,0 +1,219 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// ErrEmptyCart is returned when a cart is checked out without items
+var ErrEmptyCart = errors.New("cart has no items")
+
+// CartVendor is the part of a cart ordered from one vendor
+type CartVendor struct {
+	VendorID string
+	Data     *repo.PurchaseData
+}
+
+// CartEstimate is the estimated total of a cart and of the order placed
+// with each of its vendors
+type CartEstimate struct {
+	Total   *repo.CurrencyValue  `json:"total"`
+	Vendors []CartVendorEstimate `json:"vendors"`
+}
+
+// CartVendorEstimate is the estimated total of the order placed with a vendor
+type CartVendorEstimate struct {
+	VendorID string              `json:"vendorID"`
+	Total    *repo.CurrencyValue `json:"total"`
+}
+
+// CartOrder is the outcome of placing and funding the order with one vendor.
+// Error is set if the order could not be placed or funded.
+type CartOrder struct {
+	VendorID       string              `json:"vendorID"`
+	OrderID        string              `json:"orderId,omitempty"`
+	PaymentAddress string              `json:"paymentAddress,omitempty"`
+	Amount         *repo.CurrencyValue `json:"amount,omitempty"`
+	VendorOnline   bool                `json:"vendorOnline"`
+	Txid           string              `json:"txid,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+// CartCheckout is the outcome of checking out a cart
+type CartCheckout struct {
+	Orders []*CartOrder `json:"orders"`
+}
+
+// SplitCart groups the cart's items by the vendor of their listing. Vendors
+// are returned in the order their first item appears in the cart.
+func (n *OpenBazaarNode) SplitCart(data *repo.CartData) ([]CartVendor, error) {
+	if len(data.Items) == 0 {
+		return nil, ErrEmptyCart
+	}
+	var (
+		vendorIDs []string
+		items     = make(map[string][]repo.Item)
+	)
+	for _, item := range data.Items {
+		vendorID, err := n.listingVendor(item.ListingHash)
+		if err != nil {
+			return nil, fmt.Errorf("reading listing (%s): %s", item.ListingHash, err.Error())
+		}
+		if _, ok := items[vendorID]; !ok {
+			vendorIDs = append(vendorIDs, vendorID)
+		}
+		items[vendorID] = append(items[vendorID], item)
+	}
+	vendors := make([]CartVendor, 0, len(vendorIDs))
+	for _, vendorID := range vendorIDs {
+		vendors = append(vendors, CartVendor{
+			VendorID: vendorID,
+			Data:     data.PurchaseDataForVendor(vendorID, items[vendorID]),
+		})
+	}
+	return vendors, nil
+}
+
+func (n *OpenBazaarNode) listingVendor(listingHash string) (string, error) {
+	sl, err := n.fetchSignedListing(listingHash)
+	if err != nil {
+		return "", err
+	}
+	return sl.GetVendorID().Hash()
+}
+
+// fetchSignedListing returns the signed listing with the hash, which is
+// usually cached after the buyer has viewed it
+func (n *OpenBazaarNode) fetchSignedListing(listingHash string) (repo.SignedListing, error) {
+	b, err := ipfs.Cat(n.IpfsNode, listingHash, time.Minute)
+	if err != nil {
+		return repo.SignedListing{}, err
+	}
+	return repo.UnmarshalJSONSignedListing(b)
+}
+
+// EstimateCartTotal returns the estimated total of the order placed with
+// each vendor in the cart and their sum
+func (n *OpenBazaarNode) EstimateCartTotal(data *repo.CartData) (*CartEstimate, error) {
+	vendors, err := n.SplitCart(data)
+	if err != nil {
+		return nil, err
+	}
+	return n.estimateCart(data.PaymentCoin, vendors)
+}
+
+func (n *OpenBazaarNode) estimateCart(paymentCoin string, vendors []CartVendor) (*CartEstimate, error) {
+	defn, err := n.LookupCurrency(paymentCoin)
+	if err != nil {
+		return nil, errors.New("invalid payment coin")
+	}
+	var (
+		total    = big.NewInt(0)
+		estimate = &CartEstimate{Vendors: make([]CartVendorEstimate, 0, len(vendors))}
+	)
+	for _, v := range vendors {
+		amount, err := n.EstimateOrderTotal(v.Data)
+		if err != nil {
+			return nil, fmt.Errorf("estimating order with vendor (%s): %s", v.VendorID, err.Error())
+		}
+		total.Add(total, amount)
+		estimate.Vendors = append(estimate.Vendors, CartVendorEstimate{
+			VendorID: v.VendorID,
+			Total:    &repo.CurrencyValue{Amount: amount, Currency: defn},
+		})
+	}
+	estimate.Total = &repo.CurrencyValue{Amount: total, Currency: defn}
+	return estimate, nil
+}
+
+// CheckoutCart places one order with each vendor in the cart. Every order is
+// estimated first and nothing is sent if any of them is invalid or, when the
+// cart is to be funded, if the wallet can't cover the total. Once orders are
+// sent a failure with one vendor doesn't stop the others and is reported in
+// that vendor's result.
+func (n *OpenBazaarNode) CheckoutCart(data *repo.CartData) (*CartCheckout, error) {
+	vendors, err := n.SplitCart(data)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := n.estimateCart(data.PaymentCoin, vendors)
+	if err != nil {
+		return nil, err
+	}
+	wal, err := n.Multiwallet.WalletForCurrencyCode(data.PaymentCoin)
+	if err != nil {
+		return nil, ErrUnknownWallet
+	}
+	if data.Fund {
+		confirmed, unconfirmed := wal.Balance()
+		balance := new(big.Int).Add(&confirmed.Value, &unconfirmed.Value)
+		if balance.Cmp(estimate.Total.Amount) < 0 {
+			return nil, ErrInsufficientFunds
+		}
+	}
+
+	checkout := &CartCheckout{Orders: make([]*CartOrder, 0, len(vendors))}
+	for _, v := range vendors {
+		order := &CartOrder{VendorID: v.VendorID}
+		orderID, paymentAddr, amount, online, err := n.Purchase(v.Data)
+		if err != nil {
+			order.Error = err.Error()
+		} else {
+			order.OrderID = orderID
+			order.PaymentAddress = paymentAddr
+			order.Amount = amount
+			order.VendorOnline = online
+		}
+		checkout.Orders = append(checkout.Orders, order)
+	}
+	if data.Fund {
+		n.fundCartOrders(data.FeeLevel, checkout.Orders)
+	}
+	return checkout, nil
+}
+
+// fundCartOrders pays each placed order with its own transaction so the
+// transaction metadata ties every payment to its order, and sends the payment
+// to the vendor
+func (n *OpenBazaarNode) fundCartOrders(feeLevel string, orders []*CartOrder) {
+	for _, o := range orders {
+		if o.Error != "" {
+			continue
+		}
+		txid, err := n.fundOrder(o.OrderID, o.PaymentAddress, o.Amount, feeLevel)
+		if err != nil {
+			o.Error = fmt.Sprintf("funding order: %s", err.Error())
+			continue
+		}
+		o.Txid = txid
+	}
+}
+
+// fundOrder pays the amount due for one of the node's purchases from the
+// wallet, sends the payment to the vendor and returns the transaction ID
+func (n *OpenBazaarNode) fundOrder(orderID, paymentAddress string, amount *repo.CurrencyValue, feeLevel string) (string, error) {
+	result, err := n.Spend(&SpendRequest{
+		Amount:                 amount.Amount.String(),
+		Currency:               &amount.Currency,
+		Address:                paymentAddress,
+		FeeLevel:               feeLevel,
+		OrderID:                orderID,
+		RequireAssociatedOrder: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	n.sendOrderPayment(result)
+	return result.Txid, nil
+}
+
+func (n *OpenBazaarNode) sendOrderPayment(spend *SpendResponse) {
+	if err := n.SendOrderPayment(spend); err != nil {
+		log.Errorf("error sending order with id %s payment: %v", spend.OrderID, err)
+	}
+}
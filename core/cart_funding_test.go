@@ -0,0 +1,108 @@
+package core
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/OpenBazaar/multiwallet"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+// cartSpendWallet records the spends made from the wallet instead of
+// broadcasting them
+type cartSpendWallet struct {
+	wi.Wallet
+	spends []wi.TransactionOutput
+}
+
+func (w *cartSpendWallet) DecodeAddress(addr string) (btcutil.Address, error) {
+	return btcutil.DecodeAddress(addr, &chaincfg.MainNetParams)
+}
+
+func (w *cartSpendWallet) Spend(amount big.Int, addr btcutil.Address, feeLevel wi.FeeLevel, referenceID string, spendAll bool) (*chainhash.Hash, error) {
+	w.spends = append(w.spends, wi.TransactionOutput{Address: addr, Value: amount, OrderID: referenceID})
+	return &chainhash.Hash{byte(len(w.spends))}, nil
+}
+
+func (w *cartSpendWallet) GetTransaction(txid chainhash.Hash) (wi.Txn, error) {
+	return wi.Txn{Txid: txid.String()}, nil
+}
+
+func (w *cartSpendWallet) Balance() (wi.CurrencyValue, wi.CurrencyValue) {
+	return wi.CurrencyValue{}, wi.CurrencyValue{}
+}
+
+func (w *cartSpendWallet) CurrencyCode() string {
+	return "BTC"
+}
+
+func TestFundCartOrdersPerOrder(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wal := new(cartSpendWallet)
+	node := &OpenBazaarNode{
+		Datastore:   db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin),
+		Multiwallet: multiwallet.MultiWallet{wi.Bitcoin: wal},
+	}
+
+	currency := repo.CurrencyDefinition{Code: repo.CurrencyCode("BTC"), Divisibility: 8}
+	orders := []*CartOrder{
+		{VendorID: "vendorOne", OrderID: "cartOrderOne", PaymentAddress: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Amount: &repo.CurrencyValue{Amount: big.NewInt(15000), Currency: currency}},
+		{VendorID: "vendorTwo", Error: "vendor rejected the order"},
+		{VendorID: "vendorThree", OrderID: "cartOrderThree", PaymentAddress: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", Amount: &repo.CurrencyValue{Amount: big.NewInt(27000), Currency: currency}},
+	}
+	for _, o := range []*CartOrder{orders[0], orders[2]} {
+		if err := node.Datastore.Purchases().Put(o.OrderID, *factory.NewContract(), pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	node.fundCartOrders("NORMAL", orders)
+
+	if len(wal.spends) != 2 {
+		t.Fatalf("expected a transaction for each placed order, got %d", len(wal.spends))
+	}
+	for i, o := range []*CartOrder{orders[0], orders[2]} {
+		spend := wal.spends[i]
+		if spend.Address.EncodeAddress() != o.PaymentAddress || spend.Value.Cmp(o.Amount.Amount) != 0 || spend.OrderID != o.OrderID {
+			t.Errorf("unexpected spend for order (%s): %+v", o.OrderID, spend)
+		}
+		if o.Txid == "" || o.Error != "" {
+			t.Fatalf("expected order (%s) to be funded, got %+v", o.OrderID, o)
+		}
+		metadata, err := node.Datastore.TxMetadata().Get(o.Txid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if metadata.OrderId != o.OrderID || metadata.Address != o.PaymentAddress {
+			t.Errorf("expected the transaction metadata to reference order (%s), got %+v", o.OrderID, metadata)
+		}
+	}
+	if orders[0].Txid == orders[2].Txid {
+		t.Error("expected each order to be funded by its own transaction")
+	}
+	if orders[1].Txid != "" {
+		t.Error("expected the order which wasn't placed to be left unfunded")
+	}
+}
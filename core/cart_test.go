@@ -0,0 +1,71 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func TestOpenBazaarNode_SplitCart(t *testing.T) {
+	node := newBroadcastingNode(t)
+
+	if _, err := node.SplitCart(&repo.CartData{}); err != core.ErrEmptyCart {
+		t.Fatalf("expected ErrEmptyCart, got %v", err)
+	}
+
+	for _, slug := range []string{"cart-shirt", "cart-hat"} {
+		listing, err := repo.NewListingFromProtobuf(factory.NewListing(slug))
+		if err != nil {
+			t.Fatal(err)
+		}
+		lb, err := listing.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := node.CreateListing(lb); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, err := node.GetListings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var index []repo.ListingIndexData
+	if err := json.Unmarshal(b, &index); err != nil {
+		t.Fatal(err)
+	}
+
+	vendorID := node.IPFSIdentityString()
+	cart := &repo.CartData{
+		PurchaseData: repo.PurchaseData{PaymentCoin: "TBTC"},
+		Moderators:   map[string]string{vendorID: "QmModerator"},
+	}
+	for _, l := range index {
+		cart.Items = append(cart.Items, repo.Item{ListingHash: l.Hash, Quantity: "1"})
+	}
+
+	vendors, err := node.SplitCart(cart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vendors) != 1 {
+		t.Fatalf("expected one vendor, got %d", len(vendors))
+	}
+	if vendors[0].VendorID != vendorID {
+		t.Errorf("expected vendor (%s), got (%s)", vendorID, vendors[0].VendorID)
+	}
+	if len(vendors[0].Data.Items) != len(index) {
+		t.Errorf("expected %d items, got %d", len(index), len(vendors[0].Data.Items))
+	}
+	if vendors[0].Data.Moderator != "QmModerator" || vendors[0].Data.PaymentCoin != "TBTC" {
+		t.Errorf("unexpected purchase data: %+v", vendors[0].Data)
+	}
+
+	cart.Items = append(cart.Items, repo.Item{ListingHash: "QmUnknownListing"})
+	if _, err := node.SplitCart(cart); err == nil {
+		t.Error("expected an error for an unknown listing")
+	}
+}
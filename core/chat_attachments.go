@@ -0,0 +1,246 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// ChatMaxAttachments - limit for attachments on a chat msg
+	ChatMaxAttachments = 10
+	// ChatAttachmentMaxBytes - limit for the size of a chat attachment
+	ChatAttachmentMaxBytes = 10 << 20
+	// ChatAttachmentFilenameMaxCharacters - limit for an attachment's filename
+	ChatAttachmentFilenameMaxCharacters = 255
+
+	// chatAttachmentEncryptionOverhead is the allowance for the bytes added to
+	// an attachment by encrypting it
+	chatAttachmentEncryptionOverhead = 4096
+
+	// chatAttachmentFetchTimeout is how long fetching an attachment from the
+	// network may take
+	chatAttachmentFetchTimeout = 5 * time.Minute
+)
+
+// ErrChatAttachmentNotFound - no chat message carries an attachment with the CID
+var ErrChatAttachmentNotFound = errors.New("chat attachment not found")
+
+// ValidateChatAttachments checks the attachment descriptors on a chat message
+// are well formed
+func ValidateChatAttachments(attachments []*pb.Chat_Attachment) error {
+	if len(attachments) > ChatMaxAttachments {
+		return fmt.Errorf("chat message may carry at most %d attachments", ChatMaxAttachments)
+	}
+	for i, a := range attachments {
+		if _, err := cid.Decode(a.Cid); err != nil {
+			return fmt.Errorf("attachment %d: invalid cid", i)
+		}
+		if a.Size > ChatAttachmentMaxBytes {
+			return fmt.Errorf("attachment %d: larger than %d bytes", i, ChatAttachmentMaxBytes)
+		}
+		if err := validateChatAttachmentFile(a.Filename, a.MimeType); err != nil {
+			return fmt.Errorf("attachment %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateChatAttachmentFiles checks the files to attach to an outgoing chat
+// message. Files without a mime type get the one detected from their content.
+func ValidateChatAttachmentFiles(files []repo.ChatAttachmentFile) error {
+	if len(files) > ChatMaxAttachments {
+		return fmt.Errorf("chat message may carry at most %d attachments", ChatMaxAttachments)
+	}
+	for i := range files {
+		if len(files[i].Data) == 0 {
+			return fmt.Errorf("attachment %d: file is empty", i)
+		}
+		if len(files[i].Data) > ChatAttachmentMaxBytes {
+			return fmt.Errorf("attachment %d: larger than %d bytes", i, ChatAttachmentMaxBytes)
+		}
+		if files[i].MimeType == "" {
+			files[i].MimeType = http.DetectContentType(files[i].Data)
+		}
+		if err := validateChatAttachmentFile(files[i].Filename, files[i].MimeType); err != nil {
+			return fmt.Errorf("attachment %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateChatAttachmentFile(filename, mimeType string) error {
+	if filename == "" {
+		return errors.New("filename is missing")
+	}
+	if len(filename) > ChatAttachmentFilenameMaxCharacters {
+		return fmt.Errorf("filename is longer than %d characters", ChatAttachmentFilenameMaxCharacters)
+	}
+	if strings.ContainsAny(filename, "/\\") {
+		return errors.New("filename must not contain a path")
+	}
+	if _, _, err := mime.ParseMediaType(mimeType); err != nil {
+		return errors.New("invalid mime type")
+	}
+	return nil
+}
+
+// AddChatAttachments adds the files to IPFS for a chat message to the peers.
+// It returns the attachment descriptors to send to each peer and the ones to
+// store with the outgoing message. When encrypting, each peer gets a copy
+// encrypted with its key and the stored copy is encrypted with the node's own
+// key so only the participants can read them.
+func (n *OpenBazaarNode) AddChatAttachments(peerIDs []string, files []repo.ChatAttachmentFile, encrypt bool) (map[string][]*pb.Chat_Attachment, []repo.ChatAttachment, error) {
+	if err := ValidateChatAttachmentFiles(files); err != nil {
+		return nil, nil, err
+	}
+	pids := make([]peer.ID, 0, len(peerIDs))
+	for _, peerID := range peerIDs {
+		pid, err := peer.IDB58Decode(peerID)
+		if err != nil {
+			return nil, nil, err
+		}
+		pids = append(pids, pid)
+	}
+
+	var (
+		sent   = make(map[string][]*pb.Chat_Attachment)
+		stored []repo.ChatAttachment
+	)
+	for _, f := range files {
+		data := f.Data
+		if encrypt {
+			var err error
+			data, err = net.Encrypt(n.IpfsNode.PrivateKey.GetPublic(), f.Data)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		hash, err := ipfs.AddData(n.IpfsNode, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		stored = append(stored, repo.ChatAttachment{
+			Cid:       hash,
+			MimeType:  f.MimeType,
+			Size:      uint64(len(f.Data)),
+			Filename:  f.Filename,
+			Encrypted: encrypt,
+			Fetched:   true,
+		})
+
+		for _, pid := range pids {
+			if encrypt {
+				ciphertext, err := n.EncryptMessage(pid, nil, f.Data)
+				if err != nil {
+					return nil, nil, err
+				}
+				hash, err = ipfs.AddData(n.IpfsNode, ciphertext)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			sent[pid.Pretty()] = append(sent[pid.Pretty()], &pb.Chat_Attachment{
+				Cid:       hash,
+				MimeType:  f.MimeType,
+				Size:      uint64(len(f.Data)),
+				Filename:  f.Filename,
+				Encrypted: encrypt,
+			})
+		}
+	}
+	return sent, stored, nil
+}
+
+// ReceiveChatAttachments stores the attachment descriptors of an incoming chat
+// message and fetches and pins the attachments in the background
+func (n *OpenBazaarNode) ReceiveChatAttachments(messageID string, attachments []*pb.Chat_Attachment) error {
+	received := make([]repo.ChatAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		received = append(received, repo.ChatAttachment{
+			Cid:       a.Cid,
+			MimeType:  a.MimeType,
+			Size:      a.Size,
+			Filename:  a.Filename,
+			Encrypted: a.Encrypted,
+		})
+	}
+	if err := n.Datastore.Chat().PutAttachments(messageID, received); err != nil {
+		return err
+	}
+	go func() {
+		for i := range received {
+			if _, err := n.fetchChatAttachment(&received[i], true); err != nil {
+				log.Errorf("fetching chat attachment %s: %s", received[i].Cid, err.Error())
+			}
+		}
+	}()
+	return nil
+}
+
+// GetChatAttachment returns the attachment with the CID and its decrypted
+// content. Attachments which weren't fetched yet are fetched and pinned.
+func (n *OpenBazaarNode) GetChatAttachment(hash string) (*repo.ChatAttachment, []byte, error) {
+	attachment, err := n.Datastore.Chat().GetAttachment(hash)
+	if err == sql.ErrNoRows {
+		return nil, nil, ErrChatAttachmentNotFound
+	} else if err != nil {
+		return nil, nil, err
+	}
+	data, err := n.fetchChatAttachment(attachment, !attachment.Fetched)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment.Fetched = true
+	return attachment, data, nil
+}
+
+// fetchChatAttachment fetches the attachment and returns its decrypted
+// content. Content larger than the size the attachment declares, allowing for
+// encryption, isn't read and content of any other size than declared is
+// rejected. When pinning, an attachment which passes is pinned and recorded
+// as fetched.
+func (n *OpenBazaarNode) fetchChatAttachment(attachment *repo.ChatAttachment, pin bool) ([]byte, error) {
+	if attachment.Size > ChatAttachmentMaxBytes {
+		return nil, fmt.Errorf("attachment is larger than %d bytes", ChatAttachmentMaxBytes)
+	}
+	maxBytes := int64(attachment.Size)
+	if attachment.Encrypted {
+		maxBytes += chatAttachmentEncryptionOverhead
+	}
+	data, err := ipfs.CatLimited(n.IpfsNode, attachment.Cid, chatAttachmentFetchTimeout, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.Encrypted {
+		data, err = net.Decrypt(n.IpfsNode.PrivateKey, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if uint64(len(data)) != attachment.Size {
+		return nil, fmt.Errorf("attachment is %d bytes but declared %d", len(data), attachment.Size)
+	}
+	if !pin {
+		return data, nil
+	}
+	if err := ipfs.Pin(n.IpfsNode, attachment.Cid, chatAttachmentFetchTimeout); err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.Chat().MarkAttachmentFetched(attachment.Cid); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
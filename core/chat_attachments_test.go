@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestValidateChatAttachments(t *testing.T) {
+	valid := func() *pb.Chat_Attachment {
+		return &pb.Chat_Attachment{
+			Cid:      "QmfQkD8pBSBCBxWEwFSu4XaDVSWK6bjnNuaWZjMyQbyDub",
+			MimeType: "image/png",
+			Size:     1024,
+			Filename: "damage.png",
+		}
+	}
+	if err := core.ValidateChatAttachments([]*pb.Chat_Attachment{valid()}); err != nil {
+		t.Errorf("expected a valid attachment, got %s", err)
+	}
+
+	invalid := []func(a *pb.Chat_Attachment){
+		func(a *pb.Chat_Attachment) { a.Cid = "not-a-cid" },
+		func(a *pb.Chat_Attachment) { a.Size = core.ChatAttachmentMaxBytes + 1 },
+		func(a *pb.Chat_Attachment) { a.Filename = "" },
+		func(a *pb.Chat_Attachment) { a.Filename = "../../damage.png" },
+		func(a *pb.Chat_Attachment) {
+			a.Filename = strings.Repeat("a", core.ChatAttachmentFilenameMaxCharacters+1)
+		},
+		func(a *pb.Chat_Attachment) { a.MimeType = "" },
+	}
+	for i, mutate := range invalid {
+		a := valid()
+		mutate(a)
+		if err := core.ValidateChatAttachments([]*pb.Chat_Attachment{a}); err == nil {
+			t.Errorf("case %d: expected the attachment to be rejected", i)
+		}
+	}
+
+	tooMany := make([]*pb.Chat_Attachment, core.ChatMaxAttachments+1)
+	for i := range tooMany {
+		tooMany[i] = valid()
+	}
+	if err := core.ValidateChatAttachments(tooMany); err == nil {
+		t.Error("expected too many attachments to be rejected")
+	}
+}
+
+func TestOpenBazaarNode_ChatAttachments(t *testing.T) {
+	node := newBroadcastingNode(t)
+	defer node.Datastore.Chat().DeleteMessage("chatAttachmentsTest")
+	// The test node's RSA key is shorter than the encryption supports so the
+	// attachments are encrypted with a generated identity key
+	privKey, _, err := libp2p.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeKey := node.IpfsNode.PrivateKey
+	node.IpfsNode.PrivateKey = privKey
+	defer func() { node.IpfsNode.PrivateKey = nodeKey }()
+
+	invoice := []byte("%PDF-1.4 invoice for order 1")
+	files := []repo.ChatAttachmentFile{
+		{Filename: "invoice.pdf", MimeType: "application/pdf", Data: invoice},
+		{Filename: "note.txt", Data: []byte("thanks for your order")},
+	}
+	for _, encrypt := range []bool{false, true} {
+		sent, stored, err := node.AddChatAttachments(nil, files, encrypt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != 0 {
+			t.Errorf("expected no attachments to be sent, got %d", len(sent))
+		}
+		if len(stored) != 2 {
+			t.Fatalf("expected 2 stored attachments, got %d", len(stored))
+		}
+		if stored[1].MimeType != "text/plain; charset=utf-8" {
+			t.Errorf("expected the mime type to be detected, got %s", stored[1].MimeType)
+		}
+		if stored[0].Size != uint64(len(invoice)) || stored[0].Encrypted != encrypt {
+			t.Errorf("unexpected attachment: %+v", stored[0])
+		}
+
+		if err := node.Datastore.Chat().Put("chatAttachmentsTest", "abc", "", "", time.Now(), false, true); err != nil {
+			t.Fatal(err)
+		}
+		if err := node.Datastore.Chat().PutAttachments("chatAttachmentsTest", stored); err != nil {
+			t.Fatal(err)
+		}
+		attachment, data, err := node.GetChatAttachment(stored[0].Cid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, invoice) {
+			t.Errorf("expected the attachment content to round trip, got %q", data)
+		}
+		if attachment.Filename != "invoice.pdf" || !attachment.Fetched {
+			t.Errorf("unexpected attachment: %+v", attachment)
+		}
+		if err := node.Datastore.Chat().DeleteMessage("chatAttachmentsTest"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Content of a different size than declared is neither returned nor
+	// pinned
+	hash, err := ipfs.AddData(node.IpfsNode, invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Chat().Put("chatAttachmentsTest", "abc", "", "", time.Now(), false, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, size := range []uint64{uint64(len(invoice)) - 1, uint64(len(invoice)) + 1} {
+		declared := []repo.ChatAttachment{{Cid: hash, MimeType: "application/pdf", Size: size, Filename: "invoice.pdf"}}
+		if err := node.Datastore.Chat().PutAttachments("chatAttachmentsTest", declared); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := node.GetChatAttachment(hash); err == nil {
+			t.Errorf("expected content of %d bytes declared as %d to be rejected", len(invoice), size)
+		}
+		attachment, err := node.Datastore.Chat().GetAttachment(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if attachment.Fetched {
+			t.Errorf("expected the attachment declared as %d bytes not to be fetched", size)
+		}
+	}
+	if err := node.Datastore.Chat().DeleteMessage("chatAttachmentsTest"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := node.GetChatAttachment("QmfQkD8pBSBCBxWEwFSu4XaDVSWK6bjnNuaWZjMyQbyDub"); err != core.ErrChatAttachmentNotFound {
+		t.Errorf("expected ErrChatAttachmentNotFound, got %v", err)
+	}
+	if _, _, err := node.AddChatAttachments(nil, []repo.ChatAttachmentFile{{Filename: "empty.txt"}}, false); err == nil {
+		t.Error("expected an empty file to be rejected")
+	}
+	if _, _, err := node.AddChatAttachments([]string{"not-a-peer"}, files, false); err == nil {
+		t.Error("expected an invalid peer ID to be rejected")
+	}
+}
@@ -67,6 +67,9 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 	if err != nil {
 		return err
 	}
+	if err := n.CheckCrowdFundPledge(orderID); err != nil {
+		return err
+	}
 
 	oc := new(pb.OrderCompletion)
 	oc.OrderId = orderID
@@ -265,7 +268,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			contract.Signatures = append(contract.Signatures, sig)
 		}
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_COMPLETED, true)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_COMPLETED, true, "order completed")
 	if err != nil {
 		return err
 	}
@@ -392,7 +395,7 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 		return err
 	}
 
-	err = n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_PAYMENT_FINALIZED, true)
+	err = n.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_PAYMENT_FINALIZED, true, "escrow released")
 	if err != nil {
 		return err
 	}
@@ -457,6 +460,10 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 			continue
 		}
 
+		// Replies are published by the vendor separately and attached
+		// when the rating is served
+		rating.VendorReply = nil
+
 		m := jsonpb.Marshaler{
 			EnumsAsInts:  false,
 			EmitDefaults: false,
@@ -114,13 +114,13 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(oldState pb.OrderState, contract *p
 	if err != nil {
 		return err
 	}
-	err = n.Datastore.Sales().Put(confirmedContract.VendorOrderConfirmation.OrderID, *confirmedContract, pb.OrderState_AWAITING_FULFILLMENT, false)
+	err = n.OrderStates().UpdateSale(confirmedContract.VendorOrderConfirmation.OrderID, *confirmedContract, pb.OrderState_AWAITING_FULFILLMENT, false, "order confirmed")
 	if err != nil {
 		return err
 	}
 
 	recoverState := func() {
-		err = n.Datastore.Sales().Put(confirmedContract.VendorOrderConfirmation.OrderID, *contract, oldState, false)
+		err = n.OrderStates().RestoreSale(confirmedContract.VendorOrderConfirmation.OrderID, *contract, oldState, false, "order confirmation failed")
 		if err != nil {
 			log.Errorf("failed to recover state on order (%s): %s", confirmedContract.VendorOrderConfirmation.OrderID, err.Error())
 		}
@@ -280,7 +280,7 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 	if err != nil {
 		return fmt.Errorf("sending rejection: %s", err.Error())
 	}
-	if err := n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_DECLINED, true); err != nil {
+	if err := n.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_DECLINED, true, "order rejected"); err != nil {
 		return fmt.Errorf("updating sale state: %s", err.Error())
 	}
 	return nil
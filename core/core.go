@@ -17,6 +17,7 @@ import (
 
 	"github.com/OpenBazaar/multiwallet"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	rep "github.com/OpenBazaar/openbazaar-go/net/repointer"
 	ret "github.com/OpenBazaar/openbazaar-go/net/retriever"
@@ -98,6 +99,13 @@ type OpenBazaarNode struct {
 	// Manage blocked peers
 	BanManager *net.BanManager
 
+	// RateLimiter limits the messages each peer may send the node. Peers
+	// which send RateLimitBanAfter messages over a limit are banned for
+	// RateLimitBanDuration.
+	RateLimiter          *net.RateLimiter
+	RateLimitBanDuration time.Duration
+	RateLimitBanAfter    int
+
 	// Allow other nodes to push data to this node for storage
 	AcceptStoreRequests bool
 
@@ -105,6 +113,38 @@ type OpenBazaarNode struct {
 	// notify the user as disputes age past certain thresholds
 	RecordAgingNotifier *recordAgingNotifier
 
+	// ListingScheduler is a worker that publishes scheduled listings and
+	// hides or republishes listings once they expire
+	ListingScheduler *listingScheduler
+
+	// ReservationExpirer is a worker that frees inventory held for orders
+	// which were never funded
+	ReservationExpirer *reservationExpirer
+
+	// SubscriptionRenewer is a worker that places and pays the orders of the
+	// buyer's subscriptions each billing period
+	SubscriptionRenewer *subscriptionRenewer
+
+	// CrowdFundSettler is a worker that commits or refunds the pledges to
+	// the node's crowdfunding listings once their deadline passes
+	CrowdFundSettler *crowdFundSettler
+
+	// FeedUpdater is a worker that fetches the posts of the peers the node
+	// follows into the feed
+	FeedUpdater *feedUpdater
+
+	// BackupScheduler is a worker that writes an encrypted backup of the
+	// node on an interval when scheduled backups are enabled
+	BackupScheduler *backupScheduler
+
+	// AnnouncementListener receives the post and listing announcements of
+	// the channels and peers the node is subscribed to over pubsub
+	AnnouncementListener *announcementListener
+
+	// ModeratorStatsPublisher is a worker that keeps the node's published
+	// moderator statistics up to date
+	ModeratorStatsPublisher *moderatorStatsPublisher
+
 	// Generic pubsub interface
 	Pubsub ipfs.Pubsub
 
@@ -205,7 +245,9 @@ func (n *OpenBazaarNode) publish(hash string) {
 	}()
 
 	inflightPublishRequests++
+	start := time.Now()
 	err = ipfs.Publish(n.IpfsNode, hash)
+	metrics.IPNSPublishDuration.WithLabelValues(metrics.Result(err)).Observe(time.Since(start).Seconds())
 
 	inflightPublishRequests--
 	if inflightPublishRequests == 0 {
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+)
+
+// couponLock serializes checking the redemption limits of coupons and
+// redeeming them so concurrent orders can't both take the last redemption
+var couponLock sync.Mutex
+
+// appliedCoupon is a listing coupon along with the order items it was
+// applied to
+type appliedCoupon struct {
+	listing *pb.Listing
+	coupon  *pb.Listing_Coupon
+	code    string
+	items   []*pb.Order_Item
+}
+
+// appliedCoupons matches the coupon codes in the order against the coupons
+// published in the listings. Codes which match no coupon give no discount and
+// are skipped. A coupon applied to several items of a listing is returned once.
+func appliedCoupons(contract *pb.RicardianContract, listingMap map[string]*pb.Listing) ([]*appliedCoupon, error) {
+	var (
+		applied []*appliedCoupon
+		byHash  = make(map[string]*appliedCoupon)
+	)
+	for _, item := range contract.BuyerOrder.Items {
+		listing, ok := listingMap[item.ListingHash]
+		if !ok {
+			continue
+		}
+		for _, code := range item.CouponCodes {
+			id, err := ipfs.EncodeMultihash([]byte(code))
+			if err != nil {
+				return nil, err
+			}
+			for _, coupon := range listing.Coupons {
+				if id.B58String() != coupon.GetHash() {
+					continue
+				}
+				key := listing.Slug + "/" + coupon.GetHash()
+				if ac, ok := byHash[key]; ok {
+					ac.items = append(ac.items, item)
+					continue
+				}
+				ac := &appliedCoupon{listing: listing, coupon: coupon, code: code, items: []*pb.Order_Item{item}}
+				byHash[key] = ac
+				applied = append(applied, ac)
+			}
+		}
+	}
+	return applied, nil
+}
+
+// contractListingMap returns the listings in the contract keyed by the hash
+// the order items reference them by
+func contractListingMap(contract *pb.RicardianContract) (map[string]*pb.Listing, error) {
+	listingMap := make(map[string]*pb.Listing)
+	for _, listing := range contract.VendorListings {
+		ser, err := proto.Marshal(listing)
+		if err != nil {
+			return nil, err
+		}
+		listingID, err := ipfs.EncodeCID(ser)
+		if err != nil {
+			return nil, err
+		}
+		listingMap[listingID.String()] = listing
+	}
+	return listingMap, nil
+}
+
+// validateCouponLimits rejects the order if a coupon in it is outside of its
+// validity period, is applied to items worth less than its minimum order
+// value or has already been redeemed as often as the vendor allows. Orders
+// which were never funded stop counting once their inventory reservation
+// would have expired.
+func (n *OpenBazaarNode) validateCouponLimits(contract *pb.RicardianContract, listingMap map[string]*pb.Listing, now time.Time) error {
+	applied, err := appliedCoupons(contract, listingMap)
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	return n.checkCouponLimits(orderID, contract, applied, now)
+}
+
+// checkCouponLimits checks the coupons applied to the order against their
+// limits. Redemptions already recorded for the order don't count against it.
+func (n *OpenBazaarNode) checkCouponLimits(orderID string, contract *pb.RicardianContract, applied []*appliedCoupon, now time.Time) error {
+	since := now.Add(-InventoryReservationTimeout)
+	for _, ac := range applied {
+		limits := ac.coupon.GetLimits()
+		if limits == nil {
+			continue
+		}
+		if limits.ValidFrom != nil && now.Before(time.Unix(limits.ValidFrom.Seconds, 0)) {
+			return NewErrCouponRejected("ERR_COUPON_NOT_YET_VALID", "coupon is not valid yet", ac.listing.Slug, ac.code)
+		}
+		if limits.ValidUntil != nil && !now.Before(time.Unix(limits.ValidUntil.Seconds, 0)) {
+			return NewErrCouponRejected("ERR_COUPON_EXPIRED", "coupon has expired", ac.listing.Slug, ac.code)
+		}
+		if limits.BigMinimumOrderValue != "" {
+			minimum, ok := new(big.Int).SetString(limits.BigMinimumOrderValue, 10)
+			if !ok {
+				return fmt.Errorf("invalid minimum order value for coupon (%s)", ac.code)
+			}
+			value, err := appliedCouponValue(ac)
+			if err != nil {
+				return err
+			}
+			if value.Cmp(minimum) < 0 {
+				return NewErrCouponRejected("ERR_COUPON_MINIMUM_NOT_MET", "order value is below the coupon minimum of "+minimum.String(), ac.listing.Slug, ac.code)
+			}
+		}
+		if limits.MaxRedemptions > 0 {
+			count, err := n.Datastore.Coupons().CountRedemptions(ac.listing.Slug, ac.coupon.GetHash(), since, orderID)
+			if err != nil {
+				return err
+			}
+			if uint64(count) >= limits.MaxRedemptions {
+				return NewErrCouponRejected("ERR_COUPON_REDEMPTIONS_EXHAUSTED", "coupon has reached its maximum number of redemptions", ac.listing.Slug, ac.code)
+			}
+		}
+		if limits.OncePerBuyer {
+			redeemed, err := n.Datastore.Coupons().HasRedeemed(ac.listing.Slug, ac.coupon.GetHash(), contract.BuyerOrder.BuyerID.PeerID, since, orderID)
+			if err != nil {
+				return err
+			}
+			if redeemed {
+				return NewErrCouponRejected("ERR_COUPON_ALREADY_REDEEMED", "coupon may only be redeemed once per buyer", ac.listing.Slug, ac.code)
+			}
+		}
+	}
+	return nil
+}
+
+// appliedCouponValue returns the value of the items the coupon was applied to
+// before any discounts in the listing's price currency
+func appliedCouponValue(ac *appliedCoupon) (*big.Int, error) {
+	rl, err := repo.NewListingFromProtobuf(ac.listing)
+	if err != nil {
+		return nil, err
+	}
+	price, err := rl.GetPrice()
+	if err != nil {
+		return nil, err
+	}
+	value := big.NewInt(0)
+	for _, item := range ac.items {
+		itemValue, err := orderItemValue(ac.listing, price.Amount, item)
+		if err != nil {
+			return nil, err
+		}
+		value.Add(value, itemValue)
+	}
+	return value, nil
+}
+
+// RedeemCoupons records the coupons applied to an order the vendor accepted
+// so they count towards the coupons' redemption limits. The limits are
+// checked again along with recording the redemptions so an ErrCouponRejected
+// is returned and nothing is recorded if other orders took the coupon since
+// the order was validated.
+func (n *OpenBazaarNode) RedeemCoupons(orderID string, contract *pb.RicardianContract, now time.Time) error {
+	couponLock.Lock()
+	defer couponLock.Unlock()
+
+	listingMap, err := contractListingMap(contract)
+	if err != nil {
+		return err
+	}
+	applied, err := appliedCoupons(contract, listingMap)
+	if err != nil {
+		return err
+	}
+	if err := n.checkCouponLimits(orderID, contract, applied, now); err != nil {
+		return err
+	}
+	for _, ac := range applied {
+		err := n.Datastore.Coupons().PutRedemption(repo.CouponRedemption{
+			OrderID:   orderID,
+			Slug:      ac.listing.Slug,
+			Hash:      ac.coupon.GetHash(),
+			BuyerID:   contract.BuyerOrder.BuyerID.PeerID,
+			Timestamp: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
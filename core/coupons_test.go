@@ -0,0 +1,184 @@
+package core_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// newLimitedCouponListing returns a listing with a single coupon for the
+// code as it would be published, restricted by the limits
+func newLimitedCouponListing(t *testing.T, slug, code string, limits *pb.Listing_Coupon_Limits) *pb.Listing {
+	hash, err := ipfs.EncodeMultihash([]byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	listing := factory.NewListing(slug)
+	listing.Coupons = []*pb.Listing_Coupon{
+		{
+			Title:    "Launch Discount",
+			Code:     &pb.Listing_Coupon_Hash{Hash: hash.B58String()},
+			Discount: &pb.Listing_Coupon_PercentDiscount{PercentDiscount: 10},
+			Limits:   limits,
+		},
+	}
+	return listing
+}
+
+func newCouponContract(t *testing.T, listing *pb.Listing, buyer, quantity, code string) *pb.RicardianContract {
+	contract := newInventoryContract(t, listing, buyer, quantity)
+	contract.BuyerOrder.Payment.AmountCurrency = &pb.CurrencyDefinition{Code: listing.Metadata.AcceptedCurrencies[0], Divisibility: 8}
+	contract.BuyerOrder.BuyerID.Pubkeys = &pb.ID_Pubkeys{}
+	contract.BuyerOrder.RatingKeys = [][]byte{make([]byte, 33)}
+	contract.BuyerOrder.Items[0].CouponCodes = []string{code}
+	contract.BuyerOrder.Items[0].ShippingOption = &pb.Order_Item_ShippingOption{
+		Name:    listing.ShippingOptions[0].Name,
+		Service: listing.ShippingOptions[0].Services[0].Name,
+	}
+	contract.BuyerOrder.Shipping.Country = listing.ShippingOptions[0].Regions[0]
+	return contract
+}
+
+func assertCouponRejected(t *testing.T, err error, code string) {
+	t.Helper()
+	rejected, ok := err.(core.ErrCouponRejected)
+	if !ok {
+		t.Fatalf("expected coupon to be rejected with %s, got %v", code, err)
+	}
+	if rejected.Code != code || rejected.CouponCode != "LAUNCH" {
+		t.Errorf("unexpected rejection: %+v", rejected)
+	}
+}
+
+// assertCouponAccepted checks the order got past the coupon limits. The test
+// contracts are unsigned so ValidateOrder fails later on.
+func assertCouponAccepted(t *testing.T, err error) {
+	t.Helper()
+	if err == nil || err.Error() != "contract does not contain a signature for the order" {
+		t.Fatalf("expected coupon to be accepted, got %v", err)
+	}
+}
+
+func TestOpenBazaarNode_ValidateOrderCouponLimits(t *testing.T) {
+	node := newBroadcastingNode(t)
+	now := time.Now()
+
+	notYetValid := newLimitedCouponListing(t, "early", "LAUNCH", &pb.Listing_Coupon_Limits{
+		ValidFrom: &timestamp.Timestamp{Seconds: now.Add(time.Hour).Unix()},
+	})
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, notYetValid, "buyer1", "1", "LAUNCH"), false), "ERR_COUPON_NOT_YET_VALID")
+
+	expired := newLimitedCouponListing(t, "late", "LAUNCH", &pb.Listing_Coupon_Limits{
+		ValidUntil: &timestamp.Timestamp{Seconds: now.Add(-time.Hour).Unix()},
+	})
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, expired, "buyer1", "1", "LAUNCH"), false), "ERR_COUPON_EXPIRED")
+
+	// The minimum is compared to the item price before the discount
+	minimum := newLimitedCouponListing(t, "minimum", "LAUNCH", &pb.Listing_Coupon_Limits{
+		BigMinimumOrderValue: "4000",
+	})
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, minimum, "buyer1", "1", "LAUNCH"), false), "ERR_COUPON_MINIMUM_NOT_MET")
+	assertCouponAccepted(t, node.ValidateOrder(newCouponContract(t, minimum, "buyer1", "2", "LAUNCH"), false))
+
+	// Codes which don't match a coupon are not limited
+	assertCouponAccepted(t, node.ValidateOrder(newCouponContract(t, expired, "buyer1", "1", "UNKNOWN"), false))
+}
+
+func TestOpenBazaarNode_ValidateOrderCouponRedemptions(t *testing.T) {
+	node := newBroadcastingNode(t)
+	listing := newLimitedCouponListing(t, "limited", "LAUNCH", &pb.Listing_Coupon_Limits{
+		MaxRedemptions: 2,
+		OncePerBuyer:   true,
+	})
+
+	first := newCouponContract(t, listing, "buyer1", "1", "LAUNCH")
+	firstID, err := node.CalcOrderID(first.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.RedeemCoupons(firstID, first, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The order which redeemed the coupon may be placed again
+	if err := node.RedeemCoupons(firstID, first, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	assertCouponAccepted(t, node.ValidateOrder(first, false))
+
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, listing, "buyer1", "2", "LAUNCH"), false), "ERR_COUPON_ALREADY_REDEEMED")
+	assertCouponRejected(t, node.RedeemCoupons("order4", newCouponContract(t, listing, "buyer1", "2", "LAUNCH"), time.Now()), "ERR_COUPON_ALREADY_REDEEMED")
+	assertCouponAccepted(t, node.ValidateOrder(newCouponContract(t, listing, "buyer2", "1", "LAUNCH"), false))
+
+	if err := node.RedeemCoupons("order2", newCouponContract(t, listing, "buyer2", "1", "LAUNCH"), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, listing, "buyer3", "1", "LAUNCH"), false), "ERR_COUPON_REDEMPTIONS_EXHAUSTED")
+
+	// An order validated before the coupon ran out isn't redeemed
+	assertCouponRejected(t, node.RedeemCoupons("order3", newCouponContract(t, listing, "buyer3", "1", "LAUNCH"), time.Now()), "ERR_COUPON_REDEMPTIONS_EXHAUSTED")
+
+	// Unfunded orders stop counting once their reservation would have expired
+	if err := node.Datastore.Coupons().DeleteRedemptions("order2"); err != nil {
+		t.Fatal(err)
+	}
+	stale := time.Now().Add(-core.InventoryReservationTimeout - time.Minute)
+	if err := node.RedeemCoupons("order2", newCouponContract(t, listing, "buyer2", "1", "LAUNCH"), stale); err != nil {
+		t.Fatal(err)
+	}
+	assertCouponAccepted(t, node.ValidateOrder(newCouponContract(t, listing, "buyer3", "1", "LAUNCH"), false))
+
+	// Funded orders count for good
+	if err := node.Datastore.Coupons().SetRedemptionsFunded("order2"); err != nil {
+		t.Fatal(err)
+	}
+	assertCouponRejected(t, node.ValidateOrder(newCouponContract(t, listing, "buyer3", "1", "LAUNCH"), false), "ERR_COUPON_REDEMPTIONS_EXHAUSTED")
+
+	// Declined orders release the coupon
+	if err := node.OrderStates().UpdateSale("order2", *newCouponContract(t, listing, "buyer2", "1", "LAUNCH"), pb.OrderState_AWAITING_PAYMENT, false, "order received"); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete("order2")
+	if err := node.OrderStates().UpdateSale("order2", *newCouponContract(t, listing, "buyer2", "1", "LAUNCH"), pb.OrderState_DECLINED, true, "order rejected"); err != nil {
+		t.Fatal(err)
+	}
+	assertCouponAccepted(t, node.ValidateOrder(newCouponContract(t, listing, "buyer3", "1", "LAUNCH"), false))
+}
+
+func TestOpenBazaarNode_RedeemCouponsConcurrently(t *testing.T) {
+	node := newBroadcastingNode(t)
+	listing := newLimitedCouponListing(t, "last-one", "LAUNCH", &pb.Listing_Coupon_Limits{
+		MaxRedemptions: 1,
+	})
+
+	// Orders validated while the coupon was available race to redeem it
+	var (
+		wg       sync.WaitGroup
+		redeemed int32
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			buyer := fmt.Sprintf("buyer%d", i)
+			err := node.RedeemCoupons("race"+buyer, newCouponContract(t, listing, buyer, "1", "LAUNCH"), time.Now())
+			if err == nil {
+				atomic.AddInt32(&redeemed, 1)
+			} else if _, ok := err.(core.ErrCouponRejected); !ok {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if redeemed != 1 {
+		t.Errorf("expected the coupon to be redeemed once, got %d", redeemed)
+	}
+}
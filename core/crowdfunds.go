@@ -0,0 +1,433 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/op/go-logging"
+)
+
+const crowdFundSettlerInterval = time.Duration(1) * time.Hour
+
+var (
+	// ErrCrowdFundRequiresModerator is returned when a pledge isn't paid
+	// into escrow
+	ErrCrowdFundRequiresModerator = errors.New("pledges to crowdfunding listings must be moderated")
+	// ErrCrowdFundEnded is returned when pledging after the deadline
+	ErrCrowdFundEnded = errors.New("crowdfunding deadline has passed")
+	// ErrCrowdFundOrderItems is returned when a pledge is ordered together
+	// with other listings
+	ErrCrowdFundOrderItems = errors.New("pledges to crowdfunding listings must be ordered on their own")
+	// ErrNotCrowdFundListing is returned when asking for the progress of a
+	// listing without a funding goal
+	ErrNotCrowdFundListing = errors.New("listing is not a crowdfunding listing")
+	// ErrCrowdFundPledgePending is returned when fulfilling or completing a
+	// pledge before its crowdfunding listing met the goal
+	ErrCrowdFundPledgePending = errors.New("crowdfund pledge can't be fulfilled or completed until the goal is met")
+)
+
+// crowdFundFulfillmentNote is the note on the fulfillment sent for each
+// pledge committed when its listing met the goal
+const crowdFundFulfillmentNote = "The crowdfunding goal was met and your pledge is committed."
+
+// CrowdFundSettlementResult counts the pledges handled by a pass of the
+// crowdfund settler
+type CrowdFundSettlementResult struct {
+	Committed int
+	Refunded  int
+	Failed    int
+}
+
+// validateCrowdFundOrder checks that an order for a crowdfunding listing is
+// a moderated pledge to that listing alone made before the deadline
+func validateCrowdFundOrder(listing *repo.Listing, moderated bool, listingCount int, now time.Time) error {
+	if !listing.IsCrowdFund() {
+		return errors.New("crowdfunding listing has no funding goal")
+	}
+	if listingCount > 1 {
+		return ErrCrowdFundOrderItems
+	}
+	if !moderated {
+		return ErrCrowdFundRequiresModerator
+	}
+	if !now.Before(listing.GetCrowdFundDeadline()) {
+		return ErrCrowdFundEnded
+	}
+	return nil
+}
+
+// RecordCrowdFundPledge records the vendor's funded order as a pledge if it
+// is for a crowdfunding listing and returns the listing's slug
+func RecordCrowdFundPledge(datastore repo.Datastore, orderID string, contract *pb.RicardianContract) (string, error) {
+	var listing *pb.Listing
+	for _, l := range contract.VendorListings {
+		if l.Metadata != nil && l.Metadata.ContractType == pb.Listing_Metadata_CROWD_FUND {
+			listing = l
+			break
+		}
+	}
+	if listing == nil {
+		return "", nil
+	}
+	rl, err := repo.NewListingFromProtobuf(listing)
+	if err != nil {
+		return "", err
+	}
+	price, err := rl.GetPrice()
+	if err != nil {
+		return "", err
+	}
+	// Every item of a pledge is for the crowdfunding listing
+	amount := big.NewInt(0)
+	for _, item := range contract.BuyerOrder.Items {
+		value, err := orderItemValue(listing, price.Amount, item)
+		if err != nil {
+			return "", err
+		}
+		amount.Add(amount, value)
+	}
+	err = datastore.CrowdFundPledges().Put(repo.CrowdFundPledge{
+		OrderID:   orderID,
+		Slug:      listing.Slug,
+		BuyerID:   contract.BuyerOrder.BuyerID.PeerID,
+		Amount:    amount.String(),
+		Currency:  price.Currency.Code.String(),
+		State:     repo.CrowdFundPledgeStatePledged,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return listing.Slug, nil
+}
+
+// GetCrowdFundProgress returns the progress of one of the node's
+// crowdfunding listings towards its goal. The progress of a listing which is
+// no longer published is read from the contracts pledged to it.
+func (n *OpenBazaarNode) GetCrowdFundProgress(slug string) (*repo.CrowdFundProgress, error) {
+	var listing *repo.Listing
+	sl, err := n.GetListingFromSlug(slug)
+	if err == nil {
+		listing, err = repo.NewListingFromProtobuf(sl.Listing)
+	} else if pledges, perr := n.Datastore.CrowdFundPledges().GetBySlug(slug); perr == nil && len(pledges) > 0 {
+		listing, err = n.crowdFundTerms(slug, pledges)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !listing.IsCrowdFund() {
+		return nil, ErrNotCrowdFundListing
+	}
+	return n.crowdFundProgress(listing, time.Now())
+}
+
+func (n *OpenBazaarNode) crowdFundProgress(listing *repo.Listing, now time.Time) (*repo.CrowdFundProgress, error) {
+	goal, err := listing.GetCrowdFundGoal()
+	if err != nil {
+		return nil, err
+	}
+	pledges, err := n.Datastore.CrowdFundPledges().GetBySlug(listing.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+	return repo.NewCrowdFundProgress(goal, listing.GetCrowdFundDeadline(), pledges, now), nil
+}
+
+// PublishCrowdFundProgress updates the progress of the crowdfunding listing
+// in the listing index and publishes it if it changed
+func (n *OpenBazaarNode) PublishCrowdFundProgress(slug string) error {
+	changed, err := n.updateCrowdFundProgress(slug, time.Now())
+	if err != nil || !changed {
+		return err
+	}
+	return n.SeedNode()
+}
+
+// updateCrowdFundProgress writes the current progress of the crowdfunding
+// listing to its entry in the listing index and returns whether it changed
+func (n *OpenBazaarNode) updateCrowdFundProgress(slug string, now time.Time) (bool, error) {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return false, err
+	}
+	for _, ld := range index {
+		if ld.Slug != slug {
+			continue
+		}
+		sl, err := n.GetListingFromSlug(slug)
+		if err != nil {
+			return false, err
+		}
+		listing, err := repo.NewListingFromProtobuf(sl.Listing)
+		if err != nil {
+			return false, err
+		}
+		progress, err := n.crowdFundProgress(listing, now)
+		if err != nil {
+			return false, err
+		}
+		if old := ld.CrowdFund; old != nil && old.State == progress.State &&
+			old.Backers == progress.Backers && old.Pledged.Equal(progress.Pledged) {
+			return false, nil
+		}
+		ld.CrowdFund = progress
+		return true, n.updateListingOnDisk(index, ld, false)
+	}
+	// Listings hidden from the index have no progress to update
+	return false, nil
+}
+
+// SettleCrowdFunds handles the pledges to each of the node's crowdfunding
+// listings whose deadline has passed. Listings are found from the unsettled
+// pledges and their deadline and goal read from the pledged contracts, so
+// pledges to listings which were since hidden, expired or deleted are settled
+// too. If the goal was met the pledges are committed and the orders are
+// fulfilled so they are paid out of escrow like any other moderated sale.
+// Otherwise each pledge is refunded to the buyer. A notification is emitted
+// for each listing settled and the progress in the listing index is kept up
+// to date.
+func (n *OpenBazaarNode) SettleCrowdFunds(now time.Time) (CrowdFundSettlementResult, error) {
+	var result CrowdFundSettlementResult
+
+	pledges, err := n.Datastore.CrowdFundPledges().GetAll()
+	if err != nil {
+		return result, fmt.Errorf("reading crowdfund pledges: %s", err.Error())
+	}
+	var (
+		slugs   []string
+		bySlug  = make(map[string][]repo.CrowdFundPledge)
+		pending = make(map[string]bool)
+	)
+	for _, p := range pledges {
+		if _, ok := bySlug[p.Slug]; !ok {
+			slugs = append(slugs, p.Slug)
+		}
+		bySlug[p.Slug] = append(bySlug[p.Slug], p)
+		if p.State == repo.CrowdFundPledgeStatePledged {
+			pending[p.Slug] = true
+		}
+	}
+	for _, slug := range slugs {
+		if !pending[slug] {
+			continue
+		}
+		if err := n.settleCrowdFund(slug, bySlug[slug], now, &result); err != nil {
+			log.Errorf("settling crowdfunding listing (%s): %s", slug, err.Error())
+		}
+	}
+
+	index, err := n.getListingIndex()
+	if err != nil {
+		return result, fmt.Errorf("reading listing index: %s", err.Error())
+	}
+	published := false
+	for _, ld := range index {
+		if ld.ContractType != pb.Listing_Metadata_CROWD_FUND.String() {
+			continue
+		}
+		changed, err := n.updateCrowdFundProgress(ld.Slug, now)
+		if err != nil {
+			log.Errorf("updating crowdfunding listing (%s) progress: %s", ld.Slug, err.Error())
+		}
+		published = published || changed
+	}
+	if published {
+		if err := n.SeedNode(); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// crowdFundTerms returns the crowdfunding listing the pledges were made to
+// as it appears in their contracts. The node's own copy of the listing is
+// used for pledges without a sale.
+func (n *OpenBazaarNode) crowdFundTerms(slug string, pledges []repo.CrowdFundPledge) (*repo.Listing, error) {
+	for _, p := range pledges {
+		contract, _, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(p.OrderID)
+		if err != nil {
+			continue
+		}
+		for _, l := range contract.VendorListings {
+			if l.Slug == slug && l.Metadata != nil && l.Metadata.ContractType == pb.Listing_Metadata_CROWD_FUND {
+				return repo.NewListingFromProtobuf(l)
+			}
+		}
+	}
+	sl, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	return repo.NewListingFromProtobuf(sl.Listing)
+}
+
+func (n *OpenBazaarNode) settleCrowdFund(slug string, pledges []repo.CrowdFundPledge, now time.Time, result *CrowdFundSettlementResult) error {
+	listing, err := n.crowdFundTerms(slug, pledges)
+	if err != nil {
+		return err
+	}
+	if !listing.IsCrowdFund() || now.Before(listing.GetCrowdFundDeadline()) {
+		return nil
+	}
+	var pending []repo.CrowdFundPledge
+	for _, p := range pledges {
+		if p.State == repo.CrowdFundPledgeStatePledged {
+			pending = append(pending, p)
+		}
+	}
+	goal, err := listing.GetCrowdFundGoal()
+	if err != nil {
+		return err
+	}
+	progress := repo.NewCrowdFundProgress(goal, listing.GetCrowdFundDeadline(), pledges, now)
+
+	settled := 0
+	notif := repo.CrowdFundNotification{
+		ID:      repo.NewNotificationID(),
+		Type:    repo.NotifierTypeCrowdFundSucceeded,
+		Slug:    slug,
+		Title:   listing.GetTitle(),
+		Goal:    progress.Goal.Amount.String(),
+		Pledged: progress.Pledged.Amount.String(),
+		Backers: progress.Backers,
+	}
+	if progress.GoalMet() {
+		for _, p := range pending {
+			if err := n.Datastore.CrowdFundPledges().SetState(p.OrderID, repo.CrowdFundPledgeStateCommitted); err != nil {
+				return err
+			}
+			// A committed pledge can be fulfilled by the vendor so one which
+			// fails here isn't lost
+			if err := n.fulfillCrowdFundPledge(p); err != nil {
+				log.Warningf("fulfilling crowdfund pledge (%s): %s", p.OrderID, err.Error())
+			}
+			settled++
+			result.Committed++
+		}
+	} else {
+		notif.Type = repo.NotifierTypeCrowdFundFailed
+		for _, p := range pending {
+			if err := n.refundCrowdFundPledge(p); err != nil {
+				log.Warningf("refunding crowdfund pledge (%s): %s", p.OrderID, err.Error())
+				result.Failed++
+				continue
+			}
+			settled++
+			result.Refunded++
+		}
+	}
+	if settled > 0 {
+		n.Broadcast <- notif
+		if err := n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, now, false)); err != nil {
+			log.Errorf("persisting crowdfund notification: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// CheckCrowdFundPledge returns ErrCrowdFundPledgePending if the order is a
+// pledge whose crowdfunding listing hasn't met the goal yet
+func (n *OpenBazaarNode) CheckCrowdFundPledge(orderID string) error {
+	pledge, err := n.Datastore.CrowdFundPledges().Get(orderID)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if pledge.State == repo.CrowdFundPledgeStatePledged {
+		return ErrCrowdFundPledgePending
+	}
+	return nil
+}
+
+// fulfillCrowdFundPledge fulfills the order of a committed pledge
+func (n *OpenBazaarNode) fulfillCrowdFundPledge(pledge repo.CrowdFundPledge) error {
+	contract, state, _, records, _, _, err := n.Datastore.Sales().GetByOrderId(pledge.OrderID)
+	if err != nil {
+		return err
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT {
+		return fmt.Errorf("order in state %s can't be fulfilled", state)
+	}
+	fulfillment := &pb.OrderFulfillment{
+		OrderId: pledge.OrderID,
+		Slug:    pledge.Slug,
+		Note:    crowdFundFulfillmentNote,
+	}
+	return n.FulfillOrder(fulfillment, contract, records)
+}
+
+// refundCrowdFundPledge returns the escrowed funds of the pledge to the
+// buyer. Pledges whose order can no longer be refunded by the vendor, such
+// as disputed ones, are left for the moderator.
+func (n *OpenBazaarNode) refundCrowdFundPledge(pledge repo.CrowdFundPledge) error {
+	contract, state, _, records, _, _, err := n.Datastore.Sales().GetByOrderId(pledge.OrderID)
+	if err != nil {
+		return err
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
+		return fmt.Errorf("order in state %s can't be refunded", state)
+	}
+	if err := n.RefundOrder(contract, records); err != nil {
+		return err
+	}
+	return n.Datastore.CrowdFundPledges().SetState(pledge.OrderID, repo.CrowdFundPledgeStateWithdrawn)
+}
+
+type crowdFundSettler struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartCrowdFundSettler - start the worker which commits or refunds the
+// pledges to crowdfunding listings once their deadline passes
+func (n *OpenBazaarNode) StartCrowdFundSettler() {
+	n.CrowdFundSettler = &crowdFundSettler{
+		node:          n,
+		intervalDelay: crowdFundSettlerInterval,
+		logger:        logging.MustGetLogger("crowdFundSettler"),
+	}
+	go n.CrowdFundSettler.Run()
+}
+
+func (settler *crowdFundSettler) Run() {
+	settler.watchdogTimer = time.NewTicker(settler.intervalDelay)
+	settler.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	settler.PerformTask()
+	for {
+		select {
+		case <-settler.watchdogTimer.C:
+			settler.PerformTask()
+		case <-settler.stopWorker:
+			settler.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (settler *crowdFundSettler) Stop() {
+	settler.stopWorker <- true
+	close(settler.stopWorker)
+}
+
+func (settler *crowdFundSettler) PerformTask() {
+	result, err := settler.node.SettleCrowdFunds(time.Now())
+	if err != nil {
+		settler.logger.Errorf("settling crowdfunds: %s", err)
+		return
+	}
+	settler.logger.Debugf("crowdfund pledges committed/refunded/failed: %d/%d/%d", result.Committed, result.Refunded, result.Failed)
+}
@@ -0,0 +1,290 @@
+package core_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// newCrowdFundListing creates a crowdfunding listing for the slug with the
+// goal and a deadline an hour from now
+func newCrowdFundListing(t *testing.T, node *core.OpenBazaarNode, slug, goal string) *pb.Listing {
+	listing := factory.NewListing(slug)
+	listing.Metadata.ContractType = pb.Listing_Metadata_CROWD_FUND
+	listing.Metadata.CrowdFund = &pb.Listing_Metadata_CrowdFund{
+		BigGoal:  goal,
+		Deadline: &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()},
+	}
+	listing.Moderators = []string{"QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e"}
+	rl, err := repo.NewListingFromProtobuf(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := rl.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.CreateListing(lb); err != nil {
+		t.Fatal(err)
+	}
+	return listing
+}
+
+func crowdFundIndexEntry(t *testing.T, node *core.OpenBazaarNode, slug string) repo.ListingIndexData {
+	b, err := node.GetListings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var index []repo.ListingIndexData
+	if err := json.Unmarshal(b, &index); err != nil {
+		t.Fatal(err)
+	}
+	for _, ld := range index {
+		if ld.Slug == slug {
+			return ld
+		}
+	}
+	t.Fatalf("listing (%s) not in index", slug)
+	return repo.ListingIndexData{}
+}
+
+func TestOpenBazaarNode_CrowdFundPledges(t *testing.T) {
+	node := newBroadcastingNode(t)
+	listing := newCrowdFundListing(t, node, "synth-run", "10000")
+
+	ld := crowdFundIndexEntry(t, node, "synth-run")
+	if ld.CrowdFund == nil || ld.CrowdFund.Goal.Amount.String() != "10000" || ld.CrowdFund.State != repo.CrowdFundStateOpen {
+		t.Fatalf("expected open crowdfunding progress in index, got %+v", ld.CrowdFund)
+	}
+
+	slug, err := core.RecordCrowdFundPledge(node.Datastore, "order1", newInventoryContract(t, listing, "buyer1", "3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slug != "synth-run" {
+		t.Errorf("expected pledge for synth-run, got %s", slug)
+	}
+	if _, err := core.RecordCrowdFundPledge(node.Datastore, "order2", newInventoryContract(t, listing, "buyer2", "1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.PublishCrowdFundProgress("synth-run"); err != nil {
+		t.Fatal(err)
+	}
+	ld = crowdFundIndexEntry(t, node, "synth-run")
+	if ld.CrowdFund.Pledged.Amount.String() != "8000" || ld.CrowdFund.Backers != 2 {
+		t.Errorf("expected 8000 pledged by 2 backers, got %s by %d", ld.CrowdFund.Pledged.Amount, ld.CrowdFund.Backers)
+	}
+
+	// Orders which are declined no longer count towards the goal
+	contract := newInventoryContract(t, listing, "buyer2", "1")
+	if err := node.OrderStates().UpdateSale("order2", *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received"); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete("order2")
+	if err := node.OrderStates().UpdateSale("order2", *contract, pb.OrderState_DECLINED, true, "order rejected"); err != nil {
+		t.Fatal(err)
+	}
+	progress, err := node.GetCrowdFundProgress("synth-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.Pledged.Amount.String() != "6000" || progress.Backers != 1 {
+		t.Errorf("expected 6000 pledged by 1 backer, got %s by %d", progress.Pledged.Amount, progress.Backers)
+	}
+
+	if _, err := node.GetCrowdFundProgress("missing"); err == nil {
+		t.Error("expected error for missing listing")
+	}
+}
+
+func TestOpenBazaarNode_SettleCrowdFunds(t *testing.T) {
+	node := newBroadcastingNode(t)
+	funded := newCrowdFundListing(t, node, "funded-run", "4000")
+	unfunded := newCrowdFundListing(t, node, "unfunded-run", "100000")
+
+	if _, err := core.RecordCrowdFundPledge(node.Datastore, "funded1", newInventoryContract(t, funded, "buyer1", "2")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.RecordCrowdFundPledge(node.Datastore, "unfunded1", newInventoryContract(t, unfunded, "buyer1", "1")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing is settled before the deadline
+	result, err := node.SettleCrowdFunds(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (core.CrowdFundSettlementResult{}) {
+		t.Errorf("expected nothing settled before the deadline, got %+v", result)
+	}
+
+	// The pledge to the unfunded listing has no sale the vendor can refund
+	// so it is retried on the next pass
+	result, err = node.SettleCrowdFunds(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Committed != 1 || result.Refunded != 0 || result.Failed != 1 {
+		t.Errorf("unexpected settlement: %+v", result)
+	}
+	pledges, err := node.Datastore.CrowdFundPledges().GetBySlug("funded-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pledges[0].State != repo.CrowdFundPledgeStateCommitted {
+		t.Errorf("expected pledge to be committed, got %s", pledges[0].State)
+	}
+	pledges, err = node.Datastore.CrowdFundPledges().GetBySlug("unfunded-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pledges[0].State != repo.CrowdFundPledgeStatePledged {
+		t.Errorf("expected pledge to still be pledged, got %s", pledges[0].State)
+	}
+
+	notifs, _, err := node.Datastore.Notifications().GetAll("", -1, []string{string(repo.NotifierTypeCrowdFundSucceeded)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifs) != 1 {
+		t.Fatalf("expected one crowdfund notification, got %d", len(notifs))
+	}
+	n, ok := notifs[0].NotifierData.(repo.CrowdFundNotification)
+	if !ok {
+		t.Fatalf("unexpected notification: %T", notifs[0].NotifierData)
+	}
+	if n.Slug != "funded-run" || n.Pledged != "4000" || n.Backers != 1 {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+// unavailableMessageStorage fails to store offline messages so sending to
+// the offline buyer gives up instead of publishing a pointer
+type unavailableMessageStorage struct{}
+
+func (unavailableMessageStorage) Store(peer.ID, []byte) (ma.Multiaddr, error) {
+	return nil, errors.New("offline message storage unavailable")
+}
+
+// newPledgeSale saves a sale awaiting fulfillment for a moderated pledge to
+// the listing and records the pledge
+func newPledgeSale(t *testing.T, node *core.OpenBazaarNode, orderID string, listing *pb.Listing) *pb.RicardianContract {
+	disputeable := factory.NewDisputeableContract()
+	buyerKey, err := crypto.UnmarshalPublicKey(disputeable.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buyerID, err := peer.IDFromPublicKey(buyerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := newInventoryContract(t, listing, buyerID.Pretty(), "1")
+	contract.BuyerOrder.BuyerID.Pubkeys = disputeable.BuyerOrder.BuyerID.Pubkeys
+	contract.BuyerOrder.Payment = disputeable.BuyerOrder.Payment
+	contract.BuyerOrder.Payment.Chaincode = hex.EncodeToString(make([]byte, 32))
+	contract.BuyerOrder.Payment.RedeemScript = "00"
+	contract.BuyerOrder.RatingKeys = [][]byte{make([]byte, 33)}
+	contract.VendorOrderConfirmation = &pb.OrderConfirmation{OrderID: orderID}
+	if err := node.Datastore.Sales().Put(orderID, *contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.RecordCrowdFundPledge(node.Datastore, orderID, contract); err != nil {
+		t.Fatal(err)
+	}
+	return contract
+}
+
+func TestOpenBazaarNode_CrowdFundPledgeFulfillment(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.MessageStorage = unavailableMessageStorage{}
+	listing := newCrowdFundListing(t, node, "pledge-run", "2000")
+	contract := newPledgeSale(t, node, "pledgeOrder", listing)
+	defer node.Datastore.Sales().Delete("pledgeOrder")
+
+	// The pledge can't be fulfilled or completed before the goal is met
+	err := node.FulfillOrder(&pb.OrderFulfillment{OrderId: "pledgeOrder"}, contract, nil)
+	if err != core.ErrCrowdFundPledgePending {
+		t.Errorf("expected ErrCrowdFundPledgePending when fulfilling, got %v", err)
+	}
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.RecordCrowdFundPledge(node.Datastore, orderID, contract); err != nil {
+		t.Fatal(err)
+	}
+	err = node.CompleteOrder(&core.OrderRatings{OrderID: orderID}, contract, nil)
+	if err != core.ErrCrowdFundPledgePending {
+		t.Errorf("expected ErrCrowdFundPledgePending when completing, got %v", err)
+	}
+	if err := node.Datastore.CrowdFundPledges().Delete(orderID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the goal is met the committed pledge is fulfilled
+	result, err := node.SettleCrowdFunds(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Committed != 1 {
+		t.Errorf("expected the pledge to be committed, got %+v", result)
+	}
+	contract, state, _, _, _, _, err := node.Datastore.Sales().GetByOrderId("pledgeOrder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != pb.OrderState_FULFILLED {
+		t.Fatalf("expected the committed pledge to be fulfilled, got %s", state)
+	}
+	if len(contract.VendorOrderFulfillment) != 1 || contract.VendorOrderFulfillment[0].Payout == nil {
+		t.Errorf("expected a fulfillment with the escrow payout, got %+v", contract.VendorOrderFulfillment)
+	}
+}
+
+func TestOpenBazaarNode_SettleCrowdFundOfDeletedListing(t *testing.T) {
+	node := newBroadcastingNode(t)
+	node.MessageStorage = unavailableMessageStorage{}
+	listing := newCrowdFundListing(t, node, "deleted-run", "2000")
+	newPledgeSale(t, node, "deletedRunOrder", listing)
+	defer node.Datastore.Sales().Delete("deletedRunOrder")
+
+	// The vendor deletes the listing before the deadline but the pledge is
+	// still settled against the listing it was made to
+	if err := node.DeleteListing("deleted-run"); err != nil {
+		t.Fatal(err)
+	}
+	progress, err := node.GetCrowdFundProgress("deleted-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.Goal.Amount.String() != "2000" || progress.Backers != 1 {
+		t.Errorf("unexpected progress: %+v", progress)
+	}
+
+	result, err := node.SettleCrowdFunds(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Committed != 1 {
+		t.Errorf("expected the pledge to be committed, got %+v", result)
+	}
+	_, state, _, _, _, _, err := node.Datastore.Sales().GetByOrderId("deletedRunOrder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != pb.OrderState_FULFILLED {
+		t.Errorf("expected the committed pledge to be fulfilled, got %s", state)
+	}
+}
@@ -0,0 +1,231 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// MaxDisputeEvidenceItems is the most items a single evidence submission
+	// may carry
+	MaxDisputeEvidenceItems = 10
+
+	// MaxDisputeEvidenceStatementLength is the longest statement in characters
+	// an evidence item may carry
+	MaxDisputeEvidenceStatementLength = 4000
+
+	// disputeEvidenceFetchTimeout is how long fetching an evidence image from
+	// the network may take
+	disputeEvidenceFetchTimeout = 5 * time.Minute
+)
+
+// ErrDisputeNotOpen - tried submitting evidence for an order which isn't disputed
+var ErrDisputeNotOpen = errors.New("evidence can only be submitted for disputed orders")
+
+// SubmitDisputeEvidence signs the evidence items for the disputed order and
+// sends them to the order's moderator. Chat message items only need their
+// message ID, the rest of the message is filled in from the chat history and
+// must belong to the order's conversation.
+func (n *OpenBazaarNode) SubmitDisputeEvidence(orderID string, items []*pb.DisputeEvidence_Item) (*pb.DisputeEvidence, error) {
+	contract, state, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		contract, state, _, _, _, _, err = n.Datastore.Sales().GetByOrderId(orderID)
+		if err != nil {
+			return nil, ErrOrderNotFound
+		}
+	}
+	if state != pb.OrderState_DISPUTED {
+		return nil, ErrDisputeNotOpen
+	}
+	order, err := repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if order.Payment == nil || order.Payment.Moderator == "" {
+		return nil, errors.New("order has no moderator")
+	}
+
+	if err := n.ValidateDisputeEvidenceItems(orderID, items); err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	evidence := &pb.DisputeEvidence{
+		OrderId:     orderID,
+		SubmittedBy: n.IpfsNode.Identity.Pretty(),
+		Timestamp:   ts,
+		Items:       items,
+	}
+	ser, err := proto.Marshal(evidence)
+	if err != nil {
+		return nil, err
+	}
+	evidence.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.SendDisputeEvidence(order.Payment.Moderator, evidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// ValidateDisputeEvidenceItems validates the evidence items for the order and
+// copies the chat messages they reference out of the chat history
+func (n *OpenBazaarNode) ValidateDisputeEvidenceItems(orderID string, items []*pb.DisputeEvidence_Item) error {
+	if len(items) == 0 {
+		return errors.New("evidence must contain at least one item")
+	}
+	if len(items) > MaxDisputeEvidenceItems {
+		return fmt.Errorf("evidence may contain at most %d items", MaxDisputeEvidenceItems)
+	}
+	for i, item := range items {
+		switch item.Type {
+		case pb.DisputeEvidence_Item_STATEMENT:
+			if item.Statement == "" {
+				return fmt.Errorf("evidence item %d: statement is empty", i)
+			}
+			if len(item.Statement) > MaxDisputeEvidenceStatementLength {
+				return fmt.Errorf("evidence item %d: statement is longer than %d characters", i, MaxDisputeEvidenceStatementLength)
+			}
+		case pb.DisputeEvidence_Item_IMAGE:
+			if _, err := cid.Decode(item.ImageHash); err != nil {
+				return fmt.Errorf("evidence item %d: invalid image hash", i)
+			}
+		case pb.DisputeEvidence_Item_CHAT_MESSAGE:
+			if item.ChatMessage == nil || item.ChatMessage.MessageId == "" {
+				return fmt.Errorf("evidence item %d: chat message ID is missing", i)
+			}
+			msg, err := n.Datastore.Chat().GetMessage(item.ChatMessage.MessageId)
+			if err != nil {
+				return fmt.Errorf("evidence item %d: chat message not found", i)
+			}
+			if msg.Subject != orderID {
+				return fmt.Errorf("evidence item %d: chat message is not about this order", i)
+			}
+			peerID := msg.PeerId
+			if msg.Outgoing {
+				peerID = n.IpfsNode.Identity.Pretty()
+			}
+			var ts time.Time
+			if msg.Timestamp != nil {
+				ts = msg.Timestamp.Time
+			}
+			sent, err := ptypes.TimestampProto(ts)
+			if err != nil {
+				return err
+			}
+			item.ChatMessage = &pb.DisputeEvidence_ChatMessage{
+				MessageId: msg.MessageId,
+				PeerId:    peerID,
+				Message:   msg.Message,
+				Timestamp: sent,
+			}
+		default:
+			return fmt.Errorf("evidence item %d: unknown type", i)
+		}
+	}
+	return nil
+}
+
+// ProcessDisputeEvidence verifies evidence the buyer or vendor of a case sent
+// to the moderator and stores it with the case. The images in the evidence
+// are fetched and pinned in the background. The case is returned so the
+// caller can describe it in notifications. If the case hasn't been opened yet
+// net.OutOfOrderMessage is returned so the message is retried, and once it has
+// been closed ErrDisputeNotOpen is returned.
+func (n *OpenBazaarNode) ProcessDisputeEvidence(evidence *pb.DisputeEvidence, peerID string) (*repo.DisputeCaseRecord, error) {
+	if evidence.SubmittedBy != peerID {
+		return nil, errors.New("evidence was not submitted by the sending peer")
+	}
+	dispute, err := n.Datastore.Cases().GetByCaseID(evidence.OrderId)
+	if err != nil {
+		return nil, net.OutOfOrderMessage
+	}
+	if dispute.OrderState != pb.OrderState_DISPUTED {
+		return nil, ErrDisputeNotOpen
+	}
+
+	var pubkey []byte
+	for _, contract := range []*pb.RicardianContract{dispute.BuyerContract, dispute.VendorContract} {
+		if contract == nil || contract.BuyerOrder == nil || len(contract.VendorListings) == 0 {
+			continue
+		}
+		if contract.BuyerOrder.BuyerID != nil && contract.BuyerOrder.BuyerID.PeerID == peerID && contract.BuyerOrder.BuyerID.Pubkeys != nil {
+			pubkey = contract.BuyerOrder.BuyerID.Pubkeys.Identity
+			break
+		}
+		if vendorID := contract.VendorListings[0].VendorID; vendorID != nil && vendorID.PeerID == peerID && vendorID.Pubkeys != nil {
+			pubkey = vendorID.Pubkeys.Identity
+			break
+		}
+	}
+	if pubkey == nil {
+		return nil, errors.New("peer ID doesn't match either buyer or vendor")
+	}
+	if err := verifyDisputeEvidenceSignature(evidence, pubkey, peerID); err != nil {
+		return nil, err
+	}
+
+	if err := n.Datastore.DisputeEvidence().Put(evidence.OrderId, evidence); err != nil {
+		return nil, err
+	}
+	go n.pinDisputeEvidenceImages(evidence)
+	return dispute, nil
+}
+
+// pinDisputeEvidenceImages fetches and pins the images in the evidence so
+// they stay available to the moderator for the rest of the case
+func (n *OpenBazaarNode) pinDisputeEvidenceImages(evidence *pb.DisputeEvidence) {
+	for _, item := range evidence.Items {
+		if item.Type != pb.DisputeEvidence_Item_IMAGE {
+			continue
+		}
+		if err := ipfs.Pin(n.IpfsNode, item.ImageHash, disputeEvidenceFetchTimeout); err != nil {
+			log.Errorf("fetching evidence image %s for case (%s): %s", item.ImageHash, evidence.OrderId, err.Error())
+		}
+	}
+}
+
+// verifyDisputeEvidenceSignature checks the evidence was signed by the key
+// belonging to peerID
+func verifyDisputeEvidenceSignature(evidence *pb.DisputeEvidence, pubkeyBytes []byte, peerID string) error {
+	pubkey, err := libp2p.UnmarshalPublicKey(pubkeyBytes)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("public key in evidence does not match reported ID")
+	}
+	unsigned := proto.Clone(evidence).(*pb.DisputeEvidence)
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, evidence.Signature)
+	if err != nil || !valid {
+		return errors.New("signature on evidence failed to verify")
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestOpenBazaarNode_ValidateDisputeEvidenceItems(t *testing.T) {
+	node := newBroadcastingNode(t)
+	if err := node.Datastore.Chat().Put("evidence-msg1", "QmVendor", "evidence-order", "It was sent yesterday", time.Unix(1000, 0), true, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Chat().Put("evidence-msg2", "QmVendor", "", "Hi there", time.Unix(1000, 0), true, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Chat().DeleteMessage("evidence-msg1")
+	defer node.Datastore.Chat().DeleteMessage("evidence-msg2")
+
+	items := []*pb.DisputeEvidence_Item{
+		{Type: pb.DisputeEvidence_Item_STATEMENT, Statement: "The parcel never arrived"},
+		{Type: pb.DisputeEvidence_Item_IMAGE, ImageHash: "zb2rhj7crUKTQYRGCRATFaQ6YFLTde2YzdqbbhAASkL9uRDXn", Filename: "tracking.png"},
+		{Type: pb.DisputeEvidence_Item_CHAT_MESSAGE, ChatMessage: &pb.DisputeEvidence_ChatMessage{MessageId: "evidence-msg1"}},
+	}
+	if err := node.ValidateDisputeEvidenceItems("evidence-order", items); err != nil {
+		t.Fatal(err)
+	}
+	quoted := items[2].ChatMessage
+	if quoted.PeerId != "QmVendor" || quoted.Message != "It was sent yesterday" || quoted.Timestamp.Seconds != 1000 {
+		t.Errorf("unexpected chat message evidence: %+v", quoted)
+	}
+
+	invalid := map[string][]*pb.DisputeEvidence_Item{
+		"no items":        nil,
+		"empty statement": {{Type: pb.DisputeEvidence_Item_STATEMENT}},
+		"bad image hash":  {{Type: pb.DisputeEvidence_Item_IMAGE, ImageHash: "not a hash"}},
+		"missing message": {{Type: pb.DisputeEvidence_Item_CHAT_MESSAGE, ChatMessage: &pb.DisputeEvidence_ChatMessage{MessageId: "missing"}}},
+		"other subject":   {{Type: pb.DisputeEvidence_Item_CHAT_MESSAGE, ChatMessage: &pb.DisputeEvidence_ChatMessage{MessageId: "evidence-msg2"}}},
+	}
+	for name, items := range invalid {
+		if err := node.ValidateDisputeEvidenceItems("evidence-order", items); err == nil {
+			t.Errorf("expected %s to be rejected", name)
+		}
+	}
+}
+
+func TestOpenBazaarNode_SubmitDisputeEvidenceRequiresDispute(t *testing.T) {
+	node := newBroadcastingNode(t)
+	contract := factory.NewDisputeableContract()
+	if err := node.Datastore.Purchases().Put("evidence-undisputed", *contract, pb.OrderState_FULFILLED, true); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Purchases().Delete("evidence-undisputed")
+
+	items := []*pb.DisputeEvidence_Item{{Type: pb.DisputeEvidence_Item_STATEMENT, Statement: "Broken on arrival"}}
+	if _, err := node.SubmitDisputeEvidence("evidence-undisputed", items); err != core.ErrDisputeNotOpen {
+		t.Errorf("expected ErrDisputeNotOpen, got %v", err)
+	}
+	if _, err := node.SubmitDisputeEvidence("evidence-missing", items); err != core.ErrOrderNotFound {
+		t.Errorf("expected ErrOrderNotFound, got %v", err)
+	}
+}
+
+func TestOpenBazaarNode_ProcessDisputeEvidence(t *testing.T) {
+	node := newBroadcastingNode(t)
+	// The test node's identity isn't derived from its private key so the
+	// peer ID is taken from the key which signs the evidence
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerID := pid.Pretty()
+	pubkey, err := node.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The node plays the buyer who sent the evidence to itself as moderator
+	contract := factory.NewDisputeableContract()
+	contract.BuyerOrder.BuyerID.PeerID = peerID
+	contract.BuyerOrder.BuyerID.Pubkeys.Identity = pubkey
+	if err := node.Datastore.Cases().Put("evidence-case", pb.OrderState_DISPUTED, true, "Never arrived", "BTC", "BTC"); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Cases().UpdateBuyerInfo("evidence-case", contract, nil, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Cases().Delete("evidence-case")
+	defer node.Datastore.DisputeEvidence().DeleteByCaseID("evidence-case")
+
+	// The image is in the node's blockstore but not pinned, as if it was
+	// fetched without being kept
+	imageHash, err := ipfs.AddData(node.IpfsNode, []byte("photo of the empty parcel"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ipfs.UnPinDir(node.IpfsNode, imageHash); err != nil {
+		t.Fatal(err)
+	}
+	imageID, err := cid.Decode(imageHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evidence := &pb.DisputeEvidence{
+		OrderId:     "evidence-case",
+		SubmittedBy: peerID,
+		Timestamp:   &timestamp.Timestamp{Seconds: 1000},
+		Items: []*pb.DisputeEvidence_Item{
+			{Type: pb.DisputeEvidence_Item_STATEMENT, Statement: "The parcel never arrived"},
+			{Type: pb.DisputeEvidence_Item_IMAGE, ImageHash: imageHash},
+		},
+	}
+	ser, err := proto.Marshal(evidence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	evidence.Signature, err = node.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := node.ProcessDisputeEvidence(evidence, "QmSomeoneElse"); err == nil {
+		t.Error("expected evidence from another peer to be rejected")
+	}
+	tampered := proto.Clone(evidence).(*pb.DisputeEvidence)
+	tampered.Items[0].Statement = "The parcel arrived"
+	if _, err := node.ProcessDisputeEvidence(tampered, peerID); err == nil {
+		t.Error("expected tampered evidence to be rejected")
+	}
+	early := proto.Clone(evidence).(*pb.DisputeEvidence)
+	early.OrderId = "evidence-unopened"
+	if _, err := node.ProcessDisputeEvidence(early, peerID); err != net.OutOfOrderMessage {
+		t.Errorf("expected evidence for an unopened case to be out of order, got %v", err)
+	}
+
+	dispute, err := node.ProcessDisputeEvidence(evidence, peerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dispute.CaseID != "evidence-case" {
+		t.Errorf("unexpected case returned: %s", dispute.CaseID)
+	}
+	stored, err := node.Datastore.DisputeEvidence().GetByCaseID("evidence-case")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || !proto.Equal(stored[0], evidence) {
+		t.Errorf("expected the evidence to be stored, got %v", stored)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(50 * time.Millisecond) {
+		_, pinned, err := node.IpfsNode.Pinning.IsPinned(imageID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pinned {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the evidence image to be pinned")
+		}
+	}
+
+	// Evidence is no longer taken once the case is closed
+	if err := node.Datastore.Cases().MarkAsClosed("evidence-case", &pb.DisputeResolution{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.ProcessDisputeEvidence(evidence, peerID); err != core.ErrDisputeNotOpen {
+		t.Errorf("expected ErrDisputeNotOpen for a closed case, got %v", err)
+	}
+}
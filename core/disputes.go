@@ -138,12 +138,12 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 
 	// Update database
 	if isPurchase {
-		err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_DISPUTED, true)
+		err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_DISPUTED, true, "dispute opened")
 		if err != nil {
 			log.Error(err)
 		}
 	} else {
-		err = n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_DISPUTED, true)
+		err = n.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_DISPUTED, true, "dispute opened")
 		if err != nil {
 			log.Error(err)
 		}
@@ -311,7 +311,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 			if err != nil {
 				return err
 			}
-			err = n.Datastore.Cases().Put(orderID, pb.OrderState_DISPUTED, false, rc.Dispute.Claim, paymentCoin, db.CoinTypeForContract(contract))
+			err = n.OrderStates().OpenCase(orderID, false, rc.Dispute.Claim, paymentCoin, db.CoinTypeForContract(contract), "dispute opened by vendor")
 			if err != nil {
 				return err
 			}
@@ -328,7 +328,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 			if err != nil {
 				return err
 			}
-			err = n.Datastore.Cases().Put(orderID, pb.OrderState_DISPUTED, true, rc.Dispute.Claim, paymentCoin, db.CoinTypeForContract(contract))
+			err = n.OrderStates().OpenCase(orderID, true, rc.Dispute.Claim, paymentCoin, db.CoinTypeForContract(contract), "dispute opened by buyer")
 			if err != nil {
 				return err
 			}
@@ -394,7 +394,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 			}
 		}
 		// Save it back to the db with the new state
-		err = n.Datastore.Sales().Put(orderID, *myContract, pb.OrderState_DISPUTED, false)
+		err = n.OrderStates().UpdateSale(orderID, *myContract, pb.OrderState_DISPUTED, false, "dispute opened by buyer")
 		if err != nil {
 			return err
 		}
@@ -454,7 +454,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 			}
 		}
 		// Save it back to the db with the new state
-		err = n.Datastore.Purchases().Put(orderID, *myContract, pb.OrderState_DISPUTED, false)
+		err = n.OrderStates().UpdatePurchase(orderID, *myContract, pb.OrderState_DISPUTED, false, "dispute opened by vendor")
 		if err != nil {
 			return err
 		}
@@ -815,7 +815,7 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 		return err
 	}
 
-	err = n.Datastore.Cases().MarkAsClosed(orderID, d)
+	err = n.OrderStates().CloseCase(orderID, d, "dispute closed")
 	if err != nil {
 		return err
 	}
@@ -1256,10 +1256,10 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 
 	// Update database
 	if n.IpfsNode.Identity.Pretty() == order.BuyerID.PeerID {
-		err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_DECIDED, true)
+		err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_DECIDED, true, "dispute payout released")
 		peerID = contract.VendorListings[0].VendorID.PeerID
 	} else {
-		err = n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_DECIDED, true)
+		err = n.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_DECIDED, true, "dispute payout released")
 	}
 	if err != nil {
 		log.Errorf("ReleaseFunds error updating database: %s", err.Error())
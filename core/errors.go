@@ -10,6 +10,7 @@ import (
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/golang/protobuf/ptypes"
 )
 
@@ -118,3 +119,57 @@ func (n *OpenBazaarNode) SendProcessingError(pid, oid string, attemptedMessage p
 
 	return n.Service.SendMessage(ctx, p, errMsg)
 }
+
+// ErrIllegalOrderTransition is returned when an order state change is not
+// permitted for the role making it
+type ErrIllegalOrderTransition struct {
+	CodedError
+	OrderID string `json:"orderId"`
+	Role    string `json:"role"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+// NewErrIllegalOrderTransition - return illegal transition err for the order
+func NewErrIllegalOrderTransition(orderID string, role repo.OrderRole, from, to pb.OrderState) ErrIllegalOrderTransition {
+	return ErrIllegalOrderTransition{
+		CodedError: CodedError{
+			Reason: "illegal order state transition",
+			Code:   "ERR_ILLEGAL_ORDER_TRANSITION",
+		},
+		OrderID: orderID,
+		Role:    string(role),
+		From:    from.String(),
+		To:      to.String(),
+	}
+}
+
+func (err ErrIllegalOrderTransition) Error() string {
+	jsonBytes, _ := json.Marshal(&err)
+	return string(jsonBytes)
+}
+
+// ErrCouponRejected is a codedError returned from vendor nodes when a coupon
+// in the order breaks one of the limits the vendor set on it
+type ErrCouponRejected struct {
+	CodedError
+	Slug       string `json:"slug"`
+	CouponCode string `json:"couponCode"`
+}
+
+// NewErrCouponRejected - return coupon rejected err for the coupon code
+func NewErrCouponRejected(code, reason, slug, couponCode string) ErrCouponRejected {
+	return ErrCouponRejected{
+		CodedError: CodedError{
+			Reason: reason,
+			Code:   code,
+		},
+		Slug:       slug,
+		CouponCode: couponCode,
+	}
+}
+
+func (err ErrCouponRejected) Error() string {
+	jsonBytes, _ := json.Marshal(&err)
+	return string(jsonBytes)
+}
@@ -0,0 +1,270 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	feedUpdaterInterval = time.Duration(15) * time.Minute
+
+	// FeedMaxPostsPerPeer is the number of each followed peer's newest posts
+	// kept in the feed
+	FeedMaxPostsPerPeer = 50
+
+	// feedUpdateConcurrency is the number of followed peers whose posts are
+	// fetched at once
+	feedUpdateConcurrency = 8
+)
+
+// FeedUpdateResult counts the posts handled by a pass of the feed updater
+type FeedUpdateResult struct {
+	Peers  int
+	Added  int
+	Failed int
+}
+
+// UpdateFeed fetches the posts indexes of the peers the node follows and
+// caches the posts which are new after verifying them. Posts by peers which
+// are no longer followed, or which their author deleted, are removed.
+func (n *OpenBazaarNode) UpdateFeed() (FeedUpdateResult, error) {
+	var result FeedUpdateResult
+	following, err := n.Datastore.Following().Get("", -1)
+	if err != nil {
+		return result, err
+	}
+	followed := make(map[string]bool)
+	for _, peerID := range following {
+		followed[peerID] = true
+	}
+	cached, err := n.Datastore.FeedPosts().GetPeers()
+	if err != nil {
+		return result, err
+	}
+	for _, peerID := range cached {
+		if !followed[peerID] {
+			if err := n.Datastore.FeedPosts().DeleteByPeer(peerID); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, feedUpdateConcurrency)
+	)
+	for _, peerID := range following {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(peerID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			added, err := n.updatePeerFeed(peerID)
+			lock.Lock()
+			defer lock.Unlock()
+			result.Peers++
+			result.Added += added
+			if err != nil {
+				log.Debugf("updating feed posts of %s: %s", peerID, err.Error())
+				result.Failed++
+			}
+		}(peerID)
+	}
+	wg.Wait()
+	return result, nil
+}
+
+// updatePeerFeed caches the peer's newest posts which aren't in the feed yet
+// and returns how many were added
+func (n *OpenBazaarNode) updatePeerFeed(peerID string) (int, error) {
+	indexBytes, err := ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(peerID, "posts.json")), time.Minute, n.IPNSQuorumSize, false)
+	if err != nil {
+		return 0, err
+	}
+	var index []postData
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return 0, err
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		ti, _ := time.Parse(time.RFC3339Nano, index[i].Timestamp)
+		tj, _ := time.Parse(time.RFC3339Nano, index[j].Timestamp)
+		return ti.After(tj)
+	})
+	if len(index) > FeedMaxPostsPerPeer {
+		index = index[:FeedMaxPostsPerPeer]
+	}
+
+	var (
+		added int
+		keep  = make(map[string]bool)
+	)
+	for _, entry := range index {
+		keep[entry.Hash] = true
+		has, err := n.Datastore.FeedPosts().Has(entry.Hash)
+		if err != nil {
+			return added, err
+		}
+		if has {
+			continue
+		}
+		isNew, err := n.fetchFeedPost(peerID, entry.Hash)
+		if err != nil {
+			log.Debugf("fetching post %s of %s: %s", entry.Hash, peerID, err.Error())
+			continue
+		}
+		if isNew {
+			added++
+		}
+	}
+
+	// Drop the posts the peer deleted or which are no longer among its newest
+	hashes, err := n.Datastore.FeedPosts().GetHashes(peerID)
+	if err != nil {
+		return added, err
+	}
+	for _, hash := range hashes {
+		if !keep[hash] {
+			if err := n.Datastore.FeedPosts().Delete(hash); err != nil {
+				return added, err
+			}
+		}
+	}
+	return added, nil
+}
+
+// fetchFeedPost fetches the peer's post with the hash and adds it to the feed
+func (n *OpenBazaarNode) fetchFeedPost(peerID, hash string) (bool, error) {
+	postBytes, err := ipfs.Cat(n.IpfsNode, hash, time.Minute)
+	if err != nil {
+		return false, err
+	}
+	post := new(pb.SignedPost)
+	if err := jsonpb.UnmarshalString(string(postBytes), post); err != nil {
+		return false, err
+	}
+	post.Hash = hash
+	return n.AddFeedPost(peerID, post)
+}
+
+// AddFeedPost verifies the signed post was published by the peer and caches
+// it in the feed. The UI is told about posts which weren't cached yet.
+func (n *OpenBazaarNode) AddFeedPost(peerID string, post *pb.SignedPost) (bool, error) {
+	if err := ValidateFeedPost(peerID, post); err != nil {
+		return false, err
+	}
+	has, err := n.Datastore.FeedPosts().Has(post.Hash)
+	if err != nil || has {
+		return false, err
+	}
+	if err := n.Datastore.FeedPosts().Put(peerID, post); err != nil {
+		return false, err
+	}
+
+	notif := repo.FeedPost{
+		Hash:   post.Hash,
+		PeerID: peerID,
+		Handle: post.Post.VendorID.Handle,
+		Slug:   post.Post.Slug,
+		Status: post.Post.Status,
+	}
+	if len(post.Post.Images) > 0 {
+		notif.Thumbnail = repo.Thumbnail{Tiny: post.Post.Images[0].Tiny, Small: post.Post.Images[0].Small}
+	}
+	if post.Post.Timestamp != nil {
+		notif.Timestamp, _ = ptypes.Timestamp(post.Post.Timestamp)
+	}
+	if n.Broadcast != nil {
+		n.Broadcast <- notif
+	}
+	return true, nil
+}
+
+// ValidateFeedPost checks the post is well formed and was signed by the peer
+func ValidateFeedPost(peerID string, post *pb.SignedPost) error {
+	if post.Hash == "" {
+		return errors.New("post hash is missing")
+	}
+	if post.Post == nil || post.Post.VendorID == nil || post.Post.VendorID.Pubkeys == nil {
+		return errors.New("post is missing its author")
+	}
+	if post.Post.VendorID.PeerID != peerID {
+		return errors.New("post was not published by the peer")
+	}
+	if err := validatePost(post.Post); err != nil {
+		return err
+	}
+	if err := verifySignature(post.Post, post.Post.VendorID.Pubkeys.Identity, post.Signature, peerID); err != nil {
+		return err
+	}
+	return verifyBitcoinSignature(post.Post.VendorID.Pubkeys.Bitcoin, post.Post.VendorID.BitcoinSig, peerID)
+}
+
+type feedUpdater struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartFeedUpdater - start the worker which fetches the posts of the peers
+// the node follows into the feed
+func (n *OpenBazaarNode) StartFeedUpdater() {
+	n.FeedUpdater = &feedUpdater{
+		node:          n,
+		intervalDelay: feedUpdaterInterval,
+		logger:        logging.MustGetLogger("feedUpdater"),
+	}
+	go n.FeedUpdater.Run()
+}
+
+func (updater *feedUpdater) Run() {
+	updater.watchdogTimer = time.NewTicker(updater.intervalDelay)
+	updater.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	updater.PerformTask()
+	for {
+		select {
+		case <-updater.watchdogTimer.C:
+			updater.PerformTask()
+		case <-updater.stopWorker:
+			updater.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (updater *feedUpdater) Stop() {
+	updater.stopWorker <- true
+	close(updater.stopWorker)
+}
+
+func (updater *feedUpdater) PerformTask() {
+	result, err := updater.node.UpdateFeed()
+	if err != nil {
+		updater.logger.Errorf("updating feed: %s", err)
+		return
+	}
+	updater.logger.Debugf("feed posts added from %d peers: %d (%d peers failed)", result.Peers, result.Added, result.Failed)
+}
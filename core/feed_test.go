@@ -0,0 +1,69 @@
+package core_test
+
+import (
+	"testing"
+
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestOpenBazaarNode_AddFeedPost(t *testing.T) {
+	node := newBroadcastingNode(t)
+	// The test node's identity isn't derived from its private key so it's
+	// replaced with one which verifies against the signed post
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.IpfsNode.Identity = pid
+	peerID := pid.Pretty()
+
+	post, err := node.SignPost(&pb.Post{
+		Slug:      "feed-test",
+		Status:    "New lamps in stock",
+		Tags:      []string{"lamps"},
+		Timestamp: ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	post.Hash = "QmFeedTestPost"
+	defer node.Datastore.FeedPosts().DeleteByPeer(peerID)
+
+	added, err := node.AddFeedPost(peerID, post)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !added {
+		t.Error("expected the post to be added to the feed")
+	}
+	if added, err := node.AddFeedPost(peerID, post); err != nil || added {
+		t.Errorf("expected a cached post not to be added again, got %v (%v)", added, err)
+	}
+
+	if _, err := node.AddFeedPost("QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", post); err == nil {
+		t.Error("expected a post under another peer to be rejected")
+	}
+	post.Hash = "QmFeedTestTampered"
+	post.Post.Status = "Everything free"
+	if _, err := node.AddFeedPost(peerID, post); err == nil {
+		t.Error("expected a tampered post to be rejected")
+	}
+	if has, _ := node.Datastore.FeedPosts().Has("QmFeedTestTampered"); has {
+		t.Error("expected the tampered post not to be cached")
+	}
+
+	// The node follows no one so the update drops the cached post
+	result, err := node.UpdateFeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Peers != 0 {
+		t.Errorf("expected no peers to be fetched, got %d", result.Peers)
+	}
+	if has, _ := node.Datastore.FeedPosts().Has("QmFeedTestPost"); has {
+		t.Error("expected posts by unfollowed peers to be pruned")
+	}
+}
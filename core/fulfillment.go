@@ -25,6 +25,9 @@ var (
 
 // FulfillOrder - fulfill the order
 func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	if err := n.CheckCrowdFundPledge(contract.VendorOrderConfirmation.OrderID); err != nil {
+		return err
+	}
 	if fulfillment.Slug == "" && len(contract.VendorListings) == 1 {
 		fulfillment.Slug = contract.VendorListings[0].Slug
 	} else if fulfillment.Slug == "" && len(contract.VendorListings) > 1 {
@@ -165,12 +168,12 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 		}
 	}
 	if n.IsFulfilled(rc) {
-		err = n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_FULFILLED, false)
+		err = n.OrderStates().UpdateSale(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_FULFILLED, false, "order fulfilled")
 		if err != nil {
 			log.Error(err)
 		}
 	} else {
-		err = n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_PARTIALLY_FULFILLED, false)
+		err = n.OrderStates().UpdateSale(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_PARTIALLY_FULFILLED, false, "order fulfilled")
 		if err != nil {
 			log.Error(err)
 		}
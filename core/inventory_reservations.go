@@ -0,0 +1,309 @@
+package core
+
+import (
+	"database/sql"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/op/go-logging"
+)
+
+const (
+	// InventoryReservationTimeout is how long units are held for an order
+	// which has not been funded before they can be sold to other buyers
+	InventoryReservationTimeout = time.Duration(48) * time.Hour
+
+	reservationExpirerInterval = time.Duration(10) * time.Minute
+)
+
+// inventoryLock serializes inventory checks and updates so concurrent orders
+// can't both claim the last units of a variant
+var inventoryLock sync.Mutex
+
+// InventoryKeeper holds inventory for orders until they are funded, commits
+// it once they are and raises low stock alerts
+type InventoryKeeper struct {
+	datastore repo.Datastore
+	broadcast chan repo.Notifier
+}
+
+// NewInventoryKeeper returns an InventoryKeeper for the datastore which
+// sends its notifications to broadcast
+func NewInventoryKeeper(datastore repo.Datastore, broadcast chan repo.Notifier) *InventoryKeeper {
+	return &InventoryKeeper{datastore: datastore, broadcast: broadcast}
+}
+
+// InventoryKeeper returns the inventory keeper for the node's listings
+func (n *OpenBazaarNode) InventoryKeeper() *InventoryKeeper {
+	return NewInventoryKeeper(n.Datastore, n.Broadcast)
+}
+
+// Available returns the units of the variant on hand less those held for
+// unfunded orders other than excludeOrderID. A negative count means the
+// variant has unlimited inventory.
+func (k *InventoryKeeper) Available(slug string, variant int, excludeOrderID string) (*big.Int, error) {
+	count, err := k.datastore.Inventory().GetSpecific(slug, variant)
+	if err != nil {
+		return nil, err
+	}
+	return k.available(slug, variant, count, excludeOrderID)
+}
+
+func (k *InventoryKeeper) available(slug string, variant int, count *big.Int, excludeOrderID string) (*big.Int, error) {
+	if count.Sign() < 0 {
+		return count, nil
+	}
+	reservations, err := k.datastore.InventoryReservations().GetBySlug(slug, variant)
+	if err != nil {
+		return nil, err
+	}
+	available := new(big.Int).Set(count)
+	for _, r := range reservations {
+		if r.OrderID != excludeOrderID {
+			available.Sub(available, r.Quantity)
+		}
+	}
+	if available.Sign() < 0 {
+		available.SetInt64(0)
+	}
+	return available, nil
+}
+
+// Reserve holds the ordered quantity of each variant with tracked inventory
+// for the order, replacing anything already held for it. When enforce is set
+// an ErrOutOfInventory is returned and nothing is held if units held for
+// other orders leave too few available.
+func (k *InventoryKeeper) Reserve(orderID string, contract *pb.RicardianContract, enforce bool) error {
+	inventoryLock.Lock()
+	defer inventoryLock.Unlock()
+
+	var held []repo.InventoryReservation
+	for _, r := range orderInventory(orderID, contract, time.Now()) {
+		count, err := k.datastore.Inventory().GetSpecific(r.Slug, r.Variant)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if count.Sign() < 0 {
+			continue
+		}
+		if enforce {
+			available, err := k.available(r.Slug, r.Variant, count, orderID)
+			if err != nil {
+				return err
+			}
+			if available.Cmp(r.Quantity) < 0 {
+				return NewErrOutOfInventory(available)
+			}
+		}
+		held = append(held, r)
+	}
+	return k.datastore.InventoryReservations().Put(orderID, held)
+}
+
+// Release frees the units held for an order
+func (k *InventoryKeeper) Release(orderID string) error {
+	inventoryLock.Lock()
+	defer inventoryLock.Unlock()
+
+	return k.datastore.InventoryReservations().Delete(orderID)
+}
+
+// ReleaseExpired frees the units held for orders which have gone unfunded
+// longer than InventoryReservationTimeout and returns the number of orders
+// released
+func (k *InventoryKeeper) ReleaseExpired(now time.Time) (int, error) {
+	inventoryLock.Lock()
+	defer inventoryLock.Unlock()
+
+	orderIDs, err := k.datastore.InventoryReservations().GetOrderIDsBefore(now.Add(-InventoryReservationTimeout))
+	if err != nil {
+		return 0, err
+	}
+	for _, orderID := range orderIDs {
+		if err := k.datastore.InventoryReservations().Delete(orderID); err != nil {
+			return 0, err
+		}
+		log.Infof("released inventory held for unfunded order (%s)", orderID)
+	}
+	return len(orderIDs), nil
+}
+
+// Commit takes the ordered quantities out of inventory once the order is
+// funded and frees the units held for it. It returns true if any inventory
+// count was changed.
+func (k *InventoryKeeper) Commit(orderID string, contract *pb.RicardianContract) (bool, error) {
+	inventoryLock.Lock()
+	defer inventoryLock.Unlock()
+
+	inventoryUpdated := false
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			continue
+		}
+		variant, err := GetSelectedSku(listing, item.Options)
+		if err != nil {
+			continue
+		}
+		c, err := k.datastore.Inventory().GetSpecific(listing.Slug, variant)
+		if err != nil {
+			continue
+		}
+		itemQty := GetOrderQuantity(listing, item)
+		if itemQty.Cmp(big.NewInt(0)) <= 0 || !itemQty.IsInt64() {
+			// TODO: https://github.com/OpenBazaar/openbazaar-go/issues/1739
+			log.Errorf("unable to update inventory with invalid quantity")
+			continue
+		}
+		newCount := new(big.Int).Sub(c, itemQty)
+		if c.Cmp(big.NewInt(0)) < 0 {
+			newCount = big.NewInt(-1)
+		} else if newCount.Cmp(big.NewInt(0)) < 0 {
+			newCount = big.NewInt(0)
+		}
+		if (c.Cmp(big.NewInt(0)) == 0) || (c.Cmp(big.NewInt(0)) > 0 && new(big.Int).Sub(c, itemQty).Cmp(big.NewInt(0)) < 0) {
+			log.Warningf("Order %s purchased more inventory for %s than we have on hand", orderID, listing.Slug)
+			k.broadcast <- repo.PremarshalledNotifier{Payload: []byte(`{"warning": "order ` + orderID + ` exceeded on hand inventory for ` + listing.Slug + `"`)}
+		}
+		if err := k.datastore.Inventory().Put(listing.Slug, variant, newCount); err != nil {
+			log.Errorf("failed updating inventory for listing (%s, %d): %s", listing.Slug, variant, err.Error())
+			continue
+		}
+		inventoryUpdated = true
+		if newCount.Cmp(big.NewInt(0)) >= 0 {
+			log.Debugf("Adjusting inventory for %s:%d to %d\n", listing.Slug, variant, newCount)
+		}
+		k.checkLowStock(listing, variant, c, newCount)
+	}
+
+	return inventoryUpdated, k.datastore.InventoryReservations().Delete(orderID)
+}
+
+// checkLowStock raises a LowInventoryNotification when a variant's count
+// drops from above the listing's low stock threshold to at or below it
+func (k *InventoryKeeper) checkLowStock(listing *pb.Listing, variant int, before, after *big.Int) {
+	threshold, err := k.datastore.Inventory().GetLowStockThreshold(listing.Slug)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorf("reading low stock threshold for (%s): %s", listing.Slug, err.Error())
+		}
+		return
+	}
+	if after.Sign() < 0 || before.Cmp(threshold) <= 0 || after.Cmp(threshold) > 0 {
+		return
+	}
+	notif := repo.LowInventoryNotification{
+		ID:        repo.NewNotificationID(),
+		Type:      repo.NotifierTypeLowInventory,
+		Slug:      listing.Slug,
+		Variant:   variant,
+		Remaining: after.String(),
+		Threshold: threshold.String(),
+	}
+	if listing.Item != nil {
+		notif.Title = listing.Item.Title
+		if len(listing.Item.Images) > 0 {
+			notif.Thumbnail = repo.Thumbnail{Tiny: listing.Item.Images[0].Tiny, Small: listing.Item.Images[0].Small}
+		}
+	}
+	k.broadcast <- notif
+	if err := k.datastore.Notifications().PutRecord(repo.NewNotification(notif, time.Now(), false)); err != nil {
+		log.Errorf("persisting low inventory notification: %s", err.Error())
+	}
+}
+
+// orderInventory returns the quantity of each listing variant in the order,
+// skipping items which can't be matched to a listing in the contract
+func orderInventory(orderID string, contract *pb.RicardianContract, now time.Time) []repo.InventoryReservation {
+	var reservations []repo.InventoryReservation
+	if contract.BuyerOrder == nil {
+		return reservations
+	}
+items:
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			continue
+		}
+		variant, err := GetSelectedSku(listing, item.Options)
+		if err != nil {
+			continue
+		}
+		qty := GetOrderQuantity(listing, item)
+		if qty == nil || qty.Sign() <= 0 {
+			continue
+		}
+		for i, r := range reservations {
+			if r.Slug == listing.Slug && r.Variant == variant {
+				reservations[i].Quantity = new(big.Int).Add(r.Quantity, qty)
+				continue items
+			}
+		}
+		reservations = append(reservations, repo.InventoryReservation{
+			OrderID:   orderID,
+			Slug:      listing.Slug,
+			Variant:   variant,
+			Quantity:  qty,
+			Timestamp: now,
+		})
+	}
+	return reservations
+}
+
+type reservationExpirer struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartReservationExpirer - start the worker which frees inventory held for
+// orders which were never funded
+func (n *OpenBazaarNode) StartReservationExpirer() {
+	n.ReservationExpirer = &reservationExpirer{
+		node:          n,
+		intervalDelay: reservationExpirerInterval,
+		logger:        logging.MustGetLogger("reservationExpirer"),
+	}
+	go n.ReservationExpirer.Run()
+}
+
+func (expirer *reservationExpirer) Run() {
+	expirer.watchdogTimer = time.NewTicker(expirer.intervalDelay)
+	expirer.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	expirer.PerformTask()
+	for {
+		select {
+		case <-expirer.watchdogTimer.C:
+			expirer.PerformTask()
+		case <-expirer.stopWorker:
+			expirer.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (expirer *reservationExpirer) Stop() {
+	expirer.stopWorker <- true
+	close(expirer.stopWorker)
+}
+
+func (expirer *reservationExpirer) PerformTask() {
+	released, err := expirer.node.InventoryKeeper().ReleaseExpired(time.Now())
+	if err != nil {
+		expirer.logger.Errorf("releasing expired inventory reservations: %s", err)
+		return
+	}
+	expirer.logger.Debugf("released inventory for %d unfunded orders", released)
+}
@@ -0,0 +1,200 @@
+package core_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
+)
+
+// newInventoryContract returns a contract for quantity units of the small
+// green variant (1) of the listing
+func newInventoryContract(t *testing.T, listing *pb.Listing, buyer, quantity string) *pb.RicardianContract {
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := factory.NewContract()
+	contract.VendorListings = []*pb.Listing{listing}
+	contract.BuyerOrder.BuyerID.PeerID = buyer
+	contract.BuyerOrder.Items = []*pb.Order_Item{
+		{
+			ListingHash: listingID.String(),
+			BigQuantity: quantity,
+			Options: []*pb.Order_Item_Option{
+				{Name: "Size", Value: "Small"},
+				{Name: "Color", Value: "Green"},
+			},
+		},
+	}
+	return contract
+}
+
+func TestInventoryKeeper_Reservations(t *testing.T) {
+	node := newBroadcastingNode(t)
+	keeper := node.InventoryKeeper()
+	listing := factory.NewListing("limited-run")
+
+	if err := node.Datastore.Inventory().Put("limited-run", 1, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := keeper.Reserve("order1", newInventoryContract(t, listing, "buyer1", "3"), true); err != nil {
+		t.Fatal(err)
+	}
+	// Reserving again for the same order replaces its reservation
+	if err := keeper.Reserve("order1", newInventoryContract(t, listing, "buyer1", "3"), true); err != nil {
+		t.Fatal(err)
+	}
+	available, err := keeper.Available("limited-run", 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if available.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("expected 2 units available, got %s", available)
+	}
+
+	order2 := newInventoryContract(t, listing, "buyer2", "3")
+	err = keeper.Reserve("order2", order2, true)
+	if _, ok := err.(core.ErrOutOfInventory); !ok {
+		t.Fatalf("expected ErrOutOfInventory while units are held, got %v", err)
+	}
+	held, err := node.Datastore.InventoryReservations().GetByOrderID("order2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 0 {
+		t.Error("expected nothing to be held for a rejected order")
+	}
+
+	// Offline orders are held even when they oversell
+	if err := keeper.Reserve("order3", newInventoryContract(t, listing, "buyer3", "3"), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.Release("order3"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := keeper.Release("order1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.Reserve("order2", order2, true); err != nil {
+		t.Fatalf("expected released units to be available, got %v", err)
+	}
+
+	// Unfunded orders give up their units after the timeout
+	released, err := keeper.ReleaseExpired(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released != 0 {
+		t.Errorf("expected nothing released before the timeout, got %d", released)
+	}
+	released, err = keeper.ReleaseExpired(time.Now().Add(core.InventoryReservationTimeout + time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released != 1 {
+		t.Errorf("expected one order released after the timeout, got %d", released)
+	}
+}
+
+func TestInventoryKeeper_CommitRaisesLowInventory(t *testing.T) {
+	node := newBroadcastingNode(t)
+	keeper := node.InventoryKeeper()
+	listing := factory.NewListing("limited-run")
+
+	if err := node.Datastore.Inventory().Put("limited-run", 1, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Inventory().PutLowStockThreshold("limited-run", big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	contract := newInventoryContract(t, listing, "buyer1", "3")
+	if err := keeper.Reserve("order1", contract, true); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := keeper.Commit("order1", contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated {
+		t.Error("expected inventory to be updated")
+	}
+	count, err := node.Datastore.Inventory().GetSpecific("limited-run", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("expected 2 units left, got %s", count)
+	}
+	held, err := node.Datastore.InventoryReservations().GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 0 {
+		t.Error("expected reservation to be freed once committed")
+	}
+
+	// Only crossing the threshold raises an alert
+	if _, err := keeper.Commit("order2", newInventoryContract(t, listing, "buyer2", "1")); err != nil {
+		t.Fatal(err)
+	}
+
+	notifs, _, err := node.Datastore.Notifications().GetAll("", -1, []string{string(repo.NotifierTypeLowInventory)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifs) != 1 {
+		t.Fatalf("expected one low inventory notification, got %d", len(notifs))
+	}
+	n, ok := notifs[0].NotifierData.(repo.LowInventoryNotification)
+	if !ok {
+		t.Fatalf("unexpected notification: %T", notifs[0].NotifierData)
+	}
+	if n.Slug != "limited-run" || n.Variant != 1 || n.Remaining != "2" || n.Threshold != "2" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+func TestOrderStateMachine_DeclinedSaleReleasesInventory(t *testing.T) {
+	node := newBroadcastingNode(t)
+	listing := factory.NewListing("limited-run")
+
+	if err := node.Datastore.Inventory().Put("limited-run", 1, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	// Sales aren't cleared between tests so the order needs a fresh ID
+	orderID := fmt.Sprintf("declined-%d", time.Now().UnixNano())
+	defer node.Datastore.Sales().Delete(orderID)
+
+	contract := newInventoryContract(t, listing, "buyer1", "2")
+	if err := node.InventoryKeeper().Reserve(orderID, contract, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received"); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_DECLINED, true, "order rejected"); err != nil {
+		t.Fatal(err)
+	}
+	held, err := node.Datastore.InventoryReservations().GetByOrderID(orderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 0 {
+		t.Error("expected declined order to release its inventory")
+	}
+}
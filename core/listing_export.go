@@ -0,0 +1,282 @@
+package core
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// ListingExportFormatCSV - flat CSV file which can be read by the importer
+	ListingExportFormatCSV = ListingImportFormatCSV
+	// ListingExportFormatZip - zip bundle of signed listings and their original images
+	ListingExportFormatZip = ListingImportFormatZip
+
+	// listingBundleListingsDir holds one signed listing json file per listing
+	listingBundleListingsDir = "listings"
+	// listingBundleImagesDir holds the original images referenced by the listings
+	listingBundleImagesDir = "images"
+)
+
+// listingCSVBaseColumns are the listing level columns written by the exporter
+var listingCSVBaseColumns = []string{
+	ListingCSVColumnSlug,
+	ListingCSVColumnContractType,
+	ListingCSVColumnTitle,
+	ListingCSVColumnDescription,
+	ListingCSVColumnProcessingTime,
+	ListingCSVColumnPrice,
+	ListingCSVColumnPricingCurrency,
+	ListingCSVColumnAcceptedCurrencies,
+	ListingCSVColumnNSFW,
+	ListingCSVColumnCondition,
+	ListingCSVColumnCategories,
+	ListingCSVColumnTags,
+	ListingCSVColumnGrams,
+	ListingCSVColumnExpiry,
+	ListingCSVColumnLanguage,
+	ListingCSVColumnTermsAndConditions,
+	ListingCSVColumnRefundPolicy,
+	ListingCSVColumnImageURLs,
+	ListingCSVColumnSKUProductID,
+	ListingCSVColumnSKUQuantity,
+	ListingCSVColumnSKUSurcharge,
+}
+
+// ExportListings writes all of the node's listings to w in the given format.
+// Only the repo path and the datastore of the node are used so the export
+// may also be run against a repo which is not currently started.
+func (n *OpenBazaarNode) ExportListings(w io.Writer, format string) error {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return fmt.Errorf("reading listing index: %s", err.Error())
+	}
+	var listings []*pb.SignedListing
+	for _, ld := range index {
+		sl, err := n.GetListingFromSlug(ld.Slug)
+		if err != nil {
+			return fmt.Errorf("reading listing (%s): %s", ld.Slug, err.Error())
+		}
+		rsl := repo.NewSignedListingFromProtobuf(sl)
+		if err := rsl.GetListing().UpdateCouponsFromDatastore(n.Datastore.Coupons()); err != nil {
+			log.Warningf("updating coupons for listing (%s): %s", ld.Slug, err.Error())
+		}
+		if err := rsl.Normalize(); err != nil {
+			return fmt.Errorf("normalizing listing (%s): %s", ld.Slug, err.Error())
+		}
+		listings = append(listings, sl)
+	}
+
+	switch strings.ToLower(format) {
+	case ListingExportFormatCSV:
+		return writeListingsCSV(w, listings)
+	case ListingExportFormatZip:
+		return n.writeListingsBundle(w, listings)
+	default:
+		return fmt.Errorf("unknown export format (%s)", format)
+	}
+}
+
+func writeListingsCSV(w io.Writer, listings []*pb.SignedListing) error {
+	var (
+		maxOptions, maxShippingOptions, maxServices int
+		exported                                    []*pb.Listing
+	)
+	for _, sl := range listings {
+		l := sl.Listing
+		if l.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
+			log.Warningf("skipping csv export of cryptocurrency listing (%s)", l.Slug)
+			continue
+		}
+		if len(l.Item.Options) > maxOptions {
+			maxOptions = len(l.Item.Options)
+		}
+		if len(l.ShippingOptions) > maxShippingOptions {
+			maxShippingOptions = len(l.ShippingOptions)
+		}
+		for _, so := range l.ShippingOptions {
+			if len(so.Services) > maxServices {
+				maxServices = len(so.Services)
+			}
+		}
+		exported = append(exported, l)
+	}
+
+	header := append([]string{}, listingCSVBaseColumns...)
+	for i := 1; i <= maxOptions; i++ {
+		header = append(header, csvOptionColumn(i, "name"), csvOptionColumn(i, "description"), csvOptionColumn(i, "variants"), csvOptionColumn(i, "value"))
+	}
+	for i := 1; i <= maxShippingOptions; i++ {
+		header = append(header, csvShippingOptionColumn(i, "name"), csvShippingOptionColumn(i, "type"), csvShippingOptionColumn(i, "regions"))
+		for j := 1; j <= maxServices; j++ {
+			header = append(header,
+				csvShippingServiceColumn(i, j, "name"),
+				csvShippingServiceColumn(i, j, "price"),
+				csvShippingServiceColumn(i, j, "additional_item_price"),
+				csvShippingServiceColumn(i, j, "estimated_delivery"),
+			)
+		}
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[h] = i
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, l := range exported {
+		skus := l.Item.Skus
+		if len(skus) == 0 {
+			skus = []*pb.Listing_Item_Sku{{}}
+		}
+		for i, sku := range skus {
+			row := make([]string, len(header))
+			set := func(column, value string) { row[columns[column]] = value }
+
+			set(ListingCSVColumnSlug, l.Slug)
+			if i == 0 {
+				setListingCSVColumns(l, set)
+			}
+			set(ListingCSVColumnSKUProductID, sku.ProductID)
+			set(ListingCSVColumnSKUQuantity, sku.BigQuantity)
+			set(ListingCSVColumnSKUSurcharge, sku.BigSurcharge)
+			for j, combo := range sku.VariantCombo {
+				if j < len(l.Item.Options) && int(combo) < len(l.Item.Options[j].Variants) {
+					set(csvOptionColumn(j+1, "value"), l.Item.Options[j].Variants[combo].Name)
+				}
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// setListingCSVColumns fills in the listing level columns which only appear
+// on the first row of each listing
+func setListingCSVColumns(l *pb.Listing, set func(column, value string)) {
+	set(ListingCSVColumnContractType, l.Metadata.ContractType.String())
+	set(ListingCSVColumnTitle, l.Item.Title)
+	set(ListingCSVColumnDescription, l.Item.Description)
+	set(ListingCSVColumnProcessingTime, l.Item.ProcessingTime)
+	set(ListingCSVColumnPrice, l.Item.BigPrice)
+	if l.Item.PriceCurrency != nil {
+		set(ListingCSVColumnPricingCurrency, l.Item.PriceCurrency.Code)
+	}
+	set(ListingCSVColumnAcceptedCurrencies, strings.Join(l.Metadata.AcceptedCurrencies, listingCSVListSeparator))
+	set(ListingCSVColumnNSFW, strconv.FormatBool(l.Item.Nsfw))
+	set(ListingCSVColumnCondition, l.Item.Condition)
+	set(ListingCSVColumnCategories, strings.Join(l.Item.Categories, listingCSVListSeparator))
+	set(ListingCSVColumnTags, strings.Join(l.Item.Tags, listingCSVListSeparator))
+	set(ListingCSVColumnGrams, strconv.FormatFloat(float64(l.Item.Grams), 'f', -1, 32))
+	if l.Metadata.Expiry != nil {
+		set(ListingCSVColumnExpiry, time.Unix(l.Metadata.Expiry.Seconds, 0).UTC().Format(time.RFC3339))
+	}
+	set(ListingCSVColumnLanguage, l.Metadata.Language)
+	set(ListingCSVColumnTermsAndConditions, l.TermsAndConditions)
+	set(ListingCSVColumnRefundPolicy, l.RefundPolicy)
+
+	var imageRefs []string
+	for _, img := range l.Item.Images {
+		imageRefs = append(imageRefs, listingImageRef(img))
+	}
+	set(ListingCSVColumnImageURLs, strings.Join(imageRefs, listingCSVListSeparator))
+
+	for i, o := range l.Item.Options {
+		set(csvOptionColumn(i+1, "name"), o.Name)
+		set(csvOptionColumn(i+1, "description"), o.Description)
+		var variants []string
+		for _, v := range o.Variants {
+			variants = append(variants, v.Name)
+		}
+		set(csvOptionColumn(i+1, "variants"), strings.Join(variants, listingCSVListSeparator))
+	}
+	for i, so := range l.ShippingOptions {
+		set(csvShippingOptionColumn(i+1, "name"), so.Name)
+		set(csvShippingOptionColumn(i+1, "type"), so.Type.String())
+		var regions []string
+		for _, r := range so.Regions {
+			regions = append(regions, r.String())
+		}
+		set(csvShippingOptionColumn(i+1, "regions"), strings.Join(regions, listingCSVListSeparator))
+		for j, s := range so.Services {
+			set(csvShippingServiceColumn(i+1, j+1, "name"), s.Name)
+			set(csvShippingServiceColumn(i+1, j+1, "price"), s.BigPrice)
+			set(csvShippingServiceColumn(i+1, j+1, "additional_item_price"), s.BigAdditionalItemPrice)
+			set(csvShippingServiceColumn(i+1, j+1, "estimated_delivery"), s.EstimatedDelivery)
+		}
+	}
+}
+
+// writeListingsBundle writes a zip file containing each signed listing and
+// the original images it references
+func (n *OpenBazaarNode) writeListingsBundle(w io.Writer, listings []*pb.SignedListing) error {
+	zw := zip.NewWriter(w)
+	addedImages := make(map[string]bool)
+	for _, sl := range listings {
+		out, err := repo.NewSignedListingFromProtobuf(sl).MarshalJSON()
+		if err != nil {
+			return err
+		}
+		f, err := zw.Create(path.Join(listingBundleListingsDir, sl.Listing.Slug+".json"))
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(out); err != nil {
+			return err
+		}
+
+		for _, filename := range listingImageFilenames(sl.Listing) {
+			if addedImages[filename] {
+				continue
+			}
+			b, err := ioutil.ReadFile(path.Join(n.RepoPath, "root", "images", "original", filename))
+			if os.IsNotExist(err) {
+				log.Warningf("original image (%s) for listing (%s) not found", filename, sl.Listing.Slug)
+				continue
+			} else if err != nil {
+				return err
+			}
+			f, err := zw.Create(path.Join(listingBundleImagesDir, filename))
+			if err != nil {
+				return err
+			}
+			if _, err := f.Write(b); err != nil {
+				return err
+			}
+			addedImages[filename] = true
+		}
+	}
+	return zw.Close()
+}
+
+// listingImageFilenames returns the filenames of every image used by the
+// listing, including variant images
+func listingImageFilenames(l *pb.Listing) []string {
+	var filenames []string
+	for _, img := range l.Item.Images {
+		filenames = append(filenames, img.Filename)
+	}
+	for _, o := range l.Item.Options {
+		for _, v := range o.Variants {
+			if v.Image != nil && v.Image.Filename != "" {
+				filenames = append(filenames, v.Image.Filename)
+			}
+		}
+	}
+	return filenames
+}
@@ -0,0 +1,824 @@
+package core
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	netUrl "net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// ListingImportFormatCSV - flat CSV file with one row per listing or per SKU
+	ListingImportFormatCSV = "csv"
+	// ListingImportFormatJSON - JSON array of listings
+	ListingImportFormatJSON = "json"
+	// ListingImportFormatZip - zip bundle as written by ExportListings
+	ListingImportFormatZip = "zip"
+
+	// ListingImportStatusCreated - the listing did not exist and was created
+	ListingImportStatusCreated = "created"
+	// ListingImportStatusUpdated - the listing already existed and was updated
+	ListingImportStatusUpdated = "updated"
+	// ListingImportStatusFailed - the listing could not be imported
+	ListingImportStatusFailed = "failed"
+
+	// listingCSVListSeparator separates multiple values within a single CSV cell
+	listingCSVListSeparator = ","
+	// listingImageIPFSScheme marks an image reference which is fetched from IPFS
+	// instead of over HTTP. The format is ipfs://<original hash>/<filename>.
+	listingImageIPFSScheme = "ipfs"
+)
+
+// Columns of the listing CSV format. Rows sharing the same slug (or the same
+// title when no slug is given) are merged into a single listing where each
+// row describes one SKU. Listing level columns only need to be filled in on
+// the first row of a listing. Options and shipping options are numbered
+// starting from 1, e.g. option1_name, shipping_option2_service1_price.
+// The optional option<N>_variants column lists every variant of an option
+// in order, otherwise the variants are collected from the option values of
+// each row.
+const (
+	ListingCSVColumnSlug               = "slug"
+	ListingCSVColumnContractType       = "contract_type"
+	ListingCSVColumnTitle              = "title"
+	ListingCSVColumnDescription        = "description"
+	ListingCSVColumnProcessingTime     = "processing_time"
+	ListingCSVColumnPrice              = "price"
+	ListingCSVColumnPricingCurrency    = "pricing_currency"
+	ListingCSVColumnAcceptedCurrencies = "accepted_currencies"
+	ListingCSVColumnNSFW               = "nsfw"
+	ListingCSVColumnCondition          = "condition"
+	ListingCSVColumnCategories         = "categories"
+	ListingCSVColumnTags               = "tags"
+	ListingCSVColumnGrams              = "grams"
+	ListingCSVColumnExpiry             = "expiry"
+	ListingCSVColumnLanguage           = "language"
+	ListingCSVColumnTermsAndConditions = "terms_and_conditions"
+	ListingCSVColumnRefundPolicy       = "refund_policy"
+	ListingCSVColumnImageURLs          = "image_urls"
+	ListingCSVColumnSKUProductID       = "sku_product_id"
+	ListingCSVColumnSKUQuantity        = "sku_quantity"
+	ListingCSVColumnSKUSurcharge       = "sku_surcharge"
+
+	listingCSVOptionPrefix         = "option"
+	listingCSVShippingOptionPrefix = "shipping_option"
+	listingCSVServiceInfix         = "_service"
+)
+
+// defaultImportedListingExpiry is used when an imported listing does not
+// specify an expiry
+var defaultImportedListingExpiry = time.Unix(2147483647, 0)
+
+// ListingImportResult describes the outcome of importing a single listing
+type ListingImportResult struct {
+	Rows   []int  `json:"rows,omitempty"`
+	Slug   string `json:"slug"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ListingImportReport summarizes a bulk listing import
+type ListingImportReport struct {
+	Created []string              `json:"created"`
+	Updated []string              `json:"updated"`
+	Failed  []string              `json:"failed"`
+	Results []ListingImportResult `json:"results"`
+}
+
+func (r *ListingImportReport) add(result ListingImportResult) {
+	switch result.Status {
+	case ListingImportStatusCreated:
+		r.Created = append(r.Created, result.Slug)
+	case ListingImportStatusUpdated:
+		r.Updated = append(r.Updated, result.Slug)
+	default:
+		r.Failed = append(r.Failed, result.Slug)
+	}
+	r.Results = append(r.Results, result)
+}
+
+// importedListing is a listing parsed from an import file which has not
+// been saved yet
+type importedListing struct {
+	rows      []int
+	listing   *pb.Listing
+	imageRefs []string
+	// images holds the original image data from a zip bundle by filename
+	images map[string][]byte
+	err    error
+}
+
+func (il *importedListing) slug() string {
+	if il.listing == nil {
+		return ""
+	}
+	if il.listing.Slug != "" {
+		return il.listing.Slug
+	}
+	if il.listing.Item != nil {
+		return il.listing.Item.Title
+	}
+	return ""
+}
+
+// ImportListings reads listings from the given reader in the given format and
+// creates or updates each of them. A failure to import one listing does not
+// abort the import, instead it is recorded in the returned report. The caller
+// is responsible for republishing the node once the import is complete.
+func (n *OpenBazaarNode) ImportListings(r io.Reader, format string) (*ListingImportReport, error) {
+	var (
+		listings []*importedListing
+		err      error
+	)
+	switch strings.ToLower(format) {
+	case ListingImportFormatCSV:
+		listings, err = parseListingsCSV(r)
+	case ListingImportFormatJSON:
+		listings, err = parseListingsJSON(r)
+	case ListingImportFormatZip:
+		listings, err = parseListingsBundle(r)
+	default:
+		return nil, fmt.Errorf("unknown import format (%s)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ListingImportReport{
+		Created: []string{},
+		Updated: []string{},
+		Failed:  []string{},
+		Results: []ListingImportResult{},
+	}
+	for _, il := range listings {
+		result := ListingImportResult{Rows: il.rows, Slug: il.slug()}
+		if il.err != nil {
+			result.Status = ListingImportStatusFailed
+			result.Reason = il.err.Error()
+			report.add(result)
+			continue
+		}
+		status, err := n.importListing(il)
+		if err != nil {
+			log.Warningf("importing listing (%s): %s", il.slug(), err.Error())
+			result.Status = ListingImportStatusFailed
+			result.Reason = err.Error()
+		} else {
+			result.Status = status
+		}
+		result.Slug = il.slug()
+		report.add(result)
+	}
+	return report, nil
+}
+
+func (n *OpenBazaarNode) importListing(il *importedListing) (string, error) {
+	var (
+		listing  = il.listing
+		existing *pb.SignedListing
+	)
+	if listing.Slug != "" {
+		sl, err := n.GetListingFromSlug(listing.Slug)
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+		existing = sl
+	}
+
+	if len(il.images) > 0 {
+		if err := n.importBundledImages(listing, il.images); err != nil {
+			return "", err
+		}
+	} else if len(il.imageRefs) > 0 {
+		images, err := n.importListingImages(il.imageRefs, existing)
+		if err != nil {
+			return "", err
+		}
+		listing.Item.Images = images
+	} else if len(listing.Item.Images) == 0 && existing != nil {
+		listing.Item.Images = existing.Listing.Item.Images
+	}
+
+	if existing != nil {
+		if err := n.mergeExistingListing(listing, existing.Listing); err != nil {
+			return "", err
+		}
+	}
+
+	rl, err := repo.NewListingFromProtobuf(listing)
+	if err != nil {
+		return "", err
+	}
+	lb, err := rl.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	if existing != nil {
+		if err := n.UpdateListing(lb, false); err != nil {
+			return "", err
+		}
+		return ListingImportStatusUpdated, nil
+	}
+	slug, err := n.createListing(lb)
+	if err != nil {
+		return "", err
+	}
+	listing.Slug = slug
+	return ListingImportStatusCreated, nil
+}
+
+// createListing mirrors CreateListing without republishing the node after
+// each listing is saved
+func (n *OpenBazaarNode) createListing(r []byte) (string, error) {
+	listing, err := repo.CreateListing(r, n.TestNetworkEnabled() || n.RegressionNetworkEnabled(), &n.Datastore, n.RepoPath)
+	if err != nil {
+		return "", err
+	}
+	return listing.GetSlug(), n.saveListing(listing, false)
+}
+
+// mergeExistingListing keeps the parts of an existing listing which the
+// import file does not carry, such as taxes, moderators, coupons and
+// variant images.
+func (n *OpenBazaarNode) mergeExistingListing(listing, existing *pb.Listing) error {
+	if len(listing.Taxes) == 0 {
+		listing.Taxes = existing.Taxes
+	}
+	if len(listing.Moderators) == 0 {
+		listing.Moderators = existing.Moderators
+	}
+	for _, o := range listing.Item.Options {
+		for _, eo := range existing.Item.Options {
+			if eo.Name != o.Name {
+				continue
+			}
+			if o.Description == "" {
+				o.Description = eo.Description
+			}
+			for _, v := range o.Variants {
+				for _, ev := range eo.Variants {
+					if ev.Name == v.Name && v.Image == nil {
+						v.Image = ev.Image
+					}
+				}
+			}
+		}
+	}
+	if len(listing.Coupons) > 0 || len(existing.Coupons) == 0 {
+		return nil
+	}
+
+	// Coupon codes are only stored hashed in the published listing so
+	// they are restored from the datastore
+	rl, err := repo.NewListingFromProtobuf(existing)
+	if err != nil {
+		return err
+	}
+	if err := rl.UpdateCouponsFromDatastore(n.Datastore.Coupons()); err != nil {
+		return err
+	}
+	listing.Coupons = rl.GetProtobuf().Coupons
+	return nil
+}
+
+// importListingImages adds each referenced image to the node. References to
+// images which are already part of the existing listing are reused as is.
+func (n *OpenBazaarNode) importListingImages(refs []string, existing *pb.SignedListing) ([]*pb.Listing_Item_Image, error) {
+	var images []*pb.Listing_Item_Image
+	for _, ref := range refs {
+		u, err := netUrl.Parse(ref)
+		if err != nil {
+			return nil, fmt.Errorf("parsing image url (%s): %s", ref, err.Error())
+		}
+
+		var imgData, filename string
+		switch u.Scheme {
+		case listingImageIPFSScheme:
+			if img := findListingImage(existing, u.Host); img != nil {
+				images = append(images, img)
+				continue
+			}
+			b, err := ipfs.Cat(n.IpfsNode, u.Host, time.Minute)
+			if err != nil {
+				return nil, fmt.Errorf("fetching image (%s): %s", ref, err.Error())
+			}
+			imgData = base64.StdEncoding.EncodeToString(b)
+			filename = strings.TrimPrefix(u.Path, "/")
+			if filename == "" {
+				filename = u.Host
+			}
+		case "http", "https":
+			imgData, filename, err = n.GetBase64Image(ref)
+			if err != nil {
+				return nil, fmt.Errorf("fetching image (%s): %s", ref, err.Error())
+			}
+		default:
+			return nil, fmt.Errorf("unsupported image url (%s)", ref)
+		}
+
+		hashes, err := n.SetProductImages(imgData, filename)
+		if err != nil {
+			return nil, fmt.Errorf("adding image (%s): %s", ref, err.Error())
+		}
+		images = append(images, &pb.Listing_Item_Image{
+			Filename: filename,
+			Original: hashes.Original,
+			Large:    hashes.Large,
+			Medium:   hashes.Medium,
+			Small:    hashes.Small,
+			Tiny:     hashes.Tiny,
+		})
+	}
+	return images, nil
+}
+
+// importBundledImages adds the images shipped in a zip bundle to the node
+// and points the listing and variant images at the resulting hashes
+func (n *OpenBazaarNode) importBundledImages(listing *pb.Listing, bundled map[string][]byte) error {
+	added := make(map[string]*pb.Profile_Image)
+	setHashes := func(img *pb.Listing_Item_Image) error {
+		b, ok := bundled[img.Filename]
+		if !ok {
+			return nil
+		}
+		hashes, ok := added[img.Filename]
+		if !ok {
+			var err error
+			hashes, err = n.SetProductImages(base64.StdEncoding.EncodeToString(b), img.Filename)
+			if err != nil {
+				return fmt.Errorf("adding image (%s): %s", img.Filename, err.Error())
+			}
+			added[img.Filename] = hashes
+		}
+		img.Original = hashes.Original
+		img.Large = hashes.Large
+		img.Medium = hashes.Medium
+		img.Small = hashes.Small
+		img.Tiny = hashes.Tiny
+		return nil
+	}
+	for _, img := range listing.Item.Images {
+		if err := setHashes(img); err != nil {
+			return err
+		}
+	}
+	for _, o := range listing.Item.Options {
+		for _, v := range o.Variants {
+			if v.Image == nil {
+				continue
+			}
+			if err := setHashes(v.Image); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func findListingImage(sl *pb.SignedListing, originalHash string) *pb.Listing_Item_Image {
+	if sl == nil || sl.Listing == nil || sl.Listing.Item == nil {
+		return nil
+	}
+	for _, img := range sl.Listing.Item.Images {
+		if img.Original == originalHash {
+			return img
+		}
+	}
+	return nil
+}
+
+// listingImageRef returns the import reference for an image already
+// published by this node
+func listingImageRef(img *pb.Listing_Item_Image) string {
+	return listingImageIPFSScheme + "://" + img.Original + "/" + img.Filename
+}
+
+func parseListingsJSON(r io.Reader) ([]*importedListing, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("import file must contain a json array of listings: %s", err.Error())
+	}
+	listings := make([]*importedListing, 0, len(raw))
+	for i, rl := range raw {
+		il := &importedListing{rows: []int{i + 1}, listing: new(pb.Listing)}
+		if err := jsonpb.UnmarshalString(string(rl), il.listing); err != nil {
+			il.err = err
+		} else if il.listing.Item == nil || il.listing.Metadata == nil {
+			il.err = errors.New("listing must contain an item and metadata")
+		}
+		listings = append(listings, il)
+	}
+	return listings, nil
+}
+
+// parseListingsBundle reads a zip bundle written by ExportListings. Each
+// listing is read from its signed listing file and carries the bundled
+// images so they can be added to the importing node.
+func parseListingsBundle(r io.Reader) ([]*importedListing, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, fmt.Errorf("reading zip bundle: %s", err.Error())
+	}
+
+	var (
+		listingFiles []*zip.File
+		images       = make(map[string][]byte)
+	)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		switch path.Dir(f.Name) {
+		case listingBundleListingsDir:
+			listingFiles = append(listingFiles, f)
+		case listingBundleImagesDir:
+			data, err := readZipFile(f)
+			if err != nil {
+				return nil, err
+			}
+			images[path.Base(f.Name)] = data
+		}
+	}
+
+	listings := make([]*importedListing, 0, len(listingFiles))
+	for i, f := range listingFiles {
+		il := &importedListing{rows: []int{i + 1}, images: images}
+		data, err := readZipFile(f)
+		if err != nil {
+			return nil, err
+		}
+		sl := new(pb.SignedListing)
+		if err := jsonpb.UnmarshalString(string(data), sl); err != nil {
+			il.err = fmt.Errorf("parsing %s: %s", f.Name, err.Error())
+		} else if sl.Listing == nil || sl.Listing.Item == nil || sl.Listing.Metadata == nil {
+			il.err = fmt.Errorf("%s must contain a listing with an item and metadata", f.Name)
+		} else {
+			il.listing = sl.Listing
+		}
+		if il.listing == nil {
+			il.listing = &pb.Listing{Slug: strings.TrimSuffix(path.Base(f.Name), ".json")}
+		}
+		listings = append(listings, il)
+	}
+	return listings, nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %s", f.Name, err.Error())
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
+
+// listingCSVRow provides access to the cells of a CSV row by column name
+type listingCSVRow struct {
+	columns map[string]int
+	cells   []string
+}
+
+func (r listingCSVRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.cells) {
+		return ""
+	}
+	return strings.TrimSpace(r.cells[i])
+}
+
+func (r listingCSVRow) has(column string) bool {
+	_, ok := r.columns[column]
+	return ok
+}
+
+func (r listingCSVRow) list(column string) []string {
+	var ret []string
+	for _, v := range strings.Split(r.get(column), listingCSVListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func parseListingsCSV(r io.Reader) ([]*importedListing, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %s", err.Error())
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := columns[ListingCSVColumnTitle]; !ok {
+		if _, ok := columns[ListingCSVColumnSlug]; !ok {
+			return nil, errors.New("csv must contain a slug or title column")
+		}
+	}
+
+	var (
+		listings []*importedListing
+		byKey    = make(map[string]*importedListing)
+		rowNum   = 1
+	)
+	for {
+		cells, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		rowNum++
+		if err != nil {
+			return nil, fmt.Errorf("reading csv row %d: %s", rowNum, err.Error())
+		}
+		row := listingCSVRow{columns: columns, cells: cells}
+
+		key := row.get(ListingCSVColumnSlug)
+		if key == "" {
+			key = row.get(ListingCSVColumnTitle)
+		}
+		if key == "" {
+			listings = append(listings, &importedListing{
+				rows: []int{rowNum},
+				err:  errors.New("row must contain a slug or title"),
+			})
+			continue
+		}
+
+		il, ok := byKey[key]
+		if !ok {
+			il = &importedListing{}
+			il.listing, il.imageRefs, il.err = newListingFromCSVRow(row)
+			byKey[key] = il
+			listings = append(listings, il)
+		}
+		il.rows = append(il.rows, rowNum)
+		if il.err == nil {
+			il.err = addSKUFromCSVRow(il.listing, row)
+		}
+	}
+	return listings, nil
+}
+
+// newListingFromCSVRow builds the listing level fields from the first row
+// of a listing
+func newListingFromCSVRow(row listingCSVRow) (*pb.Listing, []string, error) {
+	listing := &pb.Listing{
+		Slug:               row.get(ListingCSVColumnSlug),
+		TermsAndConditions: row.get(ListingCSVColumnTermsAndConditions),
+		RefundPolicy:       row.get(ListingCSVColumnRefundPolicy),
+		Metadata: &pb.Listing_Metadata{
+			Version:            repo.ListingVersion,
+			Format:             pb.Listing_Metadata_FIXED_PRICE,
+			ContractType:       pb.Listing_Metadata_PHYSICAL_GOOD,
+			Language:           row.get(ListingCSVColumnLanguage),
+			AcceptedCurrencies: row.list(ListingCSVColumnAcceptedCurrencies),
+		},
+		Item: &pb.Listing_Item{
+			Title:          row.get(ListingCSVColumnTitle),
+			Description:    row.get(ListingCSVColumnDescription),
+			ProcessingTime: row.get(ListingCSVColumnProcessingTime),
+			Condition:      row.get(ListingCSVColumnCondition),
+			Categories:     row.list(ListingCSVColumnCategories),
+			Tags:           row.list(ListingCSVColumnTags),
+			BigPrice:       row.get(ListingCSVColumnPrice),
+		},
+	}
+	if listing.Slug == "" {
+		// Derive the slug from the title so that importing the same file
+		// again updates the listing instead of creating a duplicate
+		listing.Slug = repo.CreateSlugFor(listing.Item.Title)
+	}
+
+	if ct := row.get(ListingCSVColumnContractType); ct != "" {
+		v, ok := pb.Listing_Metadata_ContractType_value[strings.ToUpper(ct)]
+		if !ok {
+			return listing, nil, fmt.Errorf("unknown contract type (%s)", ct)
+		}
+		if pb.Listing_Metadata_ContractType(v) == pb.Listing_Metadata_CRYPTOCURRENCY {
+			return listing, nil, errors.New("cryptocurrency listings can only be imported from json")
+		}
+		listing.Metadata.ContractType = pb.Listing_Metadata_ContractType(v)
+	}
+
+	expiry := defaultImportedListingExpiry
+	if e := row.get(ListingCSVColumnExpiry); e != "" {
+		t, err := time.Parse(time.RFC3339, e)
+		if err != nil {
+			return listing, nil, fmt.Errorf("invalid expiry (%s): %s", e, err.Error())
+		}
+		expiry = t
+	}
+	ts, err := ptypes.TimestampProto(expiry)
+	if err != nil {
+		return listing, nil, err
+	}
+	listing.Metadata.Expiry = ts
+
+	if listing.Item.BigPrice == "" {
+		return listing, nil, errors.New("price is required")
+	}
+	if _, ok := new(big.Int).SetString(listing.Item.BigPrice, 10); !ok {
+		return listing, nil, fmt.Errorf("price (%s) must be an integer amount in the smallest unit of the pricing currency", listing.Item.BigPrice)
+	}
+	code := row.get(ListingCSVColumnPricingCurrency)
+	if code == "" {
+		return listing, nil, errors.New("pricing currency is required")
+	}
+	def, err := repo.AllCurrencies().Lookup(code)
+	if err != nil {
+		return listing, nil, fmt.Errorf("unknown pricing currency (%s)", code)
+	}
+	listing.Item.PriceCurrency = &pb.CurrencyDefinition{
+		Code:         def.CurrencyCode().String(),
+		Divisibility: uint32(def.Divisibility),
+	}
+	if len(listing.Metadata.AcceptedCurrencies) == 0 {
+		listing.Metadata.AcceptedCurrencies = []string{def.CurrencyCode().String()}
+	}
+
+	if v := row.get(ListingCSVColumnNSFW); v != "" {
+		nsfw, err := strconv.ParseBool(v)
+		if err != nil {
+			return listing, nil, fmt.Errorf("invalid nsfw value (%s)", v)
+		}
+		listing.Item.Nsfw = nsfw
+	}
+	if v := row.get(ListingCSVColumnGrams); v != "" {
+		grams, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return listing, nil, fmt.Errorf("invalid grams value (%s)", v)
+		}
+		listing.Item.Grams = float32(grams)
+	}
+
+	for i := 1; row.has(csvOptionColumn(i, "name")); i++ {
+		name := row.get(csvOptionColumn(i, "name"))
+		if name == "" {
+			continue
+		}
+		option := &pb.Listing_Item_Option{
+			Name:        name,
+			Description: row.get(csvOptionColumn(i, "description")),
+		}
+		for _, v := range row.list(csvOptionColumn(i, "variants")) {
+			option.Variants = append(option.Variants, &pb.Listing_Item_Option_Variant{Name: v})
+		}
+		listing.Item.Options = append(listing.Item.Options, option)
+	}
+
+	listing.ShippingOptions, err = shippingOptionsFromCSVRow(row)
+	if err != nil {
+		return listing, nil, err
+	}
+
+	return listing, row.list(ListingCSVColumnImageURLs), nil
+}
+
+func shippingOptionsFromCSVRow(row listingCSVRow) ([]*pb.Listing_ShippingOption, error) {
+	var options []*pb.Listing_ShippingOption
+	for i := 1; row.has(csvShippingOptionColumn(i, "name")); i++ {
+		name := row.get(csvShippingOptionColumn(i, "name"))
+		if name == "" {
+			continue
+		}
+		option := &pb.Listing_ShippingOption{
+			Name: name,
+			Type: pb.Listing_ShippingOption_FIXED_PRICE,
+		}
+		if t := row.get(csvShippingOptionColumn(i, "type")); t != "" {
+			v, ok := pb.Listing_ShippingOption_ShippingType_value[strings.ToUpper(t)]
+			if !ok {
+				return nil, fmt.Errorf("unknown shipping option type (%s)", t)
+			}
+			option.Type = pb.Listing_ShippingOption_ShippingType(v)
+		}
+		for _, region := range row.list(csvShippingOptionColumn(i, "regions")) {
+			v, ok := pb.CountryCode_value[strings.ToUpper(region)]
+			if !ok {
+				return nil, fmt.Errorf("unknown shipping region (%s)", region)
+			}
+			option.Regions = append(option.Regions, pb.CountryCode(v))
+		}
+		for j := 1; row.has(csvShippingServiceColumn(i, j, "name")); j++ {
+			serviceName := row.get(csvShippingServiceColumn(i, j, "name"))
+			if serviceName == "" {
+				continue
+			}
+			service := &pb.Listing_ShippingOption_Service{
+				Name:                   serviceName,
+				EstimatedDelivery:      row.get(csvShippingServiceColumn(i, j, "estimated_delivery")),
+				BigPrice:               row.get(csvShippingServiceColumn(i, j, "price")),
+				BigAdditionalItemPrice: row.get(csvShippingServiceColumn(i, j, "additional_item_price")),
+			}
+			if service.BigPrice == "" {
+				service.BigPrice = "0"
+			}
+			if service.BigAdditionalItemPrice == "" {
+				service.BigAdditionalItemPrice = "0"
+			}
+			option.Services = append(option.Services, service)
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+// addSKUFromCSVRow adds the SKU described by the row to the listing, adding
+// any new option variants it references
+func addSKUFromCSVRow(listing *pb.Listing, row listingCSVRow) error {
+	sku := &pb.Listing_Item_Sku{
+		ProductID:    row.get(ListingCSVColumnSKUProductID),
+		BigQuantity:  row.get(ListingCSVColumnSKUQuantity),
+		BigSurcharge: row.get(ListingCSVColumnSKUSurcharge),
+	}
+	if sku.BigSurcharge == "" {
+		sku.BigSurcharge = "0"
+	}
+	if sku.BigQuantity == "" {
+		sku.BigQuantity = "-1"
+	}
+	if _, ok := new(big.Int).SetString(sku.BigQuantity, 10); !ok {
+		return fmt.Errorf("invalid sku quantity (%s)", sku.BigQuantity)
+	}
+	if _, ok := new(big.Int).SetString(sku.BigSurcharge, 10); !ok {
+		return fmt.Errorf("invalid sku surcharge (%s)", sku.BigSurcharge)
+	}
+
+	for i, option := range listing.Item.Options {
+		value := row.get(csvOptionColumn(i+1, "value"))
+		if value == "" {
+			return fmt.Errorf("missing value for option (%s)", option.Name)
+		}
+		idx := -1
+		for j, v := range option.Variants {
+			if v.Name == value {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			option.Variants = append(option.Variants, &pb.Listing_Item_Option_Variant{Name: value})
+			idx = len(option.Variants) - 1
+		}
+		sku.VariantCombo = append(sku.VariantCombo, uint32(idx))
+	}
+
+	if len(listing.Item.Options) == 0 && len(listing.Item.Skus) > 0 {
+		return errors.New("listings without options can only have a single row")
+	}
+	listing.Item.Skus = append(listing.Item.Skus, sku)
+	return nil
+}
+
+func csvOptionColumn(i int, field string) string {
+	return listingCSVOptionPrefix + strconv.Itoa(i) + "_" + field
+}
+
+func csvShippingOptionColumn(i int, field string) string {
+	return listingCSVShippingOptionPrefix + strconv.Itoa(i) + "_" + field
+}
+
+func csvShippingServiceColumn(i, j int, field string) string {
+	return listingCSVShippingOptionPrefix + strconv.Itoa(i) + listingCSVServiceInfix + strconv.Itoa(j) + "_" + field
+}
+
+// ListingImportFormatFromFilename guesses the import format from a file name
+func ListingImportFormatFromFilename(filename string) string {
+	switch ext := path.Ext(filename); {
+	case strings.EqualFold(ext, "."+ListingImportFormatJSON):
+		return ListingImportFormatJSON
+	case strings.EqualFold(ext, "."+ListingImportFormatZip):
+		return ListingImportFormatZip
+	}
+	return ListingImportFormatCSV
+}
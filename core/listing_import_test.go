@@ -0,0 +1,125 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestParseListingsCSV(t *testing.T) {
+	csvData := `slug,title,price,pricing_currency,accepted_currencies,tags,image_urls,option1_name,option1_value,sku_product_id,sku_quantity,shipping_option1_name,shipping_option1_regions,shipping_option1_service1_name,shipping_option1_service1_price,shipping_option1_service1_estimated_delivery
+tshirt,Ron Swanson Tshirt,2000,TBTC,"TBTC,TBCH","tshirts,swanson",https://example.com/shirt.jpg,Size,Small,sku-small,12,usps,"UNITED_STATES,CANADA",standard,20,3 days
+tshirt,,,,,,,,Large,sku-large,44,,,,,
+,Mug,500,TBTC,,,,,,,,usps,ALL,standard,10,5 days
+,,,,,,,,,,,,,,,
+`
+	listings, err := parseListingsCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) != 3 {
+		t.Fatalf("expected 3 parsed listings, got %d", len(listings))
+	}
+
+	shirt := listings[0]
+	if shirt.err != nil {
+		t.Fatal(shirt.err)
+	}
+	if len(shirt.rows) != 2 || shirt.rows[0] != 2 || shirt.rows[1] != 3 {
+		t.Errorf("expected shirt rows [2 3], got %v", shirt.rows)
+	}
+	if shirt.listing.Item.PriceCurrency.Code != "TBTC" || shirt.listing.Item.PriceCurrency.Divisibility != 8 {
+		t.Errorf("unexpected price currency: %v", shirt.listing.Item.PriceCurrency)
+	}
+	if len(shirt.listing.Metadata.AcceptedCurrencies) != 2 {
+		t.Errorf("expected 2 accepted currencies, got %v", shirt.listing.Metadata.AcceptedCurrencies)
+	}
+	if len(shirt.listing.Item.Tags) != 2 {
+		t.Errorf("expected 2 tags, got %v", shirt.listing.Item.Tags)
+	}
+	if len(shirt.imageRefs) != 1 || shirt.imageRefs[0] != "https://example.com/shirt.jpg" {
+		t.Errorf("unexpected image refs: %v", shirt.imageRefs)
+	}
+	if len(shirt.listing.Item.Options) != 1 || len(shirt.listing.Item.Options[0].Variants) != 2 {
+		t.Fatalf("expected one option with two variants, got %v", shirt.listing.Item.Options)
+	}
+	if len(shirt.listing.Item.Skus) != 2 {
+		t.Fatalf("expected 2 skus, got %d", len(shirt.listing.Item.Skus))
+	}
+	large := shirt.listing.Item.Skus[1]
+	if large.ProductID != "sku-large" || large.BigQuantity != "44" || large.VariantCombo[0] != 1 {
+		t.Errorf("unexpected sku: %v", large)
+	}
+	if len(shirt.listing.ShippingOptions) != 1 {
+		t.Fatalf("expected 1 shipping option, got %d", len(shirt.listing.ShippingOptions))
+	}
+	so := shirt.listing.ShippingOptions[0]
+	if so.Type != pb.Listing_ShippingOption_FIXED_PRICE || len(so.Regions) != 2 || so.Regions[1] != pb.CountryCode_CANADA {
+		t.Errorf("unexpected shipping option: %v", so)
+	}
+	if len(so.Services) != 1 || so.Services[0].BigPrice != "20" || so.Services[0].BigAdditionalItemPrice != "0" {
+		t.Errorf("unexpected shipping services: %v", so.Services)
+	}
+
+	mug := listings[1]
+	if mug.err != nil {
+		t.Fatal(mug.err)
+	}
+	if mug.listing.Slug != "mug" {
+		t.Errorf("expected slug derived from title, got %s", mug.listing.Slug)
+	}
+	if len(mug.listing.Metadata.AcceptedCurrencies) != 1 || mug.listing.Metadata.AcceptedCurrencies[0] != "TBTC" {
+		t.Errorf("expected pricing currency to be accepted by default, got %v", mug.listing.Metadata.AcceptedCurrencies)
+	}
+	if len(mug.listing.Item.Skus) != 1 || mug.listing.Item.Skus[0].BigQuantity != "-1" {
+		t.Errorf("expected a single unlimited sku, got %v", mug.listing.Item.Skus)
+	}
+
+	if listings[2].err == nil {
+		t.Error("expected an error for a row without slug or title")
+	}
+}
+
+func TestParseListingsCSVRowErrors(t *testing.T) {
+	csvData := `slug,title,price,pricing_currency
+bad-price,Bad Price,12.50,TBTC
+bad-currency,Bad Currency,100,NOTACOIN
+`
+	listings, err := parseListingsCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) != 2 {
+		t.Fatalf("expected 2 parsed listings, got %d", len(listings))
+	}
+	for _, il := range listings {
+		if il.err == nil {
+			t.Errorf("expected error for listing (%s)", il.slug())
+		}
+	}
+
+	if _, err := parseListingsCSV(strings.NewReader("foo,bar\n1,2\n")); err == nil {
+		t.Error("expected error for csv without slug or title column")
+	}
+}
+
+func TestParseListingsJSON(t *testing.T) {
+	listings, err := parseListingsJSON(strings.NewReader(`[{"slug": "one", "metadata": {}, "item": {"title": "One"}}, {"slug": "two"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) != 2 {
+		t.Fatalf("expected 2 parsed listings, got %d", len(listings))
+	}
+	if listings[0].err != nil {
+		t.Error(listings[0].err)
+	}
+	if listings[1].err == nil {
+		t.Error("expected error for listing without item")
+	}
+
+	if _, err := parseListingsJSON(strings.NewReader(`{"slug": "one"}`)); err == nil {
+		t.Error("expected error for non-array json")
+	}
+}
@@ -0,0 +1,405 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+)
+
+const (
+	listingSchedulerTestingInterval = time.Duration(1) * time.Minute
+	listingSchedulerRegularInterval = time.Duration(5) * time.Minute
+)
+
+type listingScheduler struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// ListingScheduleResult counts the listings changed by a pass of the
+// listing scheduler
+type ListingScheduleResult struct {
+	Published   int
+	Expired     int
+	Republished int
+}
+
+// StartListingScheduler - start the worker which publishes scheduled listings
+// and handles expired ones
+func (n *OpenBazaarNode) StartListingScheduler() {
+	n.ListingScheduler = &listingScheduler{
+		node:          n,
+		intervalDelay: n.listingSchedulerIntervalDelay(),
+		logger:        logging.MustGetLogger("listingScheduler"),
+	}
+	go n.ListingScheduler.Run()
+}
+
+func (n *OpenBazaarNode) listingSchedulerIntervalDelay() time.Duration {
+	if n.TestnetEnable {
+		return listingSchedulerTestingInterval
+	}
+	return listingSchedulerRegularInterval
+}
+
+func (scheduler *listingScheduler) Run() {
+	scheduler.watchdogTimer = time.NewTicker(scheduler.intervalDelay)
+	scheduler.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	scheduler.PerformTask()
+	for {
+		select {
+		case <-scheduler.watchdogTimer.C:
+			scheduler.PerformTask()
+		case <-scheduler.stopWorker:
+			scheduler.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (scheduler *listingScheduler) Stop() {
+	scheduler.stopWorker <- true
+	close(scheduler.stopWorker)
+}
+
+func (scheduler *listingScheduler) PerformTask() {
+	result, err := scheduler.node.ProcessListingSchedules(time.Now())
+	if err != nil {
+		scheduler.logger.Errorf("processing listing schedules: %s", err)
+		return
+	}
+	scheduler.logger.Debugf("listings published/expired/republished: %d/%d/%d", result.Published, result.Expired, result.Republished)
+}
+
+// ScheduleListing sets when an existing listing is published and whether it
+// is republished once it expires. A publishAt in the future removes the
+// listing from the index until then; a nil or past publishAt publishes a
+// pending listing immediately.
+func (n *OpenBazaarNode) ScheduleListing(slug string, publishAt *time.Time, republishHours uint32) (*repo.ListingSchedule, error) {
+	if _, err := n.GetListingFromSlug(slug); err != nil {
+		return nil, err
+	}
+	current, err := n.Datastore.ListingSchedules().Get(slug)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	now := time.Now()
+	schedule := repo.ListingSchedule{
+		Slug:           slug,
+		PublishAt:      publishAt,
+		RepublishHours: republishHours,
+		State:          repo.ListingScheduleStateActive,
+		UpdatedAt:      now,
+	}
+	if publishAt != nil && publishAt.After(now) {
+		schedule.State = repo.ListingScheduleStatePending
+	} else if current != nil && current.State == repo.ListingScheduleStateExpired {
+		schedule.State = repo.ListingScheduleStateExpired
+	}
+	if err := n.Datastore.ListingSchedules().Put(schedule); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case schedule.State == repo.ListingScheduleStatePending:
+		if _, err := n.removeListingFromIndex(slug); err != nil {
+			return nil, err
+		}
+	case current != nil && current.State == repo.ListingScheduleStatePending:
+		if err := n.addListingToIndex(slug); err != nil {
+			return nil, err
+		}
+	default:
+		return &schedule, nil
+	}
+	if err := n.updateProfileCounts(); err != nil {
+		return nil, err
+	}
+	return &schedule, n.SeedNode()
+}
+
+// CreateScheduledListing adds a listing which is kept out of the index until
+// publishAt
+func (n *OpenBazaarNode) CreateScheduledListing(r []byte, publishAt time.Time, republishHours uint32) (string, error) {
+	listing, err := repo.CreateListing(r, n.TestNetworkEnabled() || n.RegressionNetworkEnabled(), &n.Datastore, n.RepoPath)
+	if err != nil {
+		return "", err
+	}
+	schedule := repo.ListingSchedule{
+		Slug:           listing.GetSlug(),
+		PublishAt:      &publishAt,
+		RepublishHours: republishHours,
+		State:          repo.ListingScheduleStateActive,
+		UpdatedAt:      time.Now(),
+	}
+	if publishAt.After(schedule.UpdatedAt) {
+		schedule.State = repo.ListingScheduleStatePending
+	}
+	if err := n.Datastore.ListingSchedules().Put(schedule); err != nil {
+		return "", err
+	}
+	if err := n.saveListing(listing, true); err != nil {
+		if derr := n.Datastore.ListingSchedules().Delete(schedule.Slug); derr != nil {
+			log.Errorf("removing schedule for unsaved listing (%s): %s", schedule.Slug, derr.Error())
+		}
+		return "", err
+	}
+	return listing.GetSlug(), nil
+}
+
+// DeleteListingSchedule removes the schedule for a listing. A listing which
+// was waiting to be published is published immediately; an expired listing
+// stays hidden until it is updated with a new expiry.
+func (n *OpenBazaarNode) DeleteListingSchedule(slug string) error {
+	current, err := n.Datastore.ListingSchedules().Get(slug)
+	if err != nil {
+		return err
+	}
+	if current.State == repo.ListingScheduleStateExpired {
+		current.PublishAt = nil
+		current.RepublishHours = 0
+		current.UpdatedAt = time.Now()
+		return n.Datastore.ListingSchedules().Put(*current)
+	}
+	if err := n.Datastore.ListingSchedules().Delete(slug); err != nil {
+		return err
+	}
+	if current.State != repo.ListingScheduleStatePending {
+		return nil
+	}
+	if err := n.addListingToIndex(slug); err != nil {
+		return err
+	}
+	if err := n.updateProfileCounts(); err != nil {
+		return err
+	}
+	return n.SeedNode()
+}
+
+// ProcessListingSchedules publishes pending listings whose time has come,
+// hides listings which have expired and republishes expired listings which
+// are set to be republished. A notification is emitted for each change.
+func (n *OpenBazaarNode) ProcessListingSchedules(now time.Time) (ListingScheduleResult, error) {
+	var result ListingScheduleResult
+
+	due, err := n.Datastore.ListingSchedules().GetDue(now)
+	if err != nil {
+		return result, fmt.Errorf("getting due listing schedules: %s", err.Error())
+	}
+	for _, schedule := range due {
+		sl, ok := n.scheduledListing(schedule.Slug)
+		if !ok {
+			continue
+		}
+		if listingExpired(sl.Listing, now) {
+			if err := n.expireListing(sl, schedule, now, &result); err != nil {
+				log.Errorf("expiring listing (%s): %s", schedule.Slug, err.Error())
+			}
+			continue
+		}
+		if err := n.addListingToIndex(schedule.Slug); err != nil {
+			log.Errorf("publishing scheduled listing (%s): %s", schedule.Slug, err.Error())
+			continue
+		}
+		schedule.State = repo.ListingScheduleStateActive
+		schedule.UpdatedAt = now
+		if err := n.Datastore.ListingSchedules().Put(schedule); err != nil {
+			return result, err
+		}
+		n.notifyListingSchedule(repo.NotifierTypeListingPublished, sl.Listing, now)
+		result.Published++
+	}
+
+	index, err := n.getListingIndex()
+	if err != nil {
+		return result, fmt.Errorf("reading listing index: %s", err.Error())
+	}
+	for _, ld := range index {
+		sl, ok := n.scheduledListing(ld.Slug)
+		if !ok || !listingExpired(sl.Listing, now) {
+			continue
+		}
+		schedule, err := n.Datastore.ListingSchedules().Get(ld.Slug)
+		if err == sql.ErrNoRows {
+			schedule = &repo.ListingSchedule{Slug: ld.Slug}
+		} else if err != nil {
+			return result, err
+		}
+		if err := n.expireListing(sl, *schedule, now, &result); err != nil {
+			log.Errorf("expiring listing (%s): %s", ld.Slug, err.Error())
+		}
+	}
+
+	// Listings hidden before republishing was turned on
+	schedules, err := n.Datastore.ListingSchedules().GetAll()
+	if err != nil {
+		return result, err
+	}
+	for _, schedule := range schedules {
+		if schedule.State != repo.ListingScheduleStateExpired || schedule.RepublishHours == 0 {
+			continue
+		}
+		sl, ok := n.scheduledListing(schedule.Slug)
+		if !ok {
+			continue
+		}
+		if err := n.expireListing(sl, schedule, now, &result); err != nil {
+			log.Errorf("republishing listing (%s): %s", schedule.Slug, err.Error())
+		}
+	}
+
+	if result.Published+result.Expired+result.Republished == 0 {
+		return result, nil
+	}
+	if err := n.updateProfileCounts(); err != nil {
+		return result, err
+	}
+	return result, n.SeedNode()
+}
+
+// expireListing extends the expiry of an expired listing when it is set to be
+// republished and otherwise removes it from the index
+func (n *OpenBazaarNode) expireListing(sl *pb.SignedListing, schedule repo.ListingSchedule, now time.Time, result *ListingScheduleResult) error {
+	schedule.UpdatedAt = now
+	if schedule.RepublishHours == 0 {
+		if _, err := n.removeListingFromIndex(schedule.Slug); err != nil {
+			return err
+		}
+		if schedule.State == repo.ListingScheduleStateExpired {
+			return nil
+		}
+		schedule.State = repo.ListingScheduleStateExpired
+		if err := n.Datastore.ListingSchedules().Put(schedule); err != nil {
+			return err
+		}
+		n.notifyListingSchedule(repo.NotifierTypeListingExpired, sl.Listing, now)
+		result.Expired++
+		return nil
+	}
+
+	l, err := repo.NewListingFromProtobuf(sl.Listing)
+	if err != nil {
+		return err
+	}
+	expiry, err := ptypes.TimestampProto(now.Add(time.Duration(schedule.RepublishHours) * time.Hour))
+	if err != nil {
+		return err
+	}
+	l.GetProtobuf().Metadata.Expiry = expiry
+	lb, err := l.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshaling listing (%s): %s", schedule.Slug, err.Error())
+	}
+
+	schedule.State = repo.ListingScheduleStateActive
+	if err := n.Datastore.ListingSchedules().Put(schedule); err != nil {
+		return err
+	}
+	if err := n.UpdateListing(lb, false); err != nil {
+		return err
+	}
+	n.notifyListingSchedule(repo.NotifierTypeListingRepublished, l.GetProtobuf(), now)
+	result.Republished++
+	return nil
+}
+
+// scheduledListing loads a listing for the scheduler, dropping the schedule
+// of a listing which no longer exists
+func (n *OpenBazaarNode) scheduledListing(slug string) (*pb.SignedListing, bool) {
+	sl, err := n.GetListingFromSlug(slug)
+	if os.IsNotExist(err) {
+		if err := n.Datastore.ListingSchedules().Delete(slug); err != nil {
+			log.Errorf("removing schedule for missing listing (%s): %s", slug, err.Error())
+		}
+		return nil, false
+	}
+	if err != nil {
+		log.Errorf("reading scheduled listing (%s): %s", slug, err.Error())
+		return nil, false
+	}
+	return sl, true
+}
+
+// listingHiddenBySchedule returns true if the listing should be kept out of
+// the index when it is saved. A saved listing has a valid expiry so one which
+// had expired becomes active again.
+func (n *OpenBazaarNode) listingHiddenBySchedule(slug string) (bool, error) {
+	schedule, err := n.Datastore.ListingSchedules().Get(slug)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if schedule.IsPending(now) {
+		return true, nil
+	}
+	if schedule.State != repo.ListingScheduleStateActive {
+		schedule.State = repo.ListingScheduleStateActive
+		schedule.UpdatedAt = now
+		if err := n.Datastore.ListingSchedules().Put(*schedule); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// addListingToIndex adds a listing which has already been saved to disk to
+// the listing index
+func (n *OpenBazaarNode) addListingToIndex(slug string) error {
+	sl, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return err
+	}
+	l, err := repo.NewListingFromProtobuf(sl.Listing)
+	if err != nil {
+		return err
+	}
+	ld, err := n.toListingIndexData(l)
+	if err != nil {
+		return err
+	}
+	index, err := n.getListingIndex()
+	if err != nil {
+		return err
+	}
+	return n.updateListingOnDisk(index, ld, false)
+}
+
+func (n *OpenBazaarNode) notifyListingSchedule(notificationType repo.NotificationType, l *pb.Listing, now time.Time) {
+	notif := repo.ListingScheduleNotification{
+		ID:    repo.NewNotificationID(),
+		Type:  notificationType,
+		Slug:  l.Slug,
+		Title: l.Item.Title,
+	}
+	if len(l.Item.Images) > 0 {
+		notif.Thumbnail = repo.Thumbnail{Tiny: l.Item.Images[0].Tiny, Small: l.Item.Images[0].Small}
+	}
+	if l.Metadata.Expiry != nil {
+		notif.Expiry = time.Unix(l.Metadata.Expiry.Seconds, 0)
+	}
+	n.Broadcast <- notif
+	if err := n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, now, false)); err != nil {
+		log.Errorf("persisting listing schedule notification: %s", err.Error())
+	}
+}
+
+func listingExpired(l *pb.Listing, now time.Time) bool {
+	return l.Metadata != nil && l.Metadata.Expiry != nil && time.Unix(l.Metadata.Expiry.Seconds, 0).Before(now)
+}
@@ -0,0 +1,207 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func newBroadcastingNode(t *testing.T) *core.OpenBazaarNode {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.Broadcast = make(chan repo.Notifier)
+	go func() {
+		for range node.Broadcast {
+		}
+	}()
+	return node
+}
+
+func indexedListingSlugs(t *testing.T, node *core.OpenBazaarNode) map[string]bool {
+	b, err := node.GetListings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var index []repo.ListingIndexData
+	if err := json.Unmarshal(b, &index); err != nil {
+		t.Fatal(err)
+	}
+	slugs := make(map[string]bool)
+	for _, l := range index {
+		slugs[l.Slug] = true
+	}
+	return slugs
+}
+
+func listingScheduleNotificationTypes(t *testing.T, node *core.OpenBazaarNode) map[repo.NotificationType]int {
+	notifs, _, err := node.Datastore.Notifications().GetAll("", -1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := make(map[repo.NotificationType]int)
+	for _, n := range notifs {
+		types[n.GetType()]++
+	}
+	return types
+}
+
+func TestOpenBazaarNode_ScheduledListingPublication(t *testing.T) {
+	node := newBroadcastingNode(t)
+
+	listing, err := repo.NewListingFromProtobuf(factory.NewListing("spring-drop"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := listing.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publishAt := time.Now().Add(time.Hour)
+	slug, err := node.CreateScheduledListing(lb, publishAt, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if indexedListingSlugs(t, node)[slug] {
+		t.Fatal("expected scheduled listing to be kept out of the index")
+	}
+
+	// Updating a pending listing does not publish it
+	if err := node.UpdateListing(lb, false); err != nil {
+		t.Fatal(err)
+	}
+	if indexedListingSlugs(t, node)[slug] {
+		t.Fatal("expected updated scheduled listing to be kept out of the index")
+	}
+
+	result, err := node.ProcessListingSchedules(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Published != 0 {
+		t.Errorf("expected nothing to be published before publishAt, got %+v", result)
+	}
+
+	result, err = node.ProcessListingSchedules(publishAt.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Published != 1 {
+		t.Errorf("expected the listing to be published, got %+v", result)
+	}
+	if !indexedListingSlugs(t, node)[slug] {
+		t.Error("expected published listing to be in the index")
+	}
+	schedule, err := node.Datastore.ListingSchedules().Get(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schedule.State != repo.ListingScheduleStateActive {
+		t.Errorf("expected schedule to be active, got %s", schedule.State)
+	}
+	if n := listingScheduleNotificationTypes(t, node)[repo.NotifierTypeListingPublished]; n != 1 {
+		t.Errorf("expected one published notification, got %d", n)
+	}
+
+	// Rescheduling hides the listing again and removing the schedule
+	// publishes it immediately
+	if _, err := node.ScheduleListing(slug, &publishAt, 0); err != nil {
+		t.Fatal(err)
+	}
+	if indexedListingSlugs(t, node)[slug] {
+		t.Error("expected rescheduled listing to be removed from the index")
+	}
+	if err := node.DeleteListingSchedule(slug); err != nil {
+		t.Fatal(err)
+	}
+	if !indexedListingSlugs(t, node)[slug] {
+		t.Error("expected unscheduled listing to be in the index")
+	}
+}
+
+func TestOpenBazaarNode_ExpiredListings(t *testing.T) {
+	node := newBroadcastingNode(t)
+
+	var (
+		expiry = time.Now().Add(24 * time.Hour)
+		after  = expiry.Add(time.Hour)
+	)
+	for _, slug := range []string{"winter-coat", "weekly-special"} {
+		l := factory.NewListing(slug)
+		l.Metadata.Expiry, _ = ptypes.TimestampProto(expiry)
+		listing, err := repo.NewListingFromProtobuf(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lb, err := listing.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := node.CreateListing(lb); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := node.ScheduleListing("weekly-special", nil, 168); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := node.ProcessListingSchedules(after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Expired != 1 || result.Republished != 1 {
+		t.Errorf("expected one expired and one republished listing, got %+v", result)
+	}
+
+	indexed := indexedListingSlugs(t, node)
+	if indexed["winter-coat"] {
+		t.Error("expected expired listing to be removed from the index")
+	}
+	if !indexed["weekly-special"] {
+		t.Error("expected republished listing to remain in the index")
+	}
+	sl, err := node.GetListingFromSlug("weekly-special")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sl.Listing.Metadata.Expiry.Seconds, after.Add(168*time.Hour).Unix(); got != want {
+		t.Errorf("expected republished expiry %d, got %d", want, got)
+	}
+
+	// Expired listings are only reported once
+	result, err = node.ProcessListingSchedules(after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Expired != 0 || result.Republished != 0 {
+		t.Errorf("expected no further changes, got %+v", result)
+	}
+
+	// Turning on republishing brings back a hidden listing
+	if _, err := node.ScheduleListing("winter-coat", nil, 24); err != nil {
+		t.Fatal(err)
+	}
+	result, err = node.ProcessListingSchedules(after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Republished != 1 {
+		t.Errorf("expected the hidden listing to be republished, got %+v", result)
+	}
+	if !indexedListingSlugs(t, node)["winter-coat"] {
+		t.Error("expected republished listing to be back in the index")
+	}
+
+	types := listingScheduleNotificationTypes(t, node)
+	if types[repo.NotifierTypeListingExpired] != 1 || types[repo.NotifierTypeListingRepublished] != 2 {
+		t.Errorf("unexpected notifications: %v", types)
+	}
+}
@@ -195,11 +195,23 @@ func (n *OpenBazaarNode) saveListing(l repo.Listing, publish bool) error {
 	if err != nil {
 		return err
 	}
-	err = n.updateListingOnDisk(index, ld, false)
+	hidden, err := n.listingHiddenBySchedule(l.GetSlug())
+	if err != nil {
+		return err
+	}
+	if hidden {
+		_, err = n.removeListingFromIndex(l.GetSlug())
+	} else {
+		err = n.updateListingOnDisk(index, ld, false)
+	}
 	if err != nil {
 		return err
 	}
 
+	if err := n.Datastore.Search().Index(repo.NewListingSearchDocument(&l)); err != nil {
+		log.Errorf("indexing listing (%s) for search: %s", l.GetSlug(), err.Error())
+	}
+
 	// Update followers/following
 	err = n.UpdateFollow()
 	if err != nil {
@@ -210,6 +222,11 @@ func (n *OpenBazaarNode) saveListing(l repo.Listing, publish bool) error {
 		if err = n.SeedNode(); err != nil {
 			return err
 		}
+		if !hidden {
+			if err := n.AnnounceListing(&l, ld.Hash); err != nil {
+				log.Errorf("announcing listing (%s): %s", l.GetSlug(), err.Error())
+			}
+		}
 	}
 
 	return nil
@@ -270,7 +287,7 @@ func (n *OpenBazaarNode) toListingIndexData(l *repo.Listing) (repo.ListingIndexD
 		return repo.ListingIndexData{}, fmt.Errorf("get price: %s", err.Error())
 	}
 
-	return repo.ListingIndexData{
+	ld := repo.ListingIndexData{
 		Hash:         listingHash,
 		Slug:         l.GetSlug(),
 		Title:        l.GetTitle(),
@@ -291,7 +308,15 @@ func (n *OpenBazaarNode) toListingIndexData(l *repo.Listing) (repo.ListingIndexD
 		ModeratorIDs:       l.GetModerators(),
 		AcceptedCurrencies: l.GetAcceptedCurrencies(),
 		CryptoCurrencyCode: l.GetCryptoCurrencyCode(),
-	}, nil
+	}
+	if l.IsCrowdFund() {
+		progress, err := n.crowdFundProgress(l, time.Now())
+		if err != nil {
+			return repo.ListingIndexData{}, fmt.Errorf("get crowdfunding progress: %s", err.Error())
+		}
+		ld.CrowdFund = progress
+	}
+	return ld, nil
 }
 
 func (n *OpenBazaarNode) getListingIndex() ([]repo.ListingIndexData, error) {
@@ -501,22 +526,44 @@ func (n *OpenBazaarNode) DeleteListing(slug string) error {
 	if err != nil {
 		return err
 	}
-	var index []repo.ListingIndexData
-	indexPath := path.Join(n.RepoPath, "root", "listings.json")
-	_, ferr := os.Stat(indexPath)
-	if !os.IsNotExist(ferr) {
-		// Read existing file
-		file, err := ioutil.ReadFile(indexPath)
-		if err != nil {
-			return err
-		}
-		err = json.Unmarshal(file, &index)
-		if err != nil {
-			return err
-		}
+	if _, err := n.removeListingFromIndex(slug); err != nil {
+		return err
+	}
+
+	if err := n.Datastore.Search().Delete(repo.SearchScopeListings, slug); err != nil {
+		log.Errorf("removing listing (%s) from search index: %s", slug, err.Error())
+	}
+	if err := n.Datastore.ListingSchedules().Delete(slug); err != nil {
+		log.Errorf("removing listing (%s) schedule: %s", slug, err.Error())
+	}
+
+	// Delete inventory for listing
+	err = n.Datastore.Inventory().DeleteAll(slug)
+	if err != nil {
+		return err
+	}
+	err = n.Datastore.Inventory().DeleteLowStockThreshold(slug)
+	if err != nil {
+		return err
+	}
+	err = n.PublishInventory()
+	if err != nil {
+		return err
+	}
+
+	return n.updateProfileCounts()
+}
+
+// removeListingFromIndex removes the listing from listings.json, leaving the
+// listing itself on disk. It returns false if the listing was not indexed.
+func (n *OpenBazaarNode) removeListingFromIndex(slug string) (bool, error) {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return false, err
 	}
 
 	// Check to see if the slug exists in the list. If so delete it.
+	removed := false
 	for i, d := range index {
 		if d.Slug != slug {
 			continue
@@ -524,38 +571,33 @@ func (n *OpenBazaarNode) DeleteListing(slug string) error {
 
 		if len(index) == 1 {
 			index = []repo.ListingIndexData{}
+			removed = true
 			break
 		}
 		index = append(index[:i], index[i+1:]...)
+		removed = true
+		break
+	}
+	if index == nil {
+		index = []repo.ListingIndexData{}
 	}
 
 	// Write the index back to file
+	indexPath := path.Join(n.RepoPath, "root", "listings.json")
 	f, err := os.Create(indexPath)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer f.Close()
 
-	j, jerr := json.MarshalIndent(index, "", "    ")
-	if jerr != nil {
-		return jerr
-	}
-	_, werr := f.Write(j)
-	if werr != nil {
-		return werr
-	}
-
-	// Delete inventory for listing
-	err = n.Datastore.Inventory().DeleteAll(slug)
+	j, err := json.MarshalIndent(index, "", "    ")
 	if err != nil {
-		return err
+		return false, err
 	}
-	err = n.PublishInventory()
-	if err != nil {
-		return err
+	if _, err := f.Write(j); err != nil {
+		return false, err
 	}
-
-	return n.updateProfileCounts()
+	return removed, nil
 }
 
 // GetListings - fetch all listings
@@ -1,8 +1,12 @@
 package core_test
 
 import (
+	"bytes"
+	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/test"
 	"github.com/OpenBazaar/openbazaar-go/test/factory"
@@ -77,3 +81,87 @@ func TestOpenBazaarNode_SetCurrencyOnListings(t *testing.T) {
 	}
 
 }
+
+func TestOpenBazaarNode_ImportListings(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listing, err := repo.NewListingFromProtobuf(factory.NewListing("test_imported_listing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := listing.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	importData := "[" + string(lb) + `, {"slug": "missing_item"}]`
+
+	report, err := node.ImportListings(strings.NewReader(importData), core.ListingImportFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Created) != 1 || report.Created[0] != "test_imported_listing" {
+		t.Errorf("expected listing to be created, got %v", report.Created)
+	}
+	if len(report.Failed) != 1 || report.Failed[0] != "missing_item" {
+		t.Errorf("expected invalid listing to fail, got %v", report.Failed)
+	}
+	if len(report.Results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(report.Results))
+	}
+
+	report, err = node.ImportListings(strings.NewReader(importData), core.ListingImportFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Updated) != 1 || len(report.Created) != 0 {
+		t.Errorf("expected listing to be updated on second import, got %+v", report)
+	}
+
+	if _, err := node.GetListingFromSlug("test_imported_listing"); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := node.ImportListings(strings.NewReader(importData), "xml"); err == nil {
+		t.Error("expected error for unknown import format")
+	}
+}
+
+func TestOpenBazaarNode_ExportListings(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listing, err := repo.NewListingFromProtobuf(factory.NewListing("test_exported_listing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := listing.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.ImportListings(strings.NewReader("["+string(lb)+"]"), core.ListingImportFormatJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{core.ListingExportFormatCSV, core.ListingExportFormatZip} {
+		var buf bytes.Buffer
+		if err := node.ExportListings(&buf, format); err != nil {
+			t.Fatalf("exporting %s: %s", format, err)
+		}
+		report, err := node.ImportListings(&buf, format)
+		if err != nil {
+			t.Fatalf("importing %s: %s", format, err)
+		}
+		if len(report.Updated) != 1 || report.Updated[0] != "test_exported_listing" {
+			t.Errorf("expected %s export to round trip, got %+v", format, report)
+		}
+	}
+
+	if err := node.ExportListings(ioutil.Discard, "xml"); err == nil {
+		t.Error("expected error for unknown export format")
+	}
+}
@@ -8,16 +8,17 @@ import (
 // OpenBazaarNode and begin the MessageRetriever in the background
 func (n *OpenBazaarNode) StartMessageRetriever() {
 	config := net.MRConfig{
-		Db:        n.Datastore,
-		IPFSNode:  n.IpfsNode,
-		DHT:       n.DHT,
-		BanManger: n.BanManager,
-		Service:   n.Service,
-		PrefixLen: 14,
-		PushNodes: n.PushNodes,
-		Dialer:    n.TorDialer,
-		SendAck:   n.SendOfflineAck,
-		SendError: n.SendError,
+		Db:          n.Datastore,
+		IPFSNode:    n.IpfsNode,
+		DHT:         n.DHT,
+		BanManger:   n.BanManager,
+		Service:     n.Service,
+		PrefixLen:   14,
+		PushNodes:   n.PushNodes,
+		Dialer:      n.TorDialer,
+		SendAck:     n.SendOfflineAck,
+		SendError:   n.SendError,
+		RateLimiter: n.RateLimiter,
 	}
 	n.MessageRetriever = net.NewMessageRetriever(config)
 	go n.MessageRetriever.Run()
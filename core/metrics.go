@@ -0,0 +1,76 @@
+package core
+
+import (
+	"math/big"
+
+	"gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+)
+
+// nodeCollector reports the metrics which are read from the node when they
+// are scraped rather than counted as they happen
+type nodeCollector struct {
+	node *OpenBazaarNode
+
+	peers   *prometheus.Desc
+	balance *prometheus.Desc
+	orders  *prometheus.Desc
+}
+
+// MetricsCollector returns a prometheus.Collector reporting the node's
+// connected peers, wallet balances and number of orders in each state
+func (n *OpenBazaarNode) MetricsCollector() prometheus.Collector {
+	return &nodeCollector{
+		node: n,
+		peers: prometheus.NewDesc(
+			"openbazaar_net_connected_peers",
+			"Number of peers the node is connected to.",
+			nil, nil,
+		),
+		balance: prometheus.NewDesc(
+			"openbazaar_wallet_balance",
+			"Wallet balance in the coin's base units by coin and confirmation status.",
+			[]string{"coin", "status"}, nil,
+		),
+		orders: prometheus.NewDesc(
+			"openbazaar_orders",
+			"Number of orders by role and state.",
+			[]string{"role", "state"}, nil,
+		),
+	}
+}
+
+func (c *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.peers
+	ch <- c.balance
+	ch <- c.orders
+}
+
+func (c *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	if c.node.IpfsNode != nil && c.node.IpfsNode.PeerHost != nil {
+		peers := len(c.node.IpfsNode.PeerHost.Network().Peers())
+		ch <- prometheus.MustNewConstMetric(c.peers, prometheus.GaugeValue, float64(peers))
+	}
+
+	for ct, wal := range c.node.Multiwallet {
+		confirmed, unconfirmed := wal.Balance()
+		confirmedValue, _ := new(big.Float).SetInt(&confirmed.Value).Float64()
+		unconfirmedValue, _ := new(big.Float).SetInt(&unconfirmed.Value).Float64()
+		ch <- prometheus.MustNewConstMetric(c.balance, prometheus.GaugeValue, confirmedValue, ct.CurrencyCode(), "confirmed")
+		ch <- prometheus.MustNewConstMetric(c.balance, prometheus.GaugeValue, unconfirmedValue, ct.CurrencyCode(), "unconfirmed")
+	}
+
+	sales, err := c.node.Datastore.Sales().CountByState()
+	if err != nil {
+		log.Errorf("counting sales for metrics: %s", err.Error())
+	}
+	for state, count := range sales {
+		ch <- prometheus.MustNewConstMetric(c.orders, prometheus.GaugeValue, float64(count), "sale", state.String())
+	}
+	purchases, err := c.node.Datastore.Purchases().CountByState()
+	if err != nil {
+		log.Errorf("counting purchases for metrics: %s", err.Error())
+	}
+	for state, count := range purchases {
+		ch <- prometheus.MustNewConstMetric(c.orders, prometheus.GaugeValue, float64(count), "purchase", state.String())
+	}
+}
@@ -0,0 +1,68 @@
+package core_test
+
+import (
+	"testing"
+
+	"gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+// gatherNodeMetrics returns the order gauges keyed by role/state and the
+// number of wallet balance gauges reported by the collector
+func gatherNodeMetrics(t *testing.T, collector prometheus.Collector) (map[string]float64, int) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatal(err)
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		orders   = make(map[string]float64)
+		balances int
+	)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			switch f.GetName() {
+			case "openbazaar_orders":
+				orders[labels["role"]+"/"+labels["state"]] = m.GetGauge().GetValue()
+			case "openbazaar_wallet_balance":
+				balances++
+			}
+		}
+	}
+	return orders, balances
+}
+
+func TestOpenBazaarNode_MetricsCollector(t *testing.T) {
+	node := newBroadcastingNode(t)
+	before, _ := gatherNodeMetrics(t, node.MetricsCollector())
+
+	contract := factory.NewContract()
+	if err := node.Datastore.Sales().Put("metrics-order1", *contract, pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete("metrics-order1")
+	if err := node.Datastore.Purchases().Put("metrics-order2", *contract, pb.OrderState_COMPLETED, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Purchases().Delete("metrics-order2")
+
+	after, balances := gatherNodeMetrics(t, node.MetricsCollector())
+	if after["sale/AWAITING_PAYMENT"]-before["sale/AWAITING_PAYMENT"] != 1 {
+		t.Errorf("expected one more awaiting payment sale, got %v", after)
+	}
+	if after["purchase/COMPLETED"]-before["purchase/COMPLETED"] != 1 {
+		t.Errorf("expected one more completed purchase, got %v", after)
+	}
+	if expected := 2 * len(node.Multiwallet); balances != expected {
+		t.Errorf("expected %d wallet balance metrics, got %d", expected, balances)
+	}
+}
@@ -139,6 +139,11 @@ func (n *OpenBazaarNode) RemoveSelfAsModerator() error {
 		return err
 	}
 
+	// Remove the published statistics
+	if _, err := n.UpdateModeratorStats(); err != nil {
+		return err
+	}
+
 	// Delete pointer from database
 	err = n.Datastore.Pointers().DeleteAll(ipfs.MODERATOR)
 	if err != nil {
@@ -0,0 +1,523 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+const (
+	// ModeratorStatsMaxResolutions is the number of the newest resolved cases
+	// listed in the published statistics
+	ModeratorStatsMaxResolutions = 100
+
+	// ModeratorStatsFile is the name of the statistics file in the
+	// moderator's published root directory
+	ModeratorStatsFile = "moderatorstats.json"
+
+	moderatorStatsPublisherInterval = time.Duration(1) * time.Hour
+)
+
+// Keys GETModerators sorts moderators by
+const (
+	ModeratorSortCasesHandled     = "casesHandled"
+	ModeratorSortMedianResolution = "medianResolutionTime"
+	ModeratorSortOpenCases        = "openCases"
+)
+
+var (
+	// ErrModeratorSortKey - tried sorting moderators by an unknown key
+	ErrModeratorSortKey = errors.New("moderators can be sorted by casesHandled, medianResolutionTime or openCases")
+
+	// ErrModeratorStatsMismatch - the moderator's published statistics
+	// disagree with a dispute resolution the node received from it
+	ErrModeratorStatsMismatch = errors.New("moderator statistics do not match a dispute resolution signed by the moderator")
+)
+
+// ComputeModeratorStats computes the node's statistics as a moderator from the
+// cases it resolved and the ones still open
+func (n *OpenBazaarNode) ComputeModeratorStats() (*pb.ModeratorStats, error) {
+	resolved, err := n.Datastore.Cases().GetResolved()
+	if err != nil {
+		return nil, err
+	}
+	open, err := n.Datastore.Cases().CountByState(pb.OrderState_DISPUTED)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolutions []*pb.ModeratorStats_Resolution
+	for _, c := range resolved {
+		if c.Resolution.Timestamp == nil {
+			continue
+		}
+		opened, err := ptypes.TimestampProto(c.Opened)
+		if err != nil {
+			return nil, err
+		}
+		ser, err := proto.Marshal(c.Resolution)
+		if err != nil {
+			return nil, err
+		}
+		// The signature is deterministic so it matches the one sent to the
+		// buyer and vendor with the resolution
+		sig, err := n.Sign(ser)
+		if err != nil {
+			return nil, err
+		}
+		resolutions = append(resolutions, &pb.ModeratorStats_Resolution{
+			OrderId:             c.CaseID,
+			Opened:              opened,
+			Resolved:            c.Resolution.Timestamp,
+			BuyerPercentage:     buyerPayoutPercentage(c.Resolution.Payout),
+			ResolutionSignature: sig,
+		})
+	}
+	sort.Slice(resolutions, func(i, j int) bool {
+		return resolutions[i].Resolved.Seconds > resolutions[j].Resolved.Seconds
+	})
+
+	stats := summarizeResolutions(resolutions)
+	stats.OpenCases = uint32(open)
+	if len(resolutions) > ModeratorStatsMaxResolutions {
+		resolutions = resolutions[:ModeratorStatsMaxResolutions]
+	}
+	stats.Resolutions = resolutions
+	return stats, nil
+}
+
+// summarizeResolutions returns the statistics counting, and splitting by
+// payout, the resolutions and the median time they took
+func summarizeResolutions(resolutions []*pb.ModeratorStats_Resolution) *pb.ModeratorStats {
+	var (
+		split     = new(pb.ModeratorStats_SplitDistribution)
+		durations []int64
+	)
+	for _, r := range resolutions {
+		switch p := r.BuyerPercentage; {
+		case p >= 100:
+			split.BuyerFull++
+		case p > 50:
+			split.BuyerMajority++
+		case p == 50:
+			split.Even++
+		case p > 0:
+			split.VendorMajority++
+		default:
+			split.VendorFull++
+		}
+		if r.Opened != nil && r.Resolved != nil {
+			d := r.Resolved.Seconds - r.Opened.Seconds
+			if d < 0 {
+				d = 0
+			}
+			durations = append(durations, d)
+		}
+	}
+	stats := &pb.ModeratorStats{
+		CasesHandled:      uint32(len(resolutions)),
+		SplitDistribution: split,
+	}
+	if len(durations) > 0 {
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		mid := len(durations) / 2
+		median := durations[mid]
+		if len(durations)%2 == 0 {
+			median = (durations[mid-1] + durations[mid]) / 2
+		}
+		stats.MedianResolutionSeconds = uint64(median)
+	}
+	return stats
+}
+
+// buyerPayoutPercentage returns the buyer's share of the payout to the buyer
+// and vendor, leaving out the moderator's fee
+func buyerPayoutPercentage(payout *pb.DisputeResolution_Payout) uint32 {
+	if payout == nil {
+		return 0
+	}
+	buyer := payoutOutputAmount(payout.BuyerOutput)
+	total := new(big.Int).Add(buyer, payoutOutputAmount(payout.VendorOutput))
+	if total.Sign() <= 0 {
+		return 0
+	}
+	percentage := new(big.Int).Div(new(big.Int).Mul(buyer, big.NewInt(100)), total)
+	return uint32(percentage.Uint64())
+}
+
+func payoutOutputAmount(output *pb.DisputeResolution_Payout_Output) *big.Int {
+	if output == nil {
+		return big.NewInt(0)
+	}
+	if output.BigAmount != "" {
+		if amount, ok := new(big.Int).SetString(output.BigAmount, 10); ok {
+			return amount
+		}
+		return big.NewInt(0)
+	}
+	return new(big.Int).SetUint64(output.Amount)
+}
+
+// SignModeratorStats signs the statistics as the node
+func (n *OpenBazaarNode) SignModeratorStats(stats *pb.ModeratorStats) (*pb.SignedModeratorStats, error) {
+	id, err := n.GetNodeID()
+	if err != nil {
+		return nil, err
+	}
+	stats.ModeratorID = id
+	if stats.Timestamp == nil {
+		stats.Timestamp = ptypes.TimestampNow()
+	}
+	ser, err := proto.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := n.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignedModeratorStats{Stats: stats, Signature: sig}, nil
+}
+
+// UpdateModeratorStats writes the node's signed statistics to its root
+// directory, or removes them if it isn't a moderator. It returns whether
+// the published statistics changed and the node should be reseeded.
+func (n *OpenBazaarNode) UpdateModeratorStats() (bool, error) {
+	statsPath := path.Join(n.RepoPath, "root", ModeratorStatsFile)
+	if !n.IsModerator() {
+		err := os.Remove(statsPath)
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	stats, err := n.ComputeModeratorStats()
+	if err != nil {
+		return false, err
+	}
+	if existing, err := ioutil.ReadFile(statsPath); err == nil {
+		published := new(pb.SignedModeratorStats)
+		if err := jsonpb.UnmarshalString(string(existing), published); err == nil && published.Stats != nil {
+			published.Stats.ModeratorID = nil
+			published.Stats.Timestamp = nil
+			if proto.Equal(published.Stats, stats) {
+				return false, nil
+			}
+		}
+	}
+
+	signed, err := n.SignModeratorStats(stats)
+	if err != nil {
+		return false, err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(signed)
+	if err != nil {
+		return false, err
+	}
+	if err := ioutil.WriteFile(statsPath, []byte(out), os.ModePerm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PublishModeratorStats updates the node's statistics and reseeds the node if
+// they changed
+func (n *OpenBazaarNode) PublishModeratorStats() error {
+	changed, err := n.UpdateModeratorStats()
+	if err != nil || !changed {
+		return err
+	}
+	return n.SeedNode()
+}
+
+// FetchModeratorStats fetches the peer's published statistics and validates
+// them. Resolutions of the node's own disputed orders are checked against the
+// dispute resolution the moderator signed and the number checked is returned.
+func (n *OpenBazaarNode) FetchModeratorStats(peerID string, useCache bool) (*pb.SignedModeratorStats, uint32, error) {
+	statsBytes, err := ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(peerID, ModeratorStatsFile)), time.Minute, n.IPNSQuorumSize, useCache)
+	if err != nil {
+		return nil, 0, err
+	}
+	signed := new(pb.SignedModeratorStats)
+	if err := jsonpb.UnmarshalString(string(statsBytes), signed); err != nil {
+		return nil, 0, err
+	}
+	if err := ValidateModeratorStats(peerID, signed); err != nil {
+		return nil, 0, err
+	}
+	verified, err := n.VerifyModeratorResolutions(signed.Stats)
+	if err != nil {
+		return nil, 0, err
+	}
+	return signed, verified, nil
+}
+
+// ValidateModeratorStats checks the statistics were signed by the peer and
+// agree with the resolutions they list
+func ValidateModeratorStats(peerID string, signed *pb.SignedModeratorStats) error {
+	stats := signed.Stats
+	if stats == nil || stats.ModeratorID == nil || stats.ModeratorID.Pubkeys == nil {
+		return errors.New("moderator statistics are missing the moderator")
+	}
+	if stats.ModeratorID.PeerID != peerID {
+		return errors.New("moderator statistics were not published by the peer")
+	}
+	if err := verifySignature(stats, stats.ModeratorID.Pubkeys.Identity, signed.Signature, peerID); err != nil {
+		return err
+	}
+	if err := verifyBitcoinSignature(stats.ModeratorID.Pubkeys.Bitcoin, stats.ModeratorID.BitcoinSig, peerID); err != nil {
+		return err
+	}
+
+	if uint32(len(stats.Resolutions)) > stats.CasesHandled {
+		return errors.New("moderator statistics list more resolutions than cases handled")
+	}
+	for i, r := range stats.Resolutions {
+		if r.BuyerPercentage > 100 {
+			return fmt.Errorf("resolution %d: buyer percentage over 100", i)
+		}
+	}
+	// With every resolution listed the summary can be recomputed
+	if uint32(len(stats.Resolutions)) == stats.CasesHandled {
+		summary := summarizeResolutions(stats.Resolutions)
+		if summary.MedianResolutionSeconds != stats.MedianResolutionSeconds ||
+			!proto.Equal(summary.SplitDistribution, stats.SplitDistribution) {
+			return errors.New("moderator statistics do not match the resolutions they list")
+		}
+	}
+	return nil
+}
+
+// VerifyModeratorResolutions checks the listed resolutions of orders the node
+// bought or sold against the dispute resolutions it received, returning how
+// many were checked
+func (n *OpenBazaarNode) VerifyModeratorResolutions(stats *pb.ModeratorStats) (uint32, error) {
+	var verified uint32
+	for _, r := range stats.Resolutions {
+		contract, _, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(r.OrderId)
+		if err != nil {
+			contract, _, _, _, _, _, err = n.Datastore.Sales().GetByOrderId(r.OrderId)
+			if err != nil {
+				continue
+			}
+		}
+		if contract.DisputeResolution == nil || contract.BuyerOrder == nil || contract.BuyerOrder.Payment == nil ||
+			contract.BuyerOrder.Payment.Moderator != stats.ModeratorID.PeerID {
+			continue
+		}
+		if err := verifySignature(contract.DisputeResolution, stats.ModeratorID.Pubkeys.Identity, r.ResolutionSignature, stats.ModeratorID.PeerID); err != nil {
+			return verified, ErrModeratorStatsMismatch
+		}
+		if r.BuyerPercentage != buyerPayoutPercentage(contract.DisputeResolution.Payout) ||
+			!proto.Equal(r.Resolved, contract.DisputeResolution.Timestamp) {
+			return verified, ErrModeratorStatsMismatch
+		}
+		verified++
+	}
+	return verified, nil
+}
+
+// ModeratorFilter selects moderators by their profile and statistics. Zero
+// fields match every moderator.
+type ModeratorFilter struct {
+	Language                   string
+	Currency                   string
+	FeeType                    string
+	MinCasesHandled            uint32
+	MaxOpenCases               *uint32
+	MaxMedianResolutionSeconds uint64
+}
+
+// NeedsStats returns whether the filter selects on the moderators' statistics
+func (f ModeratorFilter) NeedsStats() bool {
+	return f.MinCasesHandled > 0 || f.MaxOpenCases != nil || f.MaxMedianResolutionSeconds > 0
+}
+
+// IsEmpty returns whether the filter matches every moderator
+func (f ModeratorFilter) IsEmpty() bool {
+	return f.Language == "" && f.Currency == "" && f.FeeType == "" && !f.NeedsStats()
+}
+
+// Matches returns whether the moderator passes the filter. Moderators without
+// statistics fail filters on statistics.
+func (f ModeratorFilter) Matches(mod *pb.ModeratorWithStats) bool {
+	if f.Language != "" || f.Currency != "" || f.FeeType != "" {
+		if mod.Profile == nil || mod.Profile.ModeratorInfo == nil {
+			return false
+		}
+		info := mod.Profile.ModeratorInfo
+		if f.Language != "" && !containsFold(info.Languages, f.Language) {
+			return false
+		}
+		if f.Currency != "" && !containsFold(info.AcceptedCurrencies, f.Currency) {
+			return false
+		}
+		if f.FeeType != "" && (info.Fee == nil || !strings.EqualFold(info.Fee.FeeType.String(), f.FeeType)) {
+			return false
+		}
+	}
+	if f.NeedsStats() {
+		if mod.Stats == nil || mod.Stats.Stats == nil {
+			return false
+		}
+		stats := mod.Stats.Stats
+		if stats.CasesHandled < f.MinCasesHandled {
+			return false
+		}
+		if f.MaxOpenCases != nil && stats.OpenCases > *f.MaxOpenCases {
+			return false
+		}
+		if f.MaxMedianResolutionSeconds > 0 && (stats.CasesHandled == 0 || stats.MedianResolutionSeconds > f.MaxMedianResolutionSeconds) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateModeratorSortKey returns ErrModeratorSortKey if moderators can't be
+// sorted by the key
+func ValidateModeratorSortKey(sortBy string) error {
+	_, err := moderatorStatsLess(sortBy)
+	return err
+}
+
+// SortModerators sorts the moderators by the statistic, best first.
+// Moderators without statistics are sorted last.
+func SortModerators(mods []*pb.ModeratorWithStats, sortBy string) error {
+	less, err := moderatorStatsLess(sortBy)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(mods, func(i, j int) bool {
+		a, b := mods[i].Stats, mods[j].Stats
+		if a == nil || a.Stats == nil {
+			return false
+		}
+		if b == nil || b.Stats == nil {
+			return true
+		}
+		return less(a.Stats, b.Stats)
+	})
+	return nil
+}
+
+func moderatorStatsLess(sortBy string) (func(a, b *pb.ModeratorStats) bool, error) {
+	switch sortBy {
+	case ModeratorSortCasesHandled:
+		return func(a, b *pb.ModeratorStats) bool { return a.CasesHandled > b.CasesHandled }, nil
+	case ModeratorSortMedianResolution:
+		return func(a, b *pb.ModeratorStats) bool {
+			// Moderators who haven't resolved a case have no median
+			if (a.CasesHandled == 0) != (b.CasesHandled == 0) {
+				return b.CasesHandled == 0
+			}
+			return a.MedianResolutionSeconds < b.MedianResolutionSeconds
+		}, nil
+	case ModeratorSortOpenCases:
+		return func(a, b *pb.ModeratorStats) bool { return a.OpenCases < b.OpenCases }, nil
+	}
+	return nil, ErrModeratorSortKey
+}
+
+// FetchModerator fetches the moderator's profile and, if withStats is set, its
+// statistics. Moderators whose statistics can't be fetched or don't validate
+// are returned without them.
+func (n *OpenBazaarNode) FetchModerator(peerID string, withStats bool, useCache bool) (*pb.ModeratorWithStats, error) {
+	profile, err := n.FetchProfile(peerID, useCache)
+	if err != nil {
+		return nil, err
+	}
+	mod := &pb.ModeratorWithStats{PeerId: peerID, Profile: &profile}
+	if withStats {
+		stats, verified, err := n.FetchModeratorStats(peerID, useCache)
+		if err != nil {
+			log.Debugf("fetching moderator statistics of %s: %s", peerID, err.Error())
+		} else {
+			mod.Stats = stats
+			mod.VerifiedResolutions = verified
+		}
+	}
+	return mod, nil
+}
+
+type moderatorStatsPublisher struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartModeratorStatsPublisher - start the worker which keeps the node's
+// published moderator statistics up to date
+func (n *OpenBazaarNode) StartModeratorStatsPublisher() {
+	n.ModeratorStatsPublisher = &moderatorStatsPublisher{
+		node:          n,
+		intervalDelay: moderatorStatsPublisherInterval,
+		logger:        logging.MustGetLogger("moderatorStatsPublisher"),
+	}
+	go n.ModeratorStatsPublisher.Run()
+}
+
+func (publisher *moderatorStatsPublisher) Run() {
+	publisher.watchdogTimer = time.NewTicker(publisher.intervalDelay)
+	publisher.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	publisher.PerformTask()
+	for {
+		select {
+		case <-publisher.watchdogTimer.C:
+			publisher.PerformTask()
+		case <-publisher.stopWorker:
+			publisher.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (publisher *moderatorStatsPublisher) Stop() {
+	publisher.stopWorker <- true
+	close(publisher.stopWorker)
+}
+
+func (publisher *moderatorStatsPublisher) PerformTask() {
+	if err := publisher.node.PublishModeratorStats(); err != nil {
+		publisher.logger.Errorf("publishing moderator statistics: %s", err)
+	}
+}
@@ -0,0 +1,208 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestOpenBazaarNode_ModeratorStats(t *testing.T) {
+	node := newBroadcastingNode(t)
+	// The test node's identity isn't derived from its private key so it's
+	// replaced with one which verifies against the signed statistics
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeID := node.IpfsNode.Identity
+	node.IpfsNode.Identity = pid
+	defer func() { node.IpfsNode.Identity = nodeID }()
+	peerID := pid.Pretty()
+
+	// Other tests share the datastore so the statistics are compared with
+	// the ones before the cases are added
+	baseline, err := node.ComputeModeratorStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baseline.CasesHandled != 0 {
+		t.Fatalf("expected no resolved cases, got %d", baseline.CasesHandled)
+	}
+
+	now := time.Now()
+	resolutions := map[string]struct {
+		after         time.Duration
+		buyer, vendor string
+	}{
+		"statsCaseBuyer":  {time.Hour, "90", ""},
+		"statsCaseEven":   {3 * time.Hour, "45", "45"},
+		"statsCaseVendor": {5 * time.Hour, "10", "80"},
+	}
+	for caseID, r := range resolutions {
+		if err := node.Datastore.Cases().Put(caseID, pb.OrderState_DISPUTED, true, "claim", "BTC", "BTC"); err != nil {
+			t.Fatal(err)
+		}
+		defer node.Datastore.Cases().Delete(caseID)
+		ts, _ := ptypes.TimestampProto(now.Add(r.after))
+		d := &pb.DisputeResolution{
+			Timestamp:  ts,
+			OrderId:    caseID,
+			ProposedBy: peerID,
+			Payout:     &pb.DisputeResolution_Payout{BuyerOutput: &pb.DisputeResolution_Payout_Output{BigAmount: r.buyer}},
+		}
+		if r.vendor != "" {
+			d.Payout.VendorOutput = &pb.DisputeResolution_Payout_Output{BigAmount: r.vendor}
+		}
+		if err := node.Datastore.Cases().MarkAsClosed(caseID, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := node.Datastore.Cases().Put("statsCaseOpen", pb.OrderState_DISPUTED, true, "claim", "BTC", "BTC"); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Cases().Delete("statsCaseOpen")
+
+	stats, err := node.ComputeModeratorStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.CasesHandled != 3 || stats.OpenCases != baseline.OpenCases+1 || len(stats.Resolutions) != 3 {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+	if d := time.Duration(stats.MedianResolutionSeconds)*time.Second - 3*time.Hour; d < -5*time.Second || d > 5*time.Second {
+		t.Errorf("expected a median resolution time of 3h, got %ds", stats.MedianResolutionSeconds)
+	}
+	expectedSplit := &pb.ModeratorStats_SplitDistribution{BuyerFull: 1, Even: 1, VendorMajority: 1}
+	if !proto.Equal(stats.SplitDistribution, expectedSplit) {
+		t.Errorf("expected split %+v, got %+v", expectedSplit, stats.SplitDistribution)
+	}
+	if stats.Resolutions[0].OrderId != "statsCaseVendor" {
+		t.Errorf("expected the newest resolution first, got %s", stats.Resolutions[0].OrderId)
+	}
+
+	signed, err := node.SignModeratorStats(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := core.ValidateModeratorStats(peerID, signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.ValidateModeratorStats(nodeID.Pretty(), signed); err == nil {
+		t.Error("expected statistics under another peer to be rejected")
+	}
+	tampered := proto.Clone(signed).(*pb.SignedModeratorStats)
+	tampered.Stats.CasesHandled = 30
+	if err := core.ValidateModeratorStats(peerID, tampered); err == nil {
+		t.Error("expected tampered statistics to be rejected")
+	}
+
+	// The node bought the order so the resolution it received is checked
+	// against the published one
+	contract := factory.NewContract()
+	contract.BuyerOrder.Payment.Moderator = peerID
+	_, _, _, _, _, _, _, _, _, contract.DisputeResolution, err = node.Datastore.Cases().GetCaseMetadata("statsCaseEven")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Purchases().Put("statsCaseEven", *contract, pb.OrderState_RESOLVED, true); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Purchases().Delete("statsCaseEven")
+	verified, err := node.VerifyModeratorResolutions(signed.Stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified != 1 {
+		t.Errorf("expected 1 verified resolution, got %d", verified)
+	}
+	for _, r := range tampered.Stats.Resolutions {
+		if r.OrderId == "statsCaseEven" {
+			r.BuyerPercentage = 100
+		}
+	}
+	if _, err := node.VerifyModeratorResolutions(tampered.Stats); err != core.ErrModeratorStatsMismatch {
+		t.Errorf("expected ErrModeratorStatsMismatch, got %v", err)
+	}
+}
+
+func TestModeratorFilterAndSort(t *testing.T) {
+	newModerator := func(peerID string, languages []string, feeType pb.Moderator_Fee_FeeType, stats *pb.ModeratorStats) *pb.ModeratorWithStats {
+		mod := &pb.ModeratorWithStats{
+			PeerId: peerID,
+			Profile: &pb.Profile{
+				ModeratorInfo: &pb.Moderator{
+					Languages:          languages,
+					AcceptedCurrencies: []string{"BTC"},
+					Fee:                &pb.Moderator_Fee{FeeType: feeType},
+				},
+			},
+		}
+		if stats != nil {
+			mod.Stats = &pb.SignedModeratorStats{Stats: stats}
+		}
+		return mod
+	}
+	busy := newModerator("busy", []string{"English"}, pb.Moderator_Fee_PERCENTAGE, &pb.ModeratorStats{CasesHandled: 40, OpenCases: 9, MedianResolutionSeconds: 7200})
+	quick := newModerator("quick", []string{"english", "German"}, pb.Moderator_Fee_FIXED, &pb.ModeratorStats{CasesHandled: 5, OpenCases: 0, MedianResolutionSeconds: 600})
+	unknown := newModerator("unknown", []string{"English"}, pb.Moderator_Fee_FIXED, nil)
+
+	maxOpen := uint32(2)
+	tests := []struct {
+		filter   core.ModeratorFilter
+		expected []string
+	}{
+		{core.ModeratorFilter{}, []string{"busy", "quick", "unknown"}},
+		{core.ModeratorFilter{Language: "ENGLISH"}, []string{"busy", "quick", "unknown"}},
+		{core.ModeratorFilter{Language: "german"}, []string{"quick"}},
+		{core.ModeratorFilter{Currency: "btc", FeeType: "fixed"}, []string{"quick", "unknown"}},
+		{core.ModeratorFilter{MinCasesHandled: 10}, []string{"busy"}},
+		{core.ModeratorFilter{MaxOpenCases: &maxOpen}, []string{"quick"}},
+		{core.ModeratorFilter{MaxMedianResolutionSeconds: 3600}, []string{"quick"}},
+	}
+	for i, test := range tests {
+		var matched []string
+		for _, mod := range []*pb.ModeratorWithStats{busy, quick, unknown} {
+			if test.filter.Matches(mod) {
+				matched = append(matched, mod.PeerId)
+			}
+		}
+		if len(matched) != len(test.expected) {
+			t.Errorf("case %d: expected %v, got %v", i, test.expected, matched)
+			continue
+		}
+		for j := range matched {
+			if matched[j] != test.expected[j] {
+				t.Errorf("case %d: expected %v, got %v", i, test.expected, matched)
+				break
+			}
+		}
+	}
+
+	sorts := map[string][]string{
+		core.ModeratorSortCasesHandled:     {"busy", "quick", "unknown"},
+		core.ModeratorSortMedianResolution: {"quick", "busy", "unknown"},
+		core.ModeratorSortOpenCases:        {"quick", "busy", "unknown"},
+	}
+	for sortBy, expected := range sorts {
+		mods := []*pb.ModeratorWithStats{unknown, busy, quick}
+		if err := core.SortModerators(mods, sortBy); err != nil {
+			t.Fatal(err)
+		}
+		for j := range mods {
+			if mods[j].PeerId != expected[j] {
+				t.Errorf("sorting by %s: expected %v, got %s at %d", sortBy, expected, mods[j].PeerId, j)
+				break
+			}
+		}
+	}
+	if err := core.SortModerators(nil, "rating"); err != core.ErrModeratorSortKey {
+		t.Errorf("expected ErrModeratorSortKey, got %v", err)
+	}
+}
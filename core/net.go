@@ -12,6 +12,7 @@ import (
 	"gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/golang/protobuf/proto"
@@ -79,6 +80,7 @@ func (n *OpenBazaarNode) SendOfflineMessage(p peer.ID, k *libp2p.PubKey, m *pb.M
 	if aerr != nil {
 		return aerr
 	}
+	metrics.OfflineMessagesStored.WithLabelValues(m.MessageType.String()).Inc()
 	mh, mherr := multihash.FromB58String(p.Pretty())
 	if mherr != nil {
 		return mherr
@@ -265,6 +267,9 @@ func (n *OpenBazaarNode) Unfollow(peerID string) error {
 	if err != nil {
 		return err
 	}
+	if err := n.Datastore.FeedPosts().DeleteByPeer(peerID); err != nil {
+		log.Errorf("removing unfollowed peer's posts from the feed: %s", err.Error())
+	}
 	err = n.UpdateFollow()
 	if err != nil {
 		return err
@@ -594,6 +599,33 @@ func (n *OpenBazaarNode) SendDisputeUpdate(peerID string, updateMessage *pb.Disp
 	return n.sendMessage(peerID, nil, m)
 }
 
+// SendDisputeEvidence - send dispute evidence msg to the moderator
+func (n *OpenBazaarNode) SendDisputeEvidence(peerID string, evidence *pb.DisputeEvidence) error {
+	a, err := ptypes.MarshalAny(evidence)
+	if err != nil {
+		log.Errorf("failed to marshal the evidence: %v", err)
+		return err
+	}
+
+	// Create the DISPUTE_EVIDENCE message
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_EVIDENCE,
+		Payload:     a,
+	}
+
+	// Save DISPUTE_EVIDENCE message to the database for this order for resending if necessary.
+	// Evidence may be submitted several times so the timestamp keeps the messages apart.
+	messageID := fmt.Sprintf("%s-%d-%d", evidence.OrderId, int(pb.Message_DISPUTE_EVIDENCE), evidence.Timestamp.GetSeconds())
+	err = n.Datastore.Messages().Put(
+		messageID, evidence.OrderId, pb.Message_DISPUTE_EVIDENCE, peerID, repo.Message{Msg: m},
+		"", 0, []byte{})
+	if err != nil {
+		log.Errorf("failed putting message (%s): %v", messageID, err)
+	}
+
+	return n.sendMessage(peerID, nil, m)
+}
+
 // SendDisputeClose - send dispute closed msg to peer
 func (n *OpenBazaarNode) SendDisputeClose(peerID string, k *libp2p.PubKey, resolutionMessage *pb.RicardianContract, orderID string) error {
 	a, err := ptypes.MarshalAny(resolutionMessage)
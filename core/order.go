@@ -108,6 +108,16 @@ func (n *OpenBazaarNode) GetOrder(orderID string) (*pb.OrderRespApi, error) {
 	}
 	resp.UnreadChatMessages = uint64(unread)
 
+	role := repo.OrderRoleBuyer
+	if isSale {
+		role = repo.OrderRoleVendor
+	}
+	resp.StateHistory, err = n.GetOrderStateHistory(orderID, role)
+	if err != nil {
+		log.Errorf(err.Error())
+		return nil, err
+	}
+
 	if isSale {
 		err = n.Datastore.Sales().MarkAsRead(orderID)
 		if err != nil {
@@ -362,7 +372,7 @@ func processOnlineDirectOrder(resp *pb.Message, n *OpenBazaarNode, wal wallet.Wa
 	if err != nil {
 		return "", "", *big.NewInt(0), false, err
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order placed")
 	if err != nil {
 		return "", "", *big.NewInt(0), false, err
 	}
@@ -460,7 +470,7 @@ func processOfflineDirectOrder(n *OpenBazaarNode, wal wallet.Wallet, contract *p
 	if err != nil {
 		return "", "", *big.NewInt(0), err
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order placed")
 	if err != nil {
 		return "", "", *big.NewInt(0), err
 	}
@@ -501,7 +511,7 @@ func processOnlineModeratedOrder(resp *pb.Message, n *OpenBazaarNode, contract *
 	if err != nil {
 		return "", "", *big.NewInt(0), false, err
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order placed")
 	if err != nil {
 		return "", "", *big.NewInt(0), false, err
 	}
@@ -544,7 +554,7 @@ func processOfflineModeratedOrder(n *OpenBazaarNode, contract *pb.RicardianContr
 	if err != nil {
 		return "", "", *big.NewInt(0), err
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order placed")
 	if err != nil {
 		log.Error(err)
 	}
@@ -647,6 +657,16 @@ func (n *OpenBazaarNode) createContractWithOrder(data *repo.PurchaseData) (*pb.R
 			return nil, errors.New("listing does not accept the selected currency")
 		}
 
+		if listing.GetContractType() == pb.Listing_Metadata_CROWD_FUND.String() {
+			listingHashes := make(map[string]bool)
+			for _, it := range data.Items {
+				listingHashes[it.ListingHash] = true
+			}
+			if err := validateCrowdFundOrder(listing, data.Moderator != "", len(listingHashes), time.Now()); err != nil {
+				return nil, err
+			}
+		}
+
 		ser, err := listing.MarshalProtobuf()
 		if err != nil {
 			return nil, err
@@ -928,7 +948,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 	if err != nil {
 		return err
 	}
-	err = n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_CANCELED, true)
+	err = n.OrderStates().UpdatePurchase(orderID, *contract, pb.OrderState_CANCELED, true, "order canceled")
 	if err != nil {
 		log.Error(err)
 	}
@@ -952,149 +972,157 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (*b
 	var (
 		total         = big.NewInt(0)
 		physicalGoods = make(map[string]*repo.Listing)
-		toHundredths  = func(f float32) *big.Float {
-			return new(big.Float).Mul(big.NewFloat(float64(f)), big.NewFloat(0.01))
-		}
-		v5Order, err = repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+		v5Order, err  = repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
 	)
 	if err != nil {
 		return big.NewInt(0), fmt.Errorf("normalizing buyer order: %s", err.Error())
 	}
 
 	for _, item := range v5Order.Items {
-		var itemOriginAmt *repo.CurrencyValue
-		l, err := ParseContractForListing(item.ListingHash, contract)
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("listing not found in contract for item %s", item.ListingHash)
-		}
-
-		rl, err := repo.NewListingFromProtobuf(l)
+		finalItemAmount, nrl, err := n.calculateOrderItemTotal(contract, v5Order, item)
 		if err != nil {
 			return big.NewInt(0), err
 		}
 
-		nrl, err := rl.Normalize()
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("normalize legacy listing: %s", err.Error())
-		}
-
 		// keep track of physical listings for shipping caluclation
 		if nrl.GetContractType() == pb.Listing_Metadata_PHYSICAL_GOOD.String() {
 			physicalGoods[item.ListingHash] = nrl
 		}
 
-		// calculate base amount
-		if nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
-			nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String() {
-			var originDef = repo.NewUnknownCryptoDefinition(nrl.GetCryptoCurrencyCode(), uint(nrl.GetCryptoDivisibility()))
-			itemOriginAmt = repo.NewCurrencyValueFromBigInt(GetOrderQuantity(nrl.GetProtobuf(), item), originDef)
+		// add to total
+		total.Add(total, finalItemAmount)
+	}
+
+	shippingTotal, err := n.calculateShippingTotalForListings(contract, physicalGoods)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	total.Add(total, shippingTotal)
 
-			if priceModifier := nrl.GetPriceModifier(); priceModifier != 0 {
-				itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(priceModifier))
-			}
-		} else {
-			oAmt, err := nrl.GetPrice()
-			if err != nil {
-				return big.NewInt(0), err
-			}
-			itemOriginAmt = oAmt
+	return total, nil
+}
+
+// calculateOrderItemTotal returns the price of the order item including its
+// surcharges, coupons, taxes and quantity in the order's payment currency
+// along with the normalized listing it was bought from
+func (n *OpenBazaarNode) calculateOrderItemTotal(contract *pb.RicardianContract, v5Order *pb.Order, item *pb.Order_Item) (*big.Int, *repo.Listing, error) {
+	var (
+		itemOriginAmt *repo.CurrencyValue
+		toHundredths  = func(f float32) *big.Float {
+			return new(big.Float).Mul(big.NewFloat(float64(f)), big.NewFloat(0.01))
 		}
+	)
+	l, err := ParseContractForListing(item.ListingHash, contract)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing not found in contract for item %s", item.ListingHash)
+	}
 
-		// apply surcharges
-		selectedSku, err := GetSelectedSku(nrl.GetProtobuf(), item.Options)
-		if err != nil {
-			return big.NewInt(0), err
+	rl, err := repo.NewListingFromProtobuf(l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nrl, err := rl.Normalize()
+	if err != nil {
+		return nil, nil, fmt.Errorf("normalize legacy listing: %s", err.Error())
+	}
+
+	// calculate base amount
+	if nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
+		nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String() {
+		var originDef = repo.NewUnknownCryptoDefinition(nrl.GetCryptoCurrencyCode(), uint(nrl.GetCryptoDivisibility()))
+		itemOriginAmt = repo.NewCurrencyValueFromBigInt(GetOrderQuantity(nrl.GetProtobuf(), item), originDef)
+
+		if priceModifier := nrl.GetPriceModifier(); priceModifier != 0 {
+			itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(priceModifier))
 		}
-		skus, err := nrl.GetSkus()
+	} else {
+		oAmt, err := nrl.GetPrice()
 		if err != nil {
-			return big.NewInt(0), err
-		}
-		for i, sku := range skus {
-			if selectedSku == i {
-				// surcharge may be positive or negative
-				surcharge, ok := new(big.Int).SetString(sku.BigSurcharge, 10)
-				if ok && surcharge.Cmp(big.NewInt(0)) != 0 {
-					itemOriginAmt = itemOriginAmt.AddBigInt(surcharge)
-				}
-				break
-			}
+			return nil, nil, err
 		}
+		itemOriginAmt = oAmt
+	}
 
-		// apply coupon discounts
-		for _, couponCode := range item.CouponCodes {
-			id, err := ipfs.EncodeMultihash([]byte(couponCode))
-			if err != nil {
-				return big.NewInt(0), err
-			}
-			for _, vendorCoupon := range nrl.GetProtobuf().Coupons {
-				if id.B58String() == vendorCoupon.GetHash() {
-					if disc, ok := new(big.Int).SetString(vendorCoupon.GetBigPriceDiscount(), 10); ok && disc.Cmp(big.NewInt(0)) > 0 {
-						// apply fixed discount
-						itemOriginAmt = itemOriginAmt.SubBigInt(disc)
-					} else if discountF := vendorCoupon.GetPercentDiscount(); discountF > 0 {
-						// apply percentage discount
-						itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(-discountF))
-					}
-				}
+	// apply surcharges
+	selectedSku, err := GetSelectedSku(nrl.GetProtobuf(), item.Options)
+	if err != nil {
+		return nil, nil, err
+	}
+	skus, err := nrl.GetSkus()
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, sku := range skus {
+		if selectedSku == i {
+			// surcharge may be positive or negative
+			surcharge, ok := new(big.Int).SetString(sku.BigSurcharge, 10)
+			if ok && surcharge.Cmp(big.NewInt(0)) != 0 {
+				itemOriginAmt = itemOriginAmt.AddBigInt(surcharge)
 			}
+			break
 		}
+	}
 
-		// apply taxes
-		for _, tax := range nrl.GetProtobuf().Taxes {
-			for _, taxRegion := range tax.TaxRegions {
-				if contract.BuyerOrder.Shipping.Country == taxRegion {
-					itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(tax.Percentage))
-					break
+	// apply coupon discounts
+	for _, couponCode := range item.CouponCodes {
+		id, err := ipfs.EncodeMultihash([]byte(couponCode))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, vendorCoupon := range nrl.GetProtobuf().Coupons {
+			if id.B58String() == vendorCoupon.GetHash() {
+				if disc, ok := new(big.Int).SetString(vendorCoupon.GetBigPriceDiscount(), 10); ok && disc.Cmp(big.NewInt(0)) > 0 {
+					// apply fixed discount
+					itemOriginAmt = itemOriginAmt.SubBigInt(disc)
+				} else if discountF := vendorCoupon.GetPercentDiscount(); discountF > 0 {
+					// apply percentage discount
+					itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(-discountF))
 				}
 			}
 		}
+	}
 
-		// apply requested quantity
-		if !(nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
-			nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String()) {
-			if itemQuantity := GetOrderQuantity(nrl.GetProtobuf(), item); itemQuantity.Cmp(big.NewInt(0)) > 0 {
-				itemOriginAmt = itemOriginAmt.MulBigInt(itemQuantity)
-			} else {
-				log.Debugf("missing quantity for order, assuming quantity 1")
+	// apply taxes
+	for _, tax := range nrl.GetProtobuf().Taxes {
+		for _, taxRegion := range tax.TaxRegions {
+			if contract.BuyerOrder.Shipping.Country == taxRegion {
+				itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(tax.Percentage))
+				break
 			}
 		}
+	}
 
-		// convert subtotal to final currency
-		cc, err := n.ReserveCurrencyConverter()
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("preparing reserve currency converter: %s", err.Error())
-		}
-
-		finalItemAmount, _, err := itemOriginAmt.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
-		if err != nil {
-			return big.NewInt(0), err
+	// apply requested quantity
+	if !(nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
+		nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String()) {
+		if itemQuantity := GetOrderQuantity(nrl.GetProtobuf(), item); itemQuantity.Cmp(big.NewInt(0)) > 0 {
+			itemOriginAmt = itemOriginAmt.MulBigInt(itemQuantity)
+		} else {
+			log.Debugf("missing quantity for order, assuming quantity 1")
 		}
+	}
 
-		// add to total
-		total.Add(total, finalItemAmount.AmountBigInt())
+	// convert subtotal to final currency
+	cc, err := n.ReserveCurrencyConverter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("preparing reserve currency converter: %s", err.Error())
 	}
 
-	shippingTotal, err := n.calculateShippingTotalForListings(contract, physicalGoods)
+	finalItemAmount, _, err := itemOriginAmt.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
 	if err != nil {
-		return big.NewInt(0), err
+		return nil, nil, err
 	}
-	total.Add(total, shippingTotal)
 
-	return total, nil
+	return finalItemAmount.AmountBigInt(), nrl, nil
 }
 
 func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.RicardianContract, listings map[string]*repo.Listing) (*big.Int, error) {
-	type itemShipping struct {
-		primary               *big.Int
-		secondary             *big.Int
-		quantity              uint64
-		shippingTaxPercentage float32
-		version               uint32
-	}
 	var (
-		v5Order, err  = repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
-		is            []itemShipping
-		shippingTotal *big.Int
+		v5Order, err = repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+		is           []itemShipping
+		tiered       = make(map[string]*tieredShipping)
+		tieredKeys   []string
 	)
 	if err != nil {
 		return big.NewInt(0), fmt.Errorf("normalizing buyer order: %s", err.Error())
@@ -1146,6 +1174,48 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 		if !ok {
 			return big.NewInt(0), errors.New("shipping service not found in listing")
 		}
+		// Calculate tax percentage
+		var shippingTaxPercentage float32
+		for _, tax := range rl.GetProtobuf().Taxes {
+			regions := make(map[pb.CountryCode]bool)
+			for _, taxRegion := range tax.TaxRegions {
+				regions[taxRegion] = true
+			}
+			_, ok := regions[v5Order.Shipping.Country]
+			if ok && tax.TaxShipping {
+				shippingTaxPercentage = tax.Percentage / 100
+			}
+		}
+
+		var qty uint64
+		if q := quantityForItem(rl.GetVersion(), item); q.IsUint64() {
+			qty = q.Uint64()
+		} else {
+			orderID, _ := n.CalcOrderID(contract.BuyerOrder)
+			log.Warningf("unable to detect quantity in contract (%s)", orderID)
+		}
+		if repo.IsTieredShippingType(option.Type) {
+			// Items shipped with the same service share its rate tiers
+			key := fmt.Sprintf("%s/%s/%s", option.Type, strings.ToLower(option.Name), strings.ToLower(service.Name))
+			ts, ok := tiered[key]
+			if !ok {
+				ts = &tieredShipping{shippingType: option.Type, service: service.Name}
+				tiered[key] = ts
+				tieredKeys = append(tieredKeys, key)
+			}
+			if option.Type == pb.Listing_ShippingOption_WEIGHT_BASED {
+				ts.total += float64(rl.GetWeightGrams()) * float64(qty)
+			} else {
+				ts.total += float64(qty)
+			}
+			ts.rates = append(ts.rates, tieredRate{
+				tiers:                 service.RateTiers,
+				currency:              rl.GetProtobuf().Item.PriceCurrency,
+				shippingTaxPercentage: shippingTaxPercentage,
+			})
+			continue
+		}
+
 		servicePrice, err := repo.NewCurrencyValueFromProtobuf(service.BigPrice, rl.GetProtobuf().Item.PriceCurrency)
 		if err != nil {
 			return big.NewInt(0), fmt.Errorf("parsing service price (%v): %s", service.Name, err.Error())
@@ -1168,26 +1238,6 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 			convertedAuxPrice = finalAux
 		}
 
-		// Calculate tax percentage
-		var shippingTaxPercentage float32
-		for _, tax := range rl.GetProtobuf().Taxes {
-			regions := make(map[pb.CountryCode]bool)
-			for _, taxRegion := range tax.TaxRegions {
-				regions[taxRegion] = true
-			}
-			_, ok := regions[v5Order.Shipping.Country]
-			if ok && tax.TaxShipping {
-				shippingTaxPercentage = tax.Percentage / 100
-			}
-		}
-
-		var qty uint64
-		if q := quantityForItem(rl.GetVersion(), item); q.IsUint64() {
-			qty = q.Uint64()
-		} else {
-			orderID, _ := n.CalcOrderID(contract.BuyerOrder)
-			log.Warningf("unable to detect quantity in contract (%s)", orderID)
-		}
 		is = append(is, itemShipping{
 			primary:               convertedShippingPrice.AmountBigInt(),
 			secondary:             convertedAuxPrice.AmountBigInt(),
@@ -1197,6 +1247,81 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 		})
 	}
 
+	shippingTotal, err := fixedPriceShippingTotal(is)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	for _, key := range tieredKeys {
+		price, err := n.tieredShippingPrice(tiered[key], v5Order.Payment.AmountCurrency)
+		if err != nil {
+			return big.NewInt(0), err
+		}
+		shippingTotal.Add(shippingTotal, price)
+	}
+	return shippingTotal, nil
+}
+
+// tieredShipping collects the order items sent with one weight or quantity
+// based shipping service. The total is the combined weight in grams or the
+// combined quantity of the items.
+type tieredShipping struct {
+	shippingType pb.Listing_ShippingOption_ShippingType
+	service      string
+	total        float64
+	rates        []tieredRate
+}
+
+type tieredRate struct {
+	tiers                 []*pb.Listing_ShippingOption_Service_RateTier
+	currency              *pb.CurrencyDefinition
+	shippingTaxPercentage float32
+}
+
+// tieredShippingPrice returns the price of the rate tier matching the
+// combined total of the items. When the items come from several listings
+// the highest of their prices is charged once for the whole shipment.
+func (n *OpenBazaarNode) tieredShippingPrice(ts *tieredShipping, paymentCurrency *pb.CurrencyDefinition) (*big.Int, error) {
+	cc, err := n.ReserveCurrencyConverter()
+	if err != nil {
+		return nil, fmt.Errorf("preparing reserve currency converter: %s", err.Error())
+	}
+	highest := big.NewInt(0)
+	for _, rate := range ts.rates {
+		tier := repo.ShippingRateTierFor(rate.tiers, ts.total)
+		if tier == nil {
+			return nil, fmt.Errorf("order exceeds the largest %s rate tier of shipping service (%s)", ts.shippingType, ts.service)
+		}
+		tierPrice, err := repo.NewCurrencyValueFromProtobuf(tier.BigPrice, rate.currency)
+		if err != nil {
+			return nil, fmt.Errorf("parsing rate tier price (%s): %s", ts.service, err.Error())
+		}
+		convertedPrice, _, err := tierPrice.ConvertUsingProtobufDef(paymentCurrency, cc)
+		if err != nil {
+			return nil, fmt.Errorf("converting rate tier price (%s): %s", ts.service, err.Error())
+		}
+		s := int64(((1 + rate.shippingTaxPercentage) * 100) + .5)
+		taxed := new(big.Int).Mul(convertedPrice.AmountBigInt(), big.NewInt(s))
+		price, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(taxed)).Int(nil)
+		if price.Cmp(highest) > 0 {
+			highest = price
+		}
+	}
+	return highest, nil
+}
+
+// itemShipping is the fixed shipping price of an order item
+type itemShipping struct {
+	primary               *big.Int
+	secondary             *big.Int
+	quantity              uint64
+	shippingTaxPercentage float32
+	version               uint32
+}
+
+// fixedPriceShippingTotal returns the shipping for items with fixed price
+// shipping. Only the most expensive item pays the full price and every other
+// unit pays the additional item price.
+func fixedPriceShippingTotal(is []itemShipping) (*big.Int, error) {
 	if len(is) == 0 {
 		return big.NewInt(0), nil
 	}
@@ -1205,7 +1330,7 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 		s := int64(((1 + is[0].shippingTaxPercentage) * 100) + .5)
 		shippingTotalPrimary := new(big.Int).Mul(is[0].primary, big.NewInt(s))
 		stp, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalPrimary)).Int(nil)
-		shippingTotal = stp
+		shippingTotal := stp
 		if is[0].quantity > 1 {
 			if is[0].version == 1 {
 				t1 := new(big.Int).Mul(stp, big.NewInt(int64(is[0].quantity-1)))
@@ -1224,8 +1349,11 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 		return shippingTotal, nil
 	}
 
-	var highest *big.Int
-	var i int
+	var (
+		highest       = big.NewInt(0)
+		shippingTotal = big.NewInt(0)
+		i             int
+	)
 	for x, s := range is {
 		if s.primary.Cmp(highest) > 0 {
 			highest = new(big.Int).Set(s.primary)
@@ -1372,6 +1500,21 @@ collectListings:
 		return errors.New("item hashes in the order do not match the included listings")
 	}
 
+	// Validate crowdfunding pledges
+	for _, listing := range listingMap {
+		if listing.Metadata.ContractType != pb.Listing_Metadata_CROWD_FUND {
+			continue
+		}
+		rl, err := repo.NewListingFromProtobuf(listing)
+		if err != nil {
+			return err
+		}
+		moderated := contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED
+		if err := validateCrowdFundOrder(rl, moderated, len(listingMap), time.Now()); err != nil {
+			return err
+		}
+	}
+
 	// Validate no duplicate coupons
 	for _, item := range contract.BuyerOrder.Items {
 		couponMap := make(map[string]bool)
@@ -1383,6 +1526,11 @@ collectListings:
 		}
 	}
 
+	// Validate coupon limits
+	if err := n.validateCouponLimits(contract, listingMap, time.Now()); err != nil {
+		return err
+	}
+
 	// Validate the selected variants
 	type inventory struct {
 		Slug    string
@@ -1750,6 +1898,26 @@ func quantityForItem(version uint32, item *pb.Order_Item) *big.Int {
 	return new(big.Int).SetUint64(uint64(item.Quantity))
 }
 
+// orderItemValue returns the price of the item's variant times the quantity
+// ordered in the listing's price currency, before coupons, taxes and shipping
+func orderItemValue(listing *pb.Listing, price *big.Int, item *pb.Order_Item) (*big.Int, error) {
+	value := new(big.Int).Set(price)
+	selectedSku, err := GetSelectedSku(listing, item.Options)
+	if err != nil {
+		return nil, err
+	}
+	if selectedSku < len(listing.Item.Skus) {
+		if surcharge, ok := new(big.Int).SetString(listing.Item.Skus[selectedSku].BigSurcharge, 10); ok {
+			value.Add(value, surcharge)
+		}
+	}
+	quantity := GetOrderQuantity(listing, item)
+	if quantity == nil || quantity.Sign() <= 0 {
+		quantity = big.NewInt(1)
+	}
+	return value.Mul(value, quantity), nil
+}
+
 // ReserveCurrencyConverter will attempt to build a CurrencyConverter based on
 // the reserve currency, or will panic if unsuccessful
 func (n *OpenBazaarNode) ReserveCurrencyConverter() (*repo.CurrencyConverter, error) {
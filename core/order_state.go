@@ -0,0 +1,342 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// orderStateLock serializes the read, validate and write of order state
+// changes so message handlers and wallet callbacks can't interleave
+var orderStateLock sync.Mutex
+
+// orderInitialStates are the states an order may be first persisted in
+var orderInitialStates = map[repo.OrderRole][]pb.OrderState{
+	repo.OrderRoleBuyer: {
+		pb.OrderState_AWAITING_PAYMENT,
+	},
+	repo.OrderRoleVendor: {
+		pb.OrderState_AWAITING_PAYMENT,
+		pb.OrderState_PROCESSING_ERROR,
+	},
+	repo.OrderRoleModerator: {
+		pb.OrderState_DISPUTED,
+	},
+}
+
+// orderTransitions are the legal state changes for each role. Persisting an
+// order again in its current state is always allowed and is not recorded as
+// a transition. States without an entry are final.
+var orderTransitions = map[repo.OrderRole]map[pb.OrderState][]pb.OrderState{
+	repo.OrderRoleBuyer: {
+		pb.OrderState_AWAITING_PAYMENT: {
+			pb.OrderState_PENDING,
+			pb.OrderState_AWAITING_FULFILLMENT,
+			pb.OrderState_DECLINED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_PENDING: {
+			pb.OrderState_AWAITING_PAYMENT,
+			pb.OrderState_AWAITING_FULFILLMENT,
+			pb.OrderState_DECLINED,
+			pb.OrderState_CANCELED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PAYMENT_FINALIZED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_AWAITING_FULFILLMENT: {
+			pb.OrderState_PARTIALLY_FULFILLED,
+			pb.OrderState_FULFILLED,
+			pb.OrderState_REFUNDED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_PARTIALLY_FULFILLED: {
+			pb.OrderState_FULFILLED,
+			pb.OrderState_REFUNDED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_FULFILLED: {
+			pb.OrderState_COMPLETED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PAYMENT_FINALIZED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_DISPUTED: {
+			pb.OrderState_DECIDED,
+			pb.OrderState_RESOLVED,
+			pb.OrderState_PAYMENT_FINALIZED,
+		},
+		pb.OrderState_DECIDED: {
+			pb.OrderState_RESOLVED,
+		},
+		pb.OrderState_RESOLVED: {
+			pb.OrderState_COMPLETED,
+		},
+		pb.OrderState_PAYMENT_FINALIZED: {
+			pb.OrderState_COMPLETED,
+			pb.OrderState_DISPUTED,
+		},
+		pb.OrderState_PROCESSING_ERROR: {
+			pb.OrderState_CANCELED,
+			pb.OrderState_DISPUTED,
+		},
+	},
+	repo.OrderRoleVendor: {
+		pb.OrderState_AWAITING_PAYMENT: {
+			pb.OrderState_PENDING,
+			pb.OrderState_AWAITING_FULFILLMENT,
+			pb.OrderState_CANCELED,
+			pb.OrderState_DECLINED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_PENDING: {
+			pb.OrderState_AWAITING_FULFILLMENT,
+			pb.OrderState_DECLINED,
+			pb.OrderState_CANCELED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PAYMENT_FINALIZED,
+			pb.OrderState_PROCESSING_ERROR,
+		},
+		pb.OrderState_AWAITING_FULFILLMENT: {
+			pb.OrderState_PARTIALLY_FULFILLED,
+			pb.OrderState_FULFILLED,
+			pb.OrderState_REFUNDED,
+			pb.OrderState_DISPUTED,
+		},
+		pb.OrderState_PARTIALLY_FULFILLED: {
+			pb.OrderState_FULFILLED,
+			pb.OrderState_REFUNDED,
+			pb.OrderState_DISPUTED,
+		},
+		pb.OrderState_FULFILLED: {
+			pb.OrderState_COMPLETED,
+			pb.OrderState_DISPUTED,
+			pb.OrderState_PAYMENT_FINALIZED,
+		},
+		pb.OrderState_DISPUTED: {
+			pb.OrderState_DECIDED,
+			pb.OrderState_RESOLVED,
+			pb.OrderState_PAYMENT_FINALIZED,
+		},
+		pb.OrderState_DECIDED: {
+			pb.OrderState_RESOLVED,
+			pb.OrderState_COMPLETED,
+		},
+		pb.OrderState_RESOLVED: {
+			pb.OrderState_COMPLETED,
+		},
+		pb.OrderState_PAYMENT_FINALIZED: {
+			pb.OrderState_COMPLETED,
+			pb.OrderState_DISPUTED,
+		},
+		pb.OrderState_PROCESSING_ERROR: {
+			pb.OrderState_AWAITING_PAYMENT,
+			pb.OrderState_CANCELED,
+		},
+	},
+	repo.OrderRoleModerator: {
+		pb.OrderState_DISPUTED: {
+			pb.OrderState_RESOLVED,
+		},
+	},
+}
+
+// saleReleasesInventory are the states in which an unfunded sale gives up the
+// inventory held for it
+var saleReleasesInventory = map[pb.OrderState]bool{
+	pb.OrderState_CANCELED:         true,
+	pb.OrderState_DECLINED:         true,
+	pb.OrderState_PROCESSING_ERROR: true,
+}
+
+// saleWithdrawsPledge are the states in which a sale no longer counts
+// towards the goal of a crowdfunding listing
+var saleWithdrawsPledge = map[pb.OrderState]bool{
+	pb.OrderState_CANCELED:         true,
+	pb.OrderState_DECLINED:         true,
+	pb.OrderState_PROCESSING_ERROR: true,
+	pb.OrderState_REFUNDED:         true,
+}
+
+// saleReleasesCoupons are the states in which a sale no longer counts
+// towards the redemption limits of the coupons applied to it
+var saleReleasesCoupons = map[pb.OrderState]bool{
+	pb.OrderState_CANCELED:         true,
+	pb.OrderState_DECLINED:         true,
+	pb.OrderState_PROCESSING_ERROR: true,
+	pb.OrderState_REFUNDED:         true,
+}
+
+// ValidateOrderTransition returns an ErrIllegalOrderTransition if the role
+// may not move the order from one state to the other. When created is true
+// the order is being persisted for the first time and from is ignored.
+func ValidateOrderTransition(orderID string, role repo.OrderRole, created bool, from, to pb.OrderState) error {
+	allowed := orderTransitions[role][from]
+	if created {
+		allowed = orderInitialStates[role]
+	} else if from == to {
+		return nil
+	}
+	for _, s := range allowed {
+		if s == to {
+			return nil
+		}
+	}
+	return NewErrIllegalOrderTransition(orderID, role, from, to)
+}
+
+// OrderStateMachine persists order state changes after checking them
+// against the allowed transitions and records each change in the order's
+// state history
+type OrderStateMachine struct {
+	datastore repo.Datastore
+}
+
+// NewOrderStateMachine returns an OrderStateMachine for the datastore
+func NewOrderStateMachine(datastore repo.Datastore) *OrderStateMachine {
+	return &OrderStateMachine{datastore: datastore}
+}
+
+// OrderStates returns the state machine for the node's orders
+func (n *OpenBazaarNode) OrderStates() *OrderStateMachine {
+	return NewOrderStateMachine(n.Datastore)
+}
+
+// UpdatePurchase saves the buyer's copy of the order in the given state
+func (m *OrderStateMachine) UpdatePurchase(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool, cause string) error {
+	orderStateLock.Lock()
+	defer orderStateLock.Unlock()
+
+	_, current, _, _, _, _, err := m.datastore.Purchases().GetByOrderId(orderID)
+	return m.transition(orderID, repo.OrderRoleBuyer, current, state, err, cause, false, func() error {
+		return m.datastore.Purchases().Put(orderID, contract, state, read)
+	})
+}
+
+// UpdateSale saves the vendor's copy of the order in the given state
+func (m *OrderStateMachine) UpdateSale(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool, cause string) error {
+	orderStateLock.Lock()
+	defer orderStateLock.Unlock()
+
+	_, current, _, _, _, _, err := m.datastore.Sales().GetByOrderId(orderID)
+	err = m.transition(orderID, repo.OrderRoleVendor, current, state, err, cause, false, func() error {
+		return m.datastore.Sales().Put(orderID, contract, state, read)
+	})
+	if err == nil && saleReleasesInventory[state] {
+		if err := NewInventoryKeeper(m.datastore, nil).Release(orderID); err != nil {
+			log.Errorf("releasing inventory held for order (%s): %s", orderID, err.Error())
+		}
+	}
+	if err == nil && saleWithdrawsPledge[state] {
+		if err := m.datastore.CrowdFundPledges().SetState(orderID, repo.CrowdFundPledgeStateWithdrawn); err != nil {
+			log.Errorf("withdrawing crowdfund pledge for order (%s): %s", orderID, err.Error())
+		}
+	}
+	if err == nil && saleReleasesCoupons[state] {
+		if err := m.datastore.Coupons().DeleteRedemptions(orderID); err != nil {
+			log.Errorf("releasing coupons redeemed by order (%s): %s", orderID, err.Error())
+		}
+	}
+	return err
+}
+
+// RestoreSale puts the vendor's copy of the order back into the state it was
+// in before a transition which could not be completed. The change is recorded
+// but not validated.
+func (m *OrderStateMachine) RestoreSale(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool, cause string) error {
+	orderStateLock.Lock()
+	defer orderStateLock.Unlock()
+
+	_, current, _, _, _, _, err := m.datastore.Sales().GetByOrderId(orderID)
+	return m.transition(orderID, repo.OrderRoleVendor, current, state, err, cause, true, func() error {
+		return m.datastore.Sales().Put(orderID, contract, state, read)
+	})
+}
+
+// OpenCase saves a new dispute case for the moderator
+func (m *OrderStateMachine) OpenCase(caseID string, buyerOpened bool, claim, paymentCoin, coinType, cause string) error {
+	orderStateLock.Lock()
+	defer orderStateLock.Unlock()
+
+	_, _, _, _, current, _, _, _, _, _, err := m.datastore.Cases().GetCaseMetadata(caseID)
+	return m.transition(caseID, repo.OrderRoleModerator, current, pb.OrderState_DISPUTED, err, cause, false, func() error {
+		return m.datastore.Cases().Put(caseID, pb.OrderState_DISPUTED, buyerOpened, claim, paymentCoin, coinType)
+	})
+}
+
+// CloseCase saves the moderator's resolution and resolves the case
+func (m *OrderStateMachine) CloseCase(caseID string, resolution *pb.DisputeResolution, cause string) error {
+	orderStateLock.Lock()
+	defer orderStateLock.Unlock()
+
+	_, _, _, _, current, _, _, _, _, _, err := m.datastore.Cases().GetCaseMetadata(caseID)
+	return m.transition(caseID, repo.OrderRoleModerator, current, pb.OrderState_RESOLVED, err, cause, false, func() error {
+		return m.datastore.Cases().MarkAsClosed(caseID, resolution)
+	})
+}
+
+// transition validates the change from the current state, which could not be
+// read if lookupErr is set, runs put and records the change. Validation is
+// skipped when force is set.
+func (m *OrderStateMachine) transition(orderID string, role repo.OrderRole, current, state pb.OrderState, lookupErr error, cause string, force bool, put func() error) error {
+	created := lookupErr == sql.ErrNoRows
+	if lookupErr != nil && !created {
+		return fmt.Errorf("reading %s order (%s) state: %s", role, orderID, lookupErr.Error())
+	}
+	if err := ValidateOrderTransition(orderID, role, created, current, state); err != nil && !force {
+		log.Warningf("rejected %s order (%s) transition from %s to %s (%s)", role, orderID, current, state, cause)
+		return err
+	}
+	if err := put(); err != nil {
+		return err
+	}
+	if !created && current == state {
+		return nil
+	}
+	change := repo.OrderStateChange{
+		OrderID:   orderID,
+		Role:      role,
+		Created:   created,
+		From:      current,
+		To:        state,
+		Cause:     cause,
+		Timestamp: time.Now(),
+	}
+	if err := m.datastore.OrderStateHistory().Put(change); err != nil {
+		log.Errorf("recording %s order (%s) state history: %s", role, orderID, err.Error())
+	}
+	return nil
+}
+
+// GetOrderStateHistory returns the recorded state changes of the order for
+// the given role in the API format
+func (n *OpenBazaarNode) GetOrderStateHistory(orderID string, role repo.OrderRole) ([]*pb.OrderStateChange, error) {
+	changes, err := n.Datastore.OrderStateHistory().GetByOrderID(orderID, role)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]*pb.OrderStateChange, 0, len(changes))
+	for _, c := range changes {
+		ts, err := ptypes.TimestampProto(c.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, &pb.OrderStateChange{
+			Created:   c.Created,
+			FromState: c.From,
+			ToState:   c.To,
+			Role:      string(c.Role),
+			Cause:     c.Cause,
+			Timestamp: ts,
+		})
+	}
+	return history, nil
+}
@@ -0,0 +1,94 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func TestValidateOrderTransition(t *testing.T) {
+	tests := []struct {
+		role    repo.OrderRole
+		created bool
+		from    pb.OrderState
+		to      pb.OrderState
+		legal   bool
+	}{
+		{repo.OrderRoleBuyer, true, 0, pb.OrderState_AWAITING_PAYMENT, true},
+		{repo.OrderRoleBuyer, true, 0, pb.OrderState_COMPLETED, false},
+		{repo.OrderRoleBuyer, false, pb.OrderState_AWAITING_PAYMENT, pb.OrderState_PENDING, true},
+		{repo.OrderRoleBuyer, false, pb.OrderState_FULFILLED, pb.OrderState_FULFILLED, true},
+		{repo.OrderRoleBuyer, false, pb.OrderState_COMPLETED, pb.OrderState_RESOLVED, false},
+		{repo.OrderRoleBuyer, false, pb.OrderState_PENDING, pb.OrderState_FULFILLED, false},
+		{repo.OrderRoleVendor, false, pb.OrderState_PENDING, pb.OrderState_AWAITING_FULFILLMENT, true},
+		{repo.OrderRoleVendor, false, pb.OrderState_FULFILLED, pb.OrderState_AWAITING_PAYMENT, false},
+		{repo.OrderRoleVendor, false, pb.OrderState_REFUNDED, pb.OrderState_DISPUTED, false},
+		{repo.OrderRoleModerator, true, 0, pb.OrderState_DISPUTED, true},
+		{repo.OrderRoleModerator, false, pb.OrderState_DISPUTED, pb.OrderState_RESOLVED, true},
+		{repo.OrderRoleModerator, false, pb.OrderState_RESOLVED, pb.OrderState_DISPUTED, false},
+	}
+	for _, tt := range tests {
+		err := core.ValidateOrderTransition("order", tt.role, tt.created, tt.from, tt.to)
+		if tt.legal && err != nil {
+			t.Errorf("expected %s transition %s -> %s to be legal, got %s", tt.role, tt.from, tt.to, err)
+		}
+		if !tt.legal {
+			if _, ok := err.(core.ErrIllegalOrderTransition); !ok {
+				t.Errorf("expected ErrIllegalOrderTransition for %s transition %s -> %s, got %v", tt.role, tt.from, tt.to, err)
+			}
+		}
+	}
+}
+
+func TestOrderStateMachine_UpdatePurchase(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contract := factory.NewContract()
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := node.OrderStates()
+	if err := states.UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order placed"); err != nil {
+		t.Fatal(err)
+	}
+	if err := states.UpdatePurchase(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order updated"); err != nil {
+		t.Fatal(err)
+	}
+	if err := states.UpdatePurchase(orderID, *contract, pb.OrderState_PENDING, false, "payment received"); err != nil {
+		t.Fatal(err)
+	}
+	err = states.UpdatePurchase(orderID, *contract, pb.OrderState_COMPLETED, false, "order completed")
+	if _, ok := err.(core.ErrIllegalOrderTransition); !ok {
+		t.Fatalf("expected ErrIllegalOrderTransition, got %v", err)
+	}
+
+	orderResponse, err := node.GetOrder(orderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orderResponse.State != pb.OrderState_PENDING {
+		t.Errorf("expected rejected transition to leave order PENDING, got %s", orderResponse.State)
+	}
+	history := orderResponse.StateHistory
+	if len(history) != 2 {
+		t.Fatalf("expected 2 recorded transitions, got %d", len(history))
+	}
+	if !history[0].Created || history[0].ToState != pb.OrderState_AWAITING_PAYMENT || history[0].Cause != "order placed" {
+		t.Errorf("unexpected first transition: %v", history[0])
+	}
+	if history[1].Created || history[1].FromState != pb.OrderState_AWAITING_PAYMENT || history[1].ToState != pb.OrderState_PENDING || history[1].Role != string(repo.OrderRoleBuyer) {
+		t.Errorf("unexpected second transition: %v", history[1])
+	}
+	if history[1].Timestamp == nil {
+		t.Error("expected transition timestamp")
+	}
+}
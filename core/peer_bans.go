@@ -0,0 +1,62 @@
+package core
+
+import (
+	"time"
+
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// BanPeer bans the peer for the duration, dropping any messages it sends
+// until the ban expires or is lifted. Banning a peer again replaces its ban.
+func (n *OpenBazaarNode) BanPeer(pid peer.ID, reason string, duration time.Duration) error {
+	now := time.Now()
+	ban := repo.PeerBan{
+		PeerID:  pid.Pretty(),
+		Reason:  reason,
+		Created: now,
+		Expires: now.Add(duration),
+	}
+	if err := n.Datastore.PeerBans().Put(ban); err != nil {
+		return err
+	}
+	n.BanManager.AddTemporaryBan(pid, ban.Expires)
+	return nil
+}
+
+// LiftPeerBan removes the peer's temporary ban. Blocked peers stay blocked.
+func (n *OpenBazaarNode) LiftPeerBan(pid peer.ID) error {
+	if err := n.Datastore.PeerBans().Delete(pid.Pretty()); err != nil {
+		return err
+	}
+	n.BanManager.RemoveTemporaryBan(pid)
+	return nil
+}
+
+// GetPeerBans returns the peer bans which have not expired, newest first
+func (n *OpenBazaarNode) GetPeerBans() ([]repo.PeerBan, error) {
+	return n.Datastore.PeerBans().GetActive(time.Now())
+}
+
+// LoadPeerBans deletes the expired peer bans and restores the rest to the
+// BanManager. It is called on startup.
+func (n *OpenBazaarNode) LoadPeerBans() error {
+	now := time.Now()
+	if err := n.Datastore.PeerBans().DeleteExpired(now); err != nil {
+		return err
+	}
+	bans, err := n.Datastore.PeerBans().GetActive(now)
+	if err != nil {
+		return err
+	}
+	for _, ban := range bans {
+		pid, err := peer.IDB58Decode(ban.PeerID)
+		if err != nil {
+			log.Warningf("skipping ban on invalid peer ID (%s)", ban.PeerID)
+			continue
+		}
+		n.BanManager.AddTemporaryBan(pid, ban.Expires)
+	}
+	return nil
+}
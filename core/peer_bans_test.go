@@ -0,0 +1,67 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestOpenBazaarNode_PeerBans(t *testing.T) {
+	node := newBroadcastingNode(t)
+	pid, err := peer.IDB58Decode("QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := node.BanPeer(pid, "exceeded the chat message rate limit", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if !node.BanManager.IsBanned(pid) {
+		t.Error("expected peer to be banned")
+	}
+	bans, err := node.GetPeerBans()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 1 || bans[0].PeerID != pid.Pretty() || bans[0].Reason != "exceeded the chat message rate limit" {
+		t.Fatalf("unexpected bans: %+v", bans)
+	}
+
+	// Bans survive a restart but expired ones are dropped
+	expired := repo.PeerBan{PeerID: "QmZdFj1Ew6cbVo1uBFQyeFvTYV1YcvKrNrM2ZBhSg9GXpj", Created: time.Now().Add(-2 * time.Hour), Expires: time.Now().Add(-time.Hour)}
+	if err := node.Datastore.PeerBans().Put(expired); err != nil {
+		t.Fatal(err)
+	}
+	node.BanManager = net.NewBanManager(nil)
+	if err := node.LoadPeerBans(); err != nil {
+		t.Fatal(err)
+	}
+	if !node.BanManager.IsBanned(pid) {
+		t.Error("expected ban to be restored")
+	}
+	all, err := node.Datastore.PeerBans().GetActive(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("expected expired ban to be deleted, got %+v", all)
+	}
+
+	if err := node.LiftPeerBan(pid); err != nil {
+		t.Fatal(err)
+	}
+	if node.BanManager.IsBanned(pid) {
+		t.Error("expected ban to be lifted")
+	}
+	bans, err = node.GetPeerBans()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 0 {
+		t.Errorf("expected no bans, got %+v", bans)
+	}
+}
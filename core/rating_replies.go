@@ -0,0 +1,204 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var (
+	// ErrRatingNotFound - the rating could not be fetched
+	ErrRatingNotFound = errors.New("rating not found")
+
+	// ErrRatingReplyNotFound - the rating has no reply
+	ErrRatingReplyNotFound = errors.New("rating reply not found")
+)
+
+// ratingReplyFile returns the path of the reply to the rating with the rating
+// key relative to the root directory
+func ratingReplyFile(ratingKey []byte) string {
+	return path.Join("ratings", "replies", hex.EncodeToString(ratingKey)+".json")
+}
+
+// getOwnRating fetches the rating with the ID and checks it rates this node
+func (n *OpenBazaarNode) getOwnRating(ratingID string) (*pb.Rating, error) {
+	ratingBytes, err := ipfs.Cat(n.IpfsNode, ratingID, time.Minute)
+	if err != nil {
+		return nil, ErrRatingNotFound
+	}
+	rating := new(pb.Rating)
+	if err := jsonpb.UnmarshalString(string(ratingBytes), rating); err != nil {
+		return nil, err
+	}
+	if rating.RatingData == nil || rating.RatingData.VendorID == nil || rating.RatingData.VendorID.PeerID != n.IpfsNode.Identity.Pretty() {
+		return nil, errors.New("rating is not for this node")
+	}
+	return rating, nil
+}
+
+// ReplyToRating signs the vendor's reply to one of its ratings and saves it in
+// the root directory next to the ratings. Replying again replaces the
+// previous reply. The caller is expected to publish afterwards.
+func (n *OpenBazaarNode) ReplyToRating(ratingID, message string) (*pb.RatingReply, error) {
+	if message == "" {
+		return nil, errors.New("reply message is empty")
+	}
+	if len(message) > ReviewMaxCharacters {
+		return nil, fmt.Errorf("reply is longer than the max of %d characters", ReviewMaxCharacters)
+	}
+	rating, err := n.getOwnRating(ratingID)
+	if err != nil {
+		return nil, err
+	}
+	vendorID, err := n.GetNodeID()
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.RatingReply{
+		ReplyData: &pb.RatingReply_ReplyData{
+			RatingKey: rating.RatingData.RatingKey,
+			VendorID:  vendorID,
+			Message:   message,
+			Timestamp: ts,
+		},
+	}
+	ser, err := proto.Marshal(reply.ReplyData)
+	if err != nil {
+		return nil, err
+	}
+	reply.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	replyJSON, err := m.MarshalToString(reply)
+	if err != nil {
+		return nil, err
+	}
+	replyPath := path.Join(n.RepoPath, "root", ratingReplyFile(rating.RatingData.RatingKey))
+	if err := os.MkdirAll(path.Dir(replyPath), os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(replyPath, []byte(replyJSON), os.ModePerm); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// DeleteRatingReply withdraws the vendor's reply to one of its ratings. The
+// caller is expected to publish afterwards.
+func (n *OpenBazaarNode) DeleteRatingReply(ratingID string) error {
+	rating, err := n.getOwnRating(ratingID)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path.Join(n.RepoPath, "root", ratingReplyFile(rating.RatingData.RatingKey)))
+	if os.IsNotExist(err) {
+		return ErrRatingReplyNotFound
+	}
+	return err
+}
+
+// GetRatingReply fetches the vendor's reply to a rating which has already
+// been validated, from disk if the node is the vendor or from the vendor's
+// published root otherwise. Ratings without a reply return
+// ErrRatingReplyNotFound.
+func (n *OpenBazaarNode) GetRatingReply(rating *pb.Rating, useCache bool) (*pb.RatingReply, error) {
+	if rating.RatingData == nil || rating.RatingData.VendorID == nil {
+		return nil, errors.New("missing rating data")
+	}
+	var (
+		replyBytes []byte
+		err        error
+		vendorID   = rating.RatingData.VendorID.PeerID
+		replyFile  = ratingReplyFile(rating.RatingData.RatingKey)
+	)
+	if vendorID == n.IpfsNode.Identity.Pretty() {
+		replyBytes, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", replyFile))
+	} else {
+		replyBytes, err = ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(vendorID, replyFile)), time.Minute, n.IPNSQuorumSize, useCache)
+	}
+	if err != nil {
+		return nil, ErrRatingReplyNotFound
+	}
+	reply := new(pb.RatingReply)
+	if err := jsonpb.UnmarshalString(string(replyBytes), reply); err != nil {
+		return nil, err
+	}
+	if err := ValidateRatingReply(rating, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// AttachRatingReply sets the vendor's reply on the rating if it has a valid
+// one. Failing to fetch or validate the reply leaves the rating as it is.
+func (n *OpenBazaarNode) AttachRatingReply(rating *pb.Rating, useCache bool) {
+	rating.VendorReply = nil
+	reply, err := n.GetRatingReply(rating, useCache)
+	if err != nil {
+		if err != ErrRatingReplyNotFound {
+			log.Warningf("discarding reply to rating: %s", err.Error())
+		}
+		return
+	}
+	rating.VendorReply = reply
+}
+
+// ValidateRatingReply checks the reply was signed by the vendor of the rating
+// and refers to the rating's key. The rating itself must already be valid.
+func ValidateRatingReply(rating *pb.Rating, reply *pb.RatingReply) error {
+	if reply.ReplyData == nil || reply.ReplyData.VendorID == nil {
+		return errors.New("missing reply data")
+	}
+	if rating.RatingData == nil || rating.RatingData.VendorID == nil || rating.RatingData.VendorID.Pubkeys == nil {
+		return errors.New("missing rating data")
+	}
+	if !bytes.Equal(reply.ReplyData.RatingKey, rating.RatingData.RatingKey) {
+		return errors.New("reply is for a different rating")
+	}
+	if reply.ReplyData.VendorID.PeerID != rating.RatingData.VendorID.PeerID {
+		return errors.New("reply is not from the rated vendor")
+	}
+	if len(reply.ReplyData.Message) > ReviewMaxCharacters {
+		return errors.New("reply message is too long")
+	}
+	vendorKey, err := crypto.UnmarshalPublicKey(rating.RatingData.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	ser, err := proto.Marshal(reply.ReplyData)
+	if err != nil {
+		return err
+	}
+	valid, err := vendorKey.Verify(ser, reply.Signature)
+	if !valid || err != nil {
+		return errors.New("invalid vendor signature on reply")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// addRating saves the rating in the node's root directory and returns its ID
+func addRating(t *testing.T, node *core.OpenBazaarNode, name string, rating *pb.Rating) string {
+	ratingJSON, err := new(jsonpb.Marshaler).MarshalToString(rating)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ratingPath := path.Join(node.RepoPath, "root", "ratings", name+".json")
+	if err := ioutil.WriteFile(ratingPath, []byte(ratingJSON), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	id, err := ipfs.GetHashOfFile(node.IpfsNode, ratingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestOpenBazaarNode_RatingReplies(t *testing.T) {
+	node := newBroadcastingNode(t)
+	pubkey, err := node.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ratingKey := make([]byte, 33)
+	ratingKey[0] = 2
+	rating := &pb.Rating{
+		RatingData: &pb.Rating_RatingData{
+			RatingKey: ratingKey,
+			VendorID:  &pb.ID{PeerID: node.IpfsNode.Identity.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubkey}},
+			Overall:   1,
+			Review:    "Took a month to arrive",
+		},
+	}
+	ratingID := addRating(t, node, "reply-test", rating)
+	otherID := addRating(t, node, "reply-test-other", &pb.Rating{
+		RatingData: &pb.Rating_RatingData{
+			RatingKey: ratingKey,
+			VendorID:  &pb.ID{PeerID: "QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e"},
+		},
+	})
+	defer os.Remove(path.Join(node.RepoPath, "root", "ratings", "reply-test.json"))
+	defer os.Remove(path.Join(node.RepoPath, "root", "ratings", "reply-test-other.json"))
+	defer os.RemoveAll(path.Join(node.RepoPath, "root", "ratings", "replies"))
+
+	if _, err := node.ReplyToRating(ratingID, ""); err == nil {
+		t.Error("expected empty reply to be rejected")
+	}
+	if _, err := node.ReplyToRating(otherID, "Not my rating"); err == nil {
+		t.Error("expected reply to another vendor's rating to be rejected")
+	}
+	if _, err := node.GetRatingReply(rating, false); err != core.ErrRatingReplyNotFound {
+		t.Errorf("expected ErrRatingReplyNotFound, got %v", err)
+	}
+
+	if _, err := node.ReplyToRating(ratingID, "Sorry, the carrier lost it for three weeks"); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := node.ReplyToRating(ratingID, "Sorry, the carrier lost it for three weeks. We refunded shipping.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetched, err := node.GetRatingReply(rating, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(fetched, reply) {
+		t.Errorf("expected the edited reply, got %v", fetched)
+	}
+	node.AttachRatingReply(rating, false)
+	if !proto.Equal(rating.VendorReply, reply) {
+		t.Errorf("expected the reply to be attached, got %v", rating.VendorReply)
+	}
+
+	tampered := proto.Clone(reply).(*pb.RatingReply)
+	tampered.ReplyData.Message = "Buyer is lying"
+	if err := core.ValidateRatingReply(rating, tampered); err == nil {
+		t.Error("expected tampered reply to be rejected")
+	}
+	otherRating := proto.Clone(rating).(*pb.Rating)
+	otherRating.RatingData.RatingKey = append([]byte{3}, ratingKey[1:]...)
+	if err := core.ValidateRatingReply(otherRating, reply); err == nil {
+		t.Error("expected reply to another rating to be rejected")
+	}
+
+	if err := node.DeleteRatingReply(ratingID); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.DeleteRatingReply(ratingID); err != core.ErrRatingReplyNotFound {
+		t.Errorf("expected ErrRatingReplyNotFound, got %v", err)
+	}
+	node.AttachRatingReply(rating, false)
+	if rating.VendorReply != nil {
+		t.Error("expected the withdrawn reply to be detached")
+	}
+}
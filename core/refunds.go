@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"math/big"
 	"strings"
@@ -10,12 +11,177 @@ import (
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 )
 
+var (
+	// ErrRefundAmountInvalid is returned when a partial refund is for
+	// nothing or more than is left to refund
+	ErrRefundAmountInvalid = errors.New("refund amount must be greater than zero and no more than the unrefunded funds")
+
+	// ErrRefundAmountAndItems is returned when a partial refund gives both
+	// an amount and item quantities
+	ErrRefundAmountAndItems = errors.New("refund either an amount or item quantities, not both")
+
+	// ErrRefundFulfilledOrder is returned when a refund of a fulfilled order
+	// is not for less than is left to refund or the order was paid into
+	// escrow, which is committed to the vendor's payout once fulfilled
+	ErrRefundFulfilledOrder = errors.New("a fulfilled order can only be partially refunded if it was paid directly")
+
+	// ErrRefundPending is returned when an order paid into escrow is refunded
+	// again before the previous partial refund has been broadcast and confirmed
+	ErrRefundPending = errors.New("the previous partial refund must be confirmed before the order is refunded again")
+)
+
 // RefundOrder - refund buyer
 func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	return n.refundOrder(contract, records, nil, nil)
+}
+
+// PartialRefundOrder - refund part of a funded order to the buyer. The refund
+// is either an amount in the payment currency or quantities of the order's
+// items. The remainder stays with the vendor and the order remains active
+// unless the refund covers everything left to refund.
+func (n *OpenBazaarNode) PartialRefundOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord, amount *big.Int, items []*pb.Refund_Item) error {
+	if amount != nil && len(items) > 0 {
+		return ErrRefundAmountAndItems
+	}
+	if len(items) > 0 {
+		a, err := n.CalculateRefundAmount(contract, items)
+		if err != nil {
+			return err
+		}
+		amount = a
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return ErrRefundAmountInvalid
+	}
+	return n.refundOrder(contract, records, amount, items)
+}
+
+// CanRefund returns whether an order in the state may be refunded. Fulfilled
+// orders which were paid directly may only be partially refunded, for instance
+// to compensate the buyer for a damaged item. The escrow of a fulfilled
+// moderated order is released to the vendor in full so it can't be refunded.
+func CanRefund(state pb.OrderState, partial, moderated bool) bool {
+	switch state {
+	case pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_PARTIALLY_FULFILLED:
+		return true
+	case pb.OrderState_FULFILLED:
+		return partial && !moderated
+	}
+	return false
+}
+
+// AddRefund puts the refund in the vendor's refund message on the buyer's
+// copy of the order. Only the latest refund and its signature are kept.
+func AddRefund(contract, rc *pb.RicardianContract) {
+	contract.Refund = rc.Refund
+	contract.Signatures = removeSignatures(contract.Signatures, pb.Signature_REFUND)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_REFUND {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+}
+
+// CalculateRefundAmount returns the price in the payment currency of the
+// given quantities of the order's items. Shipping is not included.
+func (n *OpenBazaarNode) CalculateRefundAmount(contract *pb.RicardianContract, items []*pb.Refund_Item) (*big.Int, error) {
+	order, err := repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+	if err != nil {
+		return nil, err
+	}
+	total := big.NewInt(0)
+	for _, ri := range items {
+		if int(ri.Index) >= len(order.Items) {
+			return nil, fmt.Errorf("order has no item at index %d", ri.Index)
+		}
+		item := order.Items[ri.Index]
+		quantity, ok := new(big.Int).SetString(ri.BigQuantity, 10)
+		if !ok || quantity.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid quantity for item at index %d", ri.Index)
+		}
+		itemTotal, listing, err := n.calculateOrderItemTotal(contract, order, item)
+		if err != nil {
+			return nil, err
+		}
+		ordered := GetOrderQuantity(listing.GetProtobuf(), item)
+		if ordered.Sign() <= 0 {
+			ordered = big.NewInt(1)
+		}
+		if quantity.Cmp(ordered) > 0 {
+			return nil, fmt.Errorf("refund quantity for item at index %d is more than was ordered", ri.Index)
+		}
+		total.Add(total, new(big.Int).Div(new(big.Int).Mul(itemTotal, quantity), ordered))
+	}
+	return total, nil
+}
+
+// RefundOutputs returns the outputs of a moderated refund transaction which
+// spends escrowed funds worth total. The buyer receives amount, or everything
+// if amount is nil, and any remainder is paid back into escrow.
+func RefundOutputs(refundAddress, escrowAddress btcutil.Address, total, amount *big.Int) []wallet.TransactionOutput {
+	if amount == nil || amount.Cmp(total) >= 0 {
+		return []wallet.TransactionOutput{{Address: refundAddress, Value: *total}}
+	}
+	return []wallet.TransactionOutput{
+		{Address: refundAddress, Value: *amount},
+		{Address: escrowAddress, Value: *new(big.Int).Sub(total, amount)},
+	}
+}
+
+// refundedTotal returns the amount already refunded for the order
+func refundedTotal(contract *pb.RicardianContract) *big.Int {
+	if contract.Refund == nil || !contract.Refund.Partial {
+		return big.NewInt(0)
+	}
+	total, ok := new(big.Int).SetString(contract.Refund.BigTotal, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return total
+}
+
+// previousRefundSettled returns whether the order's last partial refund out of
+// escrow has been broadcast and the change it paid back into escrow has
+// confirmed. Each refund signs over every unspent escrowed output, so a refund
+// signed before then would spend the same outputs as the previous one.
+func previousRefundSettled(wal wallet.Wallet, contract *pb.RicardianContract, records []*wallet.TransactionRecord) (bool, error) {
+	if contract.Refund == nil || !contract.Refund.Partial || len(contract.Refund.Sigs) == 0 {
+		return true, nil
+	}
+	signed, err := ptypes.Timestamp(contract.Refund.Timestamp)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range records {
+		if r.Spent || r.Value.Cmp(big.NewInt(0)) <= 0 {
+			continue
+		}
+		// The output was signed over by the previous refund
+		if !r.Timestamp.After(signed) {
+			return false, nil
+		}
+		hash, err := chainhash.NewHashFromStr(strings.TrimPrefix(r.Txid, "0x"))
+		if err != nil {
+			return false, err
+		}
+		confirms, _, err := wal.GetConfirmations(*hash)
+		if err != nil {
+			return false, err
+		}
+		if confirms == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (n *OpenBazaarNode) refundOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord, amount *big.Int, items []*pb.Refund_Item) error {
 	refundMsg := new(pb.Refund)
 	orderID, err := n.CalcOrderID(contract.BuyerOrder)
 	if err != nil {
@@ -35,7 +201,23 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 	if err != nil {
 		return err
 	}
+	_, state, _, _, _, _, stateErr := n.Datastore.Sales().GetByOrderId(orderID)
+	fulfilled := stateErr == nil && state == pb.OrderState_FULFILLED
+	if fulfilled && (amount == nil || order.Payment.Method == pb.Order_Payment_MODERATED) {
+		return ErrRefundFulfilledOrder
+	}
+	var (
+		refunded *big.Int
+		partial  bool
+	)
 	if order.Payment.Method == pb.Order_Payment_MODERATED {
+		settled, err := previousRefundSettled(wal, contract, records)
+		if err != nil {
+			return err
+		}
+		if !settled {
+			return ErrRefundPending
+		}
 		var ins []wallet.TransactionInput
 		outValue := big.NewInt(0)
 		for _, r := range records {
@@ -49,15 +231,21 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 				ins = append(ins, in)
 			}
 		}
+		if amount != nil && amount.Cmp(outValue) > 0 {
+			return ErrRefundAmountInvalid
+		}
 
 		refundAddress, err := wal.DecodeAddress(order.RefundAddress)
 		if err != nil {
 			return err
 		}
-		output := wallet.TransactionOutput{
-			Address: refundAddress,
-			Value:   *outValue,
+		escrowAddress, err := wal.DecodeAddress(order.Payment.Address)
+		if err != nil {
+			return err
 		}
+		outputs := RefundOutputs(refundAddress, escrowAddress, outValue, amount)
+		refunded = &outputs[0].Value
+		partial = len(outputs) > 1
 
 		chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 		if err != nil {
@@ -76,7 +264,7 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 			return err
 		}
 		f, _ := new(big.Int).SetString(order.BigRefundFee, 10)
-		signatures, err := wal.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, vendorKey, redeemScript, *f)
+		signatures, err := wal.CreateMultisigSignature(ins, outputs, vendorKey, redeemScript, *f)
 		if err != nil {
 			return err
 		}
@@ -93,6 +281,18 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 				outValue = new(big.Int).Add(outValue, &r.Value)
 			}
 		}
+		// Earlier partial refunds were already paid to the buyer
+		outValue.Sub(outValue, refundedTotal(contract))
+		if amount != nil {
+			if amount.Cmp(outValue) > 0 {
+				return ErrRefundAmountInvalid
+			}
+			partial = amount.Cmp(outValue) < 0
+			if fulfilled && !partial {
+				return ErrRefundFulfilledOrder
+			}
+			outValue = amount
+		}
 		refundAddr, err := wal.DecodeAddress(order.RefundAddress)
 		if err != nil {
 			return err
@@ -106,7 +306,19 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 		txinfo.BigValue = outValue.String()
 		txinfo.ValueCurrency = contract.BuyerOrder.Payment.AmountCurrency
 		refundMsg.RefundTransaction = txinfo
+		refunded = outValue
 	}
+
+	// A refund of everything that is left is a full refund
+	refundMsg.Partial = partial
+	refundMsg.BigAmount = refunded.String()
+	refundMsg.BigTotal = new(big.Int).Add(refundedTotal(contract), refunded).String()
+	if refundMsg.Partial {
+		refundMsg.Items = items
+	}
+
+	// Only the latest refund is kept in the contract
+	contract.Signatures = removeSignatures(contract.Signatures, pb.Signature_REFUND)
 	contract.Refund = refundMsg
 	contract, err = n.SignRefund(contract)
 	if err != nil {
@@ -117,7 +329,17 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 		// TODO: do we retry a failed refund send?
 		log.Error(err)
 	}
-	err = n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_REFUNDED, true)
+	if refundMsg.Partial {
+		err = stateErr
+		if err == nil {
+			err = n.OrderStates().UpdateSale(orderID, *contract, state, true, "order partially refunded")
+		}
+		if err != nil {
+			log.Error(err)
+		}
+		return nil
+	}
+	err = n.OrderStates().UpdateSale(orderID, *contract, pb.OrderState_REFUNDED, true, "order refunded")
 	if err != nil {
 		log.Error(err)
 	}
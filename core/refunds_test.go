@@ -0,0 +1,299 @@
+package core_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// spendRecordingWallet records the spends made from the wallet instead of
+// broadcasting them
+type spendRecordingWallet struct {
+	wallet.Wallet
+	spends []wallet.TransactionOutput
+}
+
+func (w *spendRecordingWallet) Spend(amount big.Int, addr btcutil.Address, feeLevel wallet.FeeLevel, referenceID string, spendAll bool) (*chainhash.Hash, error) {
+	w.spends = append(w.spends, wallet.TransactionOutput{Address: addr, Value: amount})
+	return &chainhash.Hash{byte(len(w.spends))}, nil
+}
+
+func (w *spendRecordingWallet) GetTransaction(txid chainhash.Hash) (wallet.Txn, error) {
+	return wallet.Txn{Txid: txid.String()}, nil
+}
+
+// useSpendRecordingWallet replaces the node's bitcoin wallet with one which
+// records spends and returns it
+func useSpendRecordingWallet(node *core.OpenBazaarNode) *spendRecordingWallet {
+	w := &spendRecordingWallet{Wallet: node.Multiwallet[wallet.TestnetBitcoin]}
+	node.Multiwallet[wallet.TestnetBitcoin] = w
+	return w
+}
+
+func TestRefundOutputs(t *testing.T) {
+	refundAddress, err := btcutil.DecodeAddress("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	escrowAddress, err := btcutil.DecodeAddress("3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := big.NewInt(100000)
+
+	outputs := core.RefundOutputs(refundAddress, escrowAddress, total, nil)
+	if len(outputs) != 1 || outputs[0].Address != refundAddress || outputs[0].Value.Cmp(total) != 0 {
+		t.Errorf("expected a full refund to pay everything to the buyer, got %+v", outputs)
+	}
+
+	outputs = core.RefundOutputs(refundAddress, escrowAddress, total, big.NewInt(30000))
+	if len(outputs) != 2 {
+		t.Fatalf("expected a partial refund to have two outputs, got %d", len(outputs))
+	}
+	if outputs[0].Address != refundAddress || outputs[0].Value.Cmp(big.NewInt(30000)) != 0 {
+		t.Errorf("unexpected refund output: %+v", outputs[0])
+	}
+	if outputs[1].Address != escrowAddress || outputs[1].Value.Cmp(big.NewInt(70000)) != 0 {
+		t.Errorf("unexpected escrow output: %+v", outputs[1])
+	}
+
+	// Refunding everything that is left is a full refund
+	outputs = core.RefundOutputs(refundAddress, escrowAddress, total, total)
+	if len(outputs) != 1 {
+		t.Errorf("expected a refund of the whole escrow to have one output, got %d", len(outputs))
+	}
+}
+
+func TestOpenBazaarNode_PartialRefundOrderValidation(t *testing.T) {
+	node := newBroadcastingNode(t)
+	contract := factory.NewContract()
+	items := []*pb.Refund_Item{{Index: 0, BigQuantity: "1"}}
+
+	if err := node.PartialRefundOrder(contract, nil, big.NewInt(100), items); err != core.ErrRefundAmountAndItems {
+		t.Errorf("expected ErrRefundAmountAndItems, got %v", err)
+	}
+	if err := node.PartialRefundOrder(contract, nil, big.NewInt(0), nil); err != core.ErrRefundAmountInvalid {
+		t.Errorf("expected ErrRefundAmountInvalid for a zero amount, got %v", err)
+	}
+	if err := node.PartialRefundOrder(contract, nil, nil, nil); err != core.ErrRefundAmountInvalid {
+		t.Errorf("expected ErrRefundAmountInvalid without an amount, got %v", err)
+	}
+	if _, err := node.CalculateRefundAmount(contract, []*pb.Refund_Item{{Index: 5, BigQuantity: "1"}}); err == nil {
+		t.Error("expected an error refunding an item which is not in the order")
+	}
+}
+
+func TestCanRefund(t *testing.T) {
+	tests := []struct {
+		state     pb.OrderState
+		partial   bool
+		moderated bool
+		expected  bool
+	}{
+		{pb.OrderState_AWAITING_FULFILLMENT, false, true, true},
+		{pb.OrderState_PARTIALLY_FULFILLED, true, true, true},
+		{pb.OrderState_FULFILLED, true, false, true},
+		{pb.OrderState_FULFILLED, true, true, false},
+		{pb.OrderState_FULFILLED, false, false, false},
+		{pb.OrderState_COMPLETED, true, false, false},
+		{pb.OrderState_DISPUTED, true, true, false},
+	}
+	for _, test := range tests {
+		if refundable := core.CanRefund(test.state, test.partial, test.moderated); refundable != test.expected {
+			t.Errorf("expected refunding (partial %t, moderated %t) in %s to be %t", test.partial, test.moderated, test.state, test.expected)
+		}
+	}
+}
+
+func TestAddRefundKeepsLatestSignature(t *testing.T) {
+	node := newBroadcastingNode(t)
+	purchase := factory.NewContract()
+	var latest *pb.Signature
+	for _, amount := range []string{"3", "2"} {
+		rc := factory.NewContract()
+		rc.Refund = &pb.Refund{OrderID: "twoPartialRefunds", Partial: true, BigAmount: amount}
+		rc, err := node.SignRefund(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		latest = rc.Signatures[0]
+		core.AddRefund(purchase, rc)
+	}
+	if purchase.Refund.BigAmount != "2" {
+		t.Errorf("expected the latest refund, got %+v", purchase.Refund)
+	}
+	if len(purchase.Signatures) != 1 || purchase.Signatures[0] != latest {
+		t.Errorf("expected only the latest refund signature, got %d signatures", len(purchase.Signatures))
+	}
+}
+
+func TestOpenBazaarNode_PartialRefundFulfilledOrder(t *testing.T) {
+	node := newBroadcastingNode(t)
+	w := useSpendRecordingWallet(node)
+
+	// Refunds of a fulfilled direct payment are paid from the vendor's wallet
+	contract := factory.NewDisputeableContract()
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_DIRECT
+	contract.BuyerOrder.Payment.Moderator = ""
+	contract.BuyerOrder.RefundAddress = w.CurrentAddress(wallet.EXTERNAL).String()
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Sales().Put(orderID, *contract, pb.OrderState_FULFILLED, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete(orderID)
+	records := []*wallet.TransactionRecord{{Txid: chainhash.Hash{1}.String(), Value: *big.NewInt(10)}}
+
+	if err := node.RefundOrder(contract, records); err != core.ErrRefundFulfilledOrder {
+		t.Errorf("expected ErrRefundFulfilledOrder for a full refund, got %v", err)
+	}
+	if err := node.PartialRefundOrder(contract, records, big.NewInt(4), nil); err != nil {
+		t.Fatal(err)
+	}
+	// Refunding the 6 left would be a full refund
+	if err := node.PartialRefundOrder(contract, records, big.NewInt(6), nil); err != core.ErrRefundFulfilledOrder {
+		t.Errorf("expected ErrRefundFulfilledOrder refunding everything left, got %v", err)
+	}
+	if err := node.PartialRefundOrder(contract, records, big.NewInt(5), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.spends) != 2 || w.spends[0].Value.Cmp(big.NewInt(4)) != 0 || w.spends[1].Value.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("expected refunds of 4 and 5 from the wallet, got %+v", w.spends)
+	}
+	sale, state, _, _, _, _, err := node.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != pb.OrderState_FULFILLED {
+		t.Errorf("expected the order to stay FULFILLED, got %s", state)
+	}
+	if !sale.Refund.Partial || sale.Refund.BigAmount != "5" || sale.Refund.BigTotal != "9" || sale.Refund.RefundTransaction == nil {
+		t.Errorf("unexpected refund: %+v", sale.Refund)
+	}
+}
+
+func TestOpenBazaarNode_PartialRefundFulfilledModeratedOrder(t *testing.T) {
+	node := newBroadcastingNode(t)
+	w := useSpendRecordingWallet(node)
+
+	// The escrow of a fulfilled moderated order is released to the vendor in
+	// full so a refund paid from the vendor's wallet would be paid twice
+	contract := factory.NewDisputeableContract()
+	contract.BuyerOrder.RefundAddress = w.CurrentAddress(wallet.EXTERNAL).String()
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Sales().Put(orderID, *contract, pb.OrderState_FULFILLED, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete(orderID)
+
+	if err := node.PartialRefundOrder(contract, nil, big.NewInt(4), nil); err != core.ErrRefundFulfilledOrder {
+		t.Errorf("expected ErrRefundFulfilledOrder, got %v", err)
+	}
+	if len(w.spends) != 0 {
+		t.Errorf("expected nothing to be paid from the wallet, got %+v", w.spends)
+	}
+}
+
+// escrowSigningWallet records the outputs of the escrow transactions signed
+// by the vendor and reports the confirmations set by the test
+type escrowSigningWallet struct {
+	wallet.Wallet
+	confirms map[string]uint32
+	signed   [][]wallet.TransactionOutput
+}
+
+func (w *escrowSigningWallet) ChildKey(keyBytes []byte, chaincode []byte, isPrivateKey bool) (*hdkeychain.ExtendedKey, error) {
+	return nil, nil
+}
+
+func (w *escrowSigningWallet) CreateMultisigSignature(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, key *hdkeychain.ExtendedKey, redeemScript []byte, feePerByte big.Int) ([]wallet.Signature, error) {
+	w.signed = append(w.signed, outs)
+	sigs := make([]wallet.Signature, 0, len(ins))
+	for i := range ins {
+		sigs = append(sigs, wallet.Signature{InputIndex: uint32(i), Signature: []byte{byte(len(w.signed))}})
+	}
+	return sigs, nil
+}
+
+func (w *escrowSigningWallet) GetConfirmations(txid chainhash.Hash) (uint32, uint32, error) {
+	return w.confirms[txid.String()], 0, nil
+}
+
+func TestOpenBazaarNode_SuccessivePartialRefundsFromEscrow(t *testing.T) {
+	node := newBroadcastingNode(t)
+	w := &escrowSigningWallet{Wallet: node.Multiwallet[wallet.TestnetBitcoin], confirms: make(map[string]uint32)}
+	node.Multiwallet[wallet.TestnetBitcoin] = w
+
+	contract := factory.NewDisputeableContract()
+	contract.BuyerOrder.RefundAddress = w.CurrentAddress(wallet.EXTERNAL).String()
+	contract.BuyerOrder.Payment.Address = w.CurrentAddress(wallet.INTERNAL).String()
+	contract.BuyerOrder.BigRefundFee = "1"
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Sales().Put(orderID, *contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Sales().Delete(orderID)
+
+	funding := &wallet.TransactionRecord{Txid: chainhash.Hash{1}.String(), Value: *big.NewInt(10), Timestamp: time.Now().Add(-time.Hour)}
+	w.confirms[funding.Txid] = 6
+	if err := node.PartialRefundOrder(contract, []*wallet.TransactionRecord{funding}, big.NewInt(4), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Until the first refund is broadcast the second would spend the same
+	// escrowed output
+	if err := node.PartialRefundOrder(contract, []*wallet.TransactionRecord{funding}, big.NewInt(3), nil); err != core.ErrRefundPending {
+		t.Errorf("expected ErrRefundPending before the first refund is broadcast, got %v", err)
+	}
+
+	// Once broadcast the change is paid back into escrow but is unconfirmed
+	funding.Spent = true
+	change := &wallet.TransactionRecord{Txid: chainhash.Hash{2}.String(), Value: *big.NewInt(6), Timestamp: time.Now().Add(time.Minute)}
+	records := []*wallet.TransactionRecord{funding, change}
+	if err := node.PartialRefundOrder(contract, records, big.NewInt(3), nil); err != core.ErrRefundPending {
+		t.Errorf("expected ErrRefundPending before the first refund confirms, got %v", err)
+	}
+
+	w.confirms[change.Txid] = 1
+	if err := node.PartialRefundOrder(contract, records, big.NewInt(3), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.signed) != 2 {
+		t.Fatalf("expected two refunds to be signed, got %d", len(w.signed))
+	}
+	for i, expected := range [][]int64{{4, 6}, {3, 3}} {
+		outs := w.signed[i]
+		if len(outs) != 2 || outs[0].Value.Cmp(big.NewInt(expected[0])) != 0 || outs[1].Value.Cmp(big.NewInt(expected[1])) != 0 {
+			t.Errorf("expected refund %d to pay %d to the buyer and %d back into escrow, got %+v", i+1, expected[0], expected[1], outs)
+		}
+	}
+	sale, state, _, _, _, _, err := node.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT {
+		t.Errorf("expected the order to stay AWAITING_FULFILLMENT, got %s", state)
+	}
+	if !sale.Refund.Partial || sale.Refund.BigAmount != "3" || sale.Refund.BigTotal != "7" {
+		t.Errorf("unexpected refund: %+v", sale.Refund)
+	}
+}
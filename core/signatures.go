@@ -97,6 +97,17 @@ func selectSignature(signatures []*pb.Signature, sigType pb.Signature_Section) (
 	return sig, err
 }
 
+// removeSignatures returns the signatures which are not for the section
+func removeSignatures(signatures []*pb.Signature, sigType pb.Signature_Section) []*pb.Signature {
+	var kept []*pb.Signature
+	for _, s := range signatures {
+		if s.Section != sigType {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
 // SignPayload produces a signature for the given private key and payload
 // and returns it and the public key or an error
 func SignPayload(payload []byte, privKey crypto.PrivKey) ([]byte, []byte, error) {
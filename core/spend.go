@@ -90,18 +90,7 @@ func (n *OpenBazaarNode) Spend(args *SpendRequest) (*SpendResponse, error) {
 		return nil, ErrOrderNotFound
 	}
 
-	switch strings.ToUpper(args.FeeLevel) {
-	case "PRIORITY":
-		feeLevel = wallet.PRIORITY
-	case "NORMAL":
-		feeLevel = wallet.NORMAL
-	case "ECONOMIC":
-		feeLevel = wallet.ECONOMIC
-	case "SUPER_ECONOMIC":
-		feeLevel = wallet.SUPER_ECONOMIC
-	default:
-		feeLevel = wallet.ECONOMIC
-	}
+	feeLevel = parseFeeLevel(args.FeeLevel)
 
 	txid, err := wal.Spend(*amt, addr, feeLevel, args.OrderID, args.SpendAll)
 	if err != nil {
@@ -175,6 +164,21 @@ func (n *OpenBazaarNode) Spend(args *SpendRequest) (*SpendResponse, error) {
 	}, nil
 }
 
+// parseFeeLevel returns the wallet fee level named by s, defaulting to
+// ECONOMIC
+func parseFeeLevel(s string) wallet.FeeLevel {
+	switch strings.ToUpper(s) {
+	case "PRIORITY":
+		return wallet.PRIORITY
+	case "NORMAL":
+		return wallet.NORMAL
+	case "SUPER_ECONOMIC":
+		return wallet.SUPER_ECONOMIC
+	default:
+		return wallet.ECONOMIC
+	}
+}
+
 func (n *OpenBazaarNode) getOrderContractBySpendRequest(args *SpendRequest) (*pb.RicardianContract, error) {
 	var errorStr = "unable to find order from order id or spend address"
 	if args.OrderID != "" {
@@ -0,0 +1,405 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/op/go-logging"
+)
+
+const (
+	// MaxSubscriptionRenewalFailures is the number of renewals in a row which
+	// may fail before a subscription is paused
+	MaxSubscriptionRenewalFailures = 3
+
+	// SubscriptionRetryDelay is how long a subscription waits after a failed
+	// renewal before it is tried again
+	SubscriptionRetryDelay = time.Duration(24) * time.Hour
+
+	subscriptionRenewerInterval = time.Duration(1) * time.Hour
+)
+
+var (
+	// ErrSubscriptionItems is returned when a subscription isn't for exactly
+	// one item
+	ErrSubscriptionItems = errors.New("a subscription must be for exactly one item")
+	// ErrNotSubscriptionListing is returned when subscribing to a listing
+	// without a billing period
+	ErrNotSubscriptionListing = errors.New("listing is not sold as a subscription")
+	// ErrSubscriptionSpendCap is returned when the approved spend cap is
+	// missing or invalid
+	ErrSubscriptionSpendCap = errors.New("spendCap must be a positive whole number in the base units of the payment coin")
+	// ErrSubscriptionSpendCapExceeded is returned when a renewal would cost
+	// more than the buyer approved
+	ErrSubscriptionSpendCapExceeded = errors.New("order total exceeds the approved spend cap")
+	// ErrSubscriptionCanceled is returned when changing a canceled subscription
+	ErrSubscriptionCanceled = errors.New("subscription is canceled")
+	// ErrSubscriptionPeriodChanged is returned when renewing a subscription
+	// whose listing is no longer sold with the same billing period
+	ErrSubscriptionPeriodChanged = errors.New("listing is no longer sold with the subscription's billing period")
+)
+
+// SubscriptionRenewalResult counts the subscriptions handled by a pass of
+// the subscription renewer
+type SubscriptionRenewalResult struct {
+	Renewed int
+	Failed  int
+}
+
+// Subscribe places and pays the first order for a subscription to a service
+// listing with a billing period and saves the subscription so the order is
+// placed again each period. Renewals are only paid while the order total is
+// within spendCap. Nothing is saved if the first order can't be placed.
+func (n *OpenBazaarNode) Subscribe(data *repo.PurchaseData, spendCap, feeLevel string) (*repo.Subscription, error) {
+	if len(data.Items) != 1 {
+		return nil, ErrSubscriptionItems
+	}
+	if limit, ok := new(big.Int).SetString(spendCap, 10); !ok || limit.Sign() <= 0 {
+		return nil, ErrSubscriptionSpendCap
+	}
+	sl, err := n.fetchSignedListing(data.Items[0].ListingHash)
+	if err != nil {
+		return nil, fmt.Errorf("reading listing (%s): %s", data.Items[0].ListingHash, err.Error())
+	}
+	period := sl.GetListing().GetBillingPeriod()
+	if sl.GetListing().GetContractType() != pb.Listing_Metadata_SERVICE.String() || period == pb.Listing_Metadata_NONE {
+		return nil, ErrNotSubscriptionListing
+	}
+	vendorID, err := sl.GetVendorID().Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	subscription := repo.Subscription{
+		ID:          hex.EncodeToString(id),
+		VendorID:    vendorID,
+		ListingHash: data.Items[0].ListingHash,
+		Title:       sl.GetTitle(),
+		Period:      period,
+		Purchase:    *data,
+		SpendCap:    spendCap,
+		FeeLevel:    feeLevel,
+		State:       repo.SubscriptionStateActive,
+		Created:     now,
+	}
+	orderID, _, err := n.placeSubscriptionOrder(subscription)
+	if err != nil {
+		if orderID != "" {
+			return nil, fmt.Errorf("order (%s) was placed but not paid: %s", orderID, err.Error())
+		}
+		return nil, err
+	}
+	subscription.LastOrderID = orderID
+	subscription.NextRenewal = repo.NextBillingDate(now, period)
+	if err := n.Datastore.Subscriptions().Put(subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// placeSubscriptionOrder places an order with the subscription's purchase
+// data and pays it from the wallet. The order ID is returned whenever the
+// order was placed, even if it could not be paid.
+func (n *OpenBazaarNode) placeSubscriptionOrder(subscription repo.Subscription) (string, *repo.CurrencyValue, error) {
+	limit, ok := new(big.Int).SetString(subscription.SpendCap, 10)
+	if !ok {
+		return "", nil, ErrSubscriptionSpendCap
+	}
+	purchase := subscription.Purchase
+	estimate, err := n.EstimateOrderTotal(&purchase)
+	if err != nil {
+		return "", nil, err
+	}
+	if estimate.Cmp(limit) > 0 {
+		return "", nil, ErrSubscriptionSpendCapExceeded
+	}
+	orderID, paymentAddr, amount, _, err := n.Purchase(&purchase)
+	if err != nil {
+		return "", nil, err
+	}
+	if amount.Amount.Cmp(limit) > 0 {
+		return orderID, amount, ErrSubscriptionSpendCapExceeded
+	}
+	if _, err := n.fundOrder(orderID, paymentAddr, amount, subscription.FeeLevel); err != nil {
+		return orderID, amount, err
+	}
+	return orderID, amount, nil
+}
+
+// renewSubscription pays the subscription's last order if it was placed but
+// never paid. Otherwise it places and pays the next order for the vendor's
+// current version of the listing.
+func (n *OpenBazaarNode) renewSubscription(subscription *repo.Subscription) (string, *repo.CurrencyValue, error) {
+	if subscription.LastOrderID != "" {
+		contract, state, funded, records, _, _, err := n.Datastore.Purchases().GetByOrderId(subscription.LastOrderID)
+		if err == nil && state == pb.OrderState_AWAITING_PAYMENT && !funded && len(records) == 0 {
+			amount, err := n.fundSubscriptionOrder(*subscription, contract)
+			return subscription.LastOrderID, amount, err
+		}
+	}
+	if err := n.updateSubscriptionListing(subscription); err != nil {
+		return "", nil, err
+	}
+	return n.placeSubscriptionOrder(*subscription)
+}
+
+// fundSubscriptionOrder pays an order which was placed for the subscription
+func (n *OpenBazaarNode) fundSubscriptionOrder(subscription repo.Subscription, contract *pb.RicardianContract) (*repo.CurrencyValue, error) {
+	limit, ok := new(big.Int).SetString(subscription.SpendCap, 10)
+	if !ok {
+		return nil, ErrSubscriptionSpendCap
+	}
+	order, err := repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+	if err != nil {
+		return nil, err
+	}
+	total, ok := new(big.Int).SetString(order.Payment.BigAmount, 10)
+	if !ok {
+		return nil, errors.New("invalid order amount")
+	}
+	currency, err := n.LookupCurrency(order.Payment.AmountCurrency.Code)
+	if err != nil {
+		return nil, err
+	}
+	currency.Divisibility = uint(order.Payment.AmountCurrency.Divisibility)
+	amount := &repo.CurrencyValue{Amount: total, Currency: currency}
+	if total.Cmp(limit) > 0 {
+		return amount, ErrSubscriptionSpendCapExceeded
+	}
+	if _, err := n.fundOrder(subscription.LastOrderID, order.Payment.Address, amount, subscription.FeeLevel); err != nil {
+		return amount, err
+	}
+	return amount, nil
+}
+
+// updateSubscriptionListing points the subscription at the vendor's current
+// version of the listing, which changes whenever the vendor edits it. The
+// listing must still be sold with the subscription's billing period.
+func (n *OpenBazaarNode) updateSubscriptionListing(subscription *repo.Subscription) error {
+	if len(subscription.Purchase.Items) != 1 {
+		return ErrSubscriptionItems
+	}
+	slug, err := n.subscriptionListingSlug(*subscription)
+	if err != nil {
+		return fmt.Errorf("finding subscribed listing: %s", err.Error())
+	}
+	var b []byte
+	if subscription.VendorID == n.IPFSIdentityString() {
+		b, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "listings", slug+".json"))
+	} else {
+		b, err = ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(subscription.VendorID, "listings", slug+".json")), time.Minute, n.IPNSQuorumSize, false)
+	}
+	if err != nil {
+		return fmt.Errorf("fetching listing (%s): %s", slug, err.Error())
+	}
+	hash, err := ipfs.GetHash(n.IpfsNode, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	sl, err := repo.UnmarshalJSONSignedListing(b)
+	if err != nil {
+		return err
+	}
+	if sl.GetListing().GetContractType() != pb.Listing_Metadata_SERVICE.String() || sl.GetListing().GetBillingPeriod() != subscription.Period {
+		return ErrSubscriptionPeriodChanged
+	}
+	subscription.ListingHash = hash
+	subscription.Title = sl.GetTitle()
+	subscription.Purchase.Items = []repo.Item{subscription.Purchase.Items[0]}
+	subscription.Purchase.Items[0].ListingHash = hash
+	return nil
+}
+
+// subscriptionListingSlug returns the slug of the subscribed listing, which
+// is read from the last order or else from the listing first subscribed to
+func (n *OpenBazaarNode) subscriptionListingSlug(subscription repo.Subscription) (string, error) {
+	if subscription.LastOrderID != "" {
+		contract, _, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(subscription.LastOrderID)
+		if err == nil && len(contract.VendorListings) > 0 && contract.VendorListings[0].Slug != "" {
+			return contract.VendorListings[0].Slug, nil
+		}
+	}
+	sl, err := n.fetchSignedListing(subscription.ListingHash)
+	if err != nil {
+		return "", err
+	}
+	return sl.GetSlug(), nil
+}
+
+// RenewDueSubscriptions places the next order for each active subscription
+// whose renewal date has passed. If the last order was placed but couldn't be
+// paid, paying it is retried instead of placing another one. A failed renewal is retried after
+// SubscriptionRetryDelay and the subscription is paused once
+// MaxSubscriptionRenewalFailures renewals in a row have failed. A
+// notification is emitted for each renewal.
+func (n *OpenBazaarNode) RenewDueSubscriptions(now time.Time) (SubscriptionRenewalResult, error) {
+	var result SubscriptionRenewalResult
+
+	due, err := n.Datastore.Subscriptions().GetDue(now)
+	if err != nil {
+		return result, fmt.Errorf("getting due subscriptions: %s", err.Error())
+	}
+	for _, subscription := range due {
+		orderID, amount, err := n.renewSubscription(&subscription)
+		notif := repo.SubscriptionNotification{
+			ID:             repo.NewNotificationID(),
+			Type:           repo.NotifierTypeSubscriptionRenewed,
+			SubscriptionID: subscription.ID,
+			OrderID:        orderID,
+			VendorID:       subscription.VendorID,
+			Title:          subscription.Title,
+		}
+		if amount != nil {
+			notif.Amount = amount.Amount.String()
+		}
+		if err != nil {
+			log.Warningf("renewing subscription (%s): %s", subscription.ID, err.Error())
+			// An order which was placed but not paid is paid on the next
+			// attempt rather than placing another one
+			if orderID != "" {
+				subscription.LastOrderID = orderID
+			}
+			subscription.Failures++
+			subscription.NextRenewal = now.Add(SubscriptionRetryDelay)
+			if subscription.Failures >= MaxSubscriptionRenewalFailures {
+				subscription.State = repo.SubscriptionStatePaused
+			}
+			notif.Type = repo.NotifierTypeSubscriptionRenewalFailed
+			notif.Reason = err.Error()
+			result.Failed++
+		} else {
+			subscription.Failures = 0
+			subscription.LastOrderID = orderID
+			next := repo.NextBillingDate(subscription.NextRenewal, subscription.Period)
+			for !next.After(now) {
+				next = repo.NextBillingDate(next, subscription.Period)
+			}
+			subscription.NextRenewal = next
+			result.Renewed++
+		}
+		if err := n.Datastore.Subscriptions().Put(subscription); err != nil {
+			return result, err
+		}
+		notif.NextRenewal = subscription.NextRenewal
+		n.notifySubscription(notif, now)
+	}
+	return result, nil
+}
+
+func (n *OpenBazaarNode) notifySubscription(notif repo.SubscriptionNotification, now time.Time) {
+	n.Broadcast <- notif
+	if err := n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, now, false)); err != nil {
+		log.Errorf("persisting subscription notification: %s", err.Error())
+	}
+}
+
+// PauseSubscription stops a subscription from being renewed until it is
+// resumed
+func (n *OpenBazaarNode) PauseSubscription(id string) (*repo.Subscription, error) {
+	return n.updateSubscription(id, func(s *repo.Subscription) {
+		s.State = repo.SubscriptionStatePaused
+	})
+}
+
+// ResumeSubscription renews a paused subscription again. One whose renewal
+// date passed while it was paused is renewed on the next pass of the
+// renewer.
+func (n *OpenBazaarNode) ResumeSubscription(id string) (*repo.Subscription, error) {
+	return n.updateSubscription(id, func(s *repo.Subscription) {
+		s.State = repo.SubscriptionStateActive
+		s.Failures = 0
+	})
+}
+
+// CancelSubscription stops a subscription from ever being renewed again.
+// Orders which were already placed are not affected.
+func (n *OpenBazaarNode) CancelSubscription(id string) (*repo.Subscription, error) {
+	return n.updateSubscription(id, func(s *repo.Subscription) {
+		s.State = repo.SubscriptionStateCanceled
+	})
+}
+
+func (n *OpenBazaarNode) updateSubscription(id string, update func(*repo.Subscription)) (*repo.Subscription, error) {
+	subscription, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.State == repo.SubscriptionStateCanceled {
+		return nil, ErrSubscriptionCanceled
+	}
+	update(subscription)
+	if err := n.Datastore.Subscriptions().Put(*subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+type subscriptionRenewer struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartSubscriptionRenewer - start the worker which places the orders of
+// subscriptions due for renewal
+func (n *OpenBazaarNode) StartSubscriptionRenewer() {
+	n.SubscriptionRenewer = &subscriptionRenewer{
+		node:          n,
+		intervalDelay: subscriptionRenewerInterval,
+		logger:        logging.MustGetLogger("subscriptionRenewer"),
+	}
+	go n.SubscriptionRenewer.Run()
+}
+
+func (renewer *subscriptionRenewer) Run() {
+	renewer.watchdogTimer = time.NewTicker(renewer.intervalDelay)
+	renewer.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	renewer.PerformTask()
+	for {
+		select {
+		case <-renewer.watchdogTimer.C:
+			renewer.PerformTask()
+		case <-renewer.stopWorker:
+			renewer.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (renewer *subscriptionRenewer) Stop() {
+	renewer.stopWorker <- true
+	close(renewer.stopWorker)
+}
+
+func (renewer *subscriptionRenewer) PerformTask() {
+	result, err := renewer.node.RenewDueSubscriptions(time.Now())
+	if err != nil {
+		renewer.logger.Errorf("renewing subscriptions: %s", err)
+		return
+	}
+	renewer.logger.Debugf("subscriptions renewed/failed: %d/%d", result.Renewed, result.Failed)
+}
@@ -0,0 +1,349 @@
+package core_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+func TestOpenBazaarNode_SubscribeValidation(t *testing.T) {
+	node := newBroadcastingNode(t)
+
+	listing, err := repo.NewListingFromProtobuf(factory.NewListing("one-off"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := listing.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.CreateListing(lb); err != nil {
+		t.Fatal(err)
+	}
+	b, err := node.GetListings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var index []repo.ListingIndexData
+	if err := json.Unmarshal(b, &index); err != nil {
+		t.Fatal(err)
+	}
+	item := repo.Item{ListingHash: index[0].Hash, Quantity: "1"}
+
+	examples := []struct {
+		items    []repo.Item
+		spendCap string
+		expected error
+	}{
+		{items: nil, spendCap: "1000", expected: core.ErrSubscriptionItems},
+		{items: []repo.Item{item, item}, spendCap: "1000", expected: core.ErrSubscriptionItems},
+		{items: []repo.Item{item}, spendCap: "", expected: core.ErrSubscriptionSpendCap},
+		{items: []repo.Item{item}, spendCap: "0", expected: core.ErrSubscriptionSpendCap},
+		{items: []repo.Item{item}, spendCap: "1000", expected: core.ErrNotSubscriptionListing},
+	}
+	for _, e := range examples {
+		data := &repo.PurchaseData{PaymentCoin: "TBTC", Items: e.items}
+		if _, err := node.Subscribe(data, e.spendCap, ""); err != e.expected {
+			t.Errorf("expected %v, got %v", e.expected, err)
+		}
+	}
+}
+
+func TestOpenBazaarNode_RenewDueSubscriptions(t *testing.T) {
+	node := newBroadcastingNode(t)
+	now := time.Now()
+
+	subscription := repo.Subscription{
+		ID:          "hosting",
+		ListingHash: "QmMissingListing",
+		Title:       "Hosting",
+		Period:      pb.Listing_Metadata_MONTHLY,
+		Purchase: repo.PurchaseData{
+			PaymentCoin: "TBTC",
+			Items:       []repo.Item{{ListingHash: "QmMissingListing", Quantity: "1"}},
+		},
+		SpendCap:    "100000",
+		State:       repo.SubscriptionStateActive,
+		NextRenewal: now.Add(-time.Minute),
+		Created:     now.Add(-time.Hour),
+	}
+	if err := node.Datastore.Subscriptions().Put(subscription); err != nil {
+		t.Fatal(err)
+	}
+
+	// Failed renewals are retried until the subscription is paused
+	renewAt := now
+	for i := 1; i <= core.MaxSubscriptionRenewalFailures; i++ {
+		result, err := node.RenewDueSubscriptions(renewAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Failed != 1 || result.Renewed != 0 {
+			t.Fatalf("expected one failed renewal, got %+v", result)
+		}
+		s, err := node.Datastore.Subscriptions().Get("hosting")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Failures != i {
+			t.Errorf("expected %d failures, got %d", i, s.Failures)
+		}
+		if result, _ := node.RenewDueSubscriptions(renewAt); result.Failed != 0 {
+			t.Error("expected a failed renewal to wait before it is retried")
+		}
+		renewAt = renewAt.Add(core.SubscriptionRetryDelay)
+	}
+	s, err := node.Datastore.Subscriptions().Get("hosting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.State != repo.SubscriptionStatePaused {
+		t.Errorf("expected subscription to be paused, got %s", s.State)
+	}
+
+	notifs, _, err := node.Datastore.Notifications().GetAll("", -1, []string{string(repo.NotifierTypeSubscriptionRenewalFailed)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifs) != core.MaxSubscriptionRenewalFailures {
+		t.Fatalf("expected %d failed renewal notifications, got %d", core.MaxSubscriptionRenewalFailures, len(notifs))
+	}
+	n, ok := notifs[0].NotifierData.(repo.SubscriptionNotification)
+	if !ok {
+		t.Fatalf("unexpected notification: %T", notifs[0].NotifierData)
+	}
+	if n.SubscriptionID != "hosting" || n.Title != "Hosting" || n.Reason == "" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+func TestOpenBazaarNode_PauseResumeCancelSubscription(t *testing.T) {
+	node := newBroadcastingNode(t)
+	now := time.Now()
+
+	if _, err := node.PauseSubscription("missing"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	if err := node.Datastore.Subscriptions().Put(repo.Subscription{
+		ID:          "support",
+		ListingHash: "QmSupport",
+		Period:      pb.Listing_Metadata_WEEKLY,
+		SpendCap:    "5000",
+		State:       repo.SubscriptionStateActive,
+		NextRenewal: now.Add(-time.Minute),
+		Failures:    2,
+		Created:     now,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := node.PauseSubscription("support")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.State != repo.SubscriptionStatePaused {
+		t.Errorf("expected paused subscription, got %s", s.State)
+	}
+	if result, _ := node.RenewDueSubscriptions(now); result.Failed+result.Renewed != 0 {
+		t.Errorf("expected paused subscription not to be renewed, got %+v", result)
+	}
+
+	s, err = node.ResumeSubscription("support")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.State != repo.SubscriptionStateActive || s.Failures != 0 {
+		t.Errorf("unexpected resumed subscription: %+v", s)
+	}
+
+	if _, err := node.CancelSubscription("support"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.ResumeSubscription("support"); err != core.ErrSubscriptionCanceled {
+		t.Errorf("expected ErrSubscriptionCanceled, got %v", err)
+	}
+}
+
+func TestOpenBazaarNode_RenewSubscriptionPaysUnpaidOrder(t *testing.T) {
+	node := newBroadcastingNode(t)
+	w := useSpendRecordingWallet(node)
+	now := time.Now()
+
+	// The last renewal placed the order but couldn't pay it
+	contract := factory.NewContract()
+	contract.BuyerOrder.Payment.Address = w.CurrentAddress(wallet.EXTERNAL).String()
+	orderID, err := node.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Purchases().Delete(orderID)
+	subscription := repo.Subscription{
+		ID:          "unpaidRenewal",
+		ListingHash: "QmMissingListing",
+		Period:      pb.Listing_Metadata_MONTHLY,
+		Purchase: repo.PurchaseData{
+			PaymentCoin: "TBTC",
+			Items:       []repo.Item{{ListingHash: "QmMissingListing", Quantity: "1"}},
+		},
+		SpendCap:    "100",
+		State:       repo.SubscriptionStateActive,
+		NextRenewal: now.Add(-time.Minute),
+		LastOrderID: orderID,
+		Failures:    1,
+		Created:     now.Add(-time.Hour),
+	}
+	if err := node.Datastore.Subscriptions().Put(subscription); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Subscriptions().Delete(subscription.ID)
+
+	result, err := node.RenewDueSubscriptions(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Renewed != 1 {
+		t.Fatalf("expected the unpaid order to be paid, got %+v", result)
+	}
+	if len(w.spends) != 1 || w.spends[0].Address.String() != contract.BuyerOrder.Payment.Address || w.spends[0].Value.Cmp(big.NewInt(10)) != 0 {
+		t.Errorf("expected the order's amount to be paid to its address, got %+v", w.spends)
+	}
+	s, err := node.Datastore.Subscriptions().Get(subscription.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.LastOrderID != orderID || s.Failures != 0 {
+		t.Errorf("expected no new order to be placed, got %+v", s)
+	}
+}
+
+func TestOpenBazaarNode_RenewSubscriptionFollowsListingEdits(t *testing.T) {
+	node := newBroadcastingNode(t)
+	now := time.Now()
+	// The test node's identity isn't derived from its private key so it's
+	// replaced with one which verifies against the signed listing
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeID := node.IpfsNode.Identity
+	node.IpfsNode.Identity = pid
+	defer func() { node.IpfsNode.Identity = nodeID }()
+
+	pbListing := factory.NewListing("renewal-hosting")
+	pbListing.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+	pbListing.Metadata.Subscription = &pb.Listing_Metadata_Subscription{BillingPeriod: pb.Listing_Metadata_MONTHLY}
+	pbListing.ShippingOptions = nil
+	pbListing.Item.Options = nil
+	pbListing.Item.Skus = []*pb.Listing_Item_Sku{{BigQuantity: "100", ProductID: "1"}}
+	saveListing := func(save func([]byte) error) string {
+		listing, err := repo.NewListingFromProtobuf(pbListing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lb, err := listing.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := save(lb); err != nil {
+			t.Fatal(err)
+		}
+		b, err := node.GetListings()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var index []repo.ListingIndexData
+		if err := json.Unmarshal(b, &index); err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range index {
+			if l.Slug == pbListing.Slug {
+				return l.Hash
+			}
+		}
+		t.Fatal("listing is not in the index")
+		return ""
+	}
+	createListing := func(lb []byte) error {
+		_, err := node.CreateListing(lb)
+		return err
+	}
+	updateListing := func(lb []byte) error { return node.UpdateListing(lb, false) }
+	subscribed := saveListing(createListing)
+	defer node.DeleteListing(pbListing.Slug)
+
+	subscription := repo.Subscription{
+		ID:          "editedListing",
+		VendorID:    node.IPFSIdentityString(),
+		ListingHash: subscribed,
+		Period:      pb.Listing_Metadata_MONTHLY,
+		Purchase: repo.PurchaseData{
+			PaymentCoin: "TBTC",
+			Items:       []repo.Item{{ListingHash: subscribed, Quantity: "1"}},
+		},
+		SpendCap:    "1",
+		State:       repo.SubscriptionStateActive,
+		NextRenewal: now.Add(-time.Minute),
+		Created:     now.Add(-time.Hour),
+	}
+	if err := node.Datastore.Subscriptions().Put(subscription); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Datastore.Subscriptions().Delete(subscription.ID)
+
+	// The renewal is for the edited listing, though no order is placed
+	// within the spend cap
+	pbListing.Item.Title = "Edited hosting"
+	edited := saveListing(updateListing)
+	if edited == subscribed {
+		t.Fatal("expected editing the listing to change its hash")
+	}
+	renewAt := now
+	renew := func() *repo.Subscription {
+		if _, err := node.RenewDueSubscriptions(renewAt); err != nil {
+			t.Fatal(err)
+		}
+		renewAt = renewAt.Add(core.SubscriptionRetryDelay)
+		s, err := node.Datastore.Subscriptions().Get(subscription.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	s := renew()
+	if s.Failures != 1 || s.ListingHash != edited || s.Purchase.Items[0].ListingHash != edited || s.Title != "Edited hosting" {
+		t.Errorf("expected the renewal to be for the edited listing, got %+v", s)
+	}
+
+	pbListing.Metadata.Subscription.BillingPeriod = pb.Listing_Metadata_WEEKLY
+	saveListing(updateListing)
+	if s := renew(); s.Failures != 2 || s.ListingHash != edited {
+		t.Errorf("expected a renewal after the billing period changed to fail, got %+v", s)
+	}
+
+	notifs, _, err := node.Datastore.Notifications().GetAll("", -1, []string{string(repo.NotifierTypeSubscriptionRenewalFailed)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reasons := make(map[string]bool)
+	for _, n := range notifs {
+		if sn, ok := n.NotifierData.(repo.SubscriptionNotification); ok && sn.SubscriptionID == subscription.ID {
+			reasons[sn.Reason] = true
+		}
+	}
+	if !reasons[core.ErrSubscriptionPeriodChanged.Error()] {
+		t.Errorf("expected a failed renewal because %q, got %v", core.ErrSubscriptionPeriodChanged, reasons)
+	}
+}
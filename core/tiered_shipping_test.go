@@ -0,0 +1,131 @@
+package core
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/multiwallet"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+)
+
+// ratedWallet is a reserve wallet whose exchange rates make every currency
+// equivalent so shipping prices are charged as listed
+type ratedWallet struct {
+	wi.Wallet
+}
+
+func (w *ratedWallet) CurrencyCode() string {
+	return "TBTC"
+}
+
+func (w *ratedWallet) ExchangeRates() wi.ExchangeRates {
+	return equivalentRates{}
+}
+
+type equivalentRates struct {
+	wi.ExchangeRates
+}
+
+func (equivalentRates) GetExchangeRate(currencyCode string) (float64, error) {
+	return 1.0, nil
+}
+
+func (equivalentRates) GetAllRates(cacheOK bool) (map[string]float64, error) {
+	return map[string]float64{"BTC": 1.0}, nil
+}
+
+// newTieredShippingListing returns a listing whose item weighs grams and
+// ships worldwide with a weight based service priced by the tiers
+func newTieredShippingListing(t *testing.T, slug string, grams float32, tiers ...*pb.Listing_ShippingOption_Service_RateTier) *repo.Listing {
+	l := factory.NewListing(slug)
+	l.Item.Grams = grams
+	l.ShippingOptions[0].Type = pb.Listing_ShippingOption_WEIGHT_BASED
+	l.ShippingOptions[0].Services[0].BigPrice = ""
+	l.ShippingOptions[0].Services[0].RateTiers = tiers
+	listing, err := repo.NewListingFromProtobuf(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return listing
+}
+
+func TestCalculateShippingTotalWithRateTiers(t *testing.T) {
+	node := &OpenBazaarNode{
+		TestnetEnable: true,
+		Multiwallet:   multiwallet.MultiWallet{wi.TestnetBitcoin: &ratedWallet{}},
+	}
+	listings := map[string]*repo.Listing{
+		"heavy": newTieredShippingListing(t, "heavy", 400,
+			&pb.Listing_ShippingOption_Service_RateTier{UpTo: 500, BigPrice: "300"},
+			&pb.Listing_ShippingOption_Service_RateTier{UpTo: 2000, BigPrice: "800"},
+		),
+		"light": newTieredShippingListing(t, "light", 150,
+			&pb.Listing_ShippingOption_Service_RateTier{UpTo: 500, BigPrice: "500"},
+			&pb.Listing_ShippingOption_Service_RateTier{UpTo: 2000, BigPrice: "1000"},
+		),
+	}
+	item := func(listingHash, quantity string) *pb.Order_Item {
+		return &pb.Order_Item{
+			ListingHash:    listingHash,
+			BigQuantity:    quantity,
+			ShippingOption: &pb.Order_Item_ShippingOption{Name: "usps", Service: "standard"},
+		}
+	}
+	examples := []struct {
+		name     string
+		items    []*pb.Order_Item
+		expected string
+		err      string
+	}{
+		{
+			name:     "within the first tier",
+			items:    []*pb.Order_Item{item("heavy", "1")},
+			expected: "300",
+		},
+		{
+			name:     "quantities crossing a tier boundary",
+			items:    []*pb.Order_Item{item("heavy", "1"), item("heavy", "1")},
+			expected: "800",
+		},
+		{
+			name:     "listings sharing a service charge the highest price once",
+			items:    []*pb.Order_Item{item("heavy", "1"), item("light", "1")},
+			expected: "1000",
+		},
+		{
+			name:  "weight over the largest tier",
+			items: []*pb.Order_Item{item("heavy", "6")},
+			err:   "exceeds the largest WEIGHT_BASED rate tier of shipping service (standard)",
+		},
+	}
+
+	for _, e := range examples {
+		contract := &pb.RicardianContract{
+			BuyerOrder: &pb.Order{
+				Items:    e.items,
+				Shipping: &pb.Order_Shipping{Country: pb.CountryCode_UNITED_KINGDOM},
+				Payment: &pb.Order_Payment{
+					AmountCurrency: &pb.CurrencyDefinition{Code: "TBTC", Divisibility: 8},
+				},
+			},
+		}
+		total, err := node.calculateShippingTotalForListings(contract, listings)
+		if e.err != "" {
+			if err == nil || !strings.Contains(err.Error(), e.err) {
+				t.Errorf("%s: expected error containing (%s), got (%v)", e.name, e.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", e.name, err)
+			continue
+		}
+		if expected, _ := new(big.Int).SetString(e.expected, 10); total.Cmp(expected) != 0 {
+			t.Errorf("%s: expected shipping total %s, got %s", e.name, e.expected, total)
+		}
+	}
+}
@@ -94,3 +94,17 @@ func addAndPin(n *core.IpfsNode, root string) (rootHash string, err error) {
 	}
 	return node.Cid().String(), nil
 }
+
+// AddData adds the data to IPFS as a file, pins it and returns its hash
+func AddData(n *core.IpfsNode, data []byte) (string, error) {
+	f, err := ioutil.TempFile("", "ipfs-add")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return "", err
+	}
+	return addAndPin(n, f.Name())
+}
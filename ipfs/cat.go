@@ -2,9 +2,11 @@ package ipfs
 
 import (
 	"context"
+	"fmt"
 	ipath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
 	"gx/ipfs/QmQmhotPUzVrMEWNK3x1R5jQ5ZHWyL7tVUrmRPjrBrvyCb/go-ipfs-files"
 	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	"io"
 	"io/ioutil"
 	"strings"
 	"time"
@@ -23,6 +25,43 @@ func Cat(n *core.IpfsNode, path string, timeout time.Duration) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	r, err := getFile(ctx, n, path)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+// CatLimited fetches the data with the hash like Cat but fails without
+// reading it if the file is larger than maxBytes
+func CatLimited(n *core.IpfsNode, path string, timeout time.Duration, maxBytes int64) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	r, err := getFile(ctx, n, path)
+	if err != nil {
+		return nil, err
+	}
+	// The size is read from the root node so a file which claims to be too
+	// large is rejected before the rest of it is fetched
+	size, err := r.Size()
+	if err != nil {
+		return nil, err
+	}
+	if size > maxBytes {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxBytes)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxBytes)
+	}
+	return data, nil
+}
+
+func getFile(ctx context.Context, n *core.IpfsNode, path string) (files.File, error) {
 	if !strings.HasPrefix(path, "/ipfs/") {
 		path = "/ipfs/" + path
 	}
@@ -44,8 +83,7 @@ func Cat(n *core.IpfsNode, path string, timeout time.Duration) ([]byte, error) {
 	if !ok {
 		return nil, errors.New("Received incorrect type from Unixfs().Get()")
 	}
-
-	return ioutil.ReadAll(r)
+	return r, nil
 }
 
 func ResolveThenCat(n *core.IpfsNode, ipnsPath ipath.Path, timeout time.Duration, quorum uint, usecache bool) ([]byte, error) {
@@ -29,3 +29,24 @@ func TestCidCompatibility(t *testing.T) {
 		}
 	}
 }
+
+func TestCatLimited(t *testing.T) {
+	n, err := coremock.NewMockNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := AddData(n, []byte("eleven byte"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := CatLimited(n, hash, time.Second, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "eleven byte" {
+		t.Errorf("unexpected data: %q", data)
+	}
+	if _, err := CatLimited(n, hash, time.Second, 10); err == nil {
+		t.Error("expected a file over the limit to be rejected")
+	}
+}
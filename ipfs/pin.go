@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	coreiface "gx/ipfs/QmXLwxifxwfc2bAwq6rdjbYqAsGzWsDE9RM5TWMGtykyj6/interface-go-ipfs-core"
 	options "gx/ipfs/QmXLwxifxwfc2bAwq6rdjbYqAsGzWsDE9RM5TWMGtykyj6/interface-go-ipfs-core/options"
@@ -39,3 +40,22 @@ func UnPinDir(n *core.IpfsNode, rootHash string) error {
 
 	return api.Pin().Rm(context.Background(), rp, options.Pin.RmRecursive(true))
 }
+
+// Pin fetches the content with the hash, if the node doesn't have it, and
+// pins it so it isn't garbage collected
+func Pin(n *core.IpfsNode, hash string, timeout time.Duration) error {
+	if !strings.HasPrefix(hash, "/ipfs/") {
+		hash = "/ipfs/" + hash
+	}
+	api, err := coreapi.NewCoreAPI(n)
+	if err != nil {
+		return fmt.Errorf("ipfs api: %s", err)
+	}
+	p, err := coreiface.ParsePath(hash)
+	if err != nil {
+		return fmt.Errorf("parsing ipfs path (%s): %s", hash, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return api.Pin().Add(ctx, p)
+}
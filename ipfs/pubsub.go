@@ -16,7 +16,16 @@ import (
 	"time"
 )
 
-const messageTopicPrefix = "/offlinemessage/"
+const (
+	messageTopicPrefix      = "/offlinemessage/"
+	announcementTopicPrefix = "/announcement/"
+)
+
+// AnnouncementTopic returns the pubsub topic which post and listing
+// announcements with the given name are published to
+func AnnouncementTopic(name string) string {
+	return announcementTopicPrefix + name
+}
 
 type Pubsub struct {
 	Subscriber *PubsubSubscriber
@@ -72,9 +81,14 @@ func NewPubsubSubscriber(ctx context.Context, host p2phost.Host, cr routing.Cont
 	}
 }
 
+// Publish publishes an offline message to the topic
 func (p *PubsubPublisher) Publish(ctx context.Context, topic string, data []byte) error {
+	return p.PublishTopic(ctx, messageTopicPrefix+topic, data)
+}
+
+// PublishTopic publishes the data to the topic as is
+func (p *PubsubPublisher) PublishTopic(ctx context.Context, id string, data []byte) error {
 	p.mx.Lock()
-	id := messageTopicPrefix + topic
 	_, ok := p.subs[id]
 
 	if !ok {
@@ -90,10 +104,16 @@ func (p *PubsubPublisher) Publish(ctx context.Context, topic string, data []byte
 	return p.ps.Publish(id, data)
 }
 
+// Subscribe subscribes to the offline messages published to the topic
 func (r *PubsubSubscriber) Subscribe(ctx context.Context, topic string) (chan []byte, error) {
+	return r.SubscribeTopic(ctx, messageTopicPrefix+topic)
+}
+
+// SubscribeTopic subscribes to the topic as is. The returned channel is
+// closed once the subscription is canceled.
+func (r *PubsubSubscriber) SubscribeTopic(ctx context.Context, id string) (chan []byte, error) {
 	r.mx.Lock()
 	// see if we already have a pubsub subscription; if not, subscribe
-	id := messageTopicPrefix + topic
 	_, ok := r.subs[id]
 	resp := make(chan []byte)
 	if !ok {
@@ -144,6 +164,7 @@ func (r *PubsubSubscriber) Cancel(name string) bool {
 }
 
 func (r *PubsubSubscriber) handleSubscription(sub *pubsub.Subscription, topic string, resp chan<- []byte, cancel func()) {
+	defer close(resp)
 	defer sub.Cancel()
 	defer cancel()
 
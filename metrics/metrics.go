@@ -0,0 +1,126 @@
+// Package metrics holds the Prometheus collectors describing the health and
+// commerce activity of the node. They are registered with Registry which the
+// API serves at /ob/metrics when metrics are enabled in the JSON-API config.
+package metrics
+
+import (
+	"strings"
+
+	"gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+)
+
+const namespace = "openbazaar"
+
+var (
+	// Registry holds the node's collectors
+	Registry = prometheus.NewRegistry()
+
+	// MessagesSent counts the messages written to peers by message type
+	MessagesSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "messages_sent_total",
+		Help:      "Messages sent to peers by message type.",
+	}, []string{"type"})
+
+	// MessagesReceived counts the messages read from peers by message type
+	MessagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "messages_received_total",
+		Help:      "Messages received from peers by message type.",
+	}, []string{"type"})
+
+	// MessagesRateLimited counts the messages dropped because the peer
+	// exceeded its rate limit by message type
+	MessagesRateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "messages_rate_limited_total",
+		Help:      "Messages dropped for exceeding the peer rate limits by message type.",
+	}, []string{"type"})
+
+	// OfflineMessagesStored counts the messages put in the message storage
+	// for peers which couldn't be reached
+	OfflineMessagesStored = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "offline_messages_stored_total",
+		Help:      "Offline messages stored for unreachable peers by message type.",
+	}, []string{"type"})
+
+	// OfflineMessagesRetrieved counts the offline messages addressed to the
+	// node which the MessageRetriever downloaded and decrypted
+	OfflineMessagesRetrieved = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "offline_messages_retrieved_total",
+		Help:      "Offline messages retrieved and decrypted by message type.",
+	}, []string{"type"})
+
+	// PointerRepublishes counts the pointers republished by the
+	// PointerRepublisher by purpose and result
+	PointerRepublishes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "net",
+		Name:      "pointer_republishes_total",
+		Help:      "Pointer republish attempts by purpose and result.",
+	}, []string{"purpose", "result"})
+
+	// IPNSPublishDuration observes how long publishing the node's root hash
+	// to IPNS takes
+	IPNSPublishDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ipns",
+		Name:      "publish_duration_seconds",
+		Help:      "Time taken to publish the root hash to IPNS by result.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+	}, []string{"result"})
+
+	// APIRequestDuration observes how long the API takes to answer requests
+	// by method and route
+	APIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to answer API requests by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+func init() {
+	Registry.MustRegister(
+		MessagesSent,
+		MessagesReceived,
+		MessagesRateLimited,
+		OfflineMessagesStored,
+		OfflineMessagesRetrieved,
+		PointerRepublishes,
+		IPNSPublishDuration,
+		APIRequestDuration,
+	)
+}
+
+// Gatherer returns the node's metrics along with the Go runtime, process and
+// IPFS metrics in the default registry
+func Gatherer() prometheus.Gatherer {
+	return prometheus.Gatherers{prometheus.DefaultGatherer, Registry}
+}
+
+// Result returns the result label for an operation which returned err
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// Route returns the route label for an API path. Only the first two path
+// segments are kept so IDs and hashes don't create a series per request.
+func Route(path string) string {
+	segments := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return "/" + strings.Join(segments, "/")
+}
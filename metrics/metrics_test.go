@@ -0,0 +1,32 @@
+package metrics_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/metrics"
+)
+
+func TestRoute(t *testing.T) {
+	examples := map[string]string{
+		"/ob/listings":                  "/ob/listings",
+		"/ob/listing/ron-swanson-shirt": "/ob/listing",
+		"/ob/order/QmOrderID/":          "/ob/order",
+		"/wallet/balance/BTC":           "/wallet/balance",
+		"/":                             "/",
+	}
+	for path, expected := range examples {
+		if route := metrics.Route(path); route != expected {
+			t.Errorf("expected route %s for %s, got %s", expected, path, route)
+		}
+	}
+}
+
+func TestResult(t *testing.T) {
+	if metrics.Result(nil) != "success" {
+		t.Error("expected success for nil error")
+	}
+	if metrics.Result(errors.New("failed")) != "error" {
+		t.Error("expected error for non-nil error")
+	}
+}
@@ -469,16 +469,17 @@ func (n *Node) start() error {
 		n.OpenBazaarNode.Service = service.New(n.OpenBazaarNode, n.OpenBazaarNode.Datastore)
 		n.OpenBazaarNode.Service.WaitForReady()
 		MR := ret.NewMessageRetriever(ret.MRConfig{
-			Db:        n.OpenBazaarNode.Datastore,
-			IPFSNode:  n.OpenBazaarNode.IpfsNode,
-			DHT:       n.OpenBazaarNode.DHT,
-			BanManger: n.OpenBazaarNode.BanManager,
-			Service:   n.OpenBazaarNode.Service,
-			PrefixLen: 14,
-			PushNodes: n.OpenBazaarNode.PushNodes,
-			Dialer:    nil,
-			SendAck:   n.OpenBazaarNode.SendOfflineAck,
-			SendError: n.OpenBazaarNode.SendError,
+			Db:          n.OpenBazaarNode.Datastore,
+			IPFSNode:    n.OpenBazaarNode.IpfsNode,
+			DHT:         n.OpenBazaarNode.DHT,
+			BanManger:   n.OpenBazaarNode.BanManager,
+			Service:     n.OpenBazaarNode.Service,
+			PrefixLen:   14,
+			PushNodes:   n.OpenBazaarNode.PushNodes,
+			Dialer:      nil,
+			SendAck:     n.OpenBazaarNode.SendOfflineAck,
+			SendError:   n.OpenBazaarNode.SendError,
+			RateLimiter: n.OpenBazaarNode.RateLimiter,
 		})
 		go MR.Run()
 		n.OpenBazaarNode.MessageRetriever = MR
@@ -4,10 +4,12 @@ import (
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
 
 	"sync"
+	"time"
 )
 
 type BanManager struct {
 	blockedIds map[string]bool
+	bans       map[string]time.Time
 	*sync.RWMutex
 }
 
@@ -16,7 +18,7 @@ func NewBanManager(blockedIds []peer.ID) *BanManager {
 	for _, pid := range blockedIds {
 		blockedMap[pid.Pretty()] = true
 	}
-	return &BanManager{blockedMap, new(sync.RWMutex)}
+	return &BanManager{blockedMap, make(map[string]time.Time), new(sync.RWMutex)}
 }
 
 func (bm *BanManager) AddBlockedId(peerId peer.ID) {
@@ -58,8 +60,33 @@ func (bm *BanManager) GetBlockedIds() []peer.ID {
 	return ret
 }
 
+// AddTemporaryBan bans the peer until expires. Unlike blocked peers the ban
+// is lifted on its own once it expires.
+func (bm *BanManager) AddTemporaryBan(peerId peer.ID, expires time.Time) {
+	bm.Lock()
+	defer bm.Unlock()
+	now := time.Now()
+	for pid, e := range bm.bans {
+		if !now.Before(e) {
+			delete(bm.bans, pid)
+		}
+	}
+	bm.bans[peerId.Pretty()] = expires
+}
+
+// RemoveTemporaryBan lifts the peer's temporary ban before it expires
+func (bm *BanManager) RemoveTemporaryBan(peerId peer.ID) {
+	bm.Lock()
+	defer bm.Unlock()
+	delete(bm.bans, peerId.Pretty())
+}
+
 func (bm *BanManager) IsBanned(peerId peer.ID) bool {
 	bm.RLock()
 	defer bm.RUnlock()
-	return bm.blockedIds[peerId.Pretty()]
+	if bm.blockedIds[peerId.Pretty()] {
+		return true
+	}
+	expires, ok := bm.bans[peerId.Pretty()]
+	return ok && time.Now().Before(expires)
 }
@@ -0,0 +1,133 @@
+package net
+
+import (
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// The classes of messages which are rate limited together. Order and dispute
+// messages are never limited as dropping one would leave the order stuck.
+const (
+	RateLimitChat   = "chat"
+	RateLimitFollow = "follow"
+	RateLimitStore  = "store"
+)
+
+// pruneInterval is how often buckets which have refilled are dropped
+const pruneInterval = time.Minute
+
+// RateLimit is a token bucket policy. A peer may send Burst messages at once
+// after which the bucket refills at Rate messages a second.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type tokenBucket struct {
+	limit   RateLimit
+	tokens  float64
+	last    time.Time
+	dropped int
+}
+
+// RateLimiter limits the messages of each class a peer may send the node
+type RateLimiter struct {
+	limits    map[string]RateLimit
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+	*sync.Mutex
+}
+
+// NewRateLimiter returns a RateLimiter applying the limits by message class.
+// Classes without a limit or with a zero rate are not limited.
+func NewRateLimiter(limits map[string]RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		buckets: make(map[string]*tokenBucket),
+		Mutex:   new(sync.Mutex),
+	}
+}
+
+// MessageClass returns the rate limit class of the message type or an empty
+// string if messages of the type are not rate limited
+func MessageClass(messageType pb.Message_MessageType) string {
+	switch messageType {
+	case pb.Message_CHAT:
+		return RateLimitChat
+	case pb.Message_FOLLOW, pb.Message_UNFOLLOW:
+		return RateLimitFollow
+	case pb.Message_STORE, pb.Message_BLOCK:
+		return RateLimitStore
+	}
+	return ""
+}
+
+// Allow takes a token from the peer's bucket for the message type and returns
+// false if the bucket is empty. A nil RateLimiter allows every message.
+func (rl *RateLimiter) Allow(peerId peer.ID, messageType pb.Message_MessageType, now time.Time) bool {
+	if rl == nil {
+		return true
+	}
+	class := MessageClass(messageType)
+	limit, ok := rl.limits[class]
+	if !ok || limit.Rate <= 0 {
+		return true
+	}
+
+	rl.Lock()
+	defer rl.Unlock()
+	if now.Sub(rl.lastPrune) >= pruneInterval {
+		rl.prune(now)
+	}
+
+	key := peerId.Pretty() + "/" + class
+	bucket, ok := rl.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		rl.buckets[key] = bucket
+	}
+	if elapsed := now.Sub(bucket.last).Seconds(); elapsed > 0 {
+		bucket.tokens += elapsed * limit.Rate
+		if bucket.tokens > float64(limit.Burst) {
+			bucket.tokens = float64(limit.Burst)
+		}
+	}
+	bucket.last = now
+	if bucket.tokens < 1 {
+		bucket.dropped++
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// Dropped returns how many messages of the type from the peer were over the
+// limit since the peer's bucket was last full
+func (rl *RateLimiter) Dropped(peerId peer.ID, messageType pb.Message_MessageType) int {
+	if rl == nil {
+		return 0
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	bucket, ok := rl.buckets[peerId.Pretty()+"/"+MessageClass(messageType)]
+	if !ok {
+		return 0
+	}
+	return bucket.dropped
+}
+
+// prune drops the buckets which would have refilled by now as they are the
+// same as a new bucket
+func (rl *RateLimiter) prune(now time.Time) {
+	for key, bucket := range rl.buckets {
+		missing := float64(bucket.limit.Burst) - bucket.tokens
+		if now.Sub(bucket.last).Seconds()*bucket.limit.Rate >= missing {
+			delete(rl.buckets, key)
+		}
+	}
+	rl.lastPrune = now
+}
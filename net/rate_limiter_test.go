@@ -0,0 +1,91 @@
+package net
+
+import (
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	rl := NewRateLimiter(map[string]RateLimit{
+		RateLimitChat:   {Rate: 1, Burst: 2},
+		RateLimitFollow: {Rate: 0, Burst: 0},
+	})
+	alice, err := peer.IDB58Decode("QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := peer.IDB58Decode("QmZdFj1Ew6cbVo1uBFQyeFvTYV1YcvKrNrM2ZBhSg9GXpj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	if !rl.Allow(alice, pb.Message_CHAT, now) || !rl.Allow(alice, pb.Message_CHAT, now) {
+		t.Fatal("expected the burst to be allowed")
+	}
+	if rl.Allow(alice, pb.Message_CHAT, now) {
+		t.Error("expected the message after the burst to be limited")
+	}
+	if !rl.Allow(bob, pb.Message_CHAT, now) {
+		t.Error("expected each peer to have its own bucket")
+	}
+	if !rl.Allow(alice, pb.Message_CHAT, now.Add(time.Second)) {
+		t.Error("expected the bucket to refill")
+	}
+	if rl.Allow(alice, pb.Message_CHAT, now.Add(time.Second)) {
+		t.Error("expected the refilled token to be used up")
+	}
+	if dropped := rl.Dropped(alice, pb.Message_CHAT); dropped != 2 {
+		t.Errorf("expected 2 dropped messages, got %d", dropped)
+	}
+	if dropped := rl.Dropped(bob, pb.Message_CHAT); dropped != 0 {
+		t.Errorf("expected no dropped messages from another peer, got %d", dropped)
+	}
+
+	// Classes with a zero rate or no limit are unlimited, as are order and
+	// dispute messages
+	for i := 0; i < 10; i++ {
+		if !rl.Allow(alice, pb.Message_FOLLOW, now) || !rl.Allow(alice, pb.Message_PING, now) {
+			t.Fatal("expected unlimited message to be allowed")
+		}
+	}
+	for _, messageType := range []pb.Message_MessageType{pb.Message_ORDER, pb.Message_ORDER_PAYMENT, pb.Message_ORDER_COMPLETION, pb.Message_REFUND, pb.Message_DISPUTE_OPEN} {
+		if class := MessageClass(messageType); class != "" {
+			t.Errorf("expected %s messages not to be rate limited, got class %q", messageType, class)
+		}
+	}
+
+	// Full buckets are pruned
+	rl.Allow(bob, pb.Message_CHAT, now.Add(time.Hour))
+	if len(rl.buckets) != 1 {
+		t.Errorf("expected only the active bucket to remain, got %d", len(rl.buckets))
+	}
+
+	var unlimited *RateLimiter
+	if !unlimited.Allow(alice, pb.Message_CHAT, now) {
+		t.Error("expected a nil rate limiter to allow messages")
+	}
+}
+
+func TestBanManager_TemporaryBan(t *testing.T) {
+	bm := NewBanManager(nil)
+	pid, err := peer.IDB58Decode("QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bm.AddTemporaryBan(pid, time.Now().Add(time.Hour))
+	if !bm.IsBanned(pid) {
+		t.Error("expected peer to be banned")
+	}
+	bm.RemoveTemporaryBan(pid)
+	if bm.IsBanned(pid) {
+		t.Error("expected ban to be lifted")
+	}
+	bm.AddTemporaryBan(pid, time.Now().Add(-time.Second))
+	if bm.IsBanned(pid) {
+		t.Error("expected expired ban to be ignored")
+	}
+}
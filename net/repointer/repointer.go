@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/op/go-logging"
 	"golang.org/x/net/context"
@@ -64,6 +65,7 @@ func (r *PointerRepublisher) Republish() {
 					if err != nil {
 						log.Error(err)
 					}
+					metrics.PointerRepublishes.WithLabelValues("message", metrics.Result(err)).Inc()
 				}(r.routing, ctx, p)
 				for _, peer0 := range r.pushNodes {
 					go func(d *dht.IpfsDHT, ctx context.Context, peerID peer.ID, pointer ipfs.Pointer) {
@@ -71,6 +73,7 @@ func (r *PointerRepublisher) Republish() {
 						if err != nil {
 							log.Error(err)
 						}
+						metrics.PointerRepublishes.WithLabelValues("message", metrics.Result(err)).Inc()
 					}(r.routing, context.Background(), peer0, p)
 				}
 			}
@@ -81,6 +84,7 @@ func (r *PointerRepublisher) Republish() {
 					if err != nil {
 						log.Error(err)
 					}
+					metrics.PointerRepublishes.WithLabelValues("moderator", metrics.Result(err)).Inc()
 				}(r.routing, ctx, p)
 			} else {
 				err = r.db.Pointers().Delete(p.Value.ID)
@@ -3,6 +3,7 @@ package net
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	routing "gx/ipfs/QmSY3nkMNLzh9GdbFKK5tT7YMfLpf52iUZ8ZRkr29MJaa5/go-libp2p-kad-dht"
 	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
@@ -18,9 +19,11 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/golang/protobuf/proto"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/op/go-logging"
@@ -42,6 +45,8 @@ type MRConfig struct {
 	Dialer    proxy.Dialer
 	SendAck   func(peerId string, pointerID peer.ID) error
 	SendError func(peerId string, k *libp2p.PubKey, errorMessage pb.Message) error
+	// RateLimiter drops the offline messages of peers over their rate limit
+	RateLimiter *net.RateLimiter
 }
 
 type MessageRetriever struct {
@@ -49,6 +54,7 @@ type MessageRetriever struct {
 	node       *core.IpfsNode
 	routing    *routing.IpfsDHT
 	bm         *net.BanManager
+	rl         *net.RateLimiter
 	service    net.NetworkService
 	prefixLen  int
 	sendAck    func(peerId string, pointerID peer.ID) error
@@ -79,6 +85,7 @@ func NewMessageRetriever(cfg MRConfig) *MessageRetriever {
 		node:       cfg.IPFSNode,
 		routing:    cfg.DHT,
 		bm:         cfg.BanManger,
+		rl:         cfg.RateLimiter,
 		service:    cfg.Service,
 		prefixLen:  cfg.PrefixLen,
 		sendAck:    cfg.SendAck,
@@ -162,6 +169,14 @@ func (m *MessageRetriever) downloadMessages(peerOut chan ps.PeerInfo) {
 		if len(p.Addrs) > 0 && !m.db.OfflineMessages().Has(p.Addrs[0].String()) && !inFlight[p.Addrs[0].String()] {
 			inFlight[p.Addrs[0].String()] = true
 			log.Debugf("Found pointer with location %s", p.Addrs[0].String())
+			// HTTPS URL encoded as an identity CID
+			if url, ok := sto.HTTPSURLFromMultiaddr(p.Addrs[0]); ok {
+				wg.Add(1)
+				downloaded++
+				go m.fetchHTTPS(p.ID, url, p.Addrs[0], wg)
+				continue
+			}
+
 			// IPFS
 			if len(p.Addrs[0].Protocols()) == 1 && p.Addrs[0].Protocols()[0].Code == ma.P_IPFS {
 				wg.Add(1)
@@ -238,7 +253,7 @@ func (m *MessageRetriever) fetchIPFS(pid peer.ID, n *core.IpfsNode, addr ma.Mult
 		<-m.inFlight
 	}()
 
-	c := make(chan struct{})
+	c := make(chan struct{}, 1)
 	var ciphertext []byte
 	var err error
 
@@ -274,16 +289,20 @@ func (m *MessageRetriever) fetchHTTPS(pid peer.ID, url string, addr ma.Multiaddr
 		<-m.inFlight
 	}()
 
-	c := make(chan struct{})
+	c := make(chan struct{}, 1)
 	var ciphertext []byte
 	var err error
 
 	go func() {
 		var resp *http.Response
+		defer func() { c <- struct{}{} }()
 		resp, err = m.httpClient.Get(url)
 		if err != nil {
-			log.Errorf("Error retrieving offline message from %s, %s", addr.String(), err.Error())
-			c <- struct{}{}
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			err = fmt.Errorf("unexpected status %s", resp.Status)
 			return
 		}
 		ciphertext, err = ioutil.ReadAll(resp.Body)
@@ -350,11 +369,17 @@ func (m *MessageRetriever) attemptDecrypt(ciphertext []byte, pid peer.ID, addr m
 	}
 
 	log.Debugf("Received offline message %s from: %s\n", addr.String(), id.Pretty())
+	metrics.OfflineMessagesRetrieved.WithLabelValues(env.Message.MessageType.String()).Inc()
 
 	if m.bm.IsBanned(id) {
 		log.Warningf("Received and dropped offline message from banned user: %s\n", id.Pretty())
 		return
 	}
+	if !m.rl.Allow(id, env.Message.MessageType, time.Now()) {
+		metrics.MessagesRateLimited.WithLabelValues(env.Message.MessageType.String()).Inc()
+		log.Debugf("Dropped offline %s message from %s over the rate limit\n", env.Message.MessageType.String(), id.Pretty())
+		return
+	}
 
 	if err := m.node.Peerstore.AddPubKey(id, pubkey); err != nil {
 		log.Errorf("adding pubkey to peerstore: %s", err.Error())
@@ -443,6 +468,7 @@ var MessageProcessingOrder = []pb.Message_MessageType{
 	pb.Message_ORDER_COMPLETION,
 	pb.Message_DISPUTE_OPEN,
 	pb.Message_DISPUTE_UPDATE,
+	pb.Message_DISPUTE_EVIDENCE,
 	pb.Message_VENDOR_FINALIZED_PAYMENT,
 	pb.Message_DISPUTE_CLOSE,
 	pb.Message_REFUND,
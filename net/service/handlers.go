@@ -62,6 +62,8 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleDisputeOpen
 	case pb.Message_DISPUTE_UPDATE:
 		return service.handleDisputeUpdate
+	case pb.Message_DISPUTE_EVIDENCE:
+		return service.handleDisputeEvidence
 	case pb.Message_DISPUTE_CLOSE:
 		return service.handleDisputeClose
 	case pb.Message_CHAT:
@@ -295,7 +297,19 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 
 	contract := new(pb.RicardianContract)
 	var orderId string
+	// placed is set when the order was already placed by an earlier ORDER
+	// message. A re-sent order which fails must leave the inventory, coupons
+	// and sale held for it alone.
+	var placed bool
 	errorResponse := func(errMsg string) *pb.Message {
+		if orderId != "" && !placed {
+			if err := service.node.InventoryKeeper().Release(orderId); err != nil {
+				log.Errorf("failed releasing inventory for order (%s): %s", orderId, err)
+			}
+			if err := service.datastore.Coupons().DeleteRedemptions(orderId); err != nil {
+				log.Errorf("failed releasing coupons for order (%s): %s", orderId, err)
+			}
+		}
 		e := &pb.Error{
 			Code:         0,
 			ErrorMessage: errMsg,
@@ -310,9 +324,9 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Errorf("failed marshaling errorResponse (%s) for order (%s): %s", e.ErrorMessage, orderId, err)
 			return m
 		}
-		if offline {
+		if offline && orderId != "" && !placed {
 			contract.Errors = []string{errMsg}
-			if err := service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_PROCESSING_ERROR, false); err != nil {
+			if err := service.node.OrderStates().UpdateSale(orderId, *contract, pb.OrderState_PROCESSING_ERROR, false, "order processing failed"); err != nil {
 				log.Errorf("failed updating PROCESSING_ERROR on sale (%s): %s", orderId, err)
 			}
 		}
@@ -340,6 +354,14 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		log.Errorf("failed putting message (%s-%d): %v", orderId, int(pb.Message_ORDER), err)
 	}
 
+	// An order which has progressed past payment can't be placed again
+	if _, state, _, _, _, _, err := service.datastore.Sales().GetByOrderId(orderId); err == nil {
+		if state != pb.OrderState_AWAITING_PAYMENT && state != pb.OrderState_PROCESSING_ERROR {
+			return nil, net.DuplicateMessage
+		}
+		placed = state == pb.OrderState_AWAITING_PAYMENT
+	}
+
 	pro, err := service.node.GetProfile()
 	if err != nil {
 		log.Errorf("unable to read local profile: %s", err)
@@ -360,6 +382,15 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		}
 	}
 
+	// Hold the inventory until the order is funded so it can't be sold to
+	// another buyer in the meantime
+	if err := service.node.InventoryKeeper().Reserve(orderId, contract, !offline); err != nil {
+		return errorResponse(err.Error()), err
+	}
+	if err := service.node.RedeemCoupons(orderId, contract, time.Now()); err != nil {
+		return errorResponse(err.Error()), err
+	}
+
 	order, err := repo.ToV5Order(contract.BuyerOrder, service.node.LookupCurrency)
 	if err != nil {
 		return nil, err
@@ -393,7 +424,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			return errorResponse("Error building order confirmation"), err
 		}
 		log.Debugf("storing sales order %s into the database and awaiting payment", contract.VendorOrderConfirmation.OrderID)
-		if err := service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		if err := service.node.OrderStates().UpdateSale(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received"); err != nil {
 			log.Errorf("failed to put sale (%s): %s", contract.VendorOrderConfirmation.OrderID, err)
 			return errorResponse("Error persisting order"), err
 		}
@@ -420,7 +451,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		}
 		log.Debugf("added address to wallet to watch: %s", addr)
 		log.Debugf("storing sales order %s in database", orderId)
-		err = service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		err = service.node.OrderStates().UpdateSale(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received")
 		if err != nil {
 			log.Error(err)
 		}
@@ -464,7 +495,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			return errorResponse("Error building order confirmation"), errors.New("error building order confirmation")
 		}
-		err = service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		err = service.node.OrderStates().UpdateSale(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received")
 		if err != nil {
 			log.Error(err)
 		}
@@ -497,7 +528,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 		}
 		log.Debugf("storing sales order %s in database", orderId)
-		err = service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		err = service.node.OrderStates().UpdateSale(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order received")
 		if err != nil {
 			log.Error(err)
 		}
@@ -579,14 +610,14 @@ func (service *OpenBazaarService) handleOrderConfirmation(p peer.ID, pmes *pb.Me
 	if funded {
 		// Set message state to AWAITING_FULFILLMENT
 		log.Debugf("now awaiting fulfillment for order %s", orderId)
-		err := service.datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
+		err := service.node.OrderStates().UpdatePurchase(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false, "order confirmation received")
 		if err != nil {
 			log.Errorf("failed setting order (%) to AWAITING_FULFILLMENT: %s", orderId, err.Error())
 		}
 	} else {
 		// Set message state to AWAITING_PAYMENT
 		log.Debugf("order not funded, awaiting payment for order %s", orderId)
-		err := service.datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		err := service.node.OrderStates().UpdatePurchase(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false, "order confirmation received")
 		if err != nil {
 			log.Errorf("failed setting order (%) to AWAITING_PAYMENT: %s", orderId, err.Error())
 		}
@@ -652,7 +683,7 @@ func (service *OpenBazaarService) handleOrderCancel(p peer.ID, pmes *pb.Message,
 	}
 
 	// Set message state to canceled
-	err = service.datastore.Sales().Put(orderId, *contract, pb.OrderState_CANCELED, false)
+	err = service.node.OrderStates().UpdateSale(orderId, *contract, pb.OrderState_CANCELED, false, "order cancel received")
 	if err != nil {
 		log.Error(err)
 	}
@@ -838,7 +869,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 	}
 
 	// Set message state to rejected
-	err = service.datastore.Purchases().Put(rejectMsg.OrderID, *contract, pb.OrderState_DECLINED, false)
+	err = service.node.OrderStates().UpdatePurchase(rejectMsg.OrderID, *contract, pb.OrderState_DECLINED, false, "order reject received")
 	if err != nil {
 		log.Error(err)
 	}
@@ -911,9 +942,19 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		log.Errorf("failed putting message (%s-%d): %v", rc.Refund.OrderID, int(pb.Message_REFUND), err)
 	}
 
-	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT) {
+	if !core.CanRefund(state, rc.Refund.Partial, contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED) {
 		return nil, net.DuplicateMessage
 	}
+	if state == pb.OrderState_FULFILLED && rc.Refund.RefundTransaction == nil {
+		return nil, errors.New("refund of a fulfilled order must be paid from the vendor's wallet")
+	}
+	if contract.Refund != nil && proto.Equal(contract.Refund, rc.Refund) {
+		return nil, net.DuplicateMessage
+	}
+	refundAmount, err := partialRefundAmount(rc.Refund)
+	if err != nil {
+		return nil, err
+	}
 
 	order, err := repo.ToV5Order(contract.BuyerOrder, service.node.LookupCurrency)
 	if err != nil {
@@ -944,10 +985,14 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		if err != nil {
 			return nil, err
 		}
-		var output = wallet.TransactionOutput{
-			Address: refundAddress,
-			Value:   *outValue,
+		escrowAddress, err := wal.DecodeAddress(ut.NormalizeAddress(order.Payment.Address))
+		if err != nil {
+			return nil, err
+		}
+		if refundAmount != nil && refundAmount.Cmp(outValue) >= 0 {
+			return nil, errors.New("partial refund is for more than the escrowed funds")
 		}
+		outputs := core.RefundOutputs(refundAddress, escrowAddress, outValue, refundAmount)
 
 		chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 		if err != nil {
@@ -969,7 +1014,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		if !ok {
 			return nil, errors.New("invalid amount")
 		}
-		buyerSignatures, err := wal.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, buyerKey, redeemScript, *fee)
+		buyerSignatures, err := wal.CreateMultisigSignature(ins, outputs, buyerKey, redeemScript, *fee)
 		if err != nil {
 			return nil, err
 		}
@@ -978,20 +1023,19 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = wal.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, *fee, true)
+		_, err = wal.Multisign(ins, outputs, buyerSignatures, vendorSignatures, redeemScript, *fee, true)
 		if err != nil {
 			return nil, err
 		}
 	}
-	contract.Refund = rc.Refund
-	for _, sig := range contract.Signatures {
-		if sig.Section == pb.Signature_REFUND {
-			contract.Signatures = append(contract.Signatures, sig)
-		}
-	}
+	core.AddRefund(contract, rc)
 
-	// Set message state to refunded
-	err = service.datastore.Purchases().Put(contract.Refund.OrderID, *contract, pb.OrderState_REFUNDED, false)
+	// Set message state to refunded. Partially refunded orders stay active.
+	if rc.Refund.Partial {
+		err = service.node.OrderStates().UpdatePurchase(contract.Refund.OrderID, *contract, state, false, "partial refund received")
+	} else {
+		err = service.node.OrderStates().UpdatePurchase(contract.Refund.OrderID, *contract, pb.OrderState_REFUNDED, false, "refund received")
+	}
 	if err != nil {
 		log.Error(err)
 	}
@@ -1017,6 +1061,8 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		Thumbnail:    repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
 		VendorHandle: vendorHandle,
 		VendorID:     vendorID,
+		Partial:      rc.Refund.Partial,
+		Amount:       rc.Refund.BigAmount,
 	}
 	service.broadcast <- n
 	err = service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
@@ -1027,6 +1073,19 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 	return nil, nil
 }
 
+// partialRefundAmount returns the amount of a partial refund or nil for a
+// full refund
+func partialRefundAmount(refund *pb.Refund) (*big.Int, error) {
+	if !refund.Partial {
+		return nil, nil
+	}
+	amount, ok := new(big.Int).SetString(refund.BigAmount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, errors.New("partial refund has an invalid amount")
+	}
+	return amount, nil
+}
+
 func (service *OpenBazaarService) handleOrderFulfillment(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 
 	log.Debugf("received order fulfillment message from %s", p.Pretty())
@@ -1090,13 +1149,13 @@ func (service *OpenBazaarService) handleOrderFulfillment(p peer.ID, pmes *pb.Mes
 	// Set message state to fulfilled if all listings have a matching fulfillment message
 	if service.node.IsFulfilled(contract) {
 		log.Debugf("updating order %s in the database to fulfilled", rc.VendorOrderFulfillment[0].OrderId)
-		err = service.datastore.Purchases().Put(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_FULFILLED, false)
+		err = service.node.OrderStates().UpdatePurchase(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_FULFILLED, false, "order fulfillment received")
 		if err != nil {
 			log.Error(err)
 		}
 	} else {
 		log.Debugf("updating order %s in the database to partially fulfilled", rc.VendorOrderFulfillment[0].OrderId)
-		err = service.datastore.Purchases().Put(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_PARTIALLY_FULFILLED, false)
+		err = service.node.OrderStates().UpdatePurchase(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_PARTIALLY_FULFILLED, false, "order fulfillment received")
 		if err != nil {
 			log.Error(err)
 		}
@@ -1169,6 +1228,9 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 	if state == pb.OrderState_COMPLETED {
 		return nil, net.DuplicateMessage
 	}
+	if err := service.node.CheckCrowdFundPledge(rc.BuyerOrderCompletion.OrderId); err != nil {
+		return nil, err
+	}
 
 	order, err := repo.ToV5Order(contract.BuyerOrder, service.node.LookupCurrency)
 	if err != nil {
@@ -1265,7 +1327,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 	}
 
 	// Set message state to complete
-	err = service.datastore.Sales().Put(rc.BuyerOrderCompletion.OrderId, *contract, pb.OrderState_COMPLETED, false)
+	err = service.node.OrderStates().UpdateSale(rc.BuyerOrderCompletion.OrderId, *contract, pb.OrderState_COMPLETED, false, "order completion received")
 	if err != nil {
 		log.Error(err)
 	}
@@ -1448,6 +1510,72 @@ func (service *OpenBazaarService) handleDisputeUpdate(p peer.ID, pmes *pb.Messag
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleDisputeEvidence(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Make sure we aren't currently processing any disputes before proceeding
+	core.DisputeWg.Wait()
+
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	evidence := new(pb.DisputeEvidence)
+	err := ptypes.UnmarshalAny(pmes.Payload, evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	// Evidence sent along with a new dispute may arrive before the
+	// DISPUTE_OPEN, in which case it is retried as an out of order message
+	dispute, err := service.node.ProcessDisputeEvidence(evidence, p.Pretty())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		thumbnail       repo.Thumbnail
+		submitterHandle string
+		buyer           string
+	)
+	for _, contract := range []*pb.RicardianContract{dispute.BuyerContract, dispute.VendorContract} {
+		if contract == nil || contract.BuyerOrder == nil || len(contract.VendorListings) == 0 {
+			continue
+		}
+		if contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+			thumbnail = repo.Thumbnail{Tiny: contract.VendorListings[0].Item.Images[0].Tiny, Small: contract.VendorListings[0].Item.Images[0].Small}
+		}
+		if contract.BuyerOrder.BuyerID != nil {
+			buyer = contract.BuyerOrder.BuyerID.PeerID
+			if buyer == p.Pretty() {
+				submitterHandle = contract.BuyerOrder.BuyerID.Handle
+			}
+		}
+		if vendorID := contract.VendorListings[0].VendorID; vendorID != nil && vendorID.PeerID == p.Pretty() {
+			submitterHandle = vendorID.Handle
+		}
+		break
+	}
+
+	// Send notification to websocket
+	n := repo.DisputeEvidenceNotification{
+		ID:              repo.NewNotificationID(),
+		Type:            repo.NotifierTypeDisputeEvidenceNotification,
+		OrderId:         evidence.OrderId,
+		Thumbnail:       thumbnail,
+		SubmitterID:     p.Pretty(),
+		SubmitterHandle: submitterHandle,
+		Items:           len(evidence.Items),
+		Buyer:           buyer,
+	}
+	service.broadcast <- n
+
+	err = service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	if err != nil {
+		log.Error(err)
+	}
+	log.Debugf("received DISPUTE_EVIDENCE message from %s", p.Pretty())
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 
 	if pmes.Payload == nil {
@@ -1523,15 +1651,15 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 	// If DisputeAcceptance is already set then move the state directly to RESOLVED
 	if isPurchase {
 		if contract.DisputeAcceptance != nil {
-			err = service.datastore.Purchases().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_RESOLVED, false)
+			err = service.node.OrderStates().UpdatePurchase(rc.DisputeResolution.OrderId, *contract, pb.OrderState_RESOLVED, false, "dispute close received")
 		} else {
-			err = service.datastore.Purchases().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false)
+			err = service.node.OrderStates().UpdatePurchase(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false, "dispute close received")
 		}
 	} else {
 		if contract.DisputeAcceptance != nil {
-			err = service.datastore.Sales().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_RESOLVED, false)
+			err = service.node.OrderStates().UpdateSale(rc.DisputeResolution.OrderId, *contract, pb.OrderState_RESOLVED, false, "dispute close received")
 		} else {
-			err = service.datastore.Sales().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false)
+			err = service.node.OrderStates().UpdateSale(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false, "dispute close received")
 		}
 	}
 	if err != nil {
@@ -1689,6 +1817,9 @@ func (service *OpenBazaarService) handleChat(p peer.ID, pmes *pb.Message, option
 	if len(chat.Message) > core.ChatMessageMaxCharacters {
 		return nil, errors.New("chat message over max characters")
 	}
+	if err := core.ValidateChatAttachments(chat.Attachments); err != nil {
+		return nil, err
+	}
 
 	// Use correct timestamp
 	offline, _ := options.(bool)
@@ -1710,6 +1841,11 @@ func (service *OpenBazaarService) handleChat(p peer.ID, pmes *pb.Message, option
 	if err != nil {
 		return nil, err
 	}
+	if len(chat.Attachments) > 0 {
+		if err := service.node.ReceiveChatAttachments(chat.MessageId, chat.Attachments); err != nil {
+			return nil, err
+		}
+	}
 
 	if chat.Subject != "" {
 		go func() {
@@ -1736,6 +1872,15 @@ func (service *OpenBazaarService) handleChat(p peer.ID, pmes *pb.Message, option
 		Message:   chat.Message,
 		Timestamp: repo.NewAPITime(t),
 	}
+	for _, a := range chat.Attachments {
+		n.Attachments = append(n.Attachments, repo.ChatAttachment{
+			Cid:       a.Cid,
+			MimeType:  a.MimeType,
+			Size:      a.Size,
+			Filename:  a.Filename,
+			Encrypted: a.Encrypted,
+		})
+	}
 	service.broadcast <- n
 	log.Debugf("received CHAT message from %s", p.Pretty())
 	return nil, nil
@@ -1873,7 +2018,7 @@ func (service *OpenBazaarService) handleVendorFinalizedPayment(pid peer.ID, pmes
 	if state != pb.OrderState_PENDING && state != pb.OrderState_FULFILLED && state != pb.OrderState_DISPUTED {
 		return nil, errors.New("release escrow can only be called when sale is pending, fulfilled, or disputed")
 	}
-	err = service.datastore.Purchases().Put(paymentFinalizedMessage.OrderID, *contract, pb.OrderState_PAYMENT_FINALIZED, false)
+	err = service.node.OrderStates().UpdatePurchase(paymentFinalizedMessage.OrderID, *contract, pb.OrderState_PAYMENT_FINALIZED, false, "payment finalized received")
 	if err != nil {
 		log.Error(err)
 	}
@@ -2110,7 +2255,7 @@ func (service *OpenBazaarService) handleError(peer peer.ID, pmes *pb.Message, op
 	}
 
 	contract.Errors = []string{errorMessage.ErrorMessage}
-	err = service.datastore.Purchases().Put(errorMessage.OrderID, *contract, pb.OrderState_PROCESSING_ERROR, false)
+	err = service.node.OrderStates().UpdatePurchase(errorMessage.OrderID, *contract, pb.OrderState_PROCESSING_ERROR, false, "processing error received")
 	if err != nil {
 		log.Error(err)
 	}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 )
 
@@ -273,6 +274,9 @@ func (ms *messageSender) ctxWriteMsg(ctx context.Context, pmes *pb.Message) erro
 
 	select {
 	case err := <-errCh:
+		if err == nil {
+			metrics.MessagesSent.WithLabelValues(pmes.MessageType.String()).Inc()
+		}
 		return err
 	case <-ctx.Done():
 		return ErrWriteTimeout
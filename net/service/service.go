@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	inet "gx/ipfs/QmY3ArotKMKaL7YGfbQfyDrib6RVraLqZYWXZvVgZktBxp/go-libp2p-net"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
+	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	ctxio "github.com/jbenet/go-context/io"
@@ -116,6 +119,7 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 			}
 			return
 		}
+		metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String()).Inc()
 
 		if pmes.IsResponse {
 			log.Debugf("received response message from %s: %d", mPeer.Pretty(), pmes.RequestId)
@@ -144,6 +148,28 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 			return
 		}
 
+		// Drop the message if the peer exceeded its rate limit and ban the peer
+		// if it keeps on sending messages over the limit
+		if !service.node.RateLimiter.Allow(mPeer, pmes.MessageType, time.Now()) {
+			metrics.MessagesRateLimited.WithLabelValues(pmes.MessageType.String()).Inc()
+			banAfter := service.node.RateLimitBanAfter
+			if service.node.RateLimitBanDuration <= 0 || banAfter <= 0 || service.node.RateLimiter.Dropped(mPeer, pmes.MessageType) < banAfter {
+				log.Debugf("Dropped %s message from %s over the rate limit", pmes.MessageType.String(), mPeer.Pretty())
+				continue
+			}
+			reason := fmt.Sprintf("exceeded the %s message rate limit", net.MessageClass(pmes.MessageType))
+			log.Warningf("Banning %s for %s: %s", mPeer.Pretty(), service.node.RateLimitBanDuration, reason)
+			if err := service.node.BanPeer(mPeer, reason, service.node.RateLimitBanDuration); err != nil {
+				log.Errorf("Error banning %s: %s", mPeer.Pretty(), err.Error())
+			}
+			err = s.Reset()
+			if err != nil {
+				log.Error(err)
+			}
+			service.DisconnectFromPeer(mPeer)
+			return
+		}
+
 		// Get handler for this msg type
 		handler := service.HandlerForMsgType(pmes.MessageType)
 		if handler == nil {
@@ -33,7 +33,15 @@ func main() {
 			if core.Node != nil {
 				if core.Node.MessageRetriever != nil {
 					core.Node.RecordAgingNotifier.Stop()
+					core.Node.ListingScheduler.Stop()
+					core.Node.ReservationExpirer.Stop()
+					core.Node.SubscriptionRenewer.Stop()
+					core.Node.CrowdFundSettler.Stop()
+					core.Node.FeedUpdater.Stop()
 					core.Node.InboundMsgScanner.Stop()
+					if core.Node.BackupScheduler != nil {
+						core.Node.BackupScheduler.Stop()
+					}
 					close(core.Node.MessageRetriever.DoneChan)
 					core.Node.MessageRetriever.Wait()
 				}
@@ -72,7 +80,7 @@ func main() {
 	}
 	_, err = parser.AddCommand("setapicreds",
 		"set API credentials",
-		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file.",
+		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file. Use --add-user with --role to create additional API users with limited permissions.",
 		&cmd.SetAPICreds{})
 	if err != nil {
 		log.Error(err)
@@ -98,9 +106,23 @@ func main() {
 	if err != nil {
 		log.Error(err)
 	}
+	_, err = parser.AddCommand("exportlistings",
+		"export listings",
+		"This command exports all listings in the repo either as a CSV file which can be edited and imported again, or as a zip bundle containing each signed listing and its original images.",
+		&cmd.ExportListings{})
+	if err != nil {
+		log.Error(err)
+	}
+	_, err = parser.AddCommand("backup",
+		"back up the node",
+		"This command writes a single password encrypted backup of the database, including the wallet, the config and the user data (profile, listings, ratings, etc) which the restore command can restore with --from-backup. The daemon must not be running.",
+		&cmd.Backup{})
+	if err != nil {
+		log.Error(err)
+	}
 	_, err = parser.AddCommand("restore",
 		"restore user data",
-		"This command will attempt to restore user data (profile, listings, ratings, etc) by downloading them from the network. This will only work if the IPNS mapping is still available in the DHT. Optionally it will take a mnemonic seed to restore from.",
+		"This command will attempt to restore user data (profile, listings, ratings, etc) by downloading them from the network. This will only work if the IPNS mapping is still available in the DHT. Optionally it will take a mnemonic seed to restore from. With --from-backup it restores the database, config and user data from a file written by the backup command instead.",
 		&cmd.Restore{})
 	if err != nil {
 		log.Error(err)
@@ -76,6 +76,7 @@ type OrderRespApi struct {
 	UnreadChatMessages         uint64               `protobuf:"varint,5,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
 	PaymentAddressTransactions []*TransactionRecord `protobuf:"bytes,6,rep,name=paymentAddressTransactions,proto3" json:"paymentAddressTransactions,omitempty"`
 	RefundAddressTransaction   *TransactionRecord   `protobuf:"bytes,7,opt,name=refundAddressTransaction,proto3" json:"refundAddressTransaction,omitempty"`
+	StateHistory               []*OrderStateChange  `protobuf:"bytes,8,rep,name=stateHistory,proto3" json:"stateHistory,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}             `json:"-"`
 	XXX_unrecognized           []byte               `json:"-"`
 	XXX_sizecache              int32                `json:"-"`
@@ -155,6 +156,92 @@ func (m *OrderRespApi) GetRefundAddressTransaction() *TransactionRecord {
 	return nil
 }
 
+func (m *OrderRespApi) GetStateHistory() []*OrderStateChange {
+	if m != nil {
+		return m.StateHistory
+	}
+	return nil
+}
+
+type OrderStateChange struct {
+	Created              bool                 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	FromState            OrderState           `protobuf:"varint,2,opt,name=fromState,proto3,enum=OrderState" json:"fromState,omitempty"`
+	ToState              OrderState           `protobuf:"varint,3,opt,name=toState,proto3,enum=OrderState" json:"toState,omitempty"`
+	Role                 string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Cause                string               `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderStateChange) Reset()         { *m = OrderStateChange{} }
+func (m *OrderStateChange) String() string { return proto.CompactTextString(m) }
+func (*OrderStateChange) ProtoMessage()    {}
+func (*OrderStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *OrderStateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStateChange.Unmarshal(m, b)
+}
+func (m *OrderStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStateChange.Marshal(b, m, deterministic)
+}
+func (m *OrderStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStateChange.Merge(m, src)
+}
+func (m *OrderStateChange) XXX_Size() int {
+	return xxx_messageInfo_OrderStateChange.Size(m)
+}
+func (m *OrderStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderStateChange proto.InternalMessageInfo
+
+func (m *OrderStateChange) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *OrderStateChange) GetFromState() OrderState {
+	if m != nil {
+		return m.FromState
+	}
+	return OrderState_PENDING
+}
+
+func (m *OrderStateChange) GetToState() OrderState {
+	if m != nil {
+		return m.ToState
+	}
+	return OrderState_PENDING
+}
+
+func (m *OrderStateChange) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *OrderStateChange) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *OrderStateChange) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type CaseRespApi struct {
 	Timestamp                      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	BuyerContract                  *RicardianContract   `protobuf:"bytes,2,opt,name=buyerContract,proto3" json:"buyerContract,omitempty"`
@@ -167,6 +254,7 @@ type CaseRespApi struct {
 	Claim                          string               `protobuf:"bytes,9,opt,name=claim,proto3" json:"claim,omitempty"`
 	UnreadChatMessages             uint64               `protobuf:"varint,10,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
 	Resolution                     *DisputeResolution   `protobuf:"bytes,11,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Evidence                       []*DisputeEvidence   `protobuf:"bytes,12,rep,name=evidence,proto3" json:"evidence,omitempty"`
 	XXX_NoUnkeyedLiteral           struct{}             `json:"-"`
 	XXX_unrecognized               []byte               `json:"-"`
 	XXX_sizecache                  int32                `json:"-"`
@@ -176,7 +264,7 @@ func (m *CaseRespApi) Reset()         { *m = CaseRespApi{} }
 func (m *CaseRespApi) String() string { return proto.CompactTextString(m) }
 func (*CaseRespApi) ProtoMessage()    {}
 func (*CaseRespApi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 func (m *CaseRespApi) XXX_Unmarshal(b []byte) error {
@@ -274,6 +362,13 @@ func (m *CaseRespApi) GetResolution() *DisputeResolution {
 	return nil
 }
 
+func (m *CaseRespApi) GetEvidence() []*DisputeEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 type TransactionRecord struct {
 	Txid                 string               `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	Value                int64                `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
@@ -291,7 +386,7 @@ func (m *TransactionRecord) Reset()         { *m = TransactionRecord{} }
 func (m *TransactionRecord) String() string { return proto.CompactTextString(m) }
 func (*TransactionRecord) ProtoMessage()    {}
 func (*TransactionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *TransactionRecord) XXX_Unmarshal(b []byte) error {
@@ -374,7 +469,7 @@ func (m *PeerAndProfile) Reset()         { *m = PeerAndProfile{} }
 func (m *PeerAndProfile) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfile) ProtoMessage()    {}
 func (*PeerAndProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *PeerAndProfile) XXX_Unmarshal(b []byte) error {
@@ -422,7 +517,7 @@ func (m *PeerAndProfileWithID) Reset()         { *m = PeerAndProfileWithID{} }
 func (m *PeerAndProfileWithID) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfileWithID) ProtoMessage()    {}
 func (*PeerAndProfileWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *PeerAndProfileWithID) XXX_Unmarshal(b []byte) error {
@@ -464,6 +559,69 @@ func (m *PeerAndProfileWithID) GetProfile() *Profile {
 	return nil
 }
 
+type ModeratorWithStats struct {
+	PeerId               string                `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Profile              *Profile              `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Stats                *SignedModeratorStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	VerifiedResolutions  uint32                `protobuf:"varint,4,opt,name=verifiedResolutions,proto3" json:"verifiedResolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModeratorWithStats) Reset()         { *m = ModeratorWithStats{} }
+func (m *ModeratorWithStats) String() string { return proto.CompactTextString(m) }
+func (*ModeratorWithStats) ProtoMessage()    {}
+func (*ModeratorWithStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+
+func (m *ModeratorWithStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModeratorWithStats.Unmarshal(m, b)
+}
+func (m *ModeratorWithStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModeratorWithStats.Marshal(b, m, deterministic)
+}
+func (m *ModeratorWithStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeratorWithStats.Merge(m, src)
+}
+func (m *ModeratorWithStats) XXX_Size() int {
+	return xxx_messageInfo_ModeratorWithStats.Size(m)
+}
+func (m *ModeratorWithStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeratorWithStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeratorWithStats proto.InternalMessageInfo
+
+func (m *ModeratorWithStats) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ModeratorWithStats) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *ModeratorWithStats) GetStats() *SignedModeratorStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *ModeratorWithStats) GetVerifiedResolutions() uint32 {
+	if m != nil {
+		return m.VerifiedResolutions
+	}
+	return 0
+}
+
 type RatingWithID struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RatingId             string   `protobuf:"bytes,2,opt,name=ratingId,proto3" json:"ratingId,omitempty"`
@@ -477,7 +635,7 @@ func (m *RatingWithID) Reset()         { *m = RatingWithID{} }
 func (m *RatingWithID) String() string { return proto.CompactTextString(m) }
 func (*RatingWithID) ProtoMessage()    {}
 func (*RatingWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *RatingWithID) XXX_Unmarshal(b []byte) error {
@@ -522,10 +680,12 @@ func (m *RatingWithID) GetRating() *Rating {
 func init() {
 	proto.RegisterType((*Coupon)(nil), "Coupon")
 	proto.RegisterType((*OrderRespApi)(nil), "OrderRespApi")
+	proto.RegisterType((*OrderStateChange)(nil), "OrderStateChange")
 	proto.RegisterType((*CaseRespApi)(nil), "CaseRespApi")
 	proto.RegisterType((*TransactionRecord)(nil), "TransactionRecord")
 	proto.RegisterType((*PeerAndProfile)(nil), "PeerAndProfile")
 	proto.RegisterType((*PeerAndProfileWithID)(nil), "PeerAndProfileWithID")
+	proto.RegisterType((*ModeratorWithStats)(nil), "ModeratorWithStats")
 	proto.RegisterType((*RatingWithID)(nil), "RatingWithID")
 }
 
@@ -534,47 +694,58 @@ func init() {
 }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0x1b, 0x3b,
-	0x10, 0xc6, 0xeb, 0xbf, 0xf5, 0x38, 0xf6, 0xe1, 0xe8, 0x84, 0xc3, 0x62, 0x38, 0x27, 0xee, 0xd2,
-	0x0b, 0x5f, 0x6d, 0x8a, 0x7b, 0x13, 0x7a, 0x97, 0xd8, 0x2d, 0x04, 0xda, 0x26, 0xa8, 0x21, 0x85,
-	0xf6, 0x4a, 0x5e, 0xc9, 0xb6, 0xc0, 0x96, 0x16, 0x49, 0x1b, 0x9a, 0x17, 0xe8, 0x63, 0xf4, 0xdd,
-	0xfa, 0x26, 0x45, 0x5a, 0xed, 0xc6, 0xae, 0xb3, 0x09, 0xbd, 0xd3, 0xcc, 0x7c, 0xf3, 0x49, 0x33,
-	0xf3, 0x8d, 0xa0, 0x47, 0x32, 0x9e, 0x64, 0x4a, 0x1a, 0x39, 0xfa, 0x2b, 0x95, 0xc2, 0x28, 0x92,
-	0x1a, 0xed, 0x1d, 0x47, 0x52, 0x51, 0xa6, 0x4a, 0x6b, 0x90, 0x29, 0xb9, 0xe4, 0x1b, 0xe6, 0xcd,
-	0x93, 0x95, 0x94, 0xab, 0x0d, 0x3b, 0x75, 0xd6, 0x22, 0x5f, 0x9e, 0x1a, 0xbe, 0x65, 0xda, 0x90,
-	0x6d, 0x56, 0x00, 0xe2, 0x57, 0xd0, 0x99, 0xc9, 0x3c, 0x93, 0x02, 0x21, 0x68, 0xad, 0x89, 0x5e,
-	0x47, 0x8d, 0x71, 0x63, 0xd2, 0xc3, 0xee, 0x6c, 0x7d, 0xa9, 0xa4, 0x2c, 0x0a, 0x0a, 0x9f, 0x3d,
-	0xc7, 0x3f, 0x03, 0x38, 0xba, 0xb2, 0x57, 0x62, 0xa6, 0xb3, 0xf3, 0x8c, 0xa3, 0x04, 0xc2, 0xf2,
-	0x4d, 0x2e, 0xb9, 0x3f, 0x45, 0x09, 0xe6, 0x29, 0x51, 0x94, 0x13, 0x31, 0xf3, 0x11, 0x5c, 0x61,
-	0xd0, 0x0b, 0x68, 0x6b, 0x43, 0x4c, 0xc1, 0x3a, 0x9c, 0xf6, 0x13, 0xc7, 0xf6, 0xc9, 0xba, 0x70,
-	0x11, 0xb1, 0xf7, 0x2a, 0x46, 0x68, 0xd4, 0x1c, 0x37, 0x26, 0x21, 0x76, 0x67, 0xf4, 0x2f, 0x74,
-	0x96, 0xb9, 0xa0, 0x8c, 0x46, 0x2d, 0xe7, 0xf5, 0x16, 0x4a, 0x00, 0xe5, 0xc2, 0x22, 0x66, 0x6b,
-	0x62, 0x3e, 0x30, 0xad, 0xc9, 0x8a, 0xe9, 0xa8, 0x3d, 0x6e, 0x4c, 0x5a, 0xf8, 0x91, 0x08, 0xc2,
-	0x30, 0xca, 0xc8, 0xfd, 0x96, 0x09, 0x73, 0x4e, 0xa9, 0x62, 0x5a, 0xdf, 0x28, 0x22, 0x34, 0x49,
-	0x0d, 0x97, 0x42, 0x47, 0x9d, 0x71, 0xd3, 0x15, 0xb0, 0xe3, 0xc4, 0x2c, 0x95, 0x8a, 0xe2, 0x27,
-	0xb2, 0xd0, 0x47, 0x88, 0x14, 0xb3, 0xef, 0x39, 0x0c, 0x46, 0x5d, 0xdf, 0x92, 0x43, 0xc6, 0xda,
-	0x9c, 0xf8, 0x47, 0x0b, 0xfa, 0x33, 0xa2, 0x59, 0xd9, 0xe2, 0x33, 0xe8, 0x55, 0x83, 0xf3, 0x3d,
-	0x1e, 0x25, 0xc5, 0x68, 0x93, 0x72, 0xb4, 0xc9, 0x4d, 0x89, 0xc0, 0x0f, 0x60, 0x74, 0x06, 0x83,
-	0x45, 0x7e, 0xcf, 0x54, 0x39, 0x07, 0xd7, 0xf4, 0xc7, 0x27, 0xb4, 0x0f, 0x44, 0x6f, 0x60, 0x78,
-	0xc7, 0x04, 0x95, 0x0f, 0xa9, 0xcd, 0xda, 0xd4, 0xdf, 0x90, 0x68, 0x0e, 0xff, 0xed, 0x91, 0xdd,
-	0x92, 0x0d, 0xa7, 0xc4, 0x96, 0xf6, 0x56, 0x29, 0xa9, 0x74, 0xd4, 0x1a, 0x37, 0x27, 0x3d, 0xfc,
-	0x34, 0x08, 0xbd, 0x83, 0xff, 0xf7, 0x79, 0x0f, 0x68, 0xda, 0x8e, 0xe6, 0x19, 0xd4, 0x83, 0xe0,
-	0x3a, 0xcf, 0x0a, 0xae, 0xbb, 0x23, 0xb8, 0x31, 0xf4, 0xdd, 0xfb, 0xae, 0x32, 0x26, 0x18, 0x8d,
-	0x42, 0x17, 0xda, 0x75, 0xa1, 0x63, 0x68, 0xa7, 0x1b, 0xc2, 0xb7, 0x51, 0xcf, 0xed, 0x47, 0x61,
-	0xd4, 0x08, 0x12, 0x6a, 0x05, 0x39, 0x05, 0x50, 0x4c, 0xcb, 0x4d, 0xee, 0xe4, 0xd2, 0xf7, 0x4d,
-	0x9e, 0x73, 0x9d, 0xe5, 0xc6, 0x2a, 0xc0, 0x47, 0xf0, 0x0e, 0x2a, 0xfe, 0x1e, 0xc0, 0xdf, 0x07,
-	0x82, 0xb2, 0x55, 0x98, 0x6f, 0x9c, 0x96, 0x2b, 0x6c, 0xcf, 0x28, 0x82, 0xf6, 0x1d, 0xd9, 0xe4,
-	0xc5, 0xb6, 0x35, 0x2f, 0x82, 0xa8, 0x81, 0x0b, 0x07, 0x7a, 0x09, 0x83, 0x54, 0x8a, 0x25, 0x57,
-	0x5b, 0x52, 0x68, 0xdf, 0xce, 0x77, 0x80, 0xf7, 0x9d, 0x76, 0xed, 0xd6, 0x8c, 0xaf, 0xd6, 0xc6,
-	0xad, 0xdd, 0x00, 0x7b, 0x6b, 0x5f, 0x92, 0xed, 0x3f, 0x91, 0xe4, 0x29, 0x84, 0x69, 0xae, 0x14,
-	0x13, 0xe9, 0xbd, 0x9b, 0x48, 0x7f, 0xfa, 0x4f, 0x32, 0xf3, 0x8e, 0x39, 0x5b, 0x72, 0xc1, 0x5d,
-	0x49, 0x15, 0x08, 0x8d, 0x20, 0x5c, 0xf0, 0xd5, 0xad, 0xab, 0xa2, 0xeb, 0x4a, 0xab, 0xec, 0xf8,
-	0x3d, 0x0c, 0xaf, 0x19, 0x53, 0xe7, 0x82, 0x5e, 0x17, 0x1f, 0x9f, 0x7d, 0x70, 0xc6, 0x98, 0xba,
-	0x2c, 0xdb, 0xe0, 0x2d, 0x14, 0x43, 0xd7, 0xff, 0x8d, 0x7e, 0x07, 0xc2, 0xc4, 0xa7, 0xe0, 0x32,
-	0x10, 0x2f, 0xe0, 0x78, 0x9f, 0xed, 0x33, 0x37, 0xeb, 0xcb, 0x39, 0x1a, 0x42, 0x50, 0xb5, 0x35,
-	0xe0, 0x74, 0xe7, 0x8e, 0xa0, 0xee, 0x8e, 0x66, 0xdd, 0x1d, 0x5f, 0xe1, 0x08, 0x13, 0xc3, 0xc5,
-	0xaa, 0x86, 0x7b, 0x04, 0xa1, 0x72, 0xf1, 0x8a, 0xbd, 0xb2, 0xd1, 0x09, 0x74, 0x8a, 0xb3, 0xa7,
-	0xef, 0x26, 0x05, 0x15, 0xf6, 0xee, 0x8b, 0xd6, 0x97, 0x20, 0x5b, 0x2c, 0x3a, 0x6e, 0x00, 0xaf,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x26, 0xa7, 0xc9, 0x5a, 0x37, 0x06, 0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xef, 0x8a, 0x1b, 0x37,
+	0x10, 0xc7, 0xff, 0xd7, 0x63, 0xfb, 0x9a, 0x28, 0x69, 0x11, 0x86, 0x36, 0xee, 0xd2, 0xc2, 0x95,
+	0x96, 0xbd, 0x70, 0xa5, 0x10, 0xfa, 0xed, 0xe2, 0x4b, 0x69, 0xa0, 0x69, 0x82, 0x2e, 0xa4, 0xd0,
+	0x7e, 0x92, 0x57, 0x63, 0x5b, 0x60, 0x4b, 0x8b, 0xa4, 0x3d, 0xea, 0x17, 0xe8, 0x33, 0xf4, 0x2d,
+	0xfa, 0x22, 0x7d, 0x8a, 0x3e, 0x49, 0x59, 0xad, 0x76, 0x6d, 0xc7, 0xd9, 0x84, 0x23, 0xdf, 0x34,
+	0xbf, 0xf9, 0x69, 0x66, 0x34, 0x33, 0xbf, 0x5d, 0x18, 0xf2, 0x4c, 0x26, 0x99, 0xd1, 0x4e, 0x4f,
+	0x3f, 0x49, 0xb5, 0x72, 0x86, 0xa7, 0xce, 0x56, 0xc0, 0x56, 0x0b, 0x34, 0xdc, 0x69, 0x13, 0x80,
+	0xb1, 0x36, 0x02, 0x4d, 0xe5, 0x9e, 0x64, 0x46, 0x2f, 0xe5, 0x06, 0x83, 0xf9, 0x68, 0xa5, 0xf5,
+	0x6a, 0x83, 0x17, 0xde, 0x5a, 0xe4, 0xcb, 0x0b, 0x27, 0xb7, 0x68, 0x1d, 0xdf, 0x66, 0x25, 0x21,
+	0x7e, 0x0c, 0xfd, 0xb9, 0xce, 0x33, 0xad, 0x08, 0x81, 0xee, 0x9a, 0xdb, 0x35, 0x6d, 0xcd, 0x5a,
+	0xe7, 0x43, 0xe6, 0xcf, 0x05, 0x96, 0x6a, 0x81, 0xb4, 0x5d, 0x62, 0xc5, 0x39, 0xfe, 0xbb, 0x03,
+	0xe3, 0x97, 0x45, 0x4a, 0x86, 0x36, 0xbb, 0xca, 0x24, 0x49, 0x20, 0xaa, 0x8a, 0xf4, 0x97, 0x47,
+	0x97, 0x24, 0x61, 0x32, 0xe5, 0x46, 0x48, 0xae, 0xe6, 0xc1, 0xc3, 0x6a, 0x0e, 0xf9, 0x12, 0x7a,
+	0xd6, 0x71, 0x57, 0x46, 0x3d, 0xbb, 0x1c, 0x25, 0x3e, 0xda, 0x4d, 0x01, 0xb1, 0xd2, 0x53, 0xe4,
+	0x35, 0xc8, 0x05, 0xed, 0xcc, 0x5a, 0xe7, 0x11, 0xf3, 0x67, 0xf2, 0x19, 0xf4, 0x97, 0xb9, 0x12,
+	0x28, 0x68, 0xd7, 0xa3, 0xc1, 0x22, 0x09, 0x90, 0x5c, 0x15, 0x8c, 0xf9, 0x9a, 0xbb, 0x17, 0x68,
+	0x2d, 0x5f, 0xa1, 0xa5, 0xbd, 0x59, 0xeb, 0xbc, 0xcb, 0xde, 0xe1, 0x21, 0x0c, 0xa6, 0x19, 0xdf,
+	0x6d, 0x51, 0xb9, 0x2b, 0x21, 0x0c, 0x5a, 0xfb, 0xda, 0x70, 0x65, 0x79, 0xea, 0xa4, 0x56, 0x96,
+	0xf6, 0x67, 0x1d, 0xff, 0x80, 0x03, 0x90, 0x61, 0xaa, 0x8d, 0x60, 0xef, 0xb9, 0x45, 0x7e, 0x05,
+	0x6a, 0xb0, 0xa8, 0xe7, 0xd4, 0x49, 0x07, 0xa1, 0x25, 0xa7, 0x11, 0x1b, 0xef, 0x90, 0x1f, 0x60,
+	0xec, 0x1b, 0xf1, 0xb3, 0xb4, 0x4e, 0x9b, 0x1d, 0x8d, 0x7c, 0x55, 0xf7, 0x0f, 0x3a, 0x35, 0x5f,
+	0x73, 0xb5, 0x42, 0x76, 0x44, 0x8b, 0xff, 0x6b, 0xc1, 0xbd, 0xb7, 0x29, 0x84, 0xc2, 0x20, 0x35,
+	0xc8, 0x1d, 0x0a, 0x3f, 0x9d, 0x88, 0x55, 0x26, 0xf9, 0x06, 0x86, 0x4b, 0xa3, 0xb7, 0x37, 0x4d,
+	0xc3, 0xd8, 0x7b, 0xc9, 0xd7, 0x30, 0x70, 0xba, 0x24, 0x76, 0x4e, 0x89, 0x95, 0xcf, 0xcf, 0x4d,
+	0x6f, 0xd0, 0x4f, 0x68, 0xc8, 0xfc, 0x99, 0x3c, 0x84, 0x5e, 0xca, 0x73, 0x8b, 0x7e, 0x24, 0x43,
+	0x56, 0x1a, 0xe4, 0x09, 0x0c, 0xeb, 0x55, 0xa4, 0x7d, 0xdf, 0xa2, 0x69, 0x52, 0x2e, 0x6b, 0x52,
+	0x2d, 0x6b, 0xf2, 0xba, 0x62, 0xb0, 0x3d, 0x39, 0xfe, 0xb7, 0x0b, 0xa3, 0x39, 0xb7, 0x58, 0xad,
+	0xdf, 0x51, 0xa4, 0xd6, 0x1d, 0x22, 0x91, 0x27, 0x30, 0x59, 0xe4, 0x3b, 0x34, 0xd5, 0x8e, 0xd2,
+	0x76, 0x18, 0xd5, 0xe9, 0xf6, 0x1e, 0x13, 0xc9, 0x8f, 0x70, 0x76, 0x8b, 0x4a, 0xe8, 0xfd, 0xd5,
+	0x4e, 0xe3, 0xd5, 0xb7, 0x98, 0xe4, 0x1a, 0x3e, 0x3f, 0x0a, 0xf6, 0x86, 0x6f, 0xa4, 0xe0, 0xc5,
+	0xd8, 0x9f, 0x19, 0xa3, 0x8d, 0xa5, 0xdd, 0x59, 0xe7, 0x7c, 0xc8, 0xde, 0x4f, 0x22, 0x3f, 0xc1,
+	0x17, 0xc7, 0x71, 0x4f, 0xc2, 0xf4, 0x7c, 0x98, 0x0f, 0xb0, 0xf6, 0x62, 0xec, 0x7f, 0x50, 0x8c,
+	0x83, 0x03, 0x31, 0xce, 0x60, 0xe4, 0xeb, 0x7b, 0x99, 0xa1, 0x42, 0x41, 0x23, 0xef, 0x3a, 0x84,
+	0xfc, 0xd8, 0x37, 0x5c, 0x6e, 0xe9, 0x30, 0x8c, 0xbd, 0x30, 0x1a, 0xc4, 0x0a, 0x8d, 0x62, 0xbd,
+	0x04, 0x30, 0x68, 0xf5, 0x26, 0xf7, 0x52, 0x1a, 0x85, 0x26, 0x5f, 0x4b, 0x9b, 0xe5, 0x0e, 0x59,
+	0xed, 0x61, 0x07, 0x2c, 0xf2, 0x1d, 0x44, 0x78, 0x2b, 0x05, 0xaa, 0x14, 0xe9, 0xd8, 0x0b, 0xe7,
+	0x5e, 0x75, 0xe3, 0x59, 0xc0, 0x59, 0xcd, 0x88, 0xff, 0x6a, 0xc3, 0xfd, 0x13, 0x69, 0x16, 0x6f,
+	0x76, 0x7f, 0x4a, 0x51, 0x7d, 0x0c, 0x8b, 0x33, 0xa1, 0xd0, 0xbb, 0xe5, 0x9b, 0xbc, 0x94, 0x4a,
+	0xe7, 0x69, 0x9b, 0xb6, 0x58, 0x09, 0x90, 0xaf, 0x60, 0x92, 0x6a, 0xb5, 0x94, 0x66, 0xcb, 0xcb,
+	0xaf, 0x48, 0xb1, 0x0d, 0x13, 0x76, 0x0c, 0x16, 0x1f, 0xb0, 0x35, 0xca, 0xd5, 0xda, 0x79, 0x79,
+	0x4c, 0x58, 0xb0, 0x8e, 0x17, 0xb8, 0x77, 0x97, 0x05, 0xbe, 0x80, 0x28, 0xcd, 0x8d, 0x41, 0x95,
+	0xee, 0x82, 0x86, 0x1e, 0x24, 0xf3, 0x00, 0x5c, 0xe3, 0x52, 0x2a, 0xe9, 0x9f, 0x54, 0x93, 0xc8,
+	0x14, 0xa2, 0x85, 0x5c, 0xbd, 0xf1, 0xaf, 0x18, 0xf8, 0xa7, 0xd5, 0x76, 0xfc, 0x0b, 0x9c, 0xbd,
+	0x42, 0x34, 0x57, 0x4a, 0xbc, 0x2a, 0x7f, 0x21, 0x45, 0xc1, 0x19, 0xa2, 0x79, 0x5e, 0xb5, 0x21,
+	0x58, 0x24, 0x86, 0x41, 0xf8, 0xcb, 0x04, 0xc5, 0x44, 0x49, 0xb8, 0xc2, 0x2a, 0x47, 0xbc, 0x80,
+	0x87, 0xc7, 0xd1, 0x7e, 0x93, 0x6e, 0xfd, 0xfc, 0x9a, 0x9c, 0x41, 0xbb, 0x6e, 0x6b, 0x5b, 0x8a,
+	0x83, 0x1c, 0xed, 0xa6, 0x1c, 0x9d, 0xa6, 0x1c, 0xff, 0xb4, 0x80, 0xbc, 0xa8, 0xfe, 0x86, 0x45,
+	0xfc, 0x62, 0x6d, 0xed, 0xc7, 0x94, 0x4d, 0xbe, 0x2d, 0xe5, 0x60, 0x43, 0xd2, 0x4f, 0x93, 0x1b,
+	0xb9, 0x52, 0x28, 0xea, 0x2c, 0x3e, 0x43, 0x29, 0x0c, 0x4b, 0x1e, 0xc3, 0x83, 0x5b, 0x34, 0x72,
+	0x29, 0x51, 0xec, 0x57, 0xd1, 0x86, 0xe9, 0xbe, 0xcb, 0x15, 0xff, 0x01, 0x63, 0xc6, 0x9d, 0x54,
+	0xab, 0x86, 0x6e, 0x4c, 0x21, 0x32, 0xde, 0x5f, 0xf7, 0xa3, 0xb6, 0xc9, 0x23, 0xe8, 0x97, 0xe7,
+	0x50, 0xdb, 0x20, 0x29, 0x43, 0xb1, 0x00, 0x3f, 0xed, 0xfe, 0xde, 0xce, 0x16, 0x8b, 0xbe, 0x5f,
+	0x99, 0xef, 0xff, 0x1f, 0x00, 0xd0, 0x6f, 0x24, 0x5c, 0x44, 0x08, 0x00, 0x00,
 }
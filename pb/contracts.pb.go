@@ -80,21 +80,61 @@ func (Listing_Metadata_Format) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 1}
 }
 
+type Listing_Metadata_BillingPeriod int32
+
+const (
+	Listing_Metadata_NONE      Listing_Metadata_BillingPeriod = 0
+	Listing_Metadata_WEEKLY    Listing_Metadata_BillingPeriod = 1
+	Listing_Metadata_MONTHLY   Listing_Metadata_BillingPeriod = 2
+	Listing_Metadata_QUARTERLY Listing_Metadata_BillingPeriod = 3
+	Listing_Metadata_YEARLY    Listing_Metadata_BillingPeriod = 4
+)
+
+var Listing_Metadata_BillingPeriod_name = map[int32]string{
+	0: "NONE",
+	1: "WEEKLY",
+	2: "MONTHLY",
+	3: "QUARTERLY",
+	4: "YEARLY",
+}
+
+var Listing_Metadata_BillingPeriod_value = map[string]int32{
+	"NONE":      0,
+	"WEEKLY":    1,
+	"MONTHLY":   2,
+	"QUARTERLY": 3,
+	"YEARLY":    4,
+}
+
+func (x Listing_Metadata_BillingPeriod) String() string {
+	return proto.EnumName(Listing_Metadata_BillingPeriod_name, int32(x))
+}
+
+func (Listing_Metadata_BillingPeriod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 2}
+}
+
 type Listing_ShippingOption_ShippingType int32
 
 const (
-	Listing_ShippingOption_LOCAL_PICKUP Listing_ShippingOption_ShippingType = 0
-	Listing_ShippingOption_FIXED_PRICE  Listing_ShippingOption_ShippingType = 1
+	Listing_ShippingOption_LOCAL_PICKUP   Listing_ShippingOption_ShippingType = 0
+	Listing_ShippingOption_FIXED_PRICE    Listing_ShippingOption_ShippingType = 1
+	Listing_ShippingOption_WEIGHT_BASED   Listing_ShippingOption_ShippingType = 2
+	Listing_ShippingOption_QUANTITY_BASED Listing_ShippingOption_ShippingType = 3
 )
 
 var Listing_ShippingOption_ShippingType_name = map[int32]string{
 	0: "LOCAL_PICKUP",
 	1: "FIXED_PRICE",
+	2: "WEIGHT_BASED",
+	3: "QUANTITY_BASED",
 }
 
 var Listing_ShippingOption_ShippingType_value = map[string]int32{
-	"LOCAL_PICKUP": 0,
-	"FIXED_PRICE":  1,
+	"LOCAL_PICKUP":   0,
+	"FIXED_PRICE":    1,
+	"WEIGHT_BASED":   2,
+	"QUANTITY_BASED": 3,
 }
 
 func (x Listing_ShippingOption_ShippingType) String() string {
@@ -173,7 +213,7 @@ func (x Signature_Section) String() string {
 }
 
 func (Signature_Section) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{19, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{20, 0}
 }
 
 type RicardianContract struct {
@@ -454,22 +494,24 @@ func (m *Listing) GetRefundPolicy() string {
 }
 
 type Listing_Metadata struct {
-	Version                 uint32                        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	ContractType            Listing_Metadata_ContractType `protobuf:"varint,2,opt,name=contractType,proto3,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
-	Format                  Listing_Metadata_Format       `protobuf:"varint,3,opt,name=format,proto3,enum=Listing_Metadata_Format" json:"format,omitempty"`
-	Expiry                  *timestamp.Timestamp          `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	AcceptedCurrencies      []string                      `protobuf:"bytes,5,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
-	PricingCurrency         string                        `protobuf:"bytes,6,opt,name=pricingCurrency,proto3" json:"pricingCurrency,omitempty"` // Deprecated: Do not use.
-	Language                string                        `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
-	EscrowTimeoutHours      uint32                        `protobuf:"varint,8,opt,name=escrowTimeoutHours,proto3" json:"escrowTimeoutHours,omitempty"`
-	CryptoCurrencyCode      string                        `protobuf:"bytes,9,opt,name=cryptoCurrencyCode,json=coinType,proto3" json:"cryptoCurrencyCode,omitempty"`
-	CryptoDivisibility      uint32                        `protobuf:"varint,10,opt,name=cryptoDivisibility,json=coinDivisibility,proto3" json:"cryptoDivisibility,omitempty"`
-	PriceModifier           float32                       `protobuf:"fixed32,11,opt,name=priceModifier,proto3" json:"priceModifier,omitempty"` // Deprecated: Do not use.
-	ShippingFromCountryCode CountryCode                   `protobuf:"varint,12,opt,name=shippingFromCountryCode,proto3,enum=CountryCode" json:"shippingFromCountryCode,omitempty"`
-	ShippingFromPostalCode  string                        `protobuf:"bytes,13,opt,name=shippingFromPostalCode,proto3" json:"shippingFromPostalCode,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                      `json:"-"`
-	XXX_unrecognized        []byte                        `json:"-"`
-	XXX_sizecache           int32                         `json:"-"`
+	Version                 uint32                         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ContractType            Listing_Metadata_ContractType  `protobuf:"varint,2,opt,name=contractType,proto3,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
+	Format                  Listing_Metadata_Format        `protobuf:"varint,3,opt,name=format,proto3,enum=Listing_Metadata_Format" json:"format,omitempty"`
+	Expiry                  *timestamp.Timestamp           `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	AcceptedCurrencies      []string                       `protobuf:"bytes,5,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
+	PricingCurrency         string                         `protobuf:"bytes,6,opt,name=pricingCurrency,proto3" json:"pricingCurrency,omitempty"` // Deprecated: Do not use.
+	Language                string                         `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	EscrowTimeoutHours      uint32                         `protobuf:"varint,8,opt,name=escrowTimeoutHours,proto3" json:"escrowTimeoutHours,omitempty"`
+	CryptoCurrencyCode      string                         `protobuf:"bytes,9,opt,name=cryptoCurrencyCode,json=coinType,proto3" json:"cryptoCurrencyCode,omitempty"`
+	CryptoDivisibility      uint32                         `protobuf:"varint,10,opt,name=cryptoDivisibility,json=coinDivisibility,proto3" json:"cryptoDivisibility,omitempty"`
+	PriceModifier           float32                        `protobuf:"fixed32,11,opt,name=priceModifier,proto3" json:"priceModifier,omitempty"` // Deprecated: Do not use.
+	ShippingFromCountryCode CountryCode                    `protobuf:"varint,12,opt,name=shippingFromCountryCode,proto3,enum=CountryCode" json:"shippingFromCountryCode,omitempty"`
+	ShippingFromPostalCode  string                         `protobuf:"bytes,13,opt,name=shippingFromPostalCode,proto3" json:"shippingFromPostalCode,omitempty"`
+	Subscription            *Listing_Metadata_Subscription `protobuf:"bytes,14,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	CrowdFund               *Listing_Metadata_CrowdFund    `protobuf:"bytes,15,opt,name=crowdFund,proto3" json:"crowdFund,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                       `json:"-"`
+	XXX_unrecognized        []byte                         `json:"-"`
+	XXX_sizecache           int32                          `json:"-"`
 }
 
 func (m *Listing_Metadata) Reset()         { *m = Listing_Metadata{} }
@@ -590,6 +632,110 @@ func (m *Listing_Metadata) GetShippingFromPostalCode() string {
 	return ""
 }
 
+func (m *Listing_Metadata) GetSubscription() *Listing_Metadata_Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+func (m *Listing_Metadata) GetCrowdFund() *Listing_Metadata_CrowdFund {
+	if m != nil {
+		return m.CrowdFund
+	}
+	return nil
+}
+
+// Service listings with a subscription are renewed at the listing
+// price once each billing period
+type Listing_Metadata_Subscription struct {
+	BillingPeriod        Listing_Metadata_BillingPeriod `protobuf:"varint,1,opt,name=billingPeriod,proto3,enum=Listing_Metadata_BillingPeriod" json:"billingPeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *Listing_Metadata_Subscription) Reset()         { *m = Listing_Metadata_Subscription{} }
+func (m *Listing_Metadata_Subscription) String() string { return proto.CompactTextString(m) }
+func (*Listing_Metadata_Subscription) ProtoMessage()    {}
+func (*Listing_Metadata_Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 0}
+}
+
+func (m *Listing_Metadata_Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Metadata_Subscription.Unmarshal(m, b)
+}
+func (m *Listing_Metadata_Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Metadata_Subscription.Marshal(b, m, deterministic)
+}
+func (m *Listing_Metadata_Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Metadata_Subscription.Merge(m, src)
+}
+func (m *Listing_Metadata_Subscription) XXX_Size() int {
+	return xxx_messageInfo_Listing_Metadata_Subscription.Size(m)
+}
+func (m *Listing_Metadata_Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Metadata_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Metadata_Subscription proto.InternalMessageInfo
+
+func (m *Listing_Metadata_Subscription) GetBillingPeriod() Listing_Metadata_BillingPeriod {
+	if m != nil {
+		return m.BillingPeriod
+	}
+	return Listing_Metadata_NONE
+}
+
+// Crowdfunding listings take pledges until the deadline. The goal is
+// in the base units of the item's price currency.
+type Listing_Metadata_CrowdFund struct {
+	BigGoal              string               `protobuf:"bytes,1,opt,name=bigGoal,proto3" json:"bigGoal,omitempty"`
+	Deadline             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Listing_Metadata_CrowdFund) Reset()         { *m = Listing_Metadata_CrowdFund{} }
+func (m *Listing_Metadata_CrowdFund) String() string { return proto.CompactTextString(m) }
+func (*Listing_Metadata_CrowdFund) ProtoMessage()    {}
+func (*Listing_Metadata_CrowdFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 1}
+}
+
+func (m *Listing_Metadata_CrowdFund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Metadata_CrowdFund.Unmarshal(m, b)
+}
+func (m *Listing_Metadata_CrowdFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Metadata_CrowdFund.Marshal(b, m, deterministic)
+}
+func (m *Listing_Metadata_CrowdFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Metadata_CrowdFund.Merge(m, src)
+}
+func (m *Listing_Metadata_CrowdFund) XXX_Size() int {
+	return xxx_messageInfo_Listing_Metadata_CrowdFund.Size(m)
+}
+func (m *Listing_Metadata_CrowdFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Metadata_CrowdFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Metadata_CrowdFund proto.InternalMessageInfo
+
+func (m *Listing_Metadata_CrowdFund) GetBigGoal() string {
+	if m != nil {
+		return m.BigGoal
+	}
+	return ""
+}
+
+func (m *Listing_Metadata_CrowdFund) GetDeadline() *timestamp.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type Listing_Item struct {
 	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -1068,15 +1214,16 @@ func (m *Listing_ShippingOption) GetServices() []*Listing_ShippingOption_Service
 }
 
 type Listing_ShippingOption_Service struct {
-	Name                   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price                  uint64   `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Deprecated: Do not use.
-	EstimatedDelivery      string   `protobuf:"bytes,3,opt,name=estimatedDelivery,proto3" json:"estimatedDelivery,omitempty"`
-	AdditionalItemPrice    uint64   `protobuf:"varint,4,opt,name=additionalItemPrice,proto3" json:"additionalItemPrice,omitempty"` // Deprecated: Do not use.
-	BigPrice               string   `protobuf:"bytes,5,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
-	BigAdditionalItemPrice string   `protobuf:"bytes,6,opt,name=bigAdditionalItemPrice,proto3" json:"bigAdditionalItemPrice,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string                                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price                  uint64                                     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Deprecated: Do not use.
+	EstimatedDelivery      string                                     `protobuf:"bytes,3,opt,name=estimatedDelivery,proto3" json:"estimatedDelivery,omitempty"`
+	AdditionalItemPrice    uint64                                     `protobuf:"varint,4,opt,name=additionalItemPrice,proto3" json:"additionalItemPrice,omitempty"` // Deprecated: Do not use.
+	BigPrice               string                                     `protobuf:"bytes,5,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	BigAdditionalItemPrice string                                     `protobuf:"bytes,6,opt,name=bigAdditionalItemPrice,proto3" json:"bigAdditionalItemPrice,omitempty"`
+	RateTiers              []*Listing_ShippingOption_Service_RateTier `protobuf:"bytes,7,rep,name=rateTiers,proto3" json:"rateTiers,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                                   `json:"-"`
+	XXX_unrecognized       []byte                                     `json:"-"`
+	XXX_sizecache          int32                                      `json:"-"`
 }
 
 func (m *Listing_ShippingOption_Service) Reset()         { *m = Listing_ShippingOption_Service{} }
@@ -1148,6 +1295,62 @@ func (m *Listing_ShippingOption_Service) GetBigAdditionalItemPrice() string {
 	return ""
 }
 
+func (m *Listing_ShippingOption_Service) GetRateTiers() []*Listing_ShippingOption_Service_RateTier {
+	if m != nil {
+		return m.RateTiers
+	}
+	return nil
+}
+
+type Listing_ShippingOption_Service_RateTier struct {
+	UpTo                 uint64   `protobuf:"varint,1,opt,name=upTo,proto3" json:"upTo,omitempty"`
+	BigPrice             string   `protobuf:"bytes,2,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_ShippingOption_Service_RateTier) Reset() {
+	*m = Listing_ShippingOption_Service_RateTier{}
+}
+func (m *Listing_ShippingOption_Service_RateTier) String() string { return proto.CompactTextString(m) }
+func (*Listing_ShippingOption_Service_RateTier) ProtoMessage()    {}
+func (*Listing_ShippingOption_Service_RateTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0, 0}
+}
+
+func (m *Listing_ShippingOption_Service_RateTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_ShippingOption_Service_RateTier.Unmarshal(m, b)
+}
+func (m *Listing_ShippingOption_Service_RateTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_ShippingOption_Service_RateTier.Marshal(b, m, deterministic)
+}
+func (m *Listing_ShippingOption_Service_RateTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_ShippingOption_Service_RateTier.Merge(m, src)
+}
+func (m *Listing_ShippingOption_Service_RateTier) XXX_Size() int {
+	return xxx_messageInfo_Listing_ShippingOption_Service_RateTier.Size(m)
+}
+func (m *Listing_ShippingOption_Service_RateTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_ShippingOption_Service_RateTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_ShippingOption_Service_RateTier proto.InternalMessageInfo
+
+func (m *Listing_ShippingOption_Service_RateTier) GetUpTo() uint64 {
+	if m != nil {
+		return m.UpTo
+	}
+	return 0
+}
+
+func (m *Listing_ShippingOption_Service_RateTier) GetBigPrice() string {
+	if m != nil {
+		return m.BigPrice
+	}
+	return ""
+}
+
 type Listing_Tax struct {
 	TaxType              string        `protobuf:"bytes,1,opt,name=taxType,proto3" json:"taxType,omitempty"`
 	TaxRegions           []CountryCode `protobuf:"varint,2,rep,packed,name=taxRegions,proto3,enum=CountryCode" json:"taxRegions,omitempty"`
@@ -1222,6 +1425,7 @@ type Listing_Coupon struct {
 	//	*Listing_Coupon_PriceDiscount
 	//	*Listing_Coupon_BigPriceDiscount
 	Discount             isListing_Coupon_Discount `protobuf_oneof:"discount"`
+	Limits               *Listing_Coupon_Limits    `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -1347,6 +1551,13 @@ func (m *Listing_Coupon) GetBigPriceDiscount() string {
 	return ""
 }
 
+func (m *Listing_Coupon) GetLimits() *Listing_Coupon_Limits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Listing_Coupon) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1358,6 +1569,77 @@ func (*Listing_Coupon) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+type Listing_Coupon_Limits struct {
+	MaxRedemptions       uint64               `protobuf:"varint,1,opt,name=maxRedemptions,proto3" json:"maxRedemptions,omitempty"`
+	ValidFrom            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	BigMinimumOrderValue string               `protobuf:"bytes,4,opt,name=bigMinimumOrderValue,proto3" json:"bigMinimumOrderValue,omitempty"`
+	OncePerBuyer         bool                 `protobuf:"varint,5,opt,name=oncePerBuyer,proto3" json:"oncePerBuyer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Listing_Coupon_Limits) Reset()         { *m = Listing_Coupon_Limits{} }
+func (m *Listing_Coupon_Limits) String() string { return proto.CompactTextString(m) }
+func (*Listing_Coupon_Limits) ProtoMessage()    {}
+func (*Listing_Coupon_Limits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 4, 0}
+}
+
+func (m *Listing_Coupon_Limits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Coupon_Limits.Unmarshal(m, b)
+}
+func (m *Listing_Coupon_Limits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Coupon_Limits.Marshal(b, m, deterministic)
+}
+func (m *Listing_Coupon_Limits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Coupon_Limits.Merge(m, src)
+}
+func (m *Listing_Coupon_Limits) XXX_Size() int {
+	return xxx_messageInfo_Listing_Coupon_Limits.Size(m)
+}
+func (m *Listing_Coupon_Limits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Coupon_Limits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Coupon_Limits proto.InternalMessageInfo
+
+func (m *Listing_Coupon_Limits) GetMaxRedemptions() uint64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *Listing_Coupon_Limits) GetValidFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
+}
+
+func (m *Listing_Coupon_Limits) GetValidUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return nil
+}
+
+func (m *Listing_Coupon_Limits) GetBigMinimumOrderValue() string {
+	if m != nil {
+		return m.BigMinimumOrderValue
+	}
+	return ""
+}
+
+func (m *Listing_Coupon_Limits) GetOncePerBuyer() bool {
+	if m != nil {
+		return m.OncePerBuyer
+	}
+	return false
+}
+
 type Order struct {
 	RefundAddress        string               `protobuf:"bytes,1,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
 	RefundFee            uint64               `protobuf:"varint,2,opt,name=refundFee,proto3" json:"refundFee,omitempty"` // Deprecated: Do not use.
@@ -2696,6 +2978,7 @@ func (m *OrderProcessingFailure) GetContract() *RicardianContract {
 type Rating struct {
 	RatingData           *Rating_RatingData `protobuf:"bytes,1,opt,name=ratingData,proto3" json:"ratingData,omitempty"`
 	Signature            []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	VendorReply          *RatingReply       `protobuf:"bytes,3,opt,name=vendorReply,proto3" json:"vendorReply,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -2740,6 +3023,13 @@ func (m *Rating) GetSignature() []byte {
 	return nil
 }
 
+func (m *Rating) GetVendorReply() *RatingReply {
+	if m != nil {
+		return m.VendorReply
+	}
+	return nil
+}
+
 type Rating_RatingData struct {
 	RatingKey            []byte               `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
 	VendorID             *ID                  `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
@@ -2883,6 +3173,116 @@ func (m *Rating_RatingData) GetReview() string {
 	return ""
 }
 
+type RatingReply struct {
+	ReplyData            *RatingReply_ReplyData `protobuf:"bytes,1,opt,name=replyData,proto3" json:"replyData,omitempty"`
+	Signature            []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RatingReply) Reset()         { *m = RatingReply{} }
+func (m *RatingReply) String() string { return proto.CompactTextString(m) }
+func (*RatingReply) ProtoMessage()    {}
+func (*RatingReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{12}
+}
+
+func (m *RatingReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingReply.Unmarshal(m, b)
+}
+func (m *RatingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingReply.Marshal(b, m, deterministic)
+}
+func (m *RatingReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingReply.Merge(m, src)
+}
+func (m *RatingReply) XXX_Size() int {
+	return xxx_messageInfo_RatingReply.Size(m)
+}
+func (m *RatingReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingReply proto.InternalMessageInfo
+
+func (m *RatingReply) GetReplyData() *RatingReply_ReplyData {
+	if m != nil {
+		return m.ReplyData
+	}
+	return nil
+}
+
+func (m *RatingReply) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type RatingReply_ReplyData struct {
+	RatingKey            []byte               `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
+	VendorID             *ID                  `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RatingReply_ReplyData) Reset()         { *m = RatingReply_ReplyData{} }
+func (m *RatingReply_ReplyData) String() string { return proto.CompactTextString(m) }
+func (*RatingReply_ReplyData) ProtoMessage()    {}
+func (*RatingReply_ReplyData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{12, 0}
+}
+
+func (m *RatingReply_ReplyData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingReply_ReplyData.Unmarshal(m, b)
+}
+func (m *RatingReply_ReplyData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingReply_ReplyData.Marshal(b, m, deterministic)
+}
+func (m *RatingReply_ReplyData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingReply_ReplyData.Merge(m, src)
+}
+func (m *RatingReply_ReplyData) XXX_Size() int {
+	return xxx_messageInfo_RatingReply_ReplyData.Size(m)
+}
+func (m *RatingReply_ReplyData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingReply_ReplyData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingReply_ReplyData proto.InternalMessageInfo
+
+func (m *RatingReply_ReplyData) GetRatingKey() []byte {
+	if m != nil {
+		return m.RatingKey
+	}
+	return nil
+}
+
+func (m *RatingReply_ReplyData) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *RatingReply_ReplyData) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RatingReply_ReplyData) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type Dispute struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Claim                string               `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
@@ -2898,7 +3298,7 @@ func (m *Dispute) Reset()         { *m = Dispute{} }
 func (m *Dispute) String() string { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()    {}
 func (*Dispute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{12}
+	return fileDescriptor_b6d125f880f9ca35, []int{13}
 }
 
 func (m *Dispute) XXX_Unmarshal(b []byte) error {
@@ -2970,7 +3370,7 @@ func (m *DisputeResolution) Reset()         { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()    {}
 func (*DisputeResolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13}
+	return fileDescriptor_b6d125f880f9ca35, []int{14}
 }
 
 func (m *DisputeResolution) XXX_Unmarshal(b []byte) error {
@@ -3049,7 +3449,7 @@ func (m *DisputeResolution_Payout) Reset()         { *m = DisputeResolution_Payo
 func (m *DisputeResolution_Payout) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()    {}
 func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{14, 0}
 }
 
 func (m *DisputeResolution_Payout) XXX_Unmarshal(b []byte) error {
@@ -3128,7 +3528,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13, 0, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{14, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) XXX_Unmarshal(b []byte) error {
@@ -3221,7 +3621,7 @@ func (m *DisputeAcceptance) Reset()         { *m = DisputeAcceptance{} }
 func (m *DisputeAcceptance) String() string { return proto.CompactTextString(m) }
 func (*DisputeAcceptance) ProtoMessage()    {}
 func (*DisputeAcceptance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{14}
+	return fileDescriptor_b6d125f880f9ca35, []int{15}
 }
 
 func (m *DisputeAcceptance) XXX_Unmarshal(b []byte) error {
@@ -3270,7 +3670,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{15}
+	return fileDescriptor_b6d125f880f9ca35, []int{16}
 }
 
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
@@ -3326,6 +3726,10 @@ type Refund struct {
 	Sigs                 []*BitcoinSignature     `protobuf:"bytes,3,rep,name=sigs,proto3" json:"sigs,omitempty"`
 	RefundTransaction    *Refund_TransactionInfo `protobuf:"bytes,4,opt,name=refundTransaction,proto3" json:"refundTransaction,omitempty"`
 	Memo                 string                  `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Partial              bool                    `protobuf:"varint,6,opt,name=partial,proto3" json:"partial,omitempty"`
+	BigAmount            string                  `protobuf:"bytes,7,opt,name=bigAmount,proto3" json:"bigAmount,omitempty"`
+	BigTotal             string                  `protobuf:"bytes,8,opt,name=bigTotal,proto3" json:"bigTotal,omitempty"`
+	Items                []*Refund_Item          `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -3335,7 +3739,7 @@ func (m *Refund) Reset()         { *m = Refund{} }
 func (m *Refund) String() string { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()    {}
 func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{16}
+	return fileDescriptor_b6d125f880f9ca35, []int{17}
 }
 
 func (m *Refund) XXX_Unmarshal(b []byte) error {
@@ -3391,6 +3795,34 @@ func (m *Refund) GetMemo() string {
 	return ""
 }
 
+func (m *Refund) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *Refund) GetBigAmount() string {
+	if m != nil {
+		return m.BigAmount
+	}
+	return ""
+}
+
+func (m *Refund) GetBigTotal() string {
+	if m != nil {
+		return m.BigTotal
+	}
+	return ""
+}
+
+func (m *Refund) GetItems() []*Refund_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type Refund_TransactionInfo struct {
 	Txid                 string              `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	Value                uint64              `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
@@ -3405,7 +3837,7 @@ func (m *Refund_TransactionInfo) Reset()         { *m = Refund_TransactionInfo{}
 func (m *Refund_TransactionInfo) String() string { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()    {}
 func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{16, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{17, 0}
 }
 
 func (m *Refund_TransactionInfo) XXX_Unmarshal(b []byte) error {
@@ -3455,6 +3887,53 @@ func (m *Refund_TransactionInfo) GetValueCurrency() *CurrencyDefinition {
 	return nil
 }
 
+type Refund_Item struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	BigQuantity          string   `protobuf:"bytes,2,opt,name=bigQuantity,proto3" json:"bigQuantity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Refund_Item) Reset()         { *m = Refund_Item{} }
+func (m *Refund_Item) String() string { return proto.CompactTextString(m) }
+func (*Refund_Item) ProtoMessage()    {}
+func (*Refund_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{17, 1}
+}
+
+func (m *Refund_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Refund_Item.Unmarshal(m, b)
+}
+func (m *Refund_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Refund_Item.Marshal(b, m, deterministic)
+}
+func (m *Refund_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Refund_Item.Merge(m, src)
+}
+func (m *Refund_Item) XXX_Size() int {
+	return xxx_messageInfo_Refund_Item.Size(m)
+}
+func (m *Refund_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Refund_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Refund_Item proto.InternalMessageInfo
+
+func (m *Refund_Item) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Refund_Item) GetBigQuantity() string {
+	if m != nil {
+		return m.BigQuantity
+	}
+	return ""
+}
+
 type VendorFinalizedPayment struct {
 	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3466,7 +3945,7 @@ func (m *VendorFinalizedPayment) Reset()         { *m = VendorFinalizedPayment{}
 func (m *VendorFinalizedPayment) String() string { return proto.CompactTextString(m) }
 func (*VendorFinalizedPayment) ProtoMessage()    {}
 func (*VendorFinalizedPayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{17}
+	return fileDescriptor_b6d125f880f9ca35, []int{18}
 }
 
 func (m *VendorFinalizedPayment) XXX_Unmarshal(b []byte) error {
@@ -3508,7 +3987,7 @@ func (m *ID) Reset()         { *m = ID{} }
 func (m *ID) String() string { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()    {}
 func (*ID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18}
+	return fileDescriptor_b6d125f880f9ca35, []int{19}
 }
 
 func (m *ID) XXX_Unmarshal(b []byte) error {
@@ -3569,7 +4048,7 @@ func (m *ID_Pubkeys) Reset()         { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()    {}
 func (*ID_Pubkeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{19, 0}
 }
 
 func (m *ID_Pubkeys) XXX_Unmarshal(b []byte) error {
@@ -3616,7 +4095,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{19}
+	return fileDescriptor_b6d125f880f9ca35, []int{20}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -3664,7 +4143,7 @@ func (m *SignedListing) Reset()         { *m = SignedListing{} }
 func (m *SignedListing) String() string { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()    {}
 func (*SignedListing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{20}
+	return fileDescriptor_b6d125f880f9ca35, []int{21}
 }
 
 func (m *SignedListing) XXX_Unmarshal(b []byte) error {
@@ -3709,6 +4188,7 @@ func (m *SignedListing) GetSignature() []byte {
 func init() {
 	proto.RegisterEnum("Listing_Metadata_ContractType", Listing_Metadata_ContractType_name, Listing_Metadata_ContractType_value)
 	proto.RegisterEnum("Listing_Metadata_Format", Listing_Metadata_Format_name, Listing_Metadata_Format_value)
+	proto.RegisterEnum("Listing_Metadata_BillingPeriod", Listing_Metadata_BillingPeriod_name, Listing_Metadata_BillingPeriod_value)
 	proto.RegisterEnum("Listing_ShippingOption_ShippingType", Listing_ShippingOption_ShippingType_name, Listing_ShippingOption_ShippingType_value)
 	proto.RegisterEnum("Order_Payment_Method", Order_Payment_Method_name, Order_Payment_Method_value)
 	proto.RegisterEnum("Signature_Section", Signature_Section_name, Signature_Section_value)
@@ -3716,6 +4196,8 @@ func init() {
 	proto.RegisterType((*CurrencyDefinition)(nil), "CurrencyDefinition")
 	proto.RegisterType((*Listing)(nil), "Listing")
 	proto.RegisterType((*Listing_Metadata)(nil), "Listing.Metadata")
+	proto.RegisterType((*Listing_Metadata_Subscription)(nil), "Listing.Metadata.Subscription")
+	proto.RegisterType((*Listing_Metadata_CrowdFund)(nil), "Listing.Metadata.CrowdFund")
 	proto.RegisterType((*Listing_Item)(nil), "Listing.Item")
 	proto.RegisterType((*Listing_Item_Option)(nil), "Listing.Item.Option")
 	proto.RegisterType((*Listing_Item_Option_Variant)(nil), "Listing.Item.Option.Variant")
@@ -3723,8 +4205,10 @@ func init() {
 	proto.RegisterType((*Listing_Item_Image)(nil), "Listing.Item.Image")
 	proto.RegisterType((*Listing_ShippingOption)(nil), "Listing.ShippingOption")
 	proto.RegisterType((*Listing_ShippingOption_Service)(nil), "Listing.ShippingOption.Service")
+	proto.RegisterType((*Listing_ShippingOption_Service_RateTier)(nil), "Listing.ShippingOption.Service.RateTier")
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
 	proto.RegisterType((*Listing_Coupon)(nil), "Listing.Coupon")
+	proto.RegisterType((*Listing_Coupon_Limits)(nil), "Listing.Coupon.Limits")
 	proto.RegisterType((*Order)(nil), "Order")
 	proto.RegisterType((*Order_Shipping)(nil), "Order.Shipping")
 	proto.RegisterType((*Order_Item)(nil), "Order.Item")
@@ -3746,6 +4230,8 @@ func init() {
 	proto.RegisterType((*OrderProcessingFailure)(nil), "OrderProcessingFailure")
 	proto.RegisterType((*Rating)(nil), "Rating")
 	proto.RegisterType((*Rating_RatingData)(nil), "Rating.RatingData")
+	proto.RegisterType((*RatingReply)(nil), "RatingReply")
+	proto.RegisterType((*RatingReply_ReplyData)(nil), "RatingReply.ReplyData")
 	proto.RegisterType((*Dispute)(nil), "Dispute")
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
@@ -3754,6 +4240,7 @@ func init() {
 	proto.RegisterType((*Outpoint)(nil), "Outpoint")
 	proto.RegisterType((*Refund)(nil), "Refund")
 	proto.RegisterType((*Refund_TransactionInfo)(nil), "Refund.TransactionInfo")
+	proto.RegisterType((*Refund_Item)(nil), "Refund.Item")
 	proto.RegisterType((*VendorFinalizedPayment)(nil), "VendorFinalizedPayment")
 	proto.RegisterType((*ID)(nil), "ID")
 	proto.RegisterType((*ID_Pubkeys)(nil), "ID.Pubkeys")
@@ -3766,236 +4253,264 @@ func init() {
 }
 
 var fileDescriptor_b6d125f880f9ca35 = []byte{
-	// 3695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x93, 0x23, 0xc9,
-	0x59, 0x53, 0x7a, 0xeb, 0x6b, 0x75, 0x4b, 0x9d, 0xd3, 0xee, 0x11, 0x8a, 0x65, 0xb7, 0xa7, 0x62,
-	0xbc, 0xb4, 0x77, 0xc7, 0xe5, 0xd9, 0xc6, 0xb1, 0xb1, 0xc6, 0x84, 0xed, 0x6e, 0x49, 0xbd, 0x2d,
-	0xa6, 0x1f, 0x72, 0x4a, 0xb3, 0xb0, 0x5c, 0x86, 0x6a, 0x55, 0xb6, 0x3a, 0x3d, 0x52, 0x95, 0xb6,
-	0x1e, 0x3d, 0x2d, 0xb8, 0x10, 0x9c, 0x20, 0x38, 0x70, 0xc3, 0x47, 0xfe, 0x00, 0xc1, 0x1f, 0x80,
-	0x13, 0x17, 0x4e, 0x84, 0x23, 0x7c, 0x32, 0x77, 0x0e, 0xdc, 0x20, 0x02, 0x22, 0x88, 0x30, 0x17,
-	0x22, 0xbf, 0xcc, 0xac, 0x97, 0xa4, 0xd9, 0x19, 0x13, 0x84, 0x4f, 0xd2, 0xf7, 0xc8, 0xac, 0xcc,
-	0xef, 0xfd, 0x7d, 0x55, 0xd0, 0x9c, 0x78, 0x6e, 0xe8, 0xdb, 0x93, 0x30, 0xb0, 0x16, 0xbe, 0x17,
-	0x7a, 0x1d, 0x32, 0xf1, 0x22, 0x37, 0xf4, 0x97, 0x13, 0xcf, 0x61, 0x1a, 0xb7, 0x3d, 0x67, 0x41,
-	0x60, 0x4f, 0x99, 0x02, 0x3f, 0x98, 0x7a, 0xde, 0x74, 0xc6, 0xbe, 0x83, 0xd0, 0x75, 0x74, 0xf3,
-	0x9d, 0x90, 0xcf, 0x59, 0x10, 0xda, 0xf3, 0x85, 0x64, 0x30, 0xff, 0xa5, 0x04, 0xbb, 0x94, 0x4f,
-	0x6c, 0xdf, 0xe1, 0xb6, 0xdb, 0x55, 0x0f, 0x20, 0xcf, 0x60, 0xe7, 0x8e, 0xb9, 0x8e, 0xe7, 0x9f,
-	0xf3, 0x20, 0xe4, 0xee, 0x34, 0x68, 0x1b, 0x07, 0xc5, 0xc3, 0xad, 0xa3, 0x9a, 0xa5, 0x10, 0x34,
-	0x47, 0x27, 0x1f, 0x02, 0x5c, 0x47, 0x4b, 0xe6, 0x5f, 0xf9, 0x0e, 0xf3, 0xdb, 0x85, 0x03, 0xe3,
-	0x70, 0xeb, 0xa8, 0x62, 0x21, 0x44, 0x53, 0x14, 0x72, 0x0e, 0x8f, 0xe4, 0x4a, 0x04, 0xbb, 0x9e,
-	0x7b, 0xc3, 0xfd, 0xb9, 0x1d, 0x72, 0xcf, 0x6d, 0x17, 0x71, 0x11, 0xb1, 0x56, 0x28, 0x74, 0xd3,
-	0x12, 0x32, 0x80, 0xfd, 0x14, 0xe9, 0x34, 0x9a, 0xdd, 0xf0, 0xd9, 0x6c, 0xce, 0xdc, 0xb0, 0x5d,
-	0xc2, 0xf3, 0xee, 0x5a, 0x79, 0x02, 0xdd, 0xb0, 0x80, 0xf4, 0x60, 0x2f, 0x39, 0x66, 0xd7, 0x9b,
-	0x2f, 0x66, 0x0c, 0x4f, 0x55, 0xc6, 0x53, 0xb5, 0xac, 0x1c, 0x9e, 0xae, 0xe5, 0x26, 0x26, 0x54,
-	0x1d, 0x1e, 0x2c, 0xa2, 0x90, 0xb5, 0x2b, 0xb8, 0xb0, 0x66, 0xf5, 0x24, 0x4c, 0x35, 0x81, 0xfc,
-	0x08, 0x76, 0xd5, 0x5f, 0xca, 0x02, 0x6f, 0x16, 0xe1, 0x63, 0xaa, 0xea, 0xf2, 0xbd, 0x3c, 0x85,
-	0xae, 0x32, 0xa7, 0x76, 0x38, 0x9e, 0x4c, 0xd8, 0x22, 0xb4, 0xdd, 0x09, 0x6b, 0xd7, 0xb2, 0x3b,
-	0x24, 0x14, 0xba, 0xca, 0x4c, 0x3e, 0x80, 0x8a, 0xcf, 0x6e, 0x22, 0xd7, 0x69, 0xd7, 0x71, 0x59,
-	0xd5, 0xa2, 0x08, 0x52, 0x85, 0x26, 0x1f, 0x01, 0x04, 0x7c, 0xea, 0xda, 0x61, 0xe4, 0xb3, 0xa0,
-	0x0d, 0x28, 0x4d, 0xb0, 0x46, 0x1a, 0x45, 0x53, 0x54, 0xb2, 0x0f, 0x15, 0xe6, 0xfb, 0x9e, 0x1f,
-	0xb4, 0xb7, 0x0e, 0x8a, 0x87, 0x75, 0xaa, 0x20, 0xf3, 0x1c, 0x48, 0x37, 0xf2, 0x7d, 0xe6, 0x4e,
-	0x96, 0x3d, 0x76, 0xc3, 0x5d, 0x8e, 0x87, 0x27, 0x50, 0x12, 0x06, 0xdb, 0x36, 0x0e, 0x8c, 0xc3,
-	0x3a, 0xc5, 0xff, 0xc4, 0x84, 0x86, 0xc3, 0xef, 0x78, 0xc0, 0xaf, 0xf9, 0x8c, 0x87, 0x4b, 0xb4,
-	0x9f, 0x6d, 0x9a, 0xc1, 0x99, 0xff, 0xd4, 0x86, 0xaa, 0x32, 0x37, 0xb1, 0x47, 0x30, 0x8b, 0xa6,
-	0x7a, 0x0f, 0xf1, 0x9f, 0x7c, 0x00, 0x35, 0xa9, 0xda, 0x41, 0x4f, 0xd9, 0x5f, 0xd1, 0x1a, 0xf4,
-	0x68, 0x8c, 0x24, 0xdf, 0x86, 0xda, 0x9c, 0x85, 0xb6, 0x63, 0x87, 0xb6, 0xb2, 0xb5, 0x5d, 0x6d,
-	0xce, 0xd6, 0x85, 0x22, 0xd0, 0x98, 0x85, 0x3c, 0x86, 0x12, 0x0f, 0xd9, 0xbc, 0x5d, 0x42, 0xd6,
-	0xed, 0x98, 0x75, 0x10, 0xb2, 0x39, 0x45, 0x12, 0x39, 0x86, 0x66, 0x70, 0xcb, 0x17, 0x0b, 0xee,
-	0x4e, 0xaf, 0x16, 0xe2, 0x72, 0x41, 0xbb, 0x8c, 0x92, 0x7a, 0x14, 0x73, 0x8f, 0x32, 0x74, 0x9a,
-	0xe7, 0x27, 0x26, 0x94, 0x43, 0xfb, 0x9e, 0x05, 0xed, 0x0a, 0x2e, 0x6c, 0xc4, 0x0b, 0xc7, 0xf6,
-	0x3d, 0x95, 0x24, 0xf2, 0x2d, 0xa8, 0x4e, 0xbc, 0x68, 0x21, 0xb6, 0xaf, 0x22, 0x57, 0x33, 0xe6,
-	0xea, 0x22, 0x9e, 0x6a, 0x3a, 0x79, 0x1f, 0x60, 0xee, 0x39, 0xcc, 0xb7, 0x43, 0xa1, 0x8e, 0x1a,
-	0xaa, 0x23, 0x85, 0x21, 0x16, 0x90, 0x90, 0xf9, 0xf3, 0xe0, 0xd8, 0x75, 0xba, 0x9e, 0xeb, 0x70,
-	0x79, 0xe8, 0x3a, 0x8a, 0x71, 0x0d, 0x45, 0x28, 0x46, 0x1a, 0xc4, 0xd0, 0x9b, 0xf1, 0xc9, 0xb2,
-	0x0d, 0xc8, 0x99, 0xc1, 0x75, 0xfe, 0xa2, 0x02, 0x35, 0x2d, 0x3f, 0xd2, 0x86, 0xea, 0x1d, 0xf3,
-	0x03, 0x61, 0xd2, 0x06, 0x2a, 0x51, 0x83, 0xe4, 0x04, 0x1a, 0x3a, 0x80, 0x8d, 0x97, 0x0b, 0x86,
-	0x3a, 0xda, 0x39, 0x7a, 0x7f, 0x45, 0x05, 0x56, 0x37, 0xc5, 0x45, 0x33, 0x6b, 0xc8, 0x33, 0xa8,
-	0xdc, 0x78, 0xc2, 0xf9, 0x51, 0x81, 0x3b, 0x47, 0xed, 0xd5, 0xd5, 0xa7, 0x48, 0xa7, 0x8a, 0x8f,
-	0x1c, 0x41, 0x85, 0xdd, 0x2f, 0xb8, 0xbf, 0x54, 0x7a, 0xec, 0x58, 0x32, 0x22, 0x5a, 0x3a, 0x22,
-	0x5a, 0x63, 0x1d, 0x11, 0xa9, 0xe2, 0x14, 0x42, 0xb2, 0xd1, 0x55, 0x98, 0xa3, 0xec, 0x97, 0x33,
-	0xa9, 0xd9, 0x3a, 0x5d, 0x43, 0x21, 0x4f, 0xa1, 0xb9, 0xf0, 0xf9, 0x84, 0xbb, 0x53, 0x6d, 0xee,
-	0xe8, 0xfc, 0xf5, 0x93, 0x42, 0xdb, 0xa0, 0x79, 0x12, 0xe9, 0x40, 0x6d, 0x66, 0xbb, 0xd3, 0xc8,
-	0x9e, 0x32, 0xf4, 0xfa, 0x3a, 0x8d, 0x61, 0xf1, 0x64, 0x16, 0x4c, 0x7c, 0xef, 0xb5, 0x38, 0x94,
-	0x17, 0x85, 0x67, 0x5e, 0x84, 0x6a, 0x14, 0x82, 0x5c, 0x43, 0x21, 0x4f, 0x80, 0x4c, 0xfc, 0xe5,
-	0x22, 0xf4, 0xf4, 0xee, 0x5d, 0xe1, 0x59, 0x52, 0x9d, 0xb5, 0x89, 0xc7, 0x5d, 0x94, 0xda, 0x53,
-	0xcd, 0xd5, 0x4b, 0xfb, 0x18, 0xe0, 0xae, 0x2d, 0xc1, 0x95, 0xc6, 0x93, 0x43, 0xd8, 0x16, 0x47,
-	0x66, 0x17, 0x9e, 0xc3, 0x6f, 0x38, 0xf3, 0xdb, 0x5b, 0x07, 0xc6, 0x61, 0x01, 0xef, 0x92, 0x25,
-	0x90, 0x53, 0x78, 0xa4, 0xcd, 0xf9, 0xd4, 0xf7, 0xe6, 0x5d, 0x99, 0x8d, 0xf0, 0x08, 0x0d, 0x54,
-	0x4f, 0xc3, 0x4a, 0xe1, 0xe8, 0x26, 0x66, 0xf2, 0x29, 0xec, 0xa7, 0x49, 0x43, 0x2f, 0x08, 0xed,
-	0x19, 0x6e, 0xb3, 0x8d, 0x37, 0xd9, 0x40, 0x35, 0x1d, 0x68, 0xa4, 0x6d, 0x85, 0xec, 0xc2, 0xf6,
-	0xf0, 0xec, 0xcb, 0xd1, 0xa0, 0x7b, 0x7c, 0xfe, 0xf2, 0xf3, 0xab, 0xab, 0x5e, 0xeb, 0x01, 0x69,
-	0x41, 0xa3, 0x37, 0xf8, 0x7c, 0x30, 0xd6, 0x18, 0x83, 0x6c, 0x41, 0x75, 0xd4, 0xa7, 0x5f, 0x0c,
-	0xba, 0xfd, 0x56, 0x81, 0xec, 0x00, 0x74, 0xe9, 0xd5, 0xef, 0xf7, 0x5e, 0x9e, 0xbe, 0xb8, 0xec,
-	0xb5, 0x8a, 0x84, 0xc0, 0x4e, 0x97, 0x7e, 0x39, 0x1c, 0x5f, 0x75, 0x5f, 0x50, 0xda, 0xbf, 0xec,
-	0x7e, 0xd9, 0x2a, 0x99, 0x1f, 0x43, 0x45, 0xda, 0x14, 0x69, 0xc2, 0xd6, 0xe9, 0xe0, 0x0f, 0xfa,
-	0xbd, 0x97, 0x43, 0x2a, 0x96, 0xe3, 0xee, 0x17, 0xc7, 0xf4, 0x79, 0x7f, 0xac, 0x30, 0x85, 0xce,
-	0xdf, 0xd5, 0xa0, 0x24, 0x02, 0x04, 0xd9, 0x83, 0x72, 0xc8, 0xc3, 0x99, 0x0e, 0x73, 0x12, 0x20,
-	0x07, 0xb0, 0xe5, 0x08, 0x35, 0x72, 0xf4, 0x7e, 0x74, 0x81, 0x3a, 0x4d, 0xa3, 0xc8, 0x87, 0xb0,
-	0xb3, 0xf0, 0xbd, 0x09, 0x0b, 0x02, 0xee, 0x4e, 0x85, 0xae, 0xd1, 0xd2, 0xeb, 0x34, 0x87, 0x25,
-	0x6d, 0x28, 0xa3, 0x32, 0xd0, 0xac, 0x4b, 0xa8, 0x1d, 0x89, 0x10, 0xb1, 0xd1, 0x0d, 0x6e, 0x5e,
-	0x63, 0xe2, 0xaa, 0x51, 0xfc, 0x2f, 0x70, 0xa1, 0x3d, 0x95, 0x41, 0xa6, 0x4e, 0xf1, 0x3f, 0xf9,
-	0x18, 0x2a, 0x7c, 0x6e, 0x4f, 0x99, 0x0e, 0x2a, 0x0f, 0x33, 0x11, 0xce, 0x1a, 0x08, 0x1a, 0x55,
-	0x2c, 0x22, 0xae, 0x4c, 0xec, 0x90, 0x4d, 0x3d, 0x5f, 0xb8, 0x82, 0x8a, 0x2b, 0x09, 0x46, 0x5c,
-	0x77, 0xea, 0xdb, 0x73, 0x19, 0x4a, 0x0a, 0x54, 0x02, 0xe4, 0x3d, 0xa8, 0x4f, 0x74, 0x2c, 0x51,
-	0xa1, 0x23, 0x41, 0x10, 0x0b, 0xaa, 0x9e, 0x8a, 0x9a, 0x5b, 0x78, 0x82, 0xbd, 0xec, 0x09, 0x54,
-	0xc8, 0xd4, 0x4c, 0xe4, 0x9b, 0x50, 0x0a, 0x5e, 0x45, 0x41, 0xbb, 0xa1, 0x52, 0x7b, 0x86, 0x79,
-	0xf4, 0x2a, 0xa2, 0x48, 0x26, 0x4f, 0xf2, 0xf6, 0xbb, 0x8d, 0x47, 0xca, 0xd9, 0x6e, 0x07, 0x6a,
-	0xd7, 0x7c, 0x3a, 0x44, 0x11, 0xee, 0x48, 0x7f, 0xd1, 0x30, 0xf9, 0x9e, 0xda, 0x21, 0xf6, 0xe6,
-	0x26, 0x86, 0x8e, 0x87, 0xd6, 0x6a, 0x36, 0xa3, 0x59, 0xce, 0xce, 0x3f, 0x1a, 0x50, 0x91, 0xe7,
-	0x46, 0x3d, 0xd8, 0xf3, 0x38, 0xcf, 0x89, 0xff, 0x6f, 0xa1, 0xff, 0xcf, 0xa0, 0x76, 0x67, 0xfb,
-	0xdc, 0x76, 0xc3, 0xa0, 0x5d, 0xc4, 0x8b, 0xbe, 0xb7, 0x4e, 0x2a, 0xd6, 0x17, 0x92, 0x89, 0xc6,
-	0xdc, 0x9d, 0x33, 0xa8, 0x2a, 0xe4, 0xda, 0x47, 0x7f, 0x0b, 0xca, 0xa8, 0x4b, 0x95, 0x1b, 0xd7,
-	0x6a, 0x5b, 0x72, 0x74, 0x7e, 0x66, 0x40, 0x71, 0xf4, 0x2a, 0x12, 0xc1, 0x5f, 0xed, 0xde, 0xf5,
-	0xe6, 0xd7, 0x1e, 0xd6, 0x80, 0xdb, 0x34, 0x83, 0x13, 0x2a, 0x5e, 0xf8, 0x9e, 0x13, 0x4d, 0x42,
-	0x95, 0x76, 0xeb, 0x34, 0x41, 0x90, 0x03, 0xa8, 0x07, 0x91, 0x3f, 0xb9, 0xb5, 0xfd, 0xa9, 0x34,
-	0xe4, 0x22, 0x5a, 0x6a, 0x82, 0x24, 0xef, 0x43, 0xed, 0xab, 0xc8, 0x76, 0x43, 0x11, 0x91, 0x4a,
-	0x31, 0x43, 0x8c, 0x13, 0x67, 0xb8, 0xe6, 0xd3, 0x51, 0xbc, 0x49, 0x59, 0x26, 0xa0, 0x34, 0x4e,
-	0x48, 0xf5, 0x9a, 0x4f, 0x7f, 0xac, 0xb7, 0xa9, 0x48, 0xa9, 0xa6, 0x50, 0x9d, 0x9f, 0x1a, 0x50,
-	0xc6, 0x2b, 0x0a, 0xbd, 0xdf, 0xf0, 0x19, 0x4b, 0x89, 0x27, 0x86, 0x05, 0xcd, 0xf3, 0xf9, 0x94,
-	0xbb, 0xf6, 0x4c, 0x5d, 0x25, 0x86, 0x85, 0x81, 0xcf, 0xe2, 0x5b, 0xd4, 0xa9, 0x04, 0x44, 0xe5,
-	0x33, 0x67, 0x0e, 0x8f, 0x64, 0x95, 0x50, 0xa7, 0x0a, 0x12, 0xdc, 0xc1, 0xdc, 0x9e, 0xcd, 0xd4,
-	0x71, 0x25, 0x80, 0x5e, 0xc8, 0x5d, 0x7d, 0x40, 0xfc, 0xdf, 0xf9, 0xb7, 0x22, 0xec, 0x64, 0x6b,
-	0x84, 0xb5, 0xda, 0xfb, 0x0c, 0x4a, 0x61, 0x92, 0x34, 0x9f, 0x6c, 0x28, 0x2f, 0x62, 0x10, 0x53,
-	0x27, 0xae, 0x20, 0x1f, 0x42, 0xd5, 0x67, 0x53, 0xf4, 0x32, 0x61, 0x4f, 0xf9, 0xa0, 0xac, 0x89,
-	0xe4, 0xfb, 0x50, 0x0b, 0x98, 0x7f, 0xc7, 0x27, 0x4c, 0x17, 0x31, 0x1f, 0x6c, 0x7c, 0x8a, 0xe4,
-	0xa3, 0xf1, 0x82, 0xce, 0xbf, 0x1b, 0x50, 0x55, 0xd8, 0xb5, 0xc7, 0x8f, 0xa3, 0x55, 0x21, 0x1f,
-	0xad, 0x9e, 0xc2, 0x2e, 0x0b, 0x42, 0x3e, 0xb7, 0x43, 0xe6, 0xf4, 0xd8, 0x8c, 0xdf, 0x31, 0x7f,
-	0xa9, 0x64, 0xbc, 0x4a, 0x20, 0xdf, 0x85, 0x87, 0xb6, 0x23, 0xc3, 0x87, 0x3d, 0x13, 0x86, 0x3b,
-	0xcc, 0xc5, 0xc0, 0x75, 0xe4, 0x8c, 0xaf, 0x97, 0x73, 0xbe, 0xfe, 0x29, 0xec, 0x5f, 0xf3, 0xe9,
-	0xf1, 0x9a, 0x4d, 0xa5, 0x96, 0x36, 0x50, 0xcd, 0x4f, 0xa0, 0x91, 0x16, 0xb6, 0x48, 0x05, 0xe7,
-	0x57, 0x22, 0xf1, 0x0c, 0x07, 0xdd, 0xe7, 0x2f, 0x86, 0xad, 0x07, 0xf9, 0x6c, 0x61, 0x74, 0xfe,
-	0xca, 0x80, 0xe2, 0xd8, 0xbe, 0x17, 0x25, 0x52, 0x68, 0xdf, 0x63, 0x0d, 0x24, 0x65, 0xa4, 0x41,
-	0xf2, 0x14, 0x20, 0xb4, 0xef, 0xa9, 0x52, 0x57, 0x61, 0x8d, 0xba, 0x52, 0x74, 0x61, 0xf6, 0xa1,
-	0x7d, 0xaf, 0x4f, 0x81, 0x42, 0xab, 0xd1, 0x34, 0x4a, 0x44, 0xed, 0x05, 0xf3, 0x27, 0xcc, 0x0d,
-	0x85, 0xe3, 0x97, 0x30, 0x0e, 0xa6, 0x30, 0x9d, 0xff, 0x32, 0xa0, 0x22, 0x2b, 0xc8, 0x0d, 0xf9,
-	0x6a, 0x0f, 0x4a, 0xb7, 0x76, 0x70, 0x2b, 0xbd, 0xe1, 0xec, 0x01, 0x45, 0x88, 0x3c, 0x11, 0xd5,
-	0x7a, 0x80, 0xcd, 0x27, 0x66, 0xe9, 0xa2, 0xa2, 0x66, 0xb0, 0xe4, 0x23, 0x68, 0xaa, 0x47, 0xf5,
-	0x14, 0x1a, 0x85, 0x5f, 0x38, 0x13, 0x35, 0x51, 0x96, 0x40, 0x3e, 0x52, 0x11, 0x37, 0xe6, 0xac,
-	0x68, 0x8d, 0x9e, 0xe9, 0xaa, 0x23, 0xe6, 0x7d, 0x0a, 0x2d, 0xad, 0xbd, 0x98, 0x1d, 0xeb, 0xa8,
-	0x33, 0x83, 0xae, 0x50, 0x4e, 0x2a, 0xb2, 0xdb, 0x38, 0x01, 0xa8, 0xe9, 0xd3, 0x99, 0x7f, 0xda,
-	0x80, 0xb2, 0xec, 0x46, 0x9f, 0xc0, 0xb6, 0x2c, 0x65, 0x8f, 0x1d, 0xc7, 0x67, 0x41, 0xa0, 0x6e,
-	0x9f, 0x45, 0x8a, 0x28, 0x26, 0x11, 0xa7, 0x2c, 0x6d, 0xc1, 0x09, 0x92, 0x7c, 0x0c, 0xb5, 0x20,
-	0xad, 0x07, 0x51, 0xa2, 0xe3, 0x13, 0x62, 0xd7, 0xa1, 0x31, 0x03, 0xf9, 0x4d, 0xa8, 0x62, 0xef,
-	0x38, 0xe8, 0xa9, 0x9a, 0x14, 0xfb, 0x14, 0x8d, 0x23, 0x9f, 0x41, 0x3d, 0x6e, 0xd2, 0x55, 0xf7,
-	0xf9, 0xa6, 0xa2, 0x35, 0x61, 0x26, 0x8f, 0xa1, 0x2c, 0xda, 0x12, 0xdd, 0x4b, 0x6c, 0xa9, 0x23,
-	0x60, 0xc3, 0x22, 0x29, 0xe4, 0x10, 0xaa, 0x0b, 0x7b, 0x89, 0x1d, 0xb2, 0xec, 0x38, 0x77, 0x14,
-	0xd3, 0x50, 0x62, 0xa9, 0x26, 0x0b, 0xdb, 0xf1, 0x6d, 0xe1, 0xfd, 0xcf, 0xd9, 0x52, 0x66, 0xfc,
-	0x06, 0x4d, 0x61, 0xc8, 0x11, 0xec, 0xd9, 0xb3, 0x90, 0xf9, 0xae, 0x1d, 0x32, 0x51, 0x85, 0xd9,
-	0x93, 0x70, 0xe0, 0xde, 0x78, 0xaa, 0xf8, 0x5c, 0x4b, 0x4b, 0x37, 0x07, 0x90, 0x6d, 0x0e, 0x64,
-	0x98, 0xa7, 0xb1, 0x94, 0xb7, 0xe2, 0x30, 0x1f, 0xe3, 0x3a, 0x3f, 0x37, 0xa0, 0x16, 0x9b, 0xf6,
-	0x3e, 0x54, 0x84, 0x40, 0xc7, 0x9e, 0x52, 0x99, 0x82, 0xc4, 0x23, 0x6c, 0xa5, 0x4b, 0x19, 0xc2,
-	0x35, 0x88, 0x7d, 0xa7, 0x48, 0x0f, 0x45, 0xd5, 0x77, 0x8a, 0xec, 0x22, 0xe2, 0x74, 0x68, 0x87,
-	0x4c, 0x85, 0x6f, 0x09, 0xa0, 0xdb, 0x24, 0x35, 0xa8, 0x8c, 0x18, 0x29, 0x8c, 0x08, 0xa9, 0x6a,
-	0xf2, 0x82, 0x76, 0xba, 0x12, 0x52, 0x15, 0x51, 0x5c, 0x4a, 0x3d, 0xfc, 0xd2, 0x0b, 0xb1, 0xce,
-	0xc2, 0x4b, 0xa5, 0x71, 0x9d, 0x9f, 0x17, 0x55, 0xc1, 0x78, 0x00, 0x5b, 0x33, 0x19, 0x6e, 0xcf,
-	0x84, 0xc7, 0xc9, 0x5b, 0xa5, 0x51, 0x99, 0x54, 0x89, 0x0d, 0x72, 0x2e, 0x55, 0x3e, 0x4d, 0xea,
-	0x29, 0x59, 0x39, 0x90, 0x94, 0x01, 0xac, 0x54, 0x53, 0x27, 0xb0, 0x93, 0xed, 0x45, 0xe3, 0x06,
-	0x29, 0xb5, 0x28, 0xd7, 0xbd, 0xe6, 0x56, 0x08, 0x91, 0xce, 0xd9, 0xdc, 0x53, 0x22, 0xc2, 0xff,
-	0xe2, 0x1e, 0xb2, 0x19, 0x15, 0xb2, 0xd0, 0x15, 0x67, 0x1a, 0x85, 0x25, 0xae, 0x34, 0x32, 0xed,
-	0x75, 0x55, 0x55, 0xe2, 0x66, 0xb0, 0xc4, 0x04, 0xd0, 0x77, 0xfb, 0xf4, 0xbb, 0xd8, 0x04, 0x49,
-	0xbf, 0x4b, 0x61, 0xf3, 0xa9, 0xbf, 0xbe, 0x9a, 0xfa, 0x8f, 0xde, 0x58, 0x90, 0xed, 0x41, 0xf9,
-	0xce, 0x9e, 0x45, 0x4c, 0x19, 0x8b, 0x04, 0x3a, 0x3f, 0x78, 0xab, 0x9c, 0xdc, 0x86, 0xaa, 0x4a,
-	0x80, 0xda, 0xd4, 0x14, 0xd8, 0xf9, 0x9b, 0x22, 0x54, 0x95, 0x43, 0x91, 0x6f, 0x8b, 0x12, 0x21,
-	0xbc, 0xf5, 0x1c, 0x5c, 0xbb, 0x73, 0xf4, 0x8d, 0xac, 0xc3, 0x89, 0xc6, 0xf5, 0xd6, 0x73, 0xa8,
-	0x62, 0x12, 0xf5, 0x54, 0xdc, 0xae, 0xeb, 0x7a, 0x2a, 0x46, 0x90, 0x0e, 0x54, 0xec, 0x39, 0x46,
-	0xbc, 0x62, 0x2c, 0x0e, 0x85, 0xc1, 0x62, 0xfb, 0xd6, 0xe6, 0x2e, 0x0e, 0x57, 0x4a, 0xaa, 0xd8,
-	0xd6, 0x88, 0xb4, 0x5f, 0x94, 0xb3, 0x7e, 0x81, 0x2d, 0xbe, 0xc3, 0xd8, 0x7c, 0x84, 0x45, 0xa8,
-	0xca, 0x7b, 0x19, 0x9c, 0xe0, 0x89, 0x0f, 0xf1, 0x9c, 0x2d, 0x51, 0x61, 0x0d, 0x9a, 0xc1, 0x91,
-	0x7d, 0x11, 0x69, 0xb9, 0x8b, 0x8a, 0x92, 0xad, 0x2f, 0xc2, 0xe2, 0x5c, 0x22, 0x87, 0xca, 0x63,
-	0x4b, 0x05, 0x25, 0x08, 0xf2, 0x7d, 0xd8, 0x91, 0xe7, 0x8f, 0x8b, 0x6d, 0xd8, 0x5c, 0x6c, 0xe7,
-	0x58, 0xcd, 0xcf, 0xa0, 0x22, 0xc5, 0x47, 0x1e, 0x42, 0xf3, 0xb8, 0xd7, 0xa3, 0xfd, 0xd1, 0xe8,
-	0x25, 0xed, 0xff, 0xf8, 0x45, 0x7f, 0x34, 0x6e, 0x3d, 0x20, 0x00, 0x95, 0xde, 0x80, 0xf6, 0xbb,
-	0xe3, 0x96, 0x41, 0xb6, 0xa1, 0x7e, 0x71, 0xd5, 0xeb, 0xd3, 0xe3, 0x71, 0xbf, 0xd7, 0x2a, 0x98,
-	0xbf, 0x2c, 0xc0, 0xee, 0xea, 0x38, 0xb1, 0x0d, 0x55, 0x4f, 0x20, 0x07, 0x3d, 0x9d, 0x99, 0x15,
-	0x98, 0x0d, 0xca, 0x85, 0x77, 0x09, 0xca, 0xab, 0xd6, 0x5e, 0x5c, 0x6b, 0xed, 0x4f, 0xa1, 0xe9,
-	0xb3, 0xaf, 0x22, 0x16, 0x84, 0xcc, 0x51, 0xc2, 0x4a, 0xca, 0x9a, 0x3c, 0x89, 0xfc, 0x2e, 0xb4,
-	0x64, 0x2c, 0x1e, 0x25, 0x43, 0x3a, 0x59, 0xb5, 0xb5, 0x2c, 0x9a, 0x25, 0xd0, 0x15, 0x4e, 0x62,
-	0x01, 0xc1, 0xc8, 0x9a, 0x7d, 0x9c, 0x54, 0xfc, 0x1a, 0x0a, 0xb9, 0x80, 0x47, 0xb9, 0x03, 0xc4,
-	0xda, 0xaa, 0x6e, 0xd6, 0xd6, 0xa6, 0x35, 0xe6, 0x9f, 0x1b, 0xb0, 0x25, 0x27, 0xc3, 0xec, 0x27,
-	0x6c, 0x12, 0xfe, 0xbf, 0x88, 0x5d, 0x34, 0x8b, 0x7c, 0xaa, 0x23, 0xe1, 0xae, 0x75, 0xc2, 0x43,
-	0x61, 0x8d, 0x89, 0x54, 0x90, 0x6c, 0xfe, 0xa2, 0x08, 0xcd, 0x9c, 0xbc, 0xc8, 0x8f, 0x52, 0x73,
-	0x42, 0x03, 0x9f, 0xf9, 0x24, 0x2f, 0x53, 0x6b, 0xec, 0xdb, 0x6e, 0x60, 0x4f, 0xc4, 0x3d, 0xd7,
-	0x8c, 0x0e, 0xdf, 0x83, 0x7a, 0x3c, 0x1e, 0xc5, 0x63, 0x37, 0x68, 0x82, 0xe8, 0xfc, 0x6b, 0x01,
-	0x1e, 0xae, 0x59, 0x9f, 0xca, 0x00, 0xa3, 0x64, 0xb6, 0x99, 0x46, 0x89, 0x7d, 0xe3, 0x0c, 0xac,
-	0xf7, 0x8d, 0x11, 0x2b, 0x4e, 0x5a, 0x5c, 0xe3, 0xa4, 0x26, 0x34, 0xd4, 0x86, 0x63, 0xac, 0xf6,
-	0x64, 0x9c, 0xc8, 0xe0, 0xc8, 0x19, 0xd4, 0xc3, 0xdb, 0x68, 0x7e, 0xed, 0xda, 0x7c, 0xa6, 0x0a,
-	0x90, 0x8f, 0xde, 0x46, 0x00, 0xaa, 0x89, 0x4c, 0x16, 0x77, 0xfe, 0x44, 0x77, 0x5d, 0xba, 0xf3,
-	0x31, 0x92, 0xce, 0x27, 0xe9, 0x91, 0x0a, 0xe9, 0x1e, 0x29, 0xe9, 0xa8, 0x8a, 0xf9, 0x8e, 0x4a,
-	0xf6, 0x5f, 0xa5, 0x74, 0xff, 0x95, 0xee, 0xd8, 0xca, 0xd9, 0x8e, 0xcd, 0x1c, 0x42, 0x2b, 0xaf,
-	0x74, 0x91, 0xd9, 0xb9, 0xbb, 0x88, 0xc2, 0x81, 0xeb, 0xb0, 0x7b, 0x35, 0xa0, 0x4c, 0x61, 0xde,
-	0xac, 0x38, 0xf3, 0x67, 0x55, 0x68, 0xad, 0xbc, 0x37, 0x88, 0x8d, 0xd7, 0xc9, 0x1a, 0xaf, 0x13,
-	0x0f, 0xa9, 0x0b, 0xa9, 0x21, 0x75, 0xc6, 0xa0, 0x8b, 0xef, 0x62, 0xd0, 0x97, 0xd0, 0x5a, 0xdc,
-	0x2e, 0x03, 0x3e, 0xb1, 0x67, 0x71, 0x9f, 0x24, 0x5f, 0x72, 0x98, 0x2b, 0x2f, 0x39, 0xac, 0x61,
-	0x8e, 0x93, 0xae, 0xac, 0x25, 0xcf, 0xa1, 0xe9, 0xf0, 0x29, 0x0f, 0x53, 0xdb, 0xc9, 0x00, 0xf2,
-	0x78, 0x75, 0xbb, 0x5e, 0x96, 0x91, 0xe6, 0x57, 0x92, 0x67, 0x50, 0x59, 0xd8, 0x4b, 0x2f, 0x0a,
-	0xd5, 0x5b, 0x8f, 0xf6, 0x9a, 0x23, 0x21, 0x9d, 0x2a, 0x3e, 0xf2, 0x3b, 0xd0, 0xcc, 0x85, 0x25,
-	0x15, 0x4a, 0x56, 0xe3, 0x57, 0x9e, 0x11, 0x93, 0xb1, 0x17, 0xca, 0x37, 0x1e, 0x22, 0x19, 0x7b,
-	0x21, 0x23, 0x7f, 0x04, 0xfb, 0x72, 0xc6, 0x39, 0x89, 0x03, 0x91, 0xba, 0x55, 0x1d, 0x6f, 0x75,
-	0xb8, 0x7a, 0xa2, 0xee, 0x5a, 0x7e, 0xba, 0x61, 0x9f, 0xce, 0x18, 0x5a, 0x79, 0xb1, 0x62, 0x09,
-	0x20, 0x0a, 0x05, 0xe6, 0x6b, 0xe5, 0x2b, 0x50, 0x84, 0xfd, 0xd0, 0xb7, 0x27, 0xaf, 0xb8, 0x3b,
-	0xbd, 0x8c, 0xe6, 0xd7, 0x4c, 0x27, 0xf3, 0x1c, 0xb6, 0xf3, 0x43, 0x68, 0xe6, 0xa4, 0x4b, 0x5a,
-	0x50, 0x8c, 0xfc, 0x99, 0xda, 0x50, 0xfc, 0x15, 0x66, 0xbe, 0xb0, 0x83, 0xe0, 0xb5, 0xe7, 0x3b,
-	0x7a, 0x30, 0xa1, 0xe1, 0xce, 0x0f, 0x60, 0x7f, 0xfd, 0x45, 0x44, 0x73, 0x13, 0x26, 0x5e, 0x1a,
-	0x07, 0xd7, 0x2c, 0xb2, 0xf3, 0x4b, 0x03, 0x2a, 0x52, 0x37, 0x71, 0xcc, 0x34, 0xde, 0x18, 0x33,
-	0x71, 0xc0, 0x86, 0x0b, 0x8e, 0x33, 0x85, 0x76, 0x16, 0x49, 0x2c, 0x68, 0x49, 0xc4, 0x29, 0x63,
-	0x43, 0xe6, 0x9f, 0x2c, 0x43, 0x96, 0x2a, 0x5a, 0x56, 0x68, 0xe4, 0x19, 0x3c, 0x14, 0xcd, 0x5b,
-	0x7e, 0x89, 0x74, 0xf7, 0x75, 0x24, 0x72, 0x0c, 0xbb, 0xf1, 0x2e, 0x71, 0x3e, 0x2a, 0x6f, 0xce,
-	0x47, 0xab, 0xdc, 0xe6, 0xdf, 0x1b, 0xd0, 0xcc, 0xbf, 0xc2, 0xdb, 0xec, 0xd0, 0xbf, 0x7a, 0x36,
-	0xfa, 0x04, 0x40, 0x3e, 0x7c, 0xf4, 0xc6, 0x9c, 0x94, 0x62, 0x22, 0x8f, 0xa1, 0x2a, 0xed, 0x3e,
-	0x50, 0x6e, 0x5e, 0x55, 0x8e, 0x41, 0x35, 0xde, 0xfc, 0x5b, 0x03, 0xf6, 0xf1, 0xf4, 0xc3, 0x78,
-	0x36, 0x7c, 0x6a, 0xf3, 0x99, 0x70, 0x91, 0xcd, 0x29, 0xf5, 0x0c, 0xf6, 0xec, 0x30, 0x64, 0xf3,
-	0x45, 0xc8, 0x9c, 0x0b, 0xf9, 0xae, 0x38, 0xf5, 0x3a, 0x66, 0xcf, 0x52, 0x38, 0x2b, 0x45, 0xa3,
-	0x6b, 0x57, 0x10, 0x0b, 0x6a, 0xfa, 0xe5, 0x4c, 0xfc, 0xee, 0x76, 0xe5, 0x55, 0x32, 0x8d, 0x79,
-	0xcc, 0x7f, 0x2e, 0x41, 0x45, 0x5e, 0x81, 0x1c, 0xe9, 0xe6, 0xb2, 0x97, 0x24, 0x59, 0xa2, 0xee,
-	0xa7, 0x7e, 0x04, 0x85, 0xa6, 0xb8, 0xbe, 0x26, 0xa9, 0xfe, 0x47, 0x11, 0x80, 0x66, 0x98, 0x93,
-	0x4c, 0x69, 0xe4, 0x33, 0xe5, 0xd7, 0xbe, 0x2a, 0xb4, 0xa0, 0x2e, 0xff, 0x8f, 0xb8, 0x6e, 0xe8,
-	0x57, 0xe3, 0x52, 0xc2, 0xf2, 0x75, 0x2d, 0xbd, 0x28, 0x81, 0xc5, 0xdf, 0x4b, 0xd1, 0x42, 0x94,
-	0x55, 0x09, 0xac, 0x11, 0x38, 0x9e, 0x12, 0x80, 0x78, 0x56, 0x05, 0x8f, 0x1a, 0xc3, 0x99, 0x9c,
-	0x2e, 0xe8, 0xf9, 0xc2, 0x5b, 0xf0, 0x64, 0xcc, 0xb2, 0xf6, 0x2e, 0x66, 0x29, 0xac, 0xe4, 0x8e,
-	0xf9, 0x22, 0x09, 0xd7, 0x65, 0x3f, 0xae, 0x40, 0x41, 0xf9, 0x2a, 0xb2, 0x53, 0xef, 0x89, 0x34,
-	0x98, 0x1f, 0x61, 0x6f, 0x21, 0x35, 0x33, 0xc2, 0x7e, 0x02, 0xdb, 0x8e, 0x8a, 0x41, 0xa3, 0x05,
-	0x63, 0x0e, 0xbe, 0x0c, 0xda, 0xa6, 0x59, 0x24, 0x39, 0x84, 0xe6, 0x24, 0x0a, 0x42, 0x6f, 0xce,
-	0x7c, 0x35, 0x39, 0xc4, 0x41, 0xfd, 0x36, 0xcd, 0xa3, 0x45, 0x49, 0xe0, 0xb3, 0x3b, 0xce, 0x5e,
-	0xab, 0x41, 0xbd, 0x82, 0xcc, 0x5f, 0x18, 0x50, 0x55, 0x2f, 0xbb, 0xb3, 0x32, 0x30, 0xde, 0x45,
-	0x06, 0x7b, 0x50, 0x9e, 0xcc, 0x6c, 0x3e, 0xd7, 0x65, 0x08, 0x02, 0xab, 0x31, 0xae, 0xb8, 0x2e,
-	0xc6, 0xfd, 0x16, 0xd4, 0xbd, 0x28, 0x5c, 0x78, 0xdc, 0x0d, 0xb5, 0x97, 0xd6, 0xad, 0x2b, 0x85,
-	0xa1, 0x09, 0x4d, 0x14, 0xdc, 0x01, 0xf3, 0xb9, 0x3d, 0xe3, 0x7f, 0xcc, 0x1c, 0xed, 0x1a, 0x68,
-	0x09, 0x0d, 0xba, 0x86, 0x62, 0xfe, 0x59, 0x05, 0x76, 0x57, 0xbe, 0x04, 0xf8, 0x3f, 0x5c, 0x32,
-	0x15, 0xd3, 0x0a, 0xd9, 0x98, 0xf6, 0x3e, 0xc0, 0xc2, 0xf7, 0x16, 0x5e, 0xc0, 0x9c, 0x13, 0x3d,
-	0x1b, 0x49, 0x61, 0x70, 0x0c, 0x94, 0x7c, 0xa5, 0x20, 0xa3, 0x71, 0x0a, 0x43, 0x3e, 0x89, 0x33,
-	0xbf, 0x8c, 0xbc, 0xbf, 0xb1, 0xfa, 0x05, 0x43, 0x3e, 0xf5, 0x3f, 0x83, 0x87, 0xb1, 0xfd, 0xc6,
-	0x3e, 0x25, 0x27, 0x05, 0x0d, 0xba, 0x8e, 0xd4, 0xf9, 0xcf, 0xe2, 0xbb, 0xe6, 0xa8, 0xc7, 0x50,
-	0xc1, 0xb2, 0x4e, 0x4e, 0x51, 0x33, 0x6a, 0x51, 0x04, 0x72, 0x02, 0x5b, 0xf2, 0x13, 0x8e, 0x28,
-	0x5c, 0x44, 0x3a, 0x82, 0x1d, 0x6c, 0x3c, 0xbe, 0x25, 0xf9, 0x68, 0x7a, 0x11, 0xe9, 0x41, 0x43,
-	0x7d, 0x4e, 0x22, 0x37, 0x29, 0xbd, 0xe5, 0x26, 0x99, 0x55, 0xe4, 0xf7, 0xa0, 0x19, 0xdf, 0x5a,
-	0x6d, 0x54, 0x7e, 0xcb, 0x8d, 0xf2, 0x0b, 0x45, 0x3f, 0x2d, 0xc5, 0x9c, 0x79, 0x15, 0xbd, 0xa9,
-	0x9f, 0xce, 0xb2, 0x76, 0xfe, 0xd2, 0x80, 0x8a, 0xda, 0xa7, 0x0d, 0x15, 0xe9, 0xd1, 0x32, 0x7f,
-	0x9c, 0x3d, 0xa0, 0x0a, 0x26, 0x9d, 0x64, 0x92, 0xa0, 0x07, 0xbf, 0xf1, 0x2c, 0x21, 0x99, 0x4f,
-	0x14, 0xd6, 0xcd, 0x27, 0x92, 0x39, 0x40, 0x29, 0x37, 0x07, 0x38, 0xd9, 0x85, 0xa6, 0xdc, 0xff,
-	0xca, 0x57, 0xde, 0x65, 0xf2, 0xd8, 0x07, 0x52, 0x1f, 0xae, 0xfc, 0xea, 0x3e, 0xd0, 0x81, 0xda,
-	0x64, 0xa6, 0xec, 0x5c, 0x15, 0x51, 0x1a, 0x36, 0x7f, 0x02, 0x35, 0x6d, 0x1f, 0xa2, 0xba, 0xbc,
-	0x4d, 0x26, 0x70, 0x72, 0xe2, 0xbd, 0x07, 0x65, 0x8e, 0x2d, 0x83, 0xfc, 0x30, 0x45, 0x02, 0xa4,
-	0xad, 0x87, 0x47, 0x49, 0x5d, 0x23, 0x11, 0xea, 0x8d, 0xc3, 0x17, 0x48, 0x2c, 0xc5, 0x6f, 0x1c,
-	0x10, 0x36, 0xff, 0xbb, 0x00, 0x15, 0x39, 0xd4, 0xfc, 0x35, 0x36, 0xbe, 0xa4, 0x0f, 0xbb, 0x72,
-	0x7c, 0x9d, 0x6a, 0xe4, 0x94, 0xf9, 0x3e, 0x52, 0xdf, 0x02, 0xa5, 0x7b, 0xbc, 0x81, 0x7b, 0xe3,
-	0xd1, 0xd5, 0x15, 0xeb, 0x26, 0x80, 0x9d, 0xbf, 0x36, 0xa0, 0x99, 0x5b, 0x8a, 0x0d, 0xe0, 0x3d,
-	0x77, 0xe2, 0x06, 0xf0, 0x9e, 0x3b, 0x89, 0xf8, 0x0a, 0x6f, 0x12, 0x5f, 0x31, 0x2b, 0x3e, 0xf2,
-	0x3d, 0xd8, 0x46, 0xa6, 0xd8, 0xbe, 0x4b, 0x6f, 0x78, 0x39, 0x9b, 0xe1, 0x34, 0x8f, 0x60, 0xff,
-	0x0b, 0xf4, 0xbb, 0x53, 0xd1, 0x20, 0x8a, 0x80, 0xab, 0x87, 0x74, 0x1b, 0x15, 0x61, 0xfe, 0x83,
-	0x01, 0x85, 0x41, 0x4f, 0xe4, 0xa0, 0x05, 0x4b, 0xd1, 0x15, 0x24, 0xf0, 0xb7, 0xb6, 0xeb, 0xcc,
-	0xf4, 0x08, 0x50, 0x41, 0xe4, 0x9b, 0x50, 0x5d, 0x44, 0xd7, 0xaf, 0xd8, 0x32, 0x50, 0x81, 0x65,
-	0xcb, 0x1a, 0xf4, 0xac, 0xa1, 0x44, 0x51, 0x4d, 0x13, 0xd1, 0xf5, 0x3a, 0xd6, 0x0f, 0xde, 0xa4,
-	0x41, 0x53, 0x98, 0xce, 0x0f, 0xa1, 0xaa, 0xd6, 0x08, 0x99, 0x70, 0x87, 0xc9, 0x31, 0xa7, 0x2c,
-	0x68, 0x62, 0x58, 0x1c, 0x5f, 0x2d, 0x52, 0x85, 0x91, 0x06, 0xcd, 0xff, 0x31, 0xa0, 0x9e, 0x34,
-	0x4e, 0x4f, 0xa1, 0x1a, 0x30, 0xa9, 0x6a, 0x39, 0x8c, 0x24, 0xc9, 0x17, 0x5d, 0xd6, 0x48, 0x52,
-	0xa8, 0x66, 0x11, 0x2d, 0x4c, 0x5c, 0x5f, 0x89, 0x82, 0x3b, 0x50, 0x9b, 0xe7, 0xb0, 0xe6, 0x4f,
-	0xf1, 0xe5, 0x9f, 0x5c, 0xb3, 0x05, 0xd5, 0xf3, 0xc1, 0x68, 0x3c, 0xb8, 0xfc, 0xbc, 0xf5, 0x80,
-	0xd4, 0xa1, 0x7c, 0x45, 0x7b, 0x7d, 0xda, 0x32, 0xc8, 0x3e, 0x10, 0xfc, 0xfb, 0xb2, 0x7b, 0x75,
-	0x79, 0x3a, 0xa0, 0x17, 0xc7, 0xe3, 0xc1, 0xd5, 0x65, 0xab, 0x40, 0xbe, 0x01, 0xbb, 0x12, 0x7f,
-	0xfa, 0xe2, 0xfc, 0x74, 0x70, 0x7e, 0x7e, 0xd1, 0xbf, 0x1c, 0xb7, 0x8a, 0x64, 0x0f, 0x5a, 0x9a,
-	0xfd, 0x62, 0x78, 0xde, 0x47, 0xe6, 0x92, 0xd8, 0xbc, 0x37, 0x18, 0x0d, 0x5f, 0x8c, 0xfb, 0xad,
-	0xb2, 0xd8, 0x51, 0x01, 0x2f, 0x69, 0x7f, 0x74, 0x75, 0xfe, 0x02, 0x99, 0x2a, 0x04, 0xa0, 0x42,
-	0xfb, 0xf8, 0x39, 0x47, 0xd5, 0x64, 0xb0, 0x2d, 0xee, 0xc7, 0x1c, 0xfd, 0xdd, 0x98, 0x09, 0x55,
-	0x35, 0xea, 0x50, 0xb1, 0x23, 0xf9, 0xa0, 0x51, 0x13, 0x62, 0xff, 0x2f, 0xa4, 0xfc, 0x3f, 0x53,
-	0x7b, 0x16, 0x73, 0xb5, 0xe7, 0x49, 0xe9, 0x0f, 0x0b, 0x8b, 0xeb, 0xeb, 0x0a, 0xfa, 0xe5, 0x6f,
-	0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x59, 0xc6, 0x05, 0xa7, 0x29, 0x00, 0x00,
+	// 4129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x6f, 0x23, 0xc9,
+	0x79, 0xc3, 0x37, 0xf9, 0x91, 0x92, 0xa8, 0x1a, 0x59, 0xc3, 0x74, 0x36, 0x3b, 0x33, 0xc4, 0x78,
+	0x33, 0xde, 0x1d, 0xb7, 0xd7, 0xca, 0x62, 0xb1, 0x5e, 0x07, 0x6b, 0x4b, 0x24, 0x35, 0x62, 0x46,
+	0xaf, 0x2d, 0x52, 0xbb, 0x99, 0x00, 0xc1, 0xa4, 0xc9, 0x2e, 0x51, 0xe5, 0x69, 0x76, 0x73, 0xfb,
+	0xa1, 0x95, 0x92, 0x4b, 0xe0, 0x53, 0x80, 0x20, 0x08, 0x90, 0x43, 0x7c, 0x4b, 0x4e, 0xc9, 0x29,
+	0x48, 0x7e, 0x40, 0x72, 0xca, 0x1f, 0x30, 0xe0, 0x93, 0x73, 0xcf, 0x0f, 0x08, 0xe0, 0x9b, 0x83,
+	0x04, 0xc1, 0x57, 0x8f, 0x7e, 0x91, 0x9c, 0x87, 0x8d, 0xc0, 0xb7, 0xfe, 0x1e, 0x55, 0x5d, 0x55,
+	0xdf, 0xfb, 0xab, 0x82, 0xad, 0xa9, 0xe7, 0x86, 0xbe, 0x35, 0x0d, 0x03, 0x73, 0xe1, 0x7b, 0xa1,
+	0x67, 0x90, 0xa9, 0x17, 0xb9, 0xa1, 0x7f, 0x3b, 0xf5, 0x6c, 0xa6, 0x71, 0x1b, 0x73, 0x16, 0x04,
+	0xd6, 0x8c, 0x29, 0xf0, 0xfe, 0xcc, 0xf3, 0x66, 0x0e, 0xfb, 0x8e, 0x80, 0x26, 0xd1, 0xe5, 0x77,
+	0x42, 0x3e, 0x67, 0x41, 0x68, 0xcd, 0x17, 0x92, 0xa1, 0xfb, 0x1f, 0x65, 0xd8, 0xa6, 0x7c, 0x6a,
+	0xf9, 0x36, 0xb7, 0xdc, 0x9e, 0xfa, 0x01, 0xf9, 0x10, 0x36, 0xaf, 0x99, 0x6b, 0x7b, 0xfe, 0x31,
+	0x0f, 0x42, 0xee, 0xce, 0x82, 0x4e, 0xe1, 0x41, 0xe9, 0x71, 0x73, 0xaf, 0x6e, 0x2a, 0x04, 0xcd,
+	0xd1, 0xc9, 0x7b, 0x00, 0x93, 0xe8, 0x96, 0xf9, 0x67, 0xbe, 0xcd, 0xfc, 0x4e, 0xf1, 0x41, 0xe1,
+	0x71, 0x73, 0xaf, 0x6a, 0x0a, 0x88, 0xa6, 0x28, 0xe4, 0x18, 0xee, 0xc9, 0x91, 0x02, 0xec, 0x79,
+	0xee, 0x25, 0xf7, 0xe7, 0x56, 0xc8, 0x3d, 0xb7, 0x53, 0x12, 0x83, 0x88, 0xb9, 0x44, 0xa1, 0xeb,
+	0x86, 0x90, 0x21, 0xec, 0xa6, 0x48, 0x87, 0x91, 0x73, 0xc9, 0x1d, 0x67, 0xce, 0xdc, 0xb0, 0x53,
+	0x16, 0xeb, 0xdd, 0x36, 0xf3, 0x04, 0xba, 0x66, 0x00, 0xe9, 0xc3, 0x4e, 0xb2, 0xcc, 0x9e, 0x37,
+	0x5f, 0x38, 0x4c, 0xac, 0xaa, 0x22, 0x56, 0xd5, 0x36, 0x73, 0x78, 0xba, 0x92, 0x9b, 0x74, 0xa1,
+	0x66, 0xf3, 0x60, 0x11, 0x85, 0xac, 0x53, 0x15, 0x03, 0xeb, 0x66, 0x5f, 0xc2, 0x54, 0x13, 0xc8,
+	0x0f, 0x61, 0x5b, 0x7d, 0x52, 0x16, 0x78, 0x4e, 0x24, 0x7e, 0x53, 0x53, 0x9b, 0xef, 0xe7, 0x29,
+	0x74, 0x99, 0x39, 0x35, 0xc3, 0xfe, 0x74, 0xca, 0x16, 0xa1, 0xe5, 0x4e, 0x59, 0xa7, 0x9e, 0x9d,
+	0x21, 0xa1, 0xd0, 0x65, 0x66, 0x72, 0x1f, 0xaa, 0x3e, 0xbb, 0x8c, 0x5c, 0xbb, 0xd3, 0x10, 0xc3,
+	0x6a, 0x26, 0x15, 0x20, 0x55, 0x68, 0xf2, 0x3e, 0x40, 0xc0, 0x67, 0xae, 0x15, 0x46, 0x3e, 0x0b,
+	0x3a, 0x20, 0x4e, 0x13, 0xcc, 0x91, 0x46, 0xd1, 0x14, 0x95, 0xec, 0x42, 0x95, 0xf9, 0xbe, 0xe7,
+	0x07, 0x9d, 0xe6, 0x83, 0xd2, 0xe3, 0x06, 0x55, 0x50, 0xf7, 0x18, 0x48, 0x2f, 0xf2, 0x7d, 0xe6,
+	0x4e, 0x6f, 0xfb, 0xec, 0x92, 0xbb, 0x5c, 0x2c, 0x9e, 0x40, 0x19, 0x15, 0xb6, 0x53, 0x78, 0x50,
+	0x78, 0xdc, 0xa0, 0xe2, 0x9b, 0x74, 0xa1, 0x65, 0xf3, 0x6b, 0x1e, 0xf0, 0x09, 0x77, 0x78, 0x78,
+	0x2b, 0xf4, 0x67, 0x83, 0x66, 0x70, 0xdd, 0x7f, 0xbc, 0x0f, 0x35, 0xa5, 0x6e, 0x38, 0x47, 0xe0,
+	0x44, 0x33, 0x3d, 0x07, 0x7e, 0x93, 0xfb, 0x50, 0x97, 0xa2, 0x1d, 0xf6, 0x95, 0xfe, 0x95, 0xcc,
+	0x61, 0x9f, 0xc6, 0x48, 0xf2, 0x6d, 0xa8, 0xcf, 0x59, 0x68, 0xd9, 0x56, 0x68, 0x29, 0x5d, 0xdb,
+	0xd6, 0xea, 0x6c, 0x9e, 0x28, 0x02, 0x8d, 0x59, 0xc8, 0x43, 0x28, 0xf3, 0x90, 0xcd, 0x3b, 0x65,
+	0xc1, 0xba, 0x11, 0xb3, 0x0e, 0x43, 0x36, 0xa7, 0x82, 0x44, 0xf6, 0x61, 0x2b, 0xb8, 0xe2, 0x8b,
+	0x05, 0x77, 0x67, 0x67, 0x0b, 0xdc, 0x5c, 0xd0, 0xa9, 0x88, 0x93, 0xba, 0x17, 0x73, 0x8f, 0x32,
+	0x74, 0x9a, 0xe7, 0x27, 0x5d, 0xa8, 0x84, 0xd6, 0x0d, 0x0b, 0x3a, 0x55, 0x31, 0xb0, 0x15, 0x0f,
+	0x1c, 0x5b, 0x37, 0x54, 0x92, 0xc8, 0xb7, 0xa0, 0x36, 0xf5, 0xa2, 0x05, 0x4e, 0x5f, 0x13, 0x5c,
+	0x5b, 0x31, 0x57, 0x4f, 0xe0, 0xa9, 0xa6, 0x93, 0x77, 0x01, 0xe6, 0x9e, 0xcd, 0x7c, 0x2b, 0x44,
+	0x71, 0xd4, 0x85, 0x38, 0x52, 0x18, 0x62, 0x02, 0x09, 0x99, 0x3f, 0x0f, 0xf6, 0x5d, 0xbb, 0xe7,
+	0xb9, 0x36, 0x97, 0x8b, 0x6e, 0x88, 0x63, 0x5c, 0x41, 0x41, 0xc1, 0x48, 0x85, 0x38, 0xf7, 0x1c,
+	0x3e, 0xbd, 0xed, 0x80, 0xe0, 0xcc, 0xe0, 0x8c, 0xbf, 0x6a, 0x40, 0x5d, 0x9f, 0x1f, 0xe9, 0x40,
+	0xed, 0x9a, 0xf9, 0x01, 0xaa, 0x74, 0x41, 0x08, 0x51, 0x83, 0xe4, 0x00, 0x5a, 0xda, 0x81, 0x8d,
+	0x6f, 0x17, 0x4c, 0xc8, 0x68, 0x73, 0xef, 0xdd, 0x25, 0x11, 0x98, 0xbd, 0x14, 0x17, 0xcd, 0x8c,
+	0x21, 0x1f, 0x42, 0xf5, 0xd2, 0x43, 0xe3, 0x17, 0x02, 0xdc, 0xdc, 0xeb, 0x2c, 0x8f, 0x3e, 0x14,
+	0x74, 0xaa, 0xf8, 0xc8, 0x1e, 0x54, 0xd9, 0xcd, 0x82, 0xfb, 0xb7, 0x4a, 0x8e, 0x86, 0x29, 0x3d,
+	0xa2, 0xa9, 0x3d, 0xa2, 0x39, 0xd6, 0x1e, 0x91, 0x2a, 0x4e, 0x3c, 0x24, 0x4b, 0x98, 0x0a, 0xb3,
+	0x95, 0xfe, 0x72, 0x26, 0x25, 0xdb, 0xa0, 0x2b, 0x28, 0xe4, 0x09, 0x6c, 0x2d, 0x7c, 0x3e, 0xe5,
+	0xee, 0x4c, 0xab, 0xbb, 0x30, 0xfe, 0xc6, 0x41, 0xb1, 0x53, 0xa0, 0x79, 0x12, 0x31, 0xa0, 0xee,
+	0x58, 0xee, 0x2c, 0xb2, 0x66, 0x4c, 0x58, 0x7d, 0x83, 0xc6, 0x30, 0xfe, 0x99, 0x05, 0x53, 0xdf,
+	0xfb, 0x1a, 0x17, 0xe5, 0x45, 0xe1, 0x91, 0x17, 0x09, 0x31, 0xe2, 0x41, 0xae, 0xa0, 0x90, 0x47,
+	0x40, 0xa6, 0xfe, 0xed, 0x22, 0xf4, 0xf4, 0xec, 0x3d, 0xb4, 0x2c, 0x29, 0xce, 0xfa, 0xd4, 0xe3,
+	0xae, 0x38, 0xb5, 0x27, 0x9a, 0xab, 0x9f, 0xb6, 0x31, 0x10, 0xb3, 0xb6, 0x91, 0x2b, 0x8d, 0x27,
+	0x8f, 0x61, 0x03, 0x97, 0xcc, 0x4e, 0x3c, 0x9b, 0x5f, 0x72, 0xe6, 0x77, 0x9a, 0x0f, 0x0a, 0x8f,
+	0x8b, 0x62, 0x2f, 0x59, 0x02, 0x39, 0x84, 0x7b, 0x5a, 0x9d, 0x0f, 0x7d, 0x6f, 0xde, 0x93, 0xd1,
+	0x48, 0x2c, 0xa1, 0x25, 0xc4, 0xd3, 0x32, 0x53, 0x38, 0xba, 0x8e, 0x99, 0x7c, 0x0c, 0xbb, 0x69,
+	0xd2, 0xb9, 0x17, 0x84, 0x96, 0x23, 0xa6, 0xd9, 0x10, 0x3b, 0x59, 0x43, 0x45, 0x8d, 0x0a, 0xa2,
+	0x49, 0x30, 0xf5, 0xb9, 0x30, 0xa6, 0xce, 0xa6, 0x90, 0xf0, 0x0a, 0x8d, 0x1a, 0xa5, 0xb8, 0x68,
+	0x66, 0x0c, 0xf9, 0x1e, 0x34, 0xf0, 0x54, 0xed, 0x43, 0xf4, 0x85, 0x5b, 0x62, 0x82, 0xdf, 0x5e,
+	0xa1, 0x92, 0x9a, 0x85, 0x26, 0xdc, 0xc6, 0x05, 0xb4, 0xd2, 0x13, 0x93, 0x01, 0x6c, 0x4c, 0xb8,
+	0xe3, 0x70, 0x77, 0x76, 0xce, 0x7c, 0xee, 0xd9, 0xc2, 0x00, 0x36, 0xf7, 0xee, 0x2f, 0x4f, 0x77,
+	0x90, 0x66, 0xa3, 0xd9, 0x51, 0xc6, 0x1f, 0x43, 0x23, 0xfe, 0x1d, 0x9a, 0xd3, 0x84, 0xcf, 0x9e,
+	0x7a, 0x96, 0xa3, 0x7c, 0x9d, 0x06, 0xc9, 0xc7, 0x50, 0xb7, 0x99, 0x65, 0x3b, 0xdc, 0x65, 0x9d,
+	0xe2, 0x6b, 0x55, 0x3b, 0xe6, 0xed, 0xda, 0xd0, 0x4a, 0x1b, 0x18, 0xd9, 0x86, 0x8d, 0xf3, 0xa3,
+	0xe7, 0xa3, 0x61, 0x6f, 0xff, 0xf8, 0xc5, 0xd3, 0xb3, 0xb3, 0x7e, 0xfb, 0x0e, 0x69, 0x43, 0xab,
+	0x3f, 0x7c, 0x3a, 0x1c, 0x6b, 0x4c, 0x81, 0x34, 0xa1, 0x36, 0x1a, 0xd0, 0x2f, 0x86, 0xbd, 0x41,
+	0xbb, 0x48, 0x36, 0x01, 0x7a, 0xf4, 0xec, 0xcb, 0xfe, 0x8b, 0xc3, 0x8b, 0xd3, 0x7e, 0xbb, 0x44,
+	0x08, 0x6c, 0xf6, 0xe8, 0xf3, 0xf3, 0xf1, 0x59, 0xef, 0x82, 0xd2, 0xc1, 0x69, 0xef, 0x79, 0xbb,
+	0xdc, 0xfd, 0x00, 0xaa, 0xd2, 0x10, 0xc9, 0x16, 0x34, 0x0f, 0x87, 0x7f, 0x38, 0xe8, 0xbf, 0x38,
+	0xa7, 0x38, 0x5c, 0xcc, 0x7e, 0xb2, 0x4f, 0x9f, 0x0d, 0xc6, 0x0a, 0x53, 0xec, 0x9e, 0xc0, 0x46,
+	0xe6, 0x44, 0x48, 0x1d, 0xca, 0xa7, 0x67, 0xa7, 0xc8, 0x0c, 0x50, 0xfd, 0x72, 0x30, 0x78, 0x76,
+	0xfc, 0x5c, 0x2e, 0xe2, 0xe4, 0xec, 0x74, 0x7c, 0x74, 0xfc, 0xbc, 0x5d, 0x24, 0x1b, 0xd0, 0xf8,
+	0xfc, 0x62, 0x9f, 0x8e, 0x07, 0xf4, 0xf8, 0x79, 0xbb, 0x84, 0x7c, 0xcf, 0x07, 0xfb, 0xf8, 0x5d,
+	0x36, 0xfe, 0xb9, 0x0e, 0x65, 0x74, 0xd2, 0x64, 0x07, 0x2a, 0x21, 0x0f, 0x1d, 0x1d, 0x6a, 0x24,
+	0x40, 0x1e, 0x40, 0xd3, 0x66, 0x89, 0xd2, 0x14, 0x05, 0x2d, 0x8d, 0x22, 0xef, 0xc1, 0xe6, 0xc2,
+	0xf7, 0xa6, 0x2c, 0x08, 0xb8, 0x3b, 0xc3, 0x33, 0x14, 0xde, 0xa6, 0x41, 0x73, 0x58, 0xd2, 0x81,
+	0x8a, 0x30, 0x08, 0xe1, 0x5a, 0xca, 0xc2, 0x42, 0x24, 0x02, 0xe3, 0x93, 0x1b, 0x5c, 0x7e, 0x2d,
+	0x92, 0x87, 0x3a, 0x15, 0xdf, 0x88, 0x0b, 0xad, 0x99, 0x74, 0xf4, 0x0d, 0x2a, 0xbe, 0xc9, 0x07,
+	0x50, 0xe5, 0x73, 0x6b, 0xc6, 0xb4, 0x63, 0xbf, 0x9b, 0x89, 0x32, 0xe6, 0x10, 0x69, 0x54, 0xb1,
+	0xa0, 0x6f, 0x9f, 0x5a, 0x21, 0x9b, 0x79, 0x3e, 0x67, 0xb1, 0x6f, 0x4f, 0x30, 0xb8, 0xdd, 0x99,
+	0x6f, 0xcd, 0xa5, 0x3b, 0x2f, 0x52, 0x09, 0x90, 0x77, 0xa0, 0x31, 0xd5, 0xfe, 0x5c, 0xb9, 0xef,
+	0x04, 0x41, 0x4c, 0xa8, 0x79, 0x2a, 0x72, 0x35, 0xc5, 0x0a, 0x76, 0xb2, 0x2b, 0x50, 0x61, 0x4b,
+	0x33, 0x91, 0x6f, 0x42, 0x39, 0x78, 0x19, 0x05, 0x9d, 0x96, 0x4a, 0xaf, 0x32, 0xcc, 0xa3, 0x97,
+	0x11, 0x15, 0x64, 0xf2, 0x28, 0xef, 0x43, 0x36, 0xc4, 0x92, 0xb2, 0x48, 0xf4, 0x84, 0x13, 0x3e,
+	0x3b, 0x17, 0x47, 0xb8, 0x29, 0x7d, 0x96, 0x86, 0xc9, 0xf7, 0xd4, 0x0c, 0xb1, 0x47, 0x95, 0xb6,
+	0x79, 0xd7, 0x5c, 0xce, 0x28, 0x68, 0x96, 0xd3, 0xf8, 0xf7, 0x02, 0x54, 0xe5, 0xba, 0x85, 0x1c,
+	0xac, 0x79, 0x9c, 0x6b, 0xe0, 0xf7, 0x1b, 0xc8, 0xff, 0x13, 0xa8, 0x5f, 0x5b, 0x3e, 0xb7, 0xdc,
+	0x30, 0xe8, 0x94, 0xc4, 0x46, 0xdf, 0x59, 0x75, 0x2a, 0xe6, 0x17, 0x92, 0x89, 0xc6, 0xdc, 0xc6,
+	0x11, 0xd4, 0x14, 0x72, 0xe5, 0xaf, 0xbf, 0x05, 0x15, 0x21, 0x4b, 0x65, 0xb0, 0x2b, 0xa5, 0x2d,
+	0x39, 0x8c, 0x9f, 0x16, 0xa0, 0x34, 0x7a, 0x19, 0x61, 0x00, 0x56, 0xb3, 0xf7, 0xbc, 0xf9, 0xc4,
+	0x13, 0x79, 0xf8, 0x06, 0xcd, 0xe0, 0x50, 0xc4, 0x0b, 0xdf, 0xb3, 0xa3, 0x69, 0xa8, 0x52, 0x9f,
+	0x06, 0x4d, 0x10, 0xe4, 0x01, 0x34, 0x82, 0xc8, 0x9f, 0x5e, 0x59, 0xfe, 0x4c, 0x2a, 0x72, 0x49,
+	0x68, 0x6a, 0x82, 0x24, 0xef, 0x42, 0xfd, 0xab, 0xc8, 0x72, 0x43, 0x8c, 0x0a, 0xe5, 0x98, 0x21,
+	0xc6, 0xe1, 0x1a, 0x26, 0x7c, 0x36, 0x8a, 0x27, 0xa9, 0xc8, 0x24, 0x20, 0x8d, 0xc3, 0x53, 0x9d,
+	0xf0, 0xd9, 0xe7, 0x7a, 0x9a, 0xaa, 0x3c, 0xd5, 0x14, 0xca, 0xf8, 0x49, 0x01, 0x2a, 0x62, 0x8b,
+	0x28, 0xf7, 0x4b, 0xee, 0xb0, 0xd4, 0xf1, 0xc4, 0x30, 0xd2, 0x3c, 0x9f, 0xcf, 0xb8, 0x6b, 0x39,
+	0x6a, 0x2b, 0x31, 0x8c, 0x0a, 0xee, 0xc4, 0xbb, 0x68, 0x50, 0x09, 0x60, 0xf6, 0x39, 0x67, 0x36,
+	0x8f, 0x64, 0xa6, 0xd6, 0xa0, 0x0a, 0x42, 0xee, 0x60, 0x6e, 0x39, 0x8e, 0x5a, 0xae, 0x04, 0x84,
+	0x15, 0x72, 0x57, 0x2f, 0x50, 0x7c, 0x1b, 0x7f, 0x53, 0x81, 0xcd, 0x6c, 0x9e, 0xb6, 0x52, 0x7a,
+	0x9f, 0x40, 0x39, 0x4c, 0x12, 0x97, 0x47, 0x6b, 0x52, 0xbc, 0x18, 0x14, 0xe9, 0x8b, 0x18, 0x41,
+	0xde, 0x83, 0x9a, 0xcf, 0x66, 0xc2, 0xca, 0x50, 0x9f, 0xf2, 0x81, 0x51, 0x13, 0xc9, 0xf7, 0xa1,
+	0x1e, 0x30, 0xff, 0x9a, 0x4f, 0x99, 0x4e, 0x24, 0xef, 0xaf, 0xfd, 0x8b, 0xe4, 0xa3, 0xf1, 0x00,
+	0xe3, 0x17, 0x45, 0xa8, 0x29, 0xec, 0xca, 0xe5, 0xc7, 0xde, 0xaa, 0x98, 0xf7, 0x56, 0x4f, 0x60,
+	0x9b, 0x05, 0x21, 0x9f, 0x5b, 0x21, 0xb3, 0xfb, 0xcc, 0xe1, 0xd7, 0xcc, 0xbf, 0x55, 0x67, 0xbc,
+	0x4c, 0x20, 0x1f, 0xc1, 0x5d, 0xcb, 0x96, 0xee, 0xc3, 0x72, 0x50, 0x71, 0xcf, 0x73, 0x3e, 0x70,
+	0x15, 0x39, 0x63, 0xeb, 0x95, 0x9c, 0xad, 0x7f, 0x0c, 0xbb, 0x13, 0x3e, 0xdb, 0x5f, 0x31, 0xa9,
+	0x94, 0xd2, 0x1a, 0x2a, 0x39, 0x84, 0x86, 0x6f, 0x85, 0x6c, 0xcc, 0x99, 0xaf, 0x1d, 0xe8, 0xe3,
+	0xd7, 0x9c, 0x97, 0x49, 0xd5, 0x00, 0x9a, 0x0c, 0x35, 0x3e, 0x85, 0xba, 0x46, 0xe3, 0xc9, 0x45,
+	0x8b, 0xb1, 0x27, 0x4e, 0xae, 0x4c, 0xc5, 0x77, 0x66, 0xed, 0xc5, 0xec, 0xda, 0xbb, 0x5f, 0x42,
+	0x2b, 0x2d, 0x70, 0x8c, 0x6e, 0xc7, 0x67, 0x18, 0x4b, 0xcf, 0x87, 0xbd, 0x67, 0x17, 0xe7, 0xed,
+	0x3b, 0xf9, 0x00, 0x58, 0x40, 0x96, 0x2f, 0x07, 0xc3, 0xa7, 0x47, 0xe3, 0x17, 0x07, 0xfb, 0xa3,
+	0x41, 0xbf, 0x5d, 0xc4, 0x08, 0xfa, 0xf9, 0xc5, 0xfe, 0xe9, 0x78, 0x38, 0x7e, 0xae, 0x70, 0x25,
+	0xe3, 0xaf, 0x0b, 0x50, 0x1a, 0x5b, 0x37, 0x98, 0x01, 0x84, 0xd6, 0x0d, 0xce, 0xad, 0x33, 0x00,
+	0x05, 0x92, 0x27, 0x00, 0xa1, 0x75, 0x43, 0x95, 0x62, 0x15, 0x57, 0x28, 0x56, 0x8a, 0x8e, 0x06,
+	0x1a, 0x5a, 0x37, 0x7a, 0xad, 0x42, 0xbc, 0x75, 0x9a, 0x46, 0x61, 0x7c, 0x59, 0x30, 0x7f, 0xca,
+	0xdc, 0xd0, 0x9a, 0x49, 0x79, 0x16, 0x69, 0x0a, 0x63, 0xfc, 0x43, 0x19, 0xaa, 0xb2, 0xde, 0x58,
+	0x13, 0x59, 0x77, 0xa0, 0x7c, 0x65, 0x05, 0x57, 0xf2, 0x8c, 0x8e, 0xee, 0x50, 0x01, 0x91, 0x47,
+	0x58, 0xdb, 0x05, 0xa2, 0x55, 0x21, 0x72, 0xba, 0x92, 0xa2, 0x66, 0xb0, 0xe4, 0x7d, 0xd8, 0x52,
+	0xbf, 0xea, 0x2b, 0xb4, 0x50, 0x93, 0xe2, 0x51, 0x81, 0xe6, 0x09, 0xe4, 0x7d, 0x15, 0x1b, 0x62,
+	0xce, 0xaa, 0xd6, 0xbd, 0xa3, 0x02, 0xcd, 0x92, 0xc8, 0x13, 0x68, 0x6b, 0x59, 0xc5, 0xec, 0x22,
+	0xeb, 0x3e, 0x2a, 0xd0, 0x25, 0x0a, 0x31, 0xa1, 0xea, 0xf0, 0x39, 0x0f, 0x03, 0x55, 0x4d, 0xef,
+	0xe6, 0x0a, 0x2d, 0xf3, 0x58, 0x50, 0xa9, 0xe2, 0x32, 0xfe, 0xb7, 0x00, 0x55, 0x89, 0xc2, 0xa4,
+	0x61, 0x8e, 0xa7, 0x6d, 0xb3, 0xb9, 0x0a, 0xa8, 0x52, 0x85, 0x72, 0x58, 0xf2, 0x09, 0x34, 0xae,
+	0x2d, 0x87, 0xdb, 0x98, 0xcb, 0xbe, 0x41, 0xe2, 0x96, 0x30, 0x93, 0x4f, 0x01, 0x04, 0x70, 0xe1,
+	0x86, 0xdc, 0xe9, 0x94, 0x5e, 0x3b, 0x34, 0xc5, 0x4d, 0xf6, 0x60, 0x67, 0xc2, 0x67, 0x27, 0xdc,
+	0xe5, 0xf3, 0x68, 0x2e, 0x9a, 0x16, 0x5f, 0x58, 0x4e, 0xc4, 0x94, 0xcb, 0x5c, 0x49, 0x43, 0xb7,
+	0xef, 0xb9, 0x53, 0x76, 0xce, 0xfc, 0x03, 0x6c, 0x75, 0xa8, 0x64, 0x26, 0x83, 0x3b, 0xa8, 0xca,
+	0x62, 0xfe, 0x00, 0xa0, 0xae, 0xc5, 0xd9, 0xfd, 0xf3, 0x16, 0x54, 0xc4, 0x34, 0x98, 0x06, 0xc8,
+	0x4a, 0x71, 0xdf, 0xb6, 0x7d, 0x16, 0x04, 0x4a, 0x5d, 0xb2, 0x48, 0x0c, 0x50, 0x12, 0x71, 0xc8,
+	0xd2, 0xce, 0x29, 0x41, 0x92, 0x0f, 0xa0, 0x1e, 0xa4, 0x15, 0x17, 0x2b, 0x60, 0xf1, 0x87, 0xd8,
+	0xca, 0x69, 0xcc, 0x40, 0x7e, 0x07, 0x6a, 0xa2, 0x35, 0x33, 0xec, 0x77, 0xca, 0x49, 0x1b, 0x40,
+	0xe3, 0xf0, 0xfc, 0xe3, 0x1e, 0x58, 0xa7, 0xf2, 0xda, 0x43, 0x4c, 0x98, 0xc9, 0x43, 0xa8, 0x60,
+	0xd5, 0xaf, 0x4b, 0xf5, 0xa6, 0x5a, 0x82, 0xe8, 0x07, 0x48, 0x0a, 0x79, 0x0c, 0xb5, 0x85, 0x75,
+	0x3b, 0x67, 0x4a, 0xc9, 0x9a, 0x7b, 0x9b, 0x8a, 0xe9, 0x5c, 0x62, 0xa9, 0x26, 0xa3, 0xb1, 0xf9,
+	0x16, 0x6a, 0xd6, 0x33, 0x76, 0x2b, 0x93, 0xb9, 0x16, 0x4d, 0x61, 0x50, 0x60, 0x96, 0x13, 0x32,
+	0xdf, 0xb5, 0x42, 0x86, 0xf9, 0xba, 0x35, 0x0d, 0x87, 0xee, 0xa5, 0xa7, 0x6a, 0xbb, 0x95, 0xb4,
+	0x74, 0xed, 0x0d, 0xd9, 0xda, 0x5b, 0x46, 0x70, 0x1a, 0x9f, 0x72, 0x33, 0x8e, 0xe0, 0x31, 0xce,
+	0xf8, 0x59, 0x01, 0xea, 0xb1, 0x2f, 0xd8, 0x85, 0x2a, 0x1e, 0xa8, 0x72, 0x84, 0x0d, 0xaa, 0x20,
+	0xfc, 0x85, 0xa5, 0x64, 0x29, 0x3d, 0xa1, 0x06, 0x45, 0x5b, 0x07, 0x23, 0x7f, 0x49, 0xb5, 0x75,
+	0x30, 0x71, 0xc0, 0x10, 0x1c, 0x5a, 0xa1, 0x56, 0x33, 0x09, 0x08, 0x3f, 0x93, 0x94, 0x78, 0x32,
+	0x18, 0xa4, 0x30, 0x18, 0x2d, 0x55, 0x63, 0x53, 0x18, 0xf6, 0x52, 0xb4, 0x54, 0x44, 0xdc, 0x94,
+	0xfa, 0xf9, 0xa9, 0x17, 0x8a, 0x14, 0x5a, 0x6c, 0x2a, 0x8d, 0x33, 0x7e, 0x56, 0x52, 0xb5, 0xc0,
+	0x03, 0x68, 0x3a, 0xd2, 0x94, 0x8f, 0xd0, 0x45, 0xc9, 0x5d, 0xa5, 0x51, 0x99, 0x2c, 0x48, 0xf4,
+	0x9f, 0x72, 0x59, 0xd0, 0x93, 0x24, 0x55, 0x96, 0x49, 0x21, 0x49, 0x29, 0xc0, 0x52, 0xa2, 0x7c,
+	0x00, 0x9b, 0xd9, 0x56, 0x4f, 0xdc, 0x7f, 0x48, 0x0d, 0xca, 0x35, 0x87, 0x72, 0x23, 0xf0, 0x48,
+	0xe7, 0x6c, 0xee, 0xa9, 0x23, 0x12, 0xdf, 0xb8, 0x0f, 0xd9, 0xeb, 0xc1, 0xb3, 0xd0, 0xc5, 0x44,
+	0x1a, 0x25, 0xaa, 0x17, 0xa9, 0x64, 0xda, 0xea, 0x6a, 0xaa, 0x7a, 0xc9, 0x60, 0x49, 0x17, 0x40,
+	0xef, 0xed, 0xe3, 0x8f, 0x3a, 0xf5, 0xd8, 0xee, 0x52, 0xd8, 0x7c, 0x56, 0xd7, 0x58, 0xce, 0xea,
+	0xf6, 0x5e, 0x99, 0x6b, 0xef, 0x40, 0xe5, 0x5a, 0xf8, 0x19, 0xa9, 0x2c, 0x12, 0x30, 0x3e, 0x7b,
+	0xa3, 0x74, 0xab, 0x03, 0x35, 0x95, 0xdb, 0x68, 0x55, 0x53, 0xa0, 0xf1, 0xf7, 0x25, 0xa8, 0x29,
+	0x83, 0x22, 0xdf, 0xc6, 0xec, 0x2f, 0xbc, 0x8a, 0xab, 0xed, 0x6f, 0x64, 0x0d, 0x0e, 0x6b, 0xee,
+	0x2b, 0xcf, 0xa6, 0x8a, 0x09, 0x53, 0xe5, 0xb8, 0x1b, 0xa6, 0x53, 0xe5, 0x18, 0x41, 0x0c, 0xa8,
+	0x5a, 0x73, 0x11, 0x22, 0x4a, 0xf1, 0x71, 0x28, 0x0c, 0x8e, 0x9c, 0x5e, 0x59, 0xdc, 0x15, 0xbd,
+	0x4b, 0xa9, 0xcf, 0x09, 0x22, 0x6d, 0x17, 0x95, 0xac, 0x5d, 0x88, 0x0e, 0x9a, 0xcd, 0xd8, 0x7c,
+	0x24, 0xea, 0x0b, 0x95, 0xd2, 0x64, 0x70, 0xc8, 0x13, 0x2f, 0xe2, 0x19, 0xbb, 0x15, 0x02, 0x6b,
+	0xd1, 0x0c, 0x8e, 0xec, 0xa2, 0xa7, 0xe5, 0x6e, 0xa7, 0x1e, 0x77, 0x96, 0x04, 0x8c, 0xeb, 0xc2,
+	0xf4, 0x48, 0x2e, 0x5b, 0x0a, 0x28, 0x41, 0x90, 0xef, 0xc3, 0xa6, 0x5c, 0x7f, 0x5c, 0x47, 0xc1,
+	0xfa, 0x3a, 0x2a, 0xc7, 0xda, 0xfd, 0x04, 0xaa, 0xf2, 0xf8, 0xc8, 0x5d, 0xd8, 0xda, 0xef, 0xf7,
+	0xe9, 0x60, 0x34, 0x7a, 0x41, 0x07, 0x9f, 0x5f, 0x0c, 0x46, 0x63, 0x59, 0x9b, 0xf7, 0x87, 0x74,
+	0xd0, 0x1b, 0xb7, 0x0b, 0x58, 0x8e, 0x9f, 0x9c, 0xf5, 0x07, 0x74, 0x7f, 0x8c, 0x09, 0x4d, 0xf7,
+	0x97, 0x45, 0xd8, 0x5e, 0xee, 0xd6, 0x77, 0xa0, 0xe6, 0x21, 0x72, 0xd8, 0xd7, 0xa9, 0x8c, 0x02,
+	0xb3, 0x4e, 0xb9, 0xf8, 0x36, 0x4e, 0x79, 0x59, 0xdb, 0x4b, 0x2b, 0xb5, 0xfd, 0x09, 0x6c, 0xf9,
+	0xec, 0xab, 0x88, 0x05, 0x21, 0xb3, 0xd5, 0x61, 0x25, 0x19, 0x6b, 0x9e, 0x44, 0x7e, 0x1f, 0xda,
+	0xd2, 0x17, 0x8f, 0x92, 0x1e, 0xb8, 0x4c, 0xc8, 0xdb, 0x26, 0xcd, 0x12, 0xe8, 0x12, 0x27, 0x76,
+	0xf1, 0x84, 0x67, 0xcd, 0xfe, 0x4e, 0x0a, 0x7e, 0x05, 0x85, 0x9c, 0xc0, 0xbd, 0xdc, 0x02, 0x62,
+	0x69, 0xd5, 0xd6, 0x4b, 0x6b, 0xdd, 0x98, 0xee, 0x5f, 0x14, 0xa0, 0x29, 0x2f, 0x5e, 0xd8, 0x8f,
+	0xd8, 0x34, 0xfc, 0x7f, 0x39, 0x76, 0xec, 0x03, 0xf0, 0x99, 0xf6, 0x84, 0xdb, 0xe6, 0x01, 0x0f,
+	0x51, 0x1b, 0x93, 0x53, 0x11, 0xe4, 0xee, 0xcf, 0x4b, 0xb0, 0x95, 0x3b, 0x2f, 0xf2, 0xc3, 0x54,
+	0x1b, 0xbe, 0x20, 0xfe, 0xf9, 0x28, 0x7f, 0xa6, 0xe6, 0xd8, 0xb7, 0xdc, 0xc0, 0x9a, 0xe2, 0x3e,
+	0x57, 0x74, 0xe6, 0xdf, 0x81, 0x46, 0x7c, 0xfb, 0x20, 0x96, 0xdd, 0xa2, 0x09, 0xc2, 0xf8, 0xcf,
+	0x22, 0xdc, 0x5d, 0x31, 0x3e, 0x15, 0x01, 0x46, 0xc9, 0xd5, 0x41, 0x1a, 0x85, 0xf3, 0xc6, 0x11,
+	0x58, 0xcf, 0x1b, 0x23, 0x96, 0x8c, 0xb4, 0xb4, 0xc2, 0x48, 0xbb, 0xd0, 0x52, 0x13, 0x8e, 0x45,
+	0x7a, 0x2c, 0xfd, 0x44, 0x06, 0x47, 0x8e, 0xa0, 0x11, 0x5e, 0x45, 0xf3, 0x89, 0x6b, 0x71, 0x47,
+	0x25, 0x20, 0xef, 0xbf, 0xc9, 0x01, 0xa8, 0xfe, 0x40, 0x32, 0xd8, 0xf8, 0x33, 0x5d, 0x50, 0xeb,
+	0xa2, 0xb6, 0x90, 0x14, 0xb5, 0x49, 0xf9, 0x5b, 0x4c, 0x97, 0xbf, 0x49, 0xb1, 0x5c, 0xca, 0x17,
+	0xcb, 0xb2, 0xb4, 0x2e, 0xa7, 0x4b, 0xeb, 0x74, 0x31, 0x5e, 0xc9, 0x16, 0xe3, 0xdd, 0x73, 0x68,
+	0xe7, 0x85, 0x8e, 0x91, 0x9d, 0xbb, 0x8b, 0x28, 0x1c, 0xba, 0x36, 0xbb, 0x51, 0xfd, 0xff, 0x14,
+	0xe6, 0xd5, 0x82, 0xeb, 0xfe, 0xb4, 0x06, 0xed, 0xa5, 0x6b, 0xb9, 0x58, 0x79, 0xed, 0xac, 0xf2,
+	0xda, 0xf1, 0x1d, 0x50, 0x31, 0x75, 0x07, 0x94, 0x51, 0xe8, 0xd2, 0xdb, 0x28, 0xf4, 0x29, 0xb4,
+	0x17, 0x57, 0xb7, 0x01, 0x9f, 0x5a, 0x4e, 0x5c, 0x02, 0xcb, 0x3b, 0xc4, 0xee, 0xd2, 0x1d, 0xa2,
+	0x79, 0x9e, 0xe3, 0xa4, 0x4b, 0x63, 0xc9, 0x33, 0xd8, 0xb2, 0xf9, 0x8c, 0x87, 0xa9, 0xe9, 0xa4,
+	0x03, 0x79, 0xb8, 0x3c, 0x5d, 0x3f, 0xcb, 0x48, 0xf3, 0x23, 0xf1, 0xda, 0x63, 0x61, 0xdd, 0x7a,
+	0x51, 0xa8, 0x2e, 0x15, 0x3b, 0x2b, 0x96, 0x24, 0xe8, 0x54, 0xf1, 0x91, 0x4f, 0x61, 0x2b, 0xe7,
+	0x96, 0x94, 0x2b, 0x59, 0xf6, 0x5f, 0x79, 0x46, 0x11, 0x8c, 0xbd, 0x50, 0x5e, 0x28, 0x62, 0x30,
+	0xf6, 0x42, 0x46, 0xfe, 0x04, 0x76, 0xe5, 0x15, 0xc2, 0x34, 0x76, 0x44, 0x6a, 0x57, 0x0d, 0x55,
+	0x77, 0x2f, 0xad, 0xa8, 0xb7, 0x92, 0x9f, 0xae, 0x99, 0xc7, 0x18, 0x43, 0x3b, 0x7f, 0xac, 0x22,
+	0x05, 0xc0, 0x44, 0x81, 0xf9, 0x5a, 0xf8, 0x0a, 0x44, 0xb7, 0x8f, 0x2d, 0xec, 0x97, 0xdc, 0x9d,
+	0x9d, 0x46, 0xf3, 0x09, 0xd3, 0xc1, 0x3c, 0x87, 0x35, 0x7e, 0x00, 0x5b, 0xb9, 0xd3, 0x25, 0x6d,
+	0x28, 0x45, 0xbe, 0x6e, 0xa7, 0xe3, 0x27, 0xaa, 0xf9, 0xc2, 0x0a, 0x82, 0xaf, 0x3d, 0xdf, 0xd6,
+	0xf5, 0xbd, 0x86, 0x8d, 0xcf, 0x60, 0x77, 0xf5, 0x46, 0xb0, 0xb8, 0x09, 0x13, 0x2b, 0x8d, 0x9d,
+	0x6b, 0x16, 0x69, 0xfc, 0xb2, 0x00, 0x55, 0x29, 0x9b, 0xd8, 0x67, 0x16, 0x5e, 0xe9, 0x33, 0x71,
+	0x5e, 0x29, 0xc4, 0xfd, 0x4c, 0xa2, 0x9d, 0x45, 0x12, 0x13, 0xda, 0x12, 0x71, 0xc8, 0x44, 0x35,
+	0x76, 0x1b, 0xb2, 0x54, 0xd2, 0xb2, 0x44, 0x23, 0x1f, 0xc2, 0x5d, 0xac, 0x76, 0xf3, 0x43, 0xa4,
+	0xb9, 0xaf, 0x22, 0x91, 0x7d, 0xd8, 0x8e, 0x67, 0x89, 0xe3, 0x51, 0x65, 0x7d, 0x3c, 0x5a, 0xe6,
+	0xee, 0xfe, 0x6b, 0x01, 0xb6, 0xf2, 0x37, 0xe4, 0xeb, 0x0d, 0xfa, 0x57, 0x8f, 0x46, 0xdf, 0x05,
+	0x90, 0x3f, 0x1f, 0xbd, 0x32, 0x26, 0xa5, 0x98, 0xc8, 0x43, 0xa8, 0x49, 0xbd, 0x0f, 0x94, 0x99,
+	0xd7, 0x94, 0x61, 0x50, 0x8d, 0xef, 0xfe, 0x53, 0x01, 0x76, 0xc5, 0xea, 0xcf, 0xe3, 0xb6, 0xff,
+	0xa1, 0xc5, 0x1d, 0x34, 0x91, 0xf5, 0x21, 0xf5, 0x08, 0x76, 0xac, 0x30, 0xc4, 0x6a, 0x9f, 0xd9,
+	0x27, 0xf2, 0x29, 0x46, 0xea, 0xb6, 0x73, 0xc7, 0x54, 0x38, 0x33, 0x45, 0xa3, 0x2b, 0x47, 0x10,
+	0x13, 0xea, 0xfa, 0xee, 0x33, 0x7e, 0x1a, 0xb1, 0xf4, 0x52, 0x83, 0xc6, 0x3c, 0xdd, 0xff, 0x29,
+	0x43, 0x55, 0x6e, 0x81, 0xec, 0xe9, 0xe2, 0xb2, 0x9f, 0x04, 0x59, 0xa2, 0xf6, 0x67, 0xd2, 0x98,
+	0x42, 0x53, 0x5c, 0xaf, 0xf6, 0xcd, 0xc4, 0x84, 0xa6, 0xbc, 0x47, 0xa7, 0x6c, 0xe1, 0xdc, 0xaa,
+	0xf5, 0xb4, 0xf4, 0x91, 0x21, 0x8e, 0xa6, 0x19, 0x8c, 0xff, 0x2a, 0x01, 0xd0, 0xcc, 0xe4, 0x49,
+	0x64, 0x2d, 0xe4, 0x23, 0xeb, 0x6b, 0x6f, 0xee, 0x4d, 0x68, 0xc8, 0xef, 0x11, 0xd7, 0x0d, 0x80,
+	0x65, 0x3f, 0x96, 0xb0, 0xbc, 0xae, 0x05, 0x80, 0x29, 0x33, 0x7e, 0x9e, 0x62, 0xc9, 0x51, 0x51,
+	0x29, 0xb3, 0x46, 0x88, 0x6e, 0x1f, 0x02, 0xf8, 0xaf, 0xaa, 0x58, 0x6a, 0x0c, 0x67, 0x72, 0x00,
+	0xa4, 0xe7, 0x13, 0x75, 0xe4, 0xc9, 0xa8, 0x71, 0xfd, 0x6d, 0xd4, 0x18, 0xb5, 0xea, 0x9a, 0xf9,
+	0x18, 0xb4, 0x1b, 0xb2, 0x7e, 0x57, 0x20, 0x52, 0xbe, 0x8a, 0xac, 0xd4, 0xb5, 0xad, 0x06, 0xf3,
+	0xb7, 0x19, 0x4d, 0x41, 0x4d, 0xa3, 0xd0, 0x9f, 0xd8, 0xca, 0x67, 0x8d, 0x16, 0x8c, 0xd9, 0xe2,
+	0x6e, 0x76, 0x83, 0x66, 0x91, 0xe4, 0x31, 0x6c, 0x4d, 0xa3, 0x20, 0xf4, 0xe6, 0xcc, 0x57, 0xad,
+	0x52, 0x71, 0x67, 0xb3, 0x41, 0xf3, 0x68, 0x4c, 0x21, 0x7c, 0x76, 0xcd, 0xd9, 0xd7, 0xea, 0xce,
+	0x46, 0x41, 0xdd, 0x1f, 0x17, 0xa1, 0x99, 0xd2, 0x07, 0xf2, 0x11, 0xb6, 0x75, 0x16, 0xce, 0x6d,
+	0x4a, 0x07, 0x77, 0xd3, 0x0a, 0x63, 0x52, 0x4d, 0xa5, 0x09, 0xe3, 0x6b, 0x72, 0xbb, 0xbf, 0x2b,
+	0x40, 0x83, 0xa6, 0x79, 0x7f, 0x1d, 0xad, 0xea, 0x40, 0x4d, 0x3d, 0x96, 0x52, 0xc9, 0x90, 0x06,
+	0xb3, 0x22, 0x2c, 0xbf, 0x85, 0x08, 0xbb, 0x3f, 0x2f, 0x40, 0x4d, 0x3d, 0xc0, 0xc9, 0xce, 0x52,
+	0x78, 0x1b, 0x45, 0xd8, 0x81, 0xca, 0xd4, 0xb1, 0xf8, 0x5c, 0xe7, 0x6e, 0x02, 0x58, 0x0e, 0x0c,
+	0xa5, 0x55, 0x81, 0xe1, 0x77, 0xa1, 0xe1, 0x45, 0xe1, 0xc2, 0xe3, 0x6e, 0xa8, 0x5d, 0x5b, 0xc3,
+	0x3c, 0x53, 0x18, 0x9a, 0xd0, 0xb0, 0x4a, 0x09, 0x98, 0xcf, 0x2d, 0x87, 0xff, 0x29, 0xb3, 0xb5,
+	0x3f, 0x11, 0xe6, 0xd0, 0xa2, 0x2b, 0x28, 0xdd, 0x1f, 0x57, 0x61, 0x7b, 0xe9, 0x75, 0xd2, 0xaf,
+	0xb1, 0xc9, 0x54, 0x20, 0x28, 0x66, 0x03, 0x01, 0x36, 0x88, 0x7c, 0x6f, 0xe1, 0x05, 0xcc, 0x3e,
+	0xd0, 0x0d, 0xa5, 0x14, 0x06, 0xe9, 0x7e, 0xbc, 0x02, 0x15, 0xc2, 0x52, 0x18, 0xf2, 0xdd, 0x38,
+	0x5d, 0x92, 0xe1, 0xea, 0xb7, 0x96, 0x5f, 0x55, 0xe5, 0xf3, 0xa5, 0x0f, 0xe1, 0x6e, 0x6c, 0xc4,
+	0xb1, 0x63, 0x91, 0xed, 0x95, 0x16, 0x5d, 0x45, 0x32, 0x7e, 0x51, 0x7a, 0xdb, 0xc0, 0xfe, 0x10,
+	0xaa, 0x22, 0x17, 0x96, 0xbd, 0xfa, 0x8c, 0x58, 0x14, 0x81, 0x1c, 0x40, 0x53, 0x3e, 0x2b, 0x8b,
+	0xc2, 0x45, 0xa4, 0xdd, 0xfe, 0x83, 0xb5, 0xcb, 0x37, 0x25, 0x1f, 0x4d, 0x0f, 0x22, 0x7d, 0x68,
+	0xa9, 0x27, 0x6e, 0x72, 0x92, 0xf2, 0x1b, 0x4e, 0x92, 0x19, 0x45, 0xfe, 0x00, 0xb6, 0xe2, 0x5d,
+	0xab, 0x89, 0x2a, 0x6f, 0x38, 0x51, 0x7e, 0x20, 0x36, 0x21, 0xe4, 0x31, 0x67, 0x9e, 0xc7, 0xac,
+	0x6b, 0x42, 0x64, 0x59, 0x8d, 0xbf, 0xc4, 0xdb, 0x5c, 0x39, 0x4f, 0x07, 0xaa, 0xd2, 0xad, 0xc9,
+	0xa0, 0x7b, 0x74, 0x87, 0x2a, 0x98, 0x18, 0x49, 0xfb, 0x45, 0x5f, 0x2f, 0x68, 0x44, 0xaa, 0xa9,
+	0x53, 0x5c, 0xd5, 0xd4, 0x49, 0x9a, 0x27, 0xe5, 0x5c, 0xf3, 0xe4, 0x60, 0x1b, 0xb6, 0xe4, 0xfc,
+	0x67, 0xbe, 0xb2, 0xae, 0x2e, 0x8f, 0x6d, 0x20, 0xf5, 0x98, 0xee, 0x57, 0xb7, 0x01, 0x03, 0xea,
+	0x53, 0x47, 0xe9, 0xb9, 0xca, 0x3c, 0x35, 0xdc, 0xfd, 0x11, 0xd4, 0xb5, 0x7e, 0x60, 0x4a, 0x7e,
+	0x95, 0xb4, 0x2d, 0xc5, 0x37, 0x3a, 0x09, 0x2e, 0xea, 0x2c, 0xf9, 0x58, 0x4e, 0x02, 0x78, 0xcb,
+	0x27, 0x3b, 0x6e, 0x49, 0x32, 0x28, 0x11, 0xea, 0x16, 0x2b, 0xdd, 0xf6, 0x8f, 0xe1, 0xee, 0xbf,
+	0x60, 0xee, 0x20, 0x1f, 0xfe, 0xfd, 0xe6, 0xba, 0x05, 0x64, 0x00, 0xdb, 0xb2, 0xe7, 0x9f, 0xaa,
+	0x7e, 0x95, 0xfa, 0xde, 0x53, 0xef, 0x13, 0xd3, 0x85, 0x31, 0xf6, 0xbc, 0xe9, 0xf2, 0x88, 0x95,
+	0x6d, 0xd3, 0x0e, 0x36, 0xe6, 0xfd, 0x90, 0x5b, 0x8e, 0xd0, 0xbd, 0x3a, 0xd5, 0x60, 0x56, 0x05,
+	0x6a, 0xf9, 0xfe, 0x99, 0x3c, 0xb4, 0xb1, 0x17, 0x5a, 0x8e, 0xaa, 0x87, 0x62, 0x18, 0x9f, 0xee,
+	0xc9, 0xfb, 0x80, 0x86, 0x7a, 0xba, 0xa7, 0x96, 0x98, 0xba, 0x10, 0x30, 0xfe, 0xb6, 0x00, 0x5b,
+	0xb9, 0x25, 0xe3, 0xfa, 0xc2, 0x1b, 0x6e, 0xc7, 0xd5, 0xfa, 0x0d, 0xb7, 0x13, 0xb1, 0x15, 0x5f,
+	0x25, 0xb6, 0x52, 0x56, 0x6c, 0xf8, 0x48, 0x42, 0x30, 0xc5, 0x76, 0x55, 0x7e, 0xc5, 0x23, 0x89,
+	0x0c, 0xa7, 0xf1, 0x59, 0xf2, 0x46, 0x86, 0xa7, 0xaa, 0x75, 0x09, 0xe4, 0xfb, 0xbe, 0xc5, 0xa5,
+	0xbe, 0x6f, 0x77, 0x0f, 0x76, 0xbf, 0x10, 0xfe, 0xe2, 0x90, 0xbb, 0x32, 0x50, 0xe8, 0x8e, 0xec,
+	0x5a, 0x05, 0xea, 0xfe, 0x5b, 0x01, 0x8a, 0xc3, 0x3e, 0x26, 0x10, 0x0b, 0x96, 0xa2, 0x2b, 0x08,
+	0xf1, 0x57, 0x96, 0x6b, 0x3b, 0xba, 0xdf, 0xab, 0x20, 0xf2, 0x4d, 0xa8, 0x2d, 0xa2, 0xc9, 0x4b,
+	0xbc, 0x27, 0x91, 0x0e, 0xb1, 0x69, 0x0e, 0xfb, 0xe6, 0xb9, 0x44, 0x51, 0x4d, 0xc3, 0xa8, 0x30,
+	0x89, 0xf5, 0x4a, 0x9c, 0x44, 0x8b, 0xa6, 0x30, 0xc6, 0x0f, 0xa0, 0xa6, 0xc6, 0xe0, 0x99, 0x72,
+	0x9b, 0xc9, 0xbd, 0xc9, 0xbc, 0x21, 0x86, 0xe5, 0x8b, 0x2b, 0x31, 0x48, 0xa5, 0x1f, 0x1a, 0xec,
+	0xfe, 0x77, 0x01, 0x1a, 0x49, 0x95, 0xfc, 0x04, 0xdb, 0xd3, 0x52, 0x45, 0x65, 0xe7, 0x99, 0x24,
+	0xaf, 0x63, 0xcd, 0x91, 0xa4, 0x50, 0xcd, 0x82, 0xf5, 0x6a, 0x9c, 0xc5, 0x60, 0x75, 0x15, 0xa8,
+	0xc9, 0x73, 0xd8, 0xee, 0x4f, 0x0a, 0x78, 0x89, 0x2f, 0xc7, 0x34, 0xa1, 0x76, 0x3c, 0x1c, 0x8d,
+	0x87, 0xa7, 0x4f, 0xdb, 0x77, 0x48, 0x03, 0x2a, 0x67, 0xb4, 0x3f, 0xa0, 0xed, 0x02, 0xd9, 0x05,
+	0x22, 0x3e, 0x5f, 0xf4, 0xce, 0x4e, 0x0f, 0x87, 0xf4, 0x64, 0x7f, 0x3c, 0x3c, 0x3b, 0x6d, 0x17,
+	0xc9, 0x37, 0x60, 0x5b, 0xe2, 0x0f, 0x2f, 0x8e, 0x0f, 0x87, 0xc7, 0xc7, 0x27, 0x83, 0xd3, 0x71,
+	0xbb, 0x44, 0x76, 0xa0, 0xad, 0xd9, 0x4f, 0xce, 0x8f, 0x07, 0x82, 0xb9, 0x8c, 0x93, 0xf7, 0x87,
+	0xa3, 0xf3, 0x8b, 0xf1, 0xa0, 0x5d, 0xc1, 0x19, 0x15, 0xf0, 0x82, 0x0e, 0x46, 0x67, 0xc7, 0x17,
+	0x82, 0xa9, 0x8a, 0x1d, 0x5e, 0x3a, 0x10, 0xaf, 0xbc, 0x6a, 0x5d, 0x06, 0x1b, 0xb8, 0x3f, 0x66,
+	0xeb, 0x37, 0xb8, 0x5d, 0xa8, 0xa9, 0xbe, 0x96, 0xf2, 0x79, 0xc9, 0xe3, 0x70, 0x4d, 0x88, 0xfd,
+	0x56, 0x31, 0xe5, 0xb7, 0x32, 0x19, 0x5e, 0x29, 0x97, 0xe1, 0x1d, 0x94, 0xff, 0xa8, 0xb8, 0x98,
+	0x4c, 0xaa, 0xc2, 0x9f, 0xfc, 0xde, 0xff, 0x0d, 0x00, 0x03, 0x43, 0x29, 0xa5, 0xf3, 0x2e, 0x00,
+	0x00,
 }
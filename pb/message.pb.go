@@ -47,6 +47,7 @@ const (
 	Message_BLOCK                    Message_MessageType = 19
 	Message_VENDOR_FINALIZED_PAYMENT Message_MessageType = 20
 	Message_ORDER_PAYMENT            Message_MessageType = 21
+	Message_DISPUTE_EVIDENCE         Message_MessageType = 22
 	Message_ERROR                    Message_MessageType = 500
 	Message_ORDER_PROCESSING_FAILURE Message_MessageType = 501
 )
@@ -74,6 +75,7 @@ var Message_MessageType_name = map[int32]string{
 	19:  "BLOCK",
 	20:  "VENDOR_FINALIZED_PAYMENT",
 	21:  "ORDER_PAYMENT",
+	22:  "DISPUTE_EVIDENCE",
 	500: "ERROR",
 	501: "ORDER_PROCESSING_FAILURE",
 }
@@ -101,6 +103,7 @@ var Message_MessageType_value = map[string]int32{
 	"BLOCK":                    19,
 	"VENDOR_FINALIZED_PAYMENT": 20,
 	"ORDER_PAYMENT":            21,
+	"DISPUTE_EVIDENCE":         22,
 	"ERROR":                    500,
 	"ORDER_PROCESSING_FAILURE": 501,
 }
@@ -265,6 +268,7 @@ type Chat struct {
 	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Flag                 Chat_Flag            `protobuf:"varint,5,opt,name=flag,proto3,enum=Chat_Flag" json:"flag,omitempty"`
+	Attachments          []*Chat_Attachment   `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -330,6 +334,84 @@ func (m *Chat) GetFlag() Chat_Flag {
 	return Chat_MESSAGE
 }
 
+func (m *Chat) GetAttachments() []*Chat_Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+type Chat_Attachment struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	MimeType             string   `protobuf:"bytes,2,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	Size                 uint64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Filename             string   `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Encrypted            bool     `protobuf:"varint,5,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Chat_Attachment) Reset()         { *m = Chat_Attachment{} }
+func (m *Chat_Attachment) String() string { return proto.CompactTextString(m) }
+func (*Chat_Attachment) ProtoMessage()    {}
+func (*Chat_Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{2, 0}
+}
+
+func (m *Chat_Attachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Chat_Attachment.Unmarshal(m, b)
+}
+func (m *Chat_Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Chat_Attachment.Marshal(b, m, deterministic)
+}
+func (m *Chat_Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chat_Attachment.Merge(m, src)
+}
+func (m *Chat_Attachment) XXX_Size() int {
+	return xxx_messageInfo_Chat_Attachment.Size(m)
+}
+func (m *Chat_Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chat_Attachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chat_Attachment proto.InternalMessageInfo
+
+func (m *Chat_Attachment) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *Chat_Attachment) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *Chat_Attachment) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Chat_Attachment) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *Chat_Attachment) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
 type SignedData struct {
 	SenderPubkey         []byte   `protobuf:"bytes,1,opt,name=senderPubkey,proto3" json:"senderPubkey,omitempty"`
 	SerializedData       []byte   `protobuf:"bytes,2,opt,name=serializedData,proto3" json:"serializedData,omitempty"`
@@ -650,6 +732,7 @@ func init() {
 	proto.RegisterType((*Message)(nil), "Message")
 	proto.RegisterType((*Envelope)(nil), "Envelope")
 	proto.RegisterType((*Chat)(nil), "Chat")
+	proto.RegisterType((*Chat_Attachment)(nil), "Chat.Attachment")
 	proto.RegisterType((*SignedData)(nil), "SignedData")
 	proto.RegisterType((*SignedData_Command)(nil), "SignedData.Command")
 	proto.RegisterType((*CidList)(nil), "CidList")
@@ -663,60 +746,66 @@ func init() {
 }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xae, 0x63, 0x67, 0x93, 0xbc, 0x64, 0x77, 0x67, 0x87, 0x6d, 0x65, 0x56, 0x6d, 0x89, 0x2c,
-	0x84, 0xc2, 0x25, 0x95, 0xb6, 0x12, 0xe2, 0xea, 0xb5, 0xc7, 0x8b, 0xa9, 0x63, 0x47, 0x13, 0xa7,
-	0x68, 0x7b, 0x89, 0x9c, 0x78, 0x9a, 0x9a, 0x26, 0xb6, 0xb1, 0x1d, 0x4a, 0xb8, 0x22, 0x7e, 0x11,
-	0xbf, 0x87, 0x23, 0xff, 0x00, 0xae, 0x08, 0xcd, 0x78, 0xdc, 0x6c, 0x8a, 0x54, 0xa9, 0xa7, 0xbc,
-	0xf7, 0xbd, 0x2f, 0xef, 0xcd, 0x7c, 0xef, 0xf3, 0xc0, 0xe9, 0x96, 0x95, 0x65, 0xb4, 0x66, 0xe3,
-	0xbc, 0xc8, 0xaa, 0xec, 0xea, 0xf3, 0x75, 0x96, 0xad, 0x37, 0xec, 0x99, 0xc8, 0x96, 0xbb, 0xd7,
-	0xcf, 0xa2, 0x74, 0x2f, 0x4b, 0x5f, 0x7c, 0x58, 0xaa, 0x92, 0x2d, 0x2b, 0xab, 0x68, 0x9b, 0xd7,
-	0x04, 0xe3, 0x2f, 0x0d, 0x3a, 0x93, 0xba, 0x1b, 0xfe, 0x06, 0xfa, 0xb2, 0x71, 0xb8, 0xcf, 0x99,
-	0xae, 0x0c, 0x95, 0xd1, 0xd9, 0xf5, 0xe5, 0x58, 0x96, 0x9b, 0x5f, 0x5e, 0xa3, 0xf7, 0x89, 0x78,
-	0x0c, 0x9d, 0x3c, 0xda, 0x6f, 0xb2, 0x28, 0xd6, 0x5b, 0x43, 0x65, 0xd4, 0xbf, 0xbe, 0x1c, 0xd7,
-	0x63, 0xc7, 0xcd, 0xd8, 0xb1, 0x99, 0xee, 0x69, 0x43, 0xc2, 0x8f, 0xa1, 0x57, 0xb0, 0x9f, 0x76,
-	0xac, 0xac, 0xdc, 0x58, 0x57, 0x87, 0xca, 0xa8, 0x4d, 0x0f, 0x00, 0x7e, 0x0a, 0x90, 0x94, 0x94,
-	0x95, 0x79, 0x96, 0x96, 0x4c, 0xd7, 0x86, 0xca, 0xa8, 0x4b, 0xef, 0x21, 0xc6, 0x1f, 0x2a, 0xf4,
-	0xef, 0x1d, 0x05, 0x77, 0x41, 0x9b, 0xba, 0xfe, 0x2d, 0x7a, 0xc0, 0x23, 0xeb, 0x3b, 0x33, 0x44,
-	0x0a, 0x06, 0x38, 0x71, 0x02, 0xcf, 0x0b, 0x7e, 0x40, 0x2d, 0x3c, 0x80, 0xee, 0xdc, 0x97, 0x99,
-	0x8a, 0x7b, 0xd0, 0x0e, 0xa8, 0x4d, 0x28, 0xd2, 0x30, 0x82, 0x81, 0x08, 0x17, 0x94, 0x7c, 0x4f,
-	0xac, 0x10, 0xb5, 0x0f, 0x88, 0x65, 0xfa, 0x16, 0xf1, 0xd0, 0x09, 0x7e, 0x04, 0x58, 0x22, 0x81,
-	0xef, 0xb8, 0x74, 0x62, 0x86, 0x6e, 0xe0, 0xa3, 0x0e, 0x7e, 0x08, 0x17, 0x35, 0xee, 0xcc, 0x3d,
-	0xc7, 0xf5, 0xbc, 0x09, 0xf1, 0x43, 0xd4, 0xc5, 0x97, 0x80, 0x1a, 0xfa, 0x64, 0xea, 0x11, 0x41,
-	0xee, 0xf1, 0xb6, 0xb6, 0x3b, 0x9b, 0xce, 0x43, 0xb2, 0x08, 0xa6, 0xc4, 0x47, 0x80, 0x31, 0x9c,
-	0x35, 0xc8, 0x7c, 0x6a, 0x9b, 0x21, 0x41, 0x7d, 0x7c, 0x01, 0xa7, 0x0d, 0x66, 0x79, 0xc1, 0x8c,
-	0xa0, 0x01, 0xbf, 0x06, 0x25, 0xce, 0xdc, 0xb7, 0xd1, 0x29, 0x3e, 0x87, 0x7e, 0xe0, 0x38, 0x9e,
-	0xeb, 0x93, 0x85, 0x69, 0xbd, 0x40, 0x67, 0x9c, 0xdf, 0x00, 0x94, 0x78, 0xe6, 0x1d, 0x3a, 0xe7,
-	0xd0, 0x24, 0xb0, 0x09, 0x35, 0xc3, 0x80, 0x2e, 0x4c, 0xdb, 0x46, 0x88, 0x9f, 0xe8, 0x00, 0x51,
-	0x32, 0x09, 0x5e, 0x12, 0x74, 0xc1, 0x55, 0x98, 0x85, 0x01, 0x25, 0x08, 0xf3, 0xf0, 0xc6, 0x0b,
-	0xac, 0x17, 0xe8, 0x33, 0xfc, 0x18, 0xf4, 0x97, 0xc4, 0xb7, 0x03, 0xba, 0x70, 0x5c, 0xdf, 0xf4,
-	0xdc, 0x57, 0xc4, 0x5e, 0x4c, 0xcd, 0x3b, 0x71, 0xb7, 0x4b, 0x31, 0x4f, 0xdc, 0xad, 0x81, 0x1e,
-	0x62, 0x80, 0x36, 0xa1, 0x34, 0xa0, 0xe8, 0x6f, 0x15, 0x3f, 0x01, 0x5d, 0x96, 0x69, 0x60, 0x91,
-	0xd9, 0xcc, 0xf5, 0x6f, 0x17, 0x8e, 0xe9, 0x7a, 0x73, 0x4a, 0xd0, 0x3f, 0xaa, 0x11, 0x43, 0x97,
-	0xa4, 0x3f, 0xb3, 0x4d, 0x96, 0x33, 0x6c, 0x40, 0x47, 0xda, 0x47, 0x78, 0xac, 0x7f, 0xdd, 0x6d,
-	0xbc, 0x45, 0x9b, 0x02, 0x7e, 0x04, 0x27, 0xf9, 0x6e, 0xf9, 0x96, 0xed, 0x85, 0xa5, 0x06, 0x54,
-	0x66, 0xdc, 0x3b, 0x65, 0xb2, 0x4e, 0xa3, 0x6a, 0x57, 0x30, 0xe1, 0x9d, 0x01, 0x3d, 0x00, 0xc6,
-	0x9f, 0x0a, 0x68, 0xd6, 0x9b, 0xa8, 0xe2, 0x34, 0xd9, 0xc9, 0x8d, 0xc5, 0x90, 0x1e, 0x3d, 0x00,
-	0x58, 0x87, 0x4e, 0xb9, 0x5b, 0xfe, 0xc8, 0x56, 0x95, 0xe8, 0xde, 0xa3, 0x4d, 0xca, 0x2b, 0xcd,
-	0xd1, 0xd4, 0xba, 0xd2, 0x1c, 0xe8, 0x5b, 0xe8, 0xbd, 0xff, 0x76, 0x84, 0x2b, 0xfb, 0xd7, 0x57,
-	0xff, 0xb3, 0x79, 0xd8, 0x30, 0xe8, 0x81, 0x8c, 0x9f, 0x82, 0xf6, 0x7a, 0x13, 0xad, 0xf5, 0xb6,
-	0xf8, 0x9e, 0x60, 0xcc, 0x0f, 0x38, 0x76, 0x36, 0xd1, 0x9a, 0x0a, 0xdc, 0xf8, 0x1a, 0x34, 0x9e,
-	0xe1, 0x3e, 0x74, 0x26, 0x64, 0x36, 0x33, 0x6f, 0x09, 0x7a, 0xc0, 0x57, 0x1f, 0xde, 0x09, 0x5f,
-	0x2b, 0xdc, 0xd7, 0x94, 0x98, 0x36, 0x6a, 0x19, 0xff, 0x2a, 0x00, 0xb3, 0x64, 0x9d, 0xb2, 0xd8,
-	0x8e, 0xaa, 0x08, 0x1b, 0x30, 0x28, 0x59, 0x1a, 0xb3, 0x62, 0x5a, 0x4b, 0xa5, 0x08, 0x3d, 0x8e,
-	0x30, 0xfc, 0x15, 0x9c, 0x95, 0xac, 0x48, 0xa2, 0x4d, 0xf2, 0x6b, 0xfd, 0x2f, 0x29, 0xe8, 0x07,
-	0xe8, 0xc7, 0x85, 0xbd, 0xfa, 0x5d, 0x81, 0x8e, 0x95, 0x6d, 0xb7, 0x51, 0x1a, 0x8b, 0xd5, 0x30,
-	0x56, 0xb8, 0xb6, 0x14, 0x56, 0x66, 0x78, 0x04, 0x5a, 0xc5, 0xdf, 0x8d, 0xd6, 0x47, 0xde, 0x0d,
-	0xc1, 0x38, 0xd6, 0x52, 0xfd, 0x04, 0x2d, 0x8d, 0x27, 0xd0, 0xb1, 0x92, 0xd8, 0x4b, 0xca, 0x0a,
-	0x63, 0xd0, 0x56, 0x49, 0x5c, 0xea, 0xca, 0x50, 0x1d, 0xf5, 0xa8, 0x88, 0x8d, 0xe7, 0xd0, 0xbe,
-	0xd9, 0x64, 0xab, 0xb7, 0x7c, 0x8f, 0x45, 0xf4, 0x4e, 0x5c, 0xb7, 0x16, 0xa5, 0x49, 0x31, 0x02,
-	0x75, 0x95, 0xc4, 0x72, 0xef, 0x3c, 0x34, 0xee, 0xa0, 0x4d, 0x8a, 0x22, 0x2b, 0x44, 0xc7, 0x2c,
-	0xae, 0x4d, 0x79, 0x4a, 0x45, 0xcc, 0x25, 0x66, 0xbc, 0x28, 0x2f, 0x21, 0xff, 0x77, 0x84, 0xf1,
-	0x61, 0x59, 0x11, 0x0b, 0x45, 0xa4, 0x69, 0x64, 0x6a, 0xfc, 0xa6, 0xc0, 0x79, 0xc0, 0xe3, 0x69,
-	0xb4, 0xdf, 0xb2, 0xb4, 0x0a, 0x7f, 0x49, 0xeb, 0x29, 0x49, 0x2a, 0xc5, 0x13, 0xf1, 0xfd, 0x0e,
-	0xad, 0xa3, 0x0e, 0xf8, 0x4b, 0x38, 0xad, 0x8a, 0x28, 0x2d, 0xa3, 0x55, 0x95, 0x64, 0xe9, 0xfb,
-	0x09, 0xc7, 0x20, 0x5f, 0xde, 0xbb, 0xa4, 0x7a, 0xe3, 0xa6, 0xf9, 0xae, 0x92, 0x4f, 0xe6, 0x01,
-	0xb8, 0xd1, 0x5e, 0xb5, 0xf2, 0xe5, 0xf2, 0x44, 0x28, 0xfb, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0x9f, 0x9a, 0x3b, 0x3d, 0x06, 0x00, 0x00,
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x51, 0x6f, 0xe2, 0x46,
+	0x10, 0x3e, 0x63, 0x13, 0x60, 0x20, 0xc9, 0x66, 0x9b, 0x8b, 0x68, 0x74, 0x77, 0x45, 0x56, 0x55,
+	0xd1, 0x17, 0x4e, 0xe2, 0xa4, 0xaa, 0xaf, 0x8e, 0xbd, 0xa4, 0xee, 0x19, 0x1b, 0x2d, 0x26, 0x55,
+	0xee, 0x05, 0x6d, 0xf0, 0x86, 0xb8, 0x07, 0x36, 0xb5, 0x4d, 0xaf, 0xdc, 0x6b, 0x55, 0xa9, 0x3f,
+	0xa2, 0x7f, 0xa8, 0xff, 0xa7, 0x7d, 0xaa, 0x54, 0x55, 0xbb, 0xb6, 0x71, 0xb8, 0xaa, 0x95, 0xee,
+	0x6d, 0xe6, 0x9b, 0xcf, 0x33, 0x3b, 0x33, 0xdf, 0x00, 0x1c, 0xaf, 0x79, 0x9a, 0xb2, 0x25, 0x1f,
+	0x6c, 0x92, 0x38, 0x8b, 0x2f, 0x3f, 0x5d, 0xc6, 0xf1, 0x72, 0xc5, 0x5f, 0x4a, 0xef, 0x6e, 0x7b,
+	0xff, 0x92, 0x45, 0xbb, 0x22, 0xf4, 0xd9, 0x87, 0xa1, 0x2c, 0x5c, 0xf3, 0x34, 0x63, 0xeb, 0x4d,
+	0x4e, 0xd0, 0xff, 0xd2, 0xa0, 0x31, 0xce, 0xb3, 0xe1, 0xaf, 0xa0, 0x5d, 0x24, 0xf6, 0x77, 0x1b,
+	0xde, 0x55, 0x7a, 0x4a, 0xff, 0x64, 0x78, 0x3e, 0x28, 0xc2, 0x83, 0x71, 0x15, 0xa3, 0x8f, 0x89,
+	0x78, 0x00, 0x8d, 0x0d, 0xdb, 0xad, 0x62, 0x16, 0x74, 0x6b, 0x3d, 0xa5, 0xdf, 0x1e, 0x9e, 0x0f,
+	0xf2, 0xb2, 0x83, 0xb2, 0xec, 0xc0, 0x88, 0x76, 0xb4, 0x24, 0xe1, 0x67, 0xd0, 0x4a, 0xf8, 0x0f,
+	0x5b, 0x9e, 0x66, 0x76, 0xd0, 0x55, 0x7b, 0x4a, 0xbf, 0x4e, 0x2b, 0x00, 0xbf, 0x00, 0x08, 0x53,
+	0xca, 0xd3, 0x4d, 0x1c, 0xa5, 0xbc, 0xab, 0xf5, 0x94, 0x7e, 0x93, 0x3e, 0x42, 0xf4, 0xdf, 0x55,
+	0x68, 0x3f, 0x7a, 0x0a, 0x6e, 0x82, 0x36, 0xb1, 0xdd, 0x6b, 0xf4, 0x44, 0x58, 0xe6, 0x37, 0x86,
+	0x8f, 0x14, 0x0c, 0x70, 0x34, 0xf2, 0x1c, 0xc7, 0xfb, 0x0e, 0xd5, 0x70, 0x07, 0x9a, 0x33, 0xb7,
+	0xf0, 0x54, 0xdc, 0x82, 0xba, 0x47, 0x2d, 0x42, 0x91, 0x86, 0x11, 0x74, 0xa4, 0x39, 0xa7, 0xe4,
+	0x5b, 0x62, 0xfa, 0xa8, 0x5e, 0x21, 0xa6, 0xe1, 0x9a, 0xc4, 0x41, 0x47, 0xf8, 0x02, 0x70, 0x81,
+	0x78, 0xee, 0xc8, 0xa6, 0x63, 0xc3, 0xb7, 0x3d, 0x17, 0x35, 0xf0, 0x53, 0x38, 0xcb, 0xf1, 0xd1,
+	0xcc, 0x19, 0xd9, 0x8e, 0x33, 0x26, 0xae, 0x8f, 0x9a, 0xf8, 0x1c, 0x50, 0x49, 0x1f, 0x4f, 0x1c,
+	0x22, 0xc9, 0x2d, 0x91, 0xd6, 0xb2, 0xa7, 0x93, 0x99, 0x4f, 0xe6, 0xde, 0x84, 0xb8, 0x08, 0x30,
+	0x86, 0x93, 0x12, 0x99, 0x4d, 0x2c, 0xc3, 0x27, 0xa8, 0x8d, 0xcf, 0xe0, 0xb8, 0xc4, 0x4c, 0xc7,
+	0x9b, 0x12, 0xd4, 0x11, 0x6d, 0x50, 0x32, 0x9a, 0xb9, 0x16, 0x3a, 0xc6, 0xa7, 0xd0, 0xf6, 0x46,
+	0x23, 0xc7, 0x76, 0xc9, 0xdc, 0x30, 0x5f, 0xa3, 0x13, 0xc1, 0x2f, 0x01, 0x4a, 0x1c, 0xe3, 0x16,
+	0x9d, 0x0a, 0x68, 0xec, 0x59, 0x84, 0x1a, 0xbe, 0x47, 0xe7, 0x86, 0x65, 0x21, 0x24, 0x5e, 0x54,
+	0x41, 0x94, 0x8c, 0xbd, 0x1b, 0x82, 0xce, 0xc4, 0x14, 0xa6, 0xbe, 0x47, 0x09, 0xc2, 0xc2, 0xbc,
+	0x72, 0x3c, 0xf3, 0x35, 0xfa, 0x04, 0x3f, 0x83, 0xee, 0x0d, 0x71, 0x2d, 0x8f, 0xce, 0x47, 0xb6,
+	0x6b, 0x38, 0xf6, 0x1b, 0x62, 0xcd, 0x27, 0xc6, 0xad, 0xec, 0xed, 0x5c, 0xd6, 0x93, 0xbd, 0x95,
+	0xd0, 0x53, 0x91, 0xbc, 0x7c, 0x32, 0xb9, 0xb1, 0x2d, 0xe2, 0x9a, 0x04, 0x5d, 0x60, 0x80, 0x3a,
+	0xa1, 0xd4, 0xa3, 0xe8, 0x0f, 0x15, 0x3f, 0x87, 0x6e, 0xf1, 0x11, 0xf5, 0x4c, 0x32, 0x9d, 0xda,
+	0xee, 0xf5, 0x7c, 0x64, 0xd8, 0xce, 0x8c, 0x12, 0xf4, 0xa7, 0xaa, 0x07, 0xd0, 0x24, 0xd1, 0x8f,
+	0x7c, 0x15, 0x6f, 0x38, 0xd6, 0xa1, 0x51, 0x88, 0x4a, 0x2a, 0xaf, 0x3d, 0x6c, 0x96, 0x8a, 0xa3,
+	0x65, 0x00, 0x5f, 0xc0, 0xd1, 0x66, 0x7b, 0xf7, 0x96, 0xef, 0xa4, 0xd0, 0x3a, 0xb4, 0xf0, 0x84,
+	0xa2, 0xd2, 0x70, 0x19, 0xb1, 0x6c, 0x9b, 0x70, 0xa9, 0xa8, 0x0e, 0xad, 0x00, 0xfd, 0x37, 0x15,
+	0x34, 0xf3, 0x81, 0x65, 0x82, 0x56, 0x64, 0xb2, 0x03, 0x59, 0xa4, 0x45, 0x2b, 0x00, 0x77, 0xa1,
+	0x91, 0x6e, 0xef, 0xbe, 0xe7, 0x8b, 0x4c, 0x66, 0x6f, 0xd1, 0xd2, 0x15, 0x91, 0xf2, 0x69, 0x6a,
+	0x1e, 0x29, 0x1f, 0xf4, 0x35, 0xb4, 0xf6, 0x17, 0x25, 0xb5, 0xda, 0x1e, 0x5e, 0xfe, 0x4b, 0xfc,
+	0x7e, 0xc9, 0xa0, 0x15, 0x19, 0xbf, 0x00, 0xed, 0x7e, 0xc5, 0x96, 0xdd, 0xba, 0xbc, 0x32, 0x18,
+	0x88, 0x07, 0x0e, 0x46, 0x2b, 0xb6, 0xa4, 0x12, 0xc7, 0x43, 0x68, 0xb3, 0x2c, 0x63, 0x8b, 0x87,
+	0x35, 0x8f, 0xb2, 0xb4, 0x7b, 0xd4, 0x53, 0xfb, 0xed, 0x21, 0xca, 0x69, 0xc6, 0x3e, 0x40, 0x1f,
+	0x93, 0x2e, 0x7f, 0x55, 0x00, 0xaa, 0x18, 0x46, 0xa0, 0x2e, 0xc2, 0xb2, 0x51, 0x61, 0xe2, 0x4b,
+	0x68, 0xae, 0xc3, 0x75, 0x7e, 0xde, 0x79, 0x8f, 0x7b, 0x1f, 0x63, 0xd0, 0xd2, 0xf0, 0x7d, 0xde,
+	0xa1, 0x46, 0xa5, 0x2d, 0xf8, 0xf7, 0xe1, 0x8a, 0x47, 0x6c, 0x9d, 0x5f, 0x62, 0x8b, 0xee, 0x7d,
+	0x31, 0x4c, 0x1e, 0x2d, 0x92, 0xdd, 0x26, 0xe3, 0x81, 0xec, 0xa2, 0x49, 0x2b, 0x40, 0xff, 0x12,
+	0x34, 0xd1, 0x0c, 0x6e, 0x43, 0x63, 0x4c, 0xa6, 0x53, 0xe3, 0x9a, 0xa0, 0x27, 0x42, 0xcf, 0xfe,
+	0xad, 0x3c, 0x56, 0x45, 0x1c, 0x2b, 0x25, 0x86, 0x85, 0x6a, 0xfa, 0xdf, 0x0a, 0xc0, 0x34, 0x5c,
+	0x46, 0x3c, 0xb0, 0x58, 0xc6, 0xb0, 0x0e, 0x9d, 0x94, 0x47, 0x01, 0x4f, 0x26, 0xf9, 0xa6, 0x15,
+	0xb9, 0xce, 0x03, 0x0c, 0x7f, 0x01, 0x27, 0x29, 0x4f, 0x42, 0xb6, 0x0a, 0xdf, 0xe7, 0x5f, 0x15,
+	0x7a, 0xf8, 0x00, 0xfd, 0x7f, 0x5d, 0x5c, 0xfe, 0xa2, 0x40, 0xc3, 0x8c, 0xd7, 0x6b, 0x16, 0x05,
+	0x52, 0x59, 0x9c, 0x27, 0xb6, 0x55, 0x8c, 0xab, 0xf0, 0x70, 0x1f, 0xb4, 0xac, 0x9c, 0xd6, 0x7f,
+	0xfd, 0x18, 0x4a, 0xc6, 0xa1, 0x14, 0xd4, 0x8f, 0x90, 0x82, 0xfe, 0x1c, 0x1a, 0x66, 0x18, 0x38,
+	0x61, 0x9a, 0x89, 0x25, 0x2c, 0xc2, 0x20, 0xed, 0x2a, 0x3d, 0xb5, 0xdf, 0xa2, 0xd2, 0xd6, 0x5f,
+	0x41, 0xfd, 0x6a, 0x15, 0x2f, 0xde, 0x0a, 0x19, 0x26, 0xec, 0x9d, 0x6c, 0x37, 0x1f, 0x4a, 0xe9,
+	0x96, 0x9b, 0xae, 0xed, 0x37, 0xad, 0xdf, 0x42, 0x9d, 0x24, 0x49, 0x9c, 0xc8, 0x8c, 0x71, 0x90,
+	0xdf, 0xd4, 0x31, 0x95, 0xb6, 0x18, 0x31, 0x17, 0xc1, 0xa2, 0x89, 0xe2, 0xbb, 0x03, 0x4c, 0x14,
+	0x8b, 0x93, 0x40, 0x4e, 0xa4, 0xd0, 0x7c, 0xe1, 0xea, 0x3f, 0x2b, 0x70, 0xea, 0x09, 0x7b, 0xc2,
+	0x76, 0x42, 0x67, 0xfe, 0x4f, 0x51, 0x5e, 0x25, 0x8c, 0x8a, 0xe1, 0x49, 0xfb, 0x71, 0x86, 0xda,
+	0x41, 0x06, 0xfc, 0x39, 0x1c, 0x67, 0x09, 0x8b, 0x52, 0xb6, 0xc8, 0xc2, 0x38, 0xda, 0x57, 0x38,
+	0x04, 0xc5, 0xf2, 0xde, 0x85, 0xd9, 0x83, 0x1d, 0x6d, 0xb6, 0x59, 0xf1, 0x3f, 0x50, 0x01, 0x57,
+	0xda, 0x9b, 0xda, 0xe6, 0xee, 0xee, 0x48, 0x4e, 0xf6, 0xd5, 0x3f, 0x03, 0x00, 0x3e, 0xe0, 0xf8,
+	0x56, 0x12, 0x07, 0x00, 0x00,
 }
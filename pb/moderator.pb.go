@@ -6,6 +6,7 @@ package pb
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
 )
 
@@ -48,6 +49,34 @@ func (Moderator_Fee_FeeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_44f20453d9230215, []int{0, 0, 0}
 }
 
+type DisputeEvidence_Item_Type int32
+
+const (
+	DisputeEvidence_Item_STATEMENT    DisputeEvidence_Item_Type = 0
+	DisputeEvidence_Item_IMAGE        DisputeEvidence_Item_Type = 1
+	DisputeEvidence_Item_CHAT_MESSAGE DisputeEvidence_Item_Type = 2
+)
+
+var DisputeEvidence_Item_Type_name = map[int32]string{
+	0: "STATEMENT",
+	1: "IMAGE",
+	2: "CHAT_MESSAGE",
+}
+
+var DisputeEvidence_Item_Type_value = map[string]int32{
+	"STATEMENT":    0,
+	"IMAGE":        1,
+	"CHAT_MESSAGE": 2,
+}
+
+func (x DisputeEvidence_Item_Type) String() string {
+	return proto.EnumName(DisputeEvidence_Item_Type_name, int32(x))
+}
+
+func (DisputeEvidence_Item_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{2, 0, 0}
+}
+
 type Moderator struct {
 	Description          string         `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	TermsAndConditions   string         `protobuf:"bytes,2,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
@@ -302,12 +331,503 @@ func (m *DisputeUpdate) GetSerializedContract() []byte {
 	return nil
 }
 
+type DisputeEvidence struct {
+	OrderId              string                  `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	SubmittedBy          string                  `protobuf:"bytes,2,opt,name=submittedBy,proto3" json:"submittedBy,omitempty"`
+	Timestamp            *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Items                []*DisputeEvidence_Item `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Signature            []byte                  `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DisputeEvidence) Reset()         { *m = DisputeEvidence{} }
+func (m *DisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence) ProtoMessage()    {}
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{2}
+}
+
+func (m *DisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence.Unmarshal(m, b)
+}
+func (m *DisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence.Marshal(b, m, deterministic)
+}
+func (m *DisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence.Merge(m, src)
+}
+func (m *DisputeEvidence) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence.Size(m)
+}
+func (m *DisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence proto.InternalMessageInfo
+
+func (m *DisputeEvidence) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetSubmittedBy() string {
+	if m != nil {
+		return m.SubmittedBy
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DisputeEvidence) GetItems() []*DisputeEvidence_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *DisputeEvidence) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type DisputeEvidence_Item struct {
+	Type                 DisputeEvidence_Item_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=DisputeEvidence_Item_Type" json:"type,omitempty"`
+	Statement            string                       `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	ImageHash            string                       `protobuf:"bytes,3,opt,name=imageHash,proto3" json:"imageHash,omitempty"`
+	Filename             string                       `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	ChatMessage          *DisputeEvidence_ChatMessage `protobuf:"bytes,5,opt,name=chatMessage,proto3" json:"chatMessage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *DisputeEvidence_Item) Reset()         { *m = DisputeEvidence_Item{} }
+func (m *DisputeEvidence_Item) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence_Item) ProtoMessage()    {}
+func (*DisputeEvidence_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{2, 0}
+}
+
+func (m *DisputeEvidence_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence_Item.Unmarshal(m, b)
+}
+func (m *DisputeEvidence_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence_Item.Marshal(b, m, deterministic)
+}
+func (m *DisputeEvidence_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence_Item.Merge(m, src)
+}
+func (m *DisputeEvidence_Item) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence_Item.Size(m)
+}
+func (m *DisputeEvidence_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence_Item proto.InternalMessageInfo
+
+func (m *DisputeEvidence_Item) GetType() DisputeEvidence_Item_Type {
+	if m != nil {
+		return m.Type
+	}
+	return DisputeEvidence_Item_STATEMENT
+}
+
+func (m *DisputeEvidence_Item) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_Item) GetImageHash() string {
+	if m != nil {
+		return m.ImageHash
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_Item) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_Item) GetChatMessage() *DisputeEvidence_ChatMessage {
+	if m != nil {
+		return m.ChatMessage
+	}
+	return nil
+}
+
+type DisputeEvidence_ChatMessage struct {
+	MessageId            string               `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	PeerId               string               `protobuf:"bytes,2,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DisputeEvidence_ChatMessage) Reset()         { *m = DisputeEvidence_ChatMessage{} }
+func (m *DisputeEvidence_ChatMessage) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence_ChatMessage) ProtoMessage()    {}
+func (*DisputeEvidence_ChatMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{2, 1}
+}
+
+func (m *DisputeEvidence_ChatMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence_ChatMessage.Unmarshal(m, b)
+}
+func (m *DisputeEvidence_ChatMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence_ChatMessage.Marshal(b, m, deterministic)
+}
+func (m *DisputeEvidence_ChatMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence_ChatMessage.Merge(m, src)
+}
+func (m *DisputeEvidence_ChatMessage) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence_ChatMessage.Size(m)
+}
+func (m *DisputeEvidence_ChatMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence_ChatMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence_ChatMessage proto.InternalMessageInfo
+
+func (m *DisputeEvidence_ChatMessage) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_ChatMessage) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_ChatMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DisputeEvidence_ChatMessage) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type ModeratorStats struct {
+	ModeratorID             *ID                               `protobuf:"bytes,1,opt,name=moderatorID,proto3" json:"moderatorID,omitempty"`
+	CasesHandled            uint32                            `protobuf:"varint,2,opt,name=casesHandled,proto3" json:"casesHandled,omitempty"`
+	OpenCases               uint32                            `protobuf:"varint,3,opt,name=openCases,proto3" json:"openCases,omitempty"`
+	MedianResolutionSeconds uint64                            `protobuf:"varint,4,opt,name=medianResolutionSeconds,proto3" json:"medianResolutionSeconds,omitempty"`
+	SplitDistribution       *ModeratorStats_SplitDistribution `protobuf:"bytes,5,opt,name=splitDistribution,proto3" json:"splitDistribution,omitempty"`
+	Resolutions             []*ModeratorStats_Resolution      `protobuf:"bytes,6,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	Timestamp               *timestamp.Timestamp              `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                          `json:"-"`
+	XXX_unrecognized        []byte                            `json:"-"`
+	XXX_sizecache           int32                             `json:"-"`
+}
+
+func (m *ModeratorStats) Reset()         { *m = ModeratorStats{} }
+func (m *ModeratorStats) String() string { return proto.CompactTextString(m) }
+func (*ModeratorStats) ProtoMessage()    {}
+func (*ModeratorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{3}
+}
+
+func (m *ModeratorStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModeratorStats.Unmarshal(m, b)
+}
+func (m *ModeratorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModeratorStats.Marshal(b, m, deterministic)
+}
+func (m *ModeratorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeratorStats.Merge(m, src)
+}
+func (m *ModeratorStats) XXX_Size() int {
+	return xxx_messageInfo_ModeratorStats.Size(m)
+}
+func (m *ModeratorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeratorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeratorStats proto.InternalMessageInfo
+
+func (m *ModeratorStats) GetModeratorID() *ID {
+	if m != nil {
+		return m.ModeratorID
+	}
+	return nil
+}
+
+func (m *ModeratorStats) GetCasesHandled() uint32 {
+	if m != nil {
+		return m.CasesHandled
+	}
+	return 0
+}
+
+func (m *ModeratorStats) GetOpenCases() uint32 {
+	if m != nil {
+		return m.OpenCases
+	}
+	return 0
+}
+
+func (m *ModeratorStats) GetMedianResolutionSeconds() uint64 {
+	if m != nil {
+		return m.MedianResolutionSeconds
+	}
+	return 0
+}
+
+func (m *ModeratorStats) GetSplitDistribution() *ModeratorStats_SplitDistribution {
+	if m != nil {
+		return m.SplitDistribution
+	}
+	return nil
+}
+
+func (m *ModeratorStats) GetResolutions() []*ModeratorStats_Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+func (m *ModeratorStats) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+// Counts the resolved cases by the buyer's share of the payout to the
+// buyer and vendor
+type ModeratorStats_SplitDistribution struct {
+	BuyerFull            uint32   `protobuf:"varint,1,opt,name=buyerFull,proto3" json:"buyerFull,omitempty"`
+	BuyerMajority        uint32   `protobuf:"varint,2,opt,name=buyerMajority,proto3" json:"buyerMajority,omitempty"`
+	Even                 uint32   `protobuf:"varint,3,opt,name=even,proto3" json:"even,omitempty"`
+	VendorMajority       uint32   `protobuf:"varint,4,opt,name=vendorMajority,proto3" json:"vendorMajority,omitempty"`
+	VendorFull           uint32   `protobuf:"varint,5,opt,name=vendorFull,proto3" json:"vendorFull,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModeratorStats_SplitDistribution) Reset()         { *m = ModeratorStats_SplitDistribution{} }
+func (m *ModeratorStats_SplitDistribution) String() string { return proto.CompactTextString(m) }
+func (*ModeratorStats_SplitDistribution) ProtoMessage()    {}
+func (*ModeratorStats_SplitDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{3, 0}
+}
+
+func (m *ModeratorStats_SplitDistribution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModeratorStats_SplitDistribution.Unmarshal(m, b)
+}
+func (m *ModeratorStats_SplitDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModeratorStats_SplitDistribution.Marshal(b, m, deterministic)
+}
+func (m *ModeratorStats_SplitDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeratorStats_SplitDistribution.Merge(m, src)
+}
+func (m *ModeratorStats_SplitDistribution) XXX_Size() int {
+	return xxx_messageInfo_ModeratorStats_SplitDistribution.Size(m)
+}
+func (m *ModeratorStats_SplitDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeratorStats_SplitDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeratorStats_SplitDistribution proto.InternalMessageInfo
+
+func (m *ModeratorStats_SplitDistribution) GetBuyerFull() uint32 {
+	if m != nil {
+		return m.BuyerFull
+	}
+	return 0
+}
+
+func (m *ModeratorStats_SplitDistribution) GetBuyerMajority() uint32 {
+	if m != nil {
+		return m.BuyerMajority
+	}
+	return 0
+}
+
+func (m *ModeratorStats_SplitDistribution) GetEven() uint32 {
+	if m != nil {
+		return m.Even
+	}
+	return 0
+}
+
+func (m *ModeratorStats_SplitDistribution) GetVendorMajority() uint32 {
+	if m != nil {
+		return m.VendorMajority
+	}
+	return 0
+}
+
+func (m *ModeratorStats_SplitDistribution) GetVendorFull() uint32 {
+	if m != nil {
+		return m.VendorFull
+	}
+	return 0
+}
+
+type ModeratorStats_Resolution struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Opened               *timestamp.Timestamp `protobuf:"bytes,2,opt,name=opened,proto3" json:"opened,omitempty"`
+	Resolved             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	BuyerPercentage      uint32               `protobuf:"varint,4,opt,name=buyerPercentage,proto3" json:"buyerPercentage,omitempty"`
+	ResolutionSignature  []byte               `protobuf:"bytes,5,opt,name=resolutionSignature,proto3" json:"resolutionSignature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ModeratorStats_Resolution) Reset()         { *m = ModeratorStats_Resolution{} }
+func (m *ModeratorStats_Resolution) String() string { return proto.CompactTextString(m) }
+func (*ModeratorStats_Resolution) ProtoMessage()    {}
+func (*ModeratorStats_Resolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{3, 1}
+}
+
+func (m *ModeratorStats_Resolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModeratorStats_Resolution.Unmarshal(m, b)
+}
+func (m *ModeratorStats_Resolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModeratorStats_Resolution.Marshal(b, m, deterministic)
+}
+func (m *ModeratorStats_Resolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeratorStats_Resolution.Merge(m, src)
+}
+func (m *ModeratorStats_Resolution) XXX_Size() int {
+	return xxx_messageInfo_ModeratorStats_Resolution.Size(m)
+}
+func (m *ModeratorStats_Resolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeratorStats_Resolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeratorStats_Resolution proto.InternalMessageInfo
+
+func (m *ModeratorStats_Resolution) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ModeratorStats_Resolution) GetOpened() *timestamp.Timestamp {
+	if m != nil {
+		return m.Opened
+	}
+	return nil
+}
+
+func (m *ModeratorStats_Resolution) GetResolved() *timestamp.Timestamp {
+	if m != nil {
+		return m.Resolved
+	}
+	return nil
+}
+
+func (m *ModeratorStats_Resolution) GetBuyerPercentage() uint32 {
+	if m != nil {
+		return m.BuyerPercentage
+	}
+	return 0
+}
+
+func (m *ModeratorStats_Resolution) GetResolutionSignature() []byte {
+	if m != nil {
+		return m.ResolutionSignature
+	}
+	return nil
+}
+
+type SignedModeratorStats struct {
+	Stats                *ModeratorStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Signature            []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SignedModeratorStats) Reset()         { *m = SignedModeratorStats{} }
+func (m *SignedModeratorStats) String() string { return proto.CompactTextString(m) }
+func (*SignedModeratorStats) ProtoMessage()    {}
+func (*SignedModeratorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{4}
+}
+
+func (m *SignedModeratorStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedModeratorStats.Unmarshal(m, b)
+}
+func (m *SignedModeratorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedModeratorStats.Marshal(b, m, deterministic)
+}
+func (m *SignedModeratorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedModeratorStats.Merge(m, src)
+}
+func (m *SignedModeratorStats) XXX_Size() int {
+	return xxx_messageInfo_SignedModeratorStats.Size(m)
+}
+func (m *SignedModeratorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedModeratorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedModeratorStats proto.InternalMessageInfo
+
+func (m *SignedModeratorStats) GetStats() *ModeratorStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *SignedModeratorStats) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("Moderator_Fee_FeeType", Moderator_Fee_FeeType_name, Moderator_Fee_FeeType_value)
+	proto.RegisterEnum("DisputeEvidence_Item_Type", DisputeEvidence_Item_Type_name, DisputeEvidence_Item_Type_value)
 	proto.RegisterType((*Moderator)(nil), "Moderator")
 	proto.RegisterType((*Moderator_Fee)(nil), "Moderator.Fee")
 	proto.RegisterType((*Moderator_Price)(nil), "Moderator.Price")
 	proto.RegisterType((*DisputeUpdate)(nil), "DisputeUpdate")
+	proto.RegisterType((*DisputeEvidence)(nil), "DisputeEvidence")
+	proto.RegisterType((*DisputeEvidence_Item)(nil), "DisputeEvidence.Item")
+	proto.RegisterType((*DisputeEvidence_ChatMessage)(nil), "DisputeEvidence.ChatMessage")
+	proto.RegisterType((*ModeratorStats)(nil), "ModeratorStats")
+	proto.RegisterType((*ModeratorStats_SplitDistribution)(nil), "ModeratorStats.SplitDistribution")
+	proto.RegisterType((*ModeratorStats_Resolution)(nil), "ModeratorStats.Resolution")
+	proto.RegisterType((*SignedModeratorStats)(nil), "SignedModeratorStats")
 }
 
 func init() {
@@ -315,35 +835,69 @@ func init() {
 }
 
 var fileDescriptor_44f20453d9230215 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdb, 0x8b, 0xd3, 0x40,
-	0x14, 0xc6, 0xcd, 0xa5, 0x5b, 0x73, 0xba, 0xdb, 0x2d, 0x23, 0x4a, 0x2c, 0x22, 0xa1, 0x88, 0xf6,
-	0x41, 0x82, 0xd4, 0x47, 0x1f, 0xa4, 0xdb, 0x8b, 0x2c, 0x78, 0x29, 0xe3, 0x2e, 0x88, 0x2f, 0xcb,
-	0x34, 0x73, 0x5a, 0x06, 0xb6, 0x33, 0xc3, 0x64, 0x02, 0xd6, 0x3f, 0x48, 0xfc, 0x2f, 0x7c, 0xf3,
-	0xef, 0x92, 0x4c, 0x92, 0x5e, 0xa4, 0x0f, 0x79, 0xc8, 0xef, 0xfb, 0x48, 0xce, 0x77, 0xce, 0x07,
-	0x97, 0x1b, 0xc5, 0xd1, 0x30, 0xab, 0x4c, 0xaa, 0x8d, 0xb2, 0xaa, 0x7f, 0x99, 0x29, 0x69, 0x0d,
-	0xcb, 0x6c, 0x5e, 0x81, 0xc1, 0x9f, 0x10, 0xa2, 0x4f, 0x8d, 0x89, 0x24, 0xd0, 0xe1, 0x98, 0x67,
-	0x46, 0x68, 0x2b, 0x94, 0x8c, 0xbd, 0xc4, 0x1b, 0x46, 0xf4, 0x10, 0x91, 0x14, 0x88, 0x45, 0xb3,
-	0xc9, 0xc7, 0x92, 0x4f, 0x94, 0xe4, 0xa2, 0x84, 0x79, 0xec, 0x3b, 0xe3, 0x09, 0x85, 0x3c, 0x83,
-	0xe8, 0x9e, 0xc9, 0x75, 0xc1, 0xd6, 0x98, 0xc7, 0x41, 0x12, 0x0c, 0x23, 0xba, 0x07, 0xe5, 0xd7,
-	0x58, 0x96, 0xa1, 0xb6, 0xc8, 0x27, 0x85, 0x31, 0x28, 0x33, 0x81, 0x79, 0x1c, 0x3a, 0xdb, 0x09,
-	0x85, 0x24, 0x10, 0xac, 0x10, 0xe3, 0x56, 0xe2, 0x0d, 0x3b, 0xa3, 0x6e, 0xba, 0x1b, 0x3c, 0x9d,
-	0x23, 0xd2, 0x52, 0xea, 0xff, 0xf5, 0x20, 0x98, 0x23, 0x92, 0xd7, 0xf0, 0x70, 0x25, 0x7e, 0x20,
-	0x9f, 0x23, 0xba, 0x18, 0x9d, 0x51, 0xef, 0xc0, 0xbe, 0x30, 0x22, 0x43, 0xba, 0x73, 0x90, 0xe7,
-	0x00, 0x1a, 0x4d, 0x86, 0xd2, 0xb2, 0x35, 0xba, 0x34, 0x3e, 0x3d, 0x20, 0xe4, 0x0d, 0xb4, 0x57,
-	0x88, 0x37, 0x5b, 0x8d, 0x71, 0x90, 0x78, 0xc3, 0xee, 0xe8, 0xc9, 0xf1, 0xbf, 0xcb, 0xa7, 0x54,
-	0x69, 0x63, 0x1b, 0xbc, 0x87, 0x76, 0xcd, 0x48, 0x04, 0xad, 0xf9, 0xf5, 0xb7, 0xd9, 0xb4, 0xf7,
-	0x80, 0x74, 0x01, 0x16, 0x33, 0x3a, 0x99, 0x7d, 0xbe, 0x19, 0x7f, 0x98, 0xf5, 0x3c, 0xf2, 0x14,
-	0x1e, 0x3b, 0xe9, 0x6e, 0xf1, 0xf1, 0xf6, 0xeb, 0xdd, 0x81, 0xe4, 0xf7, 0x7f, 0x79, 0xd0, 0x72,
-	0x63, 0x92, 0x97, 0x70, 0x9e, 0x55, 0x2b, 0xd8, 0x4e, 0x14, 0xaf, 0xe2, 0x44, 0x57, 0x7e, 0xec,
-	0xd1, 0x23, 0x4e, 0xfa, 0x70, 0xc6, 0x36, 0xaa, 0x90, 0xd6, 0x05, 0x08, 0x9d, 0xa3, 0x26, 0xe5,
-	0x19, 0x96, 0x62, 0x3d, 0xae, 0xe4, 0xc0, 0x5d, 0x6b, 0x0f, 0xc8, 0x3b, 0xe8, 0x56, 0xbe, 0x7a,
-	0xd5, 0xdb, 0x38, 0x74, 0x2b, 0x7b, 0x94, 0x36, 0x60, 0x8a, 0x2b, 0x21, 0xdd, 0x49, 0xe9, 0x7f,
-	0xd6, 0xc1, 0x6f, 0x0f, 0x2e, 0xa6, 0x22, 0xd7, 0x85, 0xc5, 0x5b, 0xcd, 0x99, 0x45, 0x12, 0x43,
-	0x5b, 0x19, 0x8e, 0xe6, 0x9a, 0xd7, 0x0d, 0x6a, 0x5e, 0xc9, 0x0b, 0xb8, 0xd0, 0x6c, 0xab, 0x0a,
-	0x3b, 0xe6, 0xdc, 0x60, 0xde, 0x14, 0xe7, 0x18, 0x92, 0x57, 0x10, 0xa9, 0xc2, 0x6a, 0x25, 0xa4,
-	0xad, 0x3a, 0xd3, 0x19, 0x45, 0xe9, 0x97, 0x9a, 0xd0, 0xbd, 0x56, 0xd6, 0x27, 0x47, 0x23, 0xd8,
-	0xbd, 0xf8, 0x89, 0x65, 0xe9, 0x5c, 0xb3, 0xdd, 0xec, 0xe7, 0xf4, 0x84, 0x72, 0x15, 0x7e, 0xf7,
-	0xf5, 0x72, 0x79, 0xe6, 0x9a, 0xff, 0xf6, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x4d, 0x72,
-	0x42, 0x1d, 0x03, 0x00, 0x00,
+	// 1021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xae, 0x13, 0x27, 0x5b, 0x1f, 0x6f, 0xb2, 0xdb, 0x29, 0x2d, 0xc1, 0xaa, 0x20, 0x44, 0x50,
+	0x56, 0x02, 0xb9, 0x55, 0x90, 0x50, 0x25, 0x10, 0x28, 0xcd, 0x0f, 0x1b, 0x89, 0xb4, 0xab, 0x49,
+	0x2a, 0x21, 0xb8, 0x58, 0x4d, 0x3c, 0x67, 0xd3, 0x41, 0xf1, 0x8f, 0x3c, 0xe3, 0x15, 0xe1, 0x92,
+	0x97, 0xe0, 0x01, 0x90, 0x10, 0xf7, 0x3c, 0x00, 0x77, 0x3c, 0x0e, 0x12, 0x6f, 0x80, 0x66, 0xec,
+	0xc4, 0x49, 0x36, 0xac, 0xe8, 0x9d, 0xe7, 0x3b, 0x9f, 0xc7, 0xdf, 0x39, 0xe7, 0x3b, 0xc7, 0x70,
+	0x12, 0xc6, 0x1c, 0x53, 0xa6, 0xe2, 0xd4, 0x4f, 0xd2, 0x58, 0xc5, 0xde, 0x49, 0x10, 0x47, 0x2a,
+	0x65, 0x81, 0x92, 0x05, 0xf0, 0xde, 0x22, 0x8e, 0x17, 0x4b, 0x7c, 0x62, 0x4e, 0xf3, 0xec, 0xea,
+	0x89, 0x12, 0x21, 0x4a, 0xc5, 0xc2, 0x24, 0x27, 0x74, 0xfe, 0xb4, 0xc1, 0x99, 0xac, 0x6f, 0x21,
+	0x6d, 0x70, 0x39, 0xca, 0x20, 0x15, 0x89, 0x12, 0x71, 0xd4, 0xb2, 0xda, 0xd6, 0x99, 0x43, 0xb7,
+	0x21, 0xe2, 0x03, 0x51, 0x98, 0x86, 0xb2, 0x17, 0xf1, 0x7e, 0x1c, 0x71, 0xa1, 0x41, 0xd9, 0xaa,
+	0x18, 0xe2, 0x81, 0x08, 0x79, 0x04, 0xce, 0x92, 0x45, 0x8b, 0x8c, 0x2d, 0x50, 0xb6, 0xaa, 0xed,
+	0xea, 0x99, 0x43, 0x4b, 0x40, 0xdf, 0xc6, 0x82, 0x00, 0x13, 0x85, 0xbc, 0x9f, 0xa5, 0x29, 0x46,
+	0x81, 0x40, 0xd9, 0xb2, 0x0d, 0xed, 0x40, 0x84, 0xb4, 0xa1, 0x7a, 0x85, 0xd8, 0xaa, 0xb5, 0xad,
+	0x33, 0xb7, 0xdb, 0xf4, 0x37, 0xc2, 0xfd, 0x11, 0x22, 0xd5, 0x21, 0xef, 0x2f, 0x0b, 0xaa, 0x23,
+	0x44, 0xf2, 0x09, 0xdc, 0xbd, 0x12, 0x3f, 0x22, 0x1f, 0x21, 0x9a, 0x34, 0xdc, 0xee, 0xe9, 0x16,
+	0xfd, 0x22, 0x15, 0x01, 0xd2, 0x0d, 0x83, 0xbc, 0x0b, 0x90, 0x60, 0x1a, 0x60, 0xa4, 0xd8, 0x02,
+	0x4d, 0x36, 0x15, 0xba, 0x85, 0x90, 0xa7, 0x70, 0x74, 0x85, 0x38, 0x5b, 0x25, 0xd8, 0xaa, 0xb6,
+	0xad, 0xb3, 0x66, 0xf7, 0xe1, 0xee, 0xb7, 0xfd, 0x51, 0x1e, 0xa5, 0x6b, 0x5a, 0xe7, 0x2b, 0x38,
+	0x2a, 0x30, 0xe2, 0x40, 0x6d, 0x34, 0xfe, 0x76, 0x38, 0x38, 0xbd, 0x43, 0x9a, 0x00, 0x17, 0x43,
+	0xda, 0x1f, 0xbe, 0x98, 0xf5, 0xbe, 0x1e, 0x9e, 0x5a, 0xe4, 0x1d, 0x78, 0x60, 0x42, 0x97, 0x17,
+	0xdf, 0xbc, 0x9a, 0x5e, 0x6e, 0x85, 0x2a, 0xde, 0x6f, 0x16, 0xd4, 0x8c, 0x4c, 0xf2, 0x18, 0x8e,
+	0x83, 0xbc, 0x04, 0xab, 0x7e, 0xcc, 0xf3, 0x74, 0x9c, 0xe7, 0x95, 0x96, 0x45, 0x77, 0x70, 0xe2,
+	0x41, 0x9d, 0x85, 0x71, 0x16, 0x29, 0x93, 0x80, 0x6d, 0x18, 0x05, 0xa2, 0xdb, 0x30, 0x17, 0x8b,
+	0x5e, 0x1e, 0xae, 0x9a, 0x6e, 0x95, 0x00, 0xf9, 0x1c, 0x9a, 0x39, 0xaf, 0x28, 0xf5, 0xaa, 0x65,
+	0x9b, 0x92, 0xdd, 0xf7, 0xd7, 0xc0, 0x00, 0xaf, 0x44, 0x64, 0x5a, 0x4a, 0xf7, 0xa8, 0x9d, 0xdf,
+	0x2d, 0x68, 0x0c, 0x84, 0x4c, 0x32, 0x85, 0xaf, 0x12, 0xce, 0x14, 0x92, 0x16, 0x1c, 0xc5, 0x29,
+	0xc7, 0x74, 0xcc, 0x0b, 0x07, 0xad, 0x8f, 0xe4, 0x03, 0x68, 0x24, 0x6c, 0x15, 0x67, 0xaa, 0xc7,
+	0x79, 0x8a, 0x72, 0x6d, 0x9c, 0x5d, 0x90, 0x7c, 0x04, 0x4e, 0x9c, 0xa9, 0x24, 0x16, 0x91, 0xca,
+	0x3d, 0xe3, 0x76, 0x1d, 0xff, 0x65, 0x81, 0xd0, 0x32, 0xa6, 0xed, 0x23, 0x31, 0x15, 0x6c, 0x29,
+	0x7e, 0x42, 0xde, 0x2f, 0xac, 0x6f, 0xb4, 0x1f, 0xd3, 0x03, 0x91, 0xce, 0x3f, 0x36, 0x9c, 0x14,
+	0x52, 0x87, 0xd7, 0x82, 0x63, 0x14, 0xdc, 0x26, 0xb6, 0x0d, 0xae, 0xcc, 0xe6, 0xa1, 0x50, 0x0a,
+	0xf9, 0xf3, 0x55, 0x21, 0x75, 0x1b, 0x22, 0xcf, 0xc0, 0xd9, 0xcc, 0x93, 0xa9, 0xaa, 0xdb, 0xf5,
+	0xfc, 0x7c, 0xe2, 0xfc, 0xf5, 0xc4, 0xf9, 0xb3, 0x35, 0x83, 0x96, 0x64, 0xf2, 0x31, 0xd4, 0x84,
+	0xc2, 0x30, 0xf7, 0xba, 0xdb, 0x7d, 0xe0, 0xef, 0xc9, 0xf2, 0xc7, 0x0a, 0x43, 0x9a, 0x73, 0x74,
+	0xf3, 0xa4, 0x58, 0x44, 0x4c, 0x65, 0x69, 0xee, 0xfd, 0x63, 0x5a, 0x02, 0xde, 0xcf, 0x15, 0xb0,
+	0x35, 0x9b, 0xf8, 0x60, 0x2b, 0xed, 0x50, 0xcb, 0x38, 0xd4, 0x3b, 0x78, 0xa5, 0x6f, 0x5c, 0x6a,
+	0x78, 0xe6, 0x5a, 0xc5, 0x14, 0x86, 0x58, 0x58, 0xc6, 0xa1, 0x25, 0xa0, 0xa3, 0x22, 0x64, 0x0b,
+	0x3c, 0x67, 0xf2, 0xf5, 0xda, 0x31, 0x1b, 0x80, 0x78, 0x7a, 0xbc, 0x96, 0x18, 0xb1, 0x10, 0x4d,
+	0xbd, 0x1d, 0xba, 0x39, 0x93, 0x2f, 0xc1, 0x0d, 0x5e, 0x33, 0x35, 0x41, 0x29, 0xd9, 0x22, 0x17,
+	0xec, 0x76, 0x1f, 0xdd, 0x90, 0xd3, 0x2f, 0x39, 0x74, 0xfb, 0x85, 0x4e, 0x17, 0x6c, 0x33, 0x37,
+	0x0d, 0x70, 0xa6, 0xb3, 0xde, 0x6c, 0x38, 0x19, 0xbe, 0x98, 0x9d, 0xde, 0xd1, 0x63, 0x34, 0x9e,
+	0xe4, 0x63, 0x73, 0x0a, 0xc7, 0xfd, 0xf3, 0xde, 0xec, 0x72, 0x32, 0x9c, 0x4e, 0xf3, 0x69, 0xf9,
+	0xc5, 0x02, 0x77, 0xeb, 0x42, 0xad, 0x3e, 0xcc, 0x1f, 0x37, 0x7d, 0x2d, 0x01, 0xf2, 0x10, 0xea,
+	0x09, 0x9a, 0x96, 0xe7, 0x69, 0x17, 0x27, 0xed, 0x85, 0x82, 0x54, 0x64, 0xbc, 0x3e, 0xee, 0x76,
+	0xda, 0x7e, 0x83, 0x4e, 0x77, 0x7e, 0xad, 0x43, 0x73, 0xb3, 0x2b, 0xa6, 0x8a, 0x29, 0x49, 0x3e,
+	0x04, 0x77, 0xb3, 0xb8, 0xc7, 0x83, 0x62, 0x3d, 0x55, 0xfd, 0xf1, 0x80, 0x6e, 0xe3, 0xa4, 0x03,
+	0xc7, 0x01, 0x93, 0x28, 0xcf, 0x59, 0xc4, 0x97, 0x98, 0x6b, 0x6d, 0xd0, 0x1d, 0x4c, 0xe7, 0x19,
+	0x27, 0x18, 0xf5, 0x35, 0x66, 0x34, 0x37, 0x68, 0x09, 0x90, 0x67, 0xf0, 0x76, 0x88, 0x5c, 0xb0,
+	0x88, 0xa2, 0x8c, 0x97, 0x99, 0x1e, 0xdf, 0x29, 0x06, 0x71, 0xc4, 0xa5, 0xc9, 0xc1, 0xa6, 0xff,
+	0x15, 0x26, 0x2f, 0xe1, 0x9e, 0x4c, 0x96, 0x42, 0x0d, 0x84, 0x54, 0xa9, 0x98, 0x9b, 0x58, 0xd1,
+	0xc9, 0xf7, 0xfd, 0xdd, 0x74, 0xfc, 0xe9, 0x3e, 0x91, 0xde, 0x7c, 0x97, 0x7c, 0x01, 0x6e, 0xba,
+	0xf9, 0x8a, 0x6c, 0xd5, 0x8d, 0xed, 0xbd, 0xfd, 0xab, 0x4a, 0x21, 0x74, 0x9b, 0xbe, 0x5b, 0xfe,
+	0xa3, 0x37, 0x28, 0xbf, 0xf7, 0x87, 0x05, 0xf7, 0x6e, 0x08, 0x34, 0xeb, 0x30, 0x5b, 0x61, 0x3a,
+	0xca, 0x96, 0x4b, 0x53, 0xff, 0x06, 0x2d, 0x01, 0xbd, 0xa5, 0xcc, 0x61, 0xc2, 0x7e, 0x88, 0x53,
+	0xa1, 0x56, 0x45, 0xe5, 0x77, 0x41, 0x42, 0xc0, 0xc6, 0x6b, 0x8c, 0x8a, 0xaa, 0x9b, 0x67, 0xf2,
+	0x18, 0x9a, 0xd7, 0x18, 0xf1, 0xb8, 0x7c, 0xd5, 0x36, 0xd1, 0x3d, 0x54, 0xff, 0x6f, 0x72, 0xc4,
+	0x08, 0xa8, 0x19, 0xce, 0x16, 0xe2, 0xfd, 0x6d, 0x01, 0x94, 0xb5, 0xb8, 0x65, 0x47, 0x75, 0xa1,
+	0xae, 0xdb, 0x5d, 0xb8, 0xe3, 0xf6, 0xaa, 0x14, 0x4c, 0xf2, 0x19, 0xdc, 0x35, 0xb5, 0xbd, 0x46,
+	0xfe, 0x3f, 0x96, 0xd6, 0x86, 0x4b, 0xce, 0xe0, 0xc4, 0x54, 0xe0, 0xa2, 0xfc, 0x53, 0xe6, 0xd9,
+	0xed, 0xc3, 0xe4, 0x29, 0xdc, 0x2f, 0xbb, 0x37, 0xdd, 0x5b, 0x5d, 0x87, 0x42, 0x9d, 0xef, 0xe1,
+	0x2d, 0x7d, 0x40, 0x7e, 0x63, 0x54, 0x6a, 0x7a, 0x25, 0xc9, 0x62, 0x48, 0x4e, 0xf6, 0x0c, 0x43,
+	0xf3, 0xe8, 0xee, 0x86, 0xac, 0xec, 0x6d, 0xc8, 0xe7, 0xf6, 0x77, 0x95, 0x64, 0x3e, 0xaf, 0x9b,
+	0xe4, 0x3e, 0xfd, 0x77, 0x00, 0x85, 0x5c, 0x27, 0xad, 0x35, 0x09, 0x00, 0x00,
 }
@@ -49,6 +49,31 @@ func (Post_PostType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b14bd1586479c33d, []int{0, 0}
 }
 
+type Announcement_AnnouncementType int32
+
+const (
+	Announcement_POST    Announcement_AnnouncementType = 0
+	Announcement_LISTING Announcement_AnnouncementType = 1
+)
+
+var Announcement_AnnouncementType_name = map[int32]string{
+	0: "POST",
+	1: "LISTING",
+}
+
+var Announcement_AnnouncementType_value = map[string]int32{
+	"POST":    0,
+	"LISTING": 1,
+}
+
+func (x Announcement_AnnouncementType) String() string {
+	return proto.EnumName(Announcement_AnnouncementType_name, int32(x))
+}
+
+func (Announcement_AnnouncementType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b14bd1586479c33d, []int{2, 0}
+}
+
 type Post struct {
 	Slug                 string               `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	VendorID             *ID                  `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
@@ -294,11 +319,164 @@ func (m *SignedPost) GetSignature() []byte {
 	return nil
 }
 
+type Announcement struct {
+	Type                 Announcement_AnnouncementType `protobuf:"varint,1,opt,name=type,proto3,enum=Announcement_AnnouncementType" json:"type,omitempty"`
+	VendorID             *ID                           `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	Hash                 string                        `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Slug                 string                        `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title                string                        `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail            *Post_Image                   `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Tags                 []string                      `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Channels             []string                      `protobuf:"bytes,8,rep,name=channels,proto3" json:"channels,omitempty"`
+	Timestamp            *timestamp.Timestamp          `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *Announcement) Reset()         { *m = Announcement{} }
+func (m *Announcement) String() string { return proto.CompactTextString(m) }
+func (*Announcement) ProtoMessage()    {}
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b14bd1586479c33d, []int{2}
+}
+
+func (m *Announcement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Announcement.Unmarshal(m, b)
+}
+func (m *Announcement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Announcement.Marshal(b, m, deterministic)
+}
+func (m *Announcement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Announcement.Merge(m, src)
+}
+func (m *Announcement) XXX_Size() int {
+	return xxx_messageInfo_Announcement.Size(m)
+}
+func (m *Announcement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Announcement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Announcement proto.InternalMessageInfo
+
+func (m *Announcement) GetType() Announcement_AnnouncementType {
+	if m != nil {
+		return m.Type
+	}
+	return Announcement_POST
+}
+
+func (m *Announcement) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *Announcement) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Announcement) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *Announcement) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Announcement) GetThumbnail() *Post_Image {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *Announcement) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Announcement) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *Announcement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SignedAnnouncement struct {
+	Announcement         *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	Signature            []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignedAnnouncement) Reset()         { *m = SignedAnnouncement{} }
+func (m *SignedAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*SignedAnnouncement) ProtoMessage()    {}
+func (*SignedAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b14bd1586479c33d, []int{3}
+}
+
+func (m *SignedAnnouncement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedAnnouncement.Unmarshal(m, b)
+}
+func (m *SignedAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedAnnouncement.Marshal(b, m, deterministic)
+}
+func (m *SignedAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedAnnouncement.Merge(m, src)
+}
+func (m *SignedAnnouncement) XXX_Size() int {
+	return xxx_messageInfo_SignedAnnouncement.Size(m)
+}
+func (m *SignedAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedAnnouncement proto.InternalMessageInfo
+
+func (m *SignedAnnouncement) GetAnnouncement() *Announcement {
+	if m != nil {
+		return m.Announcement
+	}
+	return nil
+}
+
+func (m *SignedAnnouncement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("Post_PostType", Post_PostType_name, Post_PostType_value)
+	proto.RegisterEnum("Announcement_AnnouncementType", Announcement_AnnouncementType_name, Announcement_AnnouncementType_value)
 	proto.RegisterType((*Post)(nil), "Post")
 	proto.RegisterType((*Post_Image)(nil), "Post.Image")
 	proto.RegisterType((*SignedPost)(nil), "SignedPost")
+	proto.RegisterType((*Announcement)(nil), "Announcement")
+	proto.RegisterType((*SignedAnnouncement)(nil), "SignedAnnouncement")
 }
 
 func init() {
@@ -306,32 +484,41 @@ func init() {
 }
 
 var fileDescriptor_b14bd1586479c33d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x4f, 0x8b, 0xdb, 0x30,
-	0x10, 0xc5, 0xeb, 0xc4, 0xf6, 0xda, 0xe3, 0xb2, 0x5d, 0x44, 0x29, 0x6a, 0x28, 0xac, 0x49, 0x2f,
-	0xa1, 0x50, 0x2f, 0xa4, 0x97, 0x9e, 0xdb, 0xdd, 0x42, 0x0e, 0xdb, 0x0d, 0xde, 0x5c, 0xda, 0x9b,
-	0x92, 0x28, 0x8a, 0x40, 0x96, 0x8c, 0x24, 0x17, 0xf6, 0x63, 0xf4, 0xd4, 0xaf, 0x5b, 0x34, 0xfe,
-	0x93, 0xdb, 0xbc, 0x37, 0x23, 0x69, 0xde, 0xcf, 0x86, 0xa2, 0x35, 0xce, 0xbb, 0xaa, 0xb5, 0xc6,
-	0x9b, 0xc5, 0xad, 0x30, 0x46, 0x28, 0x7e, 0x87, 0x6a, 0xdf, 0x9d, 0xee, 0xbc, 0x6c, 0xb8, 0xf3,
-	0xac, 0x69, 0x87, 0x81, 0x37, 0x07, 0xa3, 0xbd, 0x65, 0x87, 0xf1, 0xc4, 0xf2, 0x6f, 0x0c, 0xf1,
-	0xd6, 0x38, 0x4f, 0x08, 0xc4, 0x4e, 0x75, 0x82, 0x46, 0x65, 0xb4, 0xca, 0x6b, 0xac, 0xc9, 0x2d,
-	0x64, 0x7f, 0xb8, 0x3e, 0x1a, 0xbb, 0xb9, 0xa7, 0xb3, 0x32, 0x5a, 0x15, 0xeb, 0x79, 0xb5, 0xb9,
-	0xaf, 0x27, 0x93, 0xbc, 0x83, 0xd4, 0x79, 0xe6, 0x3b, 0x47, 0xe7, 0x78, 0x6c, 0x50, 0x64, 0x01,
-	0x99, 0x32, 0x5a, 0xfc, 0x30, 0xb6, 0xa1, 0x31, 0x76, 0x26, 0x4d, 0x3e, 0x42, 0x2a, 0x1b, 0x26,
-	0xb8, 0xa3, 0x49, 0x39, 0x5f, 0x15, 0xeb, 0xa2, 0x0a, 0xef, 0x57, 0x9b, 0xe0, 0xd5, 0x43, 0x2b,
-	0x6c, 0xe3, 0x99, 0x70, 0x34, 0x2d, 0xe7, 0x61, 0x9b, 0x50, 0x87, 0x4b, 0x0f, 0x67, 0xa6, 0x35,
-	0x57, 0x8e, 0x5e, 0xa1, 0x3f, 0x69, 0xf2, 0x09, 0xb2, 0xc0, 0x61, 0xf7, 0xd2, 0x72, 0x9a, 0x95,
-	0xd1, 0xea, 0x7a, 0x7d, 0xdd, 0x5f, 0xbb, 0x1d, 0xdc, 0x7a, 0xea, 0x93, 0x0f, 0x90, 0x5b, 0x7e,
-	0xe2, 0x96, 0xeb, 0x03, 0xa7, 0x39, 0x6e, 0x77, 0x31, 0xc8, 0x57, 0xc8, 0x27, 0x68, 0x14, 0x30,
-	0xf4, 0xa2, 0xea, 0xb1, 0x56, 0x23, 0xd6, 0x6a, 0x37, 0x4e, 0xd4, 0x97, 0xe1, 0xc5, 0xbf, 0x08,
-	0x12, 0x4c, 0x11, 0x36, 0x3d, 0x49, 0xc5, 0x35, 0x6b, 0xf8, 0xc0, 0x73, 0xd2, 0xa1, 0x67, 0xac,
-	0x14, 0x52, 0x33, 0x85, 0x4c, 0xf3, 0x7a, 0xd2, 0xe4, 0x2d, 0x24, 0x8a, 0x59, 0xc1, 0x07, 0x9a,
-	0xbd, 0x08, 0x90, 0x1b, 0x7e, 0x94, 0xdd, 0x88, 0x72, 0x50, 0x61, 0xda, 0x35, 0x4c, 0x29, 0x9a,
-	0xf4, 0xd3, 0x28, 0x90, 0x9c, 0xd4, 0x2f, 0x34, 0xed, 0xbf, 0x63, 0xa8, 0x97, 0x9f, 0x21, 0x1b,
-	0x39, 0x90, 0x0c, 0xe2, 0xed, 0xd3, 0xf3, 0xee, 0xe6, 0x15, 0x29, 0xe0, 0xea, 0xfb, 0xd3, 0xe3,
-	0xe3, 0xc3, 0xcf, 0xdd, 0x4d, 0x44, 0x00, 0xd2, 0xfa, 0x01, 0x1b, 0xb3, 0xe5, 0x2f, 0x80, 0x67,
-	0x29, 0x34, 0x3f, 0xe2, 0x8f, 0xf1, 0x1e, 0xe2, 0x80, 0x0e, 0x83, 0x14, 0xeb, 0x04, 0x89, 0xd6,
-	0x68, 0x85, 0xb7, 0xce, 0xcc, 0x9d, 0x87, 0x1c, 0x58, 0x07, 0xba, 0x4e, 0x0a, 0xcd, 0x7c, 0x67,
-	0xfb, 0x1c, 0xaf, 0xeb, 0x8b, 0xf1, 0x2d, 0xfe, 0x3d, 0x6b, 0xf7, 0xfb, 0x14, 0x41, 0x7e, 0xf9,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x2a, 0x4a, 0xa9, 0xbc, 0x02, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc6, 0x5f, 0x27, 0xb6, 0x6b, 0x8f, 0xf3, 0x96, 0x68, 0x55, 0xa1, 0x25, 0x42, 0x34, 0x32,
+	0x97, 0x14, 0x09, 0x57, 0x84, 0x0b, 0x57, 0xa0, 0x05, 0x45, 0xa2, 0x6d, 0xe4, 0xe4, 0x02, 0xb7,
+	0x4d, 0xb2, 0x71, 0x2c, 0xd9, 0xbb, 0x91, 0x77, 0x8d, 0xd4, 0x8f, 0xc1, 0x89, 0xcf, 0xc7, 0x37,
+	0x41, 0x3b, 0xfe, 0x93, 0x38, 0x12, 0x42, 0xdc, 0xf6, 0x99, 0x19, 0x7b, 0x67, 0x7f, 0xcf, 0x03,
+	0xc1, 0x5e, 0x2a, 0xad, 0xa2, 0x7d, 0x21, 0xb5, 0x1c, 0x5d, 0x26, 0x52, 0x26, 0x19, 0xbf, 0x46,
+	0xb5, 0x2a, 0xb7, 0xd7, 0x3a, 0xcd, 0xb9, 0xd2, 0x2c, 0xdf, 0xd7, 0x03, 0x4f, 0xd6, 0x52, 0xe8,
+	0x82, 0xad, 0x9b, 0x2f, 0xc2, 0x1f, 0x36, 0xd8, 0x73, 0xa9, 0x34, 0x21, 0x60, 0xab, 0xac, 0x4c,
+	0xa8, 0x35, 0xb6, 0x26, 0x7e, 0x8c, 0x67, 0x72, 0x09, 0xde, 0x77, 0x2e, 0x36, 0xb2, 0x98, 0xdd,
+	0xd0, 0xde, 0xd8, 0x9a, 0x04, 0xd3, 0x7e, 0x34, 0xbb, 0x89, 0xdb, 0x22, 0x79, 0x0a, 0xae, 0xd2,
+	0x4c, 0x97, 0x8a, 0xf6, 0xf1, 0xb3, 0x5a, 0x91, 0x11, 0x78, 0x99, 0x14, 0xc9, 0x27, 0x59, 0xe4,
+	0xd4, 0xc6, 0x4e, 0xab, 0xc9, 0x4b, 0x70, 0xd3, 0x9c, 0x25, 0x5c, 0x51, 0x67, 0xdc, 0x9f, 0x04,
+	0xd3, 0x20, 0x32, 0xf7, 0x47, 0x33, 0x53, 0x8b, 0xeb, 0x96, 0xd9, 0x46, 0xb3, 0x44, 0x51, 0x77,
+	0xdc, 0x37, 0xdb, 0x98, 0xb3, 0xf9, 0xe9, 0x7a, 0xc7, 0x84, 0xe0, 0x99, 0xa2, 0x67, 0x58, 0x6f,
+	0x35, 0x79, 0x05, 0x9e, 0xe1, 0xb0, 0x7c, 0xdc, 0x73, 0xea, 0x8d, 0xad, 0xc9, 0xf9, 0xf4, 0xbc,
+	0xfa, 0xed, 0xbc, 0xae, 0xc6, 0x6d, 0x9f, 0x3c, 0x07, 0xbf, 0xe0, 0x5b, 0x5e, 0x70, 0xb1, 0xe6,
+	0xd4, 0xc7, 0xed, 0x0e, 0x05, 0xf2, 0x0e, 0xfc, 0x16, 0x1a, 0x05, 0x7c, 0xf4, 0x28, 0xaa, 0xb0,
+	0x46, 0x0d, 0xd6, 0x68, 0xd9, 0x4c, 0xc4, 0x87, 0xe1, 0xd1, 0x4f, 0x0b, 0x1c, 0x7c, 0x85, 0xd9,
+	0x74, 0x9b, 0x66, 0x5c, 0xb0, 0x9c, 0xd7, 0x3c, 0x5b, 0x6d, 0x7a, 0xb2, 0x48, 0x93, 0x54, 0xb0,
+	0x0c, 0x99, 0xfa, 0x71, 0xab, 0xc9, 0x05, 0x38, 0x19, 0x2b, 0x12, 0x5e, 0xd3, 0xac, 0x84, 0x81,
+	0x9c, 0xf3, 0x4d, 0x5a, 0x36, 0x28, 0x6b, 0x65, 0xa6, 0x55, 0xce, 0xb2, 0x8c, 0x3a, 0xd5, 0x34,
+	0x0a, 0x24, 0x97, 0x8a, 0x47, 0xea, 0x56, 0x3e, 0x9a, 0x73, 0xf8, 0x1a, 0xbc, 0x86, 0x03, 0xf1,
+	0xc0, 0x9e, 0x3f, 0x2c, 0x96, 0xc3, 0xff, 0x48, 0x00, 0x67, 0x1f, 0x1f, 0xee, 0xee, 0x6e, 0xef,
+	0x97, 0x43, 0x8b, 0x00, 0xb8, 0xf1, 0x2d, 0x36, 0x7a, 0xe1, 0x57, 0x80, 0x45, 0x9a, 0x08, 0xbe,
+	0xc1, 0x60, 0x3c, 0x03, 0xdb, 0xa0, 0xc3, 0x87, 0x04, 0x53, 0x07, 0x89, 0xc6, 0x58, 0x32, 0x77,
+	0xed, 0x98, 0xda, 0xd5, 0xef, 0xc0, 0xb3, 0xa1, 0xab, 0xd2, 0x44, 0x30, 0x5d, 0x16, 0xd5, 0x3b,
+	0x06, 0xf1, 0xa1, 0x10, 0xfe, 0xea, 0xc1, 0xe0, 0xbd, 0x10, 0xb2, 0x14, 0x6b, 0x9e, 0x73, 0xa1,
+	0xc9, 0x14, 0x6c, 0x6d, 0x4c, 0xb3, 0xd0, 0xb4, 0x17, 0xd1, 0x71, 0xb3, 0x23, 0xd0, 0x44, 0x9c,
+	0xfd, 0x7b, 0x2c, 0x9b, 0xbd, 0xfa, 0x47, 0x7b, 0x35, 0xf9, 0xb6, 0x8f, 0xf2, 0x7d, 0x01, 0x8e,
+	0x4e, 0x75, 0xc6, 0x1b, 0x82, 0x28, 0xc8, 0x15, 0xf8, 0x7a, 0x57, 0xe6, 0x2b, 0xc1, 0xd2, 0x0c,
+	0x31, 0x9e, 0x64, 0xf4, 0xd0, 0x6d, 0x63, 0x7a, 0xf6, 0x87, 0x98, 0x7a, 0x27, 0x31, 0xed, 0x84,
+	0xcb, 0xff, 0x87, 0x70, 0x85, 0x57, 0x30, 0x3c, 0xa5, 0xd1, 0xb5, 0xf2, 0xcb, 0x6c, 0xb1, 0x9c,
+	0xdd, 0x7f, 0x1e, 0x5a, 0x21, 0x07, 0x52, 0xd9, 0xd7, 0x01, 0xfd, 0x06, 0x06, 0xec, 0x48, 0xd7,
+	0x76, 0xfe, 0xdf, 0x61, 0x1c, 0x77, 0x46, 0xba, 0x56, 0xf6, 0x4e, 0xac, 0xfc, 0x60, 0x7f, 0xeb,
+	0xed, 0x57, 0x2b, 0x17, 0xd7, 0x7e, 0xfb, 0x7b, 0x00, 0xae, 0x23, 0x0e, 0x8c, 0x87, 0x04, 0x00,
+	0x00,
 }
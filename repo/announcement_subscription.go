@@ -0,0 +1,21 @@
+package repo
+
+import "time"
+
+// The kinds of pubsub topics post and listing announcements are published to
+const (
+	// AnnouncementKindChannel topics carry the announcements of posts
+	// addressed to a channel
+	AnnouncementKindChannel = "channel"
+	// AnnouncementKindPeer topics carry the announcements of a peer's posts
+	// and listings
+	AnnouncementKindPeer = "peer"
+)
+
+// AnnouncementSubscription is a channel or peer whose announcements the node
+// listens for
+type AnnouncementSubscription struct {
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+)
+
+// APITokenScope grants an API token access to a group of related routes
+type APITokenScope string
+
+const (
+	// APITokenScopeListingsRead - may read listings and their inventory
+	APITokenScopeListingsRead APITokenScope = "listings:read"
+	// APITokenScopeListingsWrite - may create, update and delete listings
+	// and their inventory
+	APITokenScopeListingsWrite APITokenScope = "listings:write"
+	// APITokenScopeOrdersRead - may read sales, purchases and cases
+	APITokenScopeOrdersRead APITokenScope = "orders:read"
+	// APITokenScopeOrdersWrite - may confirm, fulfill, complete, cancel,
+	// refund and dispute orders
+	APITokenScopeOrdersWrite APITokenScope = "orders:write"
+	// APITokenScopeWalletRead - may read wallet balances, addresses and
+	// transactions
+	APITokenScopeWalletRead APITokenScope = "wallet:read"
+	// APITokenScopeWalletSpend - may send funds from the wallet
+	APITokenScopeWalletSpend APITokenScope = "wallet:spend"
+	// APITokenScopeMetricsRead - may scrape the node's metrics and health
+	APITokenScopeMetricsRead APITokenScope = "metrics:read"
+)
+
+// APITokenScopes lists the scopes which can be given to an API token
+var APITokenScopes = []APITokenScope{
+	APITokenScopeListingsRead,
+	APITokenScopeListingsWrite,
+	APITokenScopeOrdersRead,
+	APITokenScopeOrdersWrite,
+	APITokenScopeWalletRead,
+	APITokenScopeWalletSpend,
+	APITokenScopeMetricsRead,
+}
+
+// ParseAPITokenScope returns the scope with the given name
+func ParseAPITokenScope(name string) (APITokenScope, error) {
+	for _, s := range APITokenScopes {
+		if string(s) == name {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown API token scope (%s)", name)
+}
+
+// APIToken is a bearer credential for the JSON API limited to a set of
+// scopes. Only the hex encoded sha256 hash of the token is stored.
+type APIToken struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	TokenHash string          `json:"-"`
+	Scopes    []APITokenScope `json:"scopes"`
+	Created   time.Time       `json:"created"`
+	Expires   time.Time       `json:"expires"`
+	Revoked   bool            `json:"revoked"`
+}
+
+// HasScope returns true if the token was granted the scope
+func (t *APIToken) HasScope(scope APITokenScope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid returns true if the token has not been revoked or expired at now
+func (t *APIToken) Valid(now time.Time) bool {
+	return !t.Revoked && now.Before(t.Expires)
+}
@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+)
+
+// APIRole determines which API routes an API user may call
+type APIRole string
+
+const (
+	// APIRoleReadOnly - may read the node's data but not change it
+	APIRoleReadOnly APIRole = "readonly"
+	// APIRoleClerk - may also fulfill orders and chat with buyers
+	APIRoleClerk APIRole = "clerk"
+	// APIRoleModerator - may also chat and close disputes
+	APIRoleModerator APIRole = "moderator"
+	// APIRoleOwner - may call every route including those which move funds
+	APIRoleOwner APIRole = "owner"
+)
+
+// APIRoles lists the roles which can be given to an API user
+var APIRoles = []APIRole{APIRoleReadOnly, APIRoleClerk, APIRoleModerator, APIRoleOwner}
+
+// ParseAPIRole returns the role with the given name
+func ParseAPIRole(name string) (APIRole, error) {
+	for _, r := range APIRoles {
+		if string(r) == name {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("unknown API role (%s)", name)
+}
+
+// APIUser is a named user of the JSON API. The password is stored as a hex
+// encoded sha256 hash in the same way as the credentials in the config.
+type APIUser struct {
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"-"`
+	Role         APIRole   `json:"role"`
+	Created      time.Time `json:"created"`
+}
@@ -0,0 +1,25 @@
+package repo
+
+// CartData represents a checkout of items from one or more vendors. The
+// shipping and payment details apply to the order placed with each vendor.
+type CartData struct {
+	PurchaseData
+
+	// Moderators selects the moderator by vendor peer ID. Vendors without
+	// an entry use the moderator of the purchase data.
+	Moderators map[string]string `json:"moderators"`
+	// Fund pays every order placed from the wallet once all are submitted
+	Fund     bool   `json:"fund"`
+	FeeLevel string `json:"feeLevel"`
+}
+
+// PurchaseDataForVendor returns the purchase data for the order placed with
+// the vendor containing only the given items
+func (c *CartData) PurchaseDataForVendor(vendorID string, items []Item) *PurchaseData {
+	data := c.PurchaseData
+	data.Items = items
+	if moderator, ok := c.Moderators[vendorID]; ok {
+		data.Moderator = moderator
+	}
+	return &data
+}
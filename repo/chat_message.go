@@ -1,13 +1,32 @@
 package repo
 
 type ChatMessage struct {
-	MessageId string   `json:"messageId"`
-	PeerId    string   `json:"peerId"`
-	Subject   string   `json:"subject"`
-	Message   string   `json:"message"`
-	Read      bool     `json:"read"`
-	Outgoing  bool     `json:"outgoing"`
-	Timestamp *APITime `json:"timestamp"`
+	MessageId   string           `json:"messageId"`
+	PeerId      string           `json:"peerId"`
+	Subject     string           `json:"subject"`
+	Message     string           `json:"message"`
+	Read        bool             `json:"read"`
+	Outgoing    bool             `json:"outgoing"`
+	Timestamp   *APITime         `json:"timestamp"`
+	Attachments []ChatAttachment `json:"attachments,omitempty"`
+}
+
+// ChatAttachment describes a file attached to a chat message. Encrypted
+// attachments can only be decrypted by the node storing the message.
+type ChatAttachment struct {
+	Cid       string `json:"cid"`
+	MimeType  string `json:"mimeType"`
+	Size      uint64 `json:"size"`
+	Filename  string `json:"filename"`
+	Encrypted bool   `json:"encrypted"`
+	Fetched   bool   `json:"fetched"`
+}
+
+// ChatAttachmentFile is a file to attach to an outgoing chat message
+type ChatAttachmentFile struct {
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Data     []byte `json:"data"`
 }
 
 type ChatConversation struct {
@@ -19,7 +38,9 @@ type ChatConversation struct {
 }
 
 type GroupChatMessage struct {
-	PeerIds []string `json:"peerIds"`
-	Subject string   `json:"subject"`
-	Message string   `json:"message"`
+	PeerIds            []string             `json:"peerIds"`
+	Subject            string               `json:"subject"`
+	Message            string               `json:"message"`
+	Attachments        []ChatAttachmentFile `json:"attachments"`
+	EncryptAttachments bool                 `json:"encryptAttachments"`
 }
@@ -6,20 +6,29 @@ const (
 	// Number of hours after dispute begins before it is resolved automatically
 	DisputeTotalDurationHours int = 45 * 24
 
+	NotifierTypeAnnouncement                  NotificationType = "announcement"
 	NotifierTypeBuyerDisputeTimeout           NotificationType = "buyerDisputeTimeout"
 	NotifierTypeBuyerDisputeExpiry            NotificationType = "buyerDisputeExpiry"
 	NotifierTypeChatMessage                   NotificationType = "chatMessage"
 	NotifierTypeChatRead                      NotificationType = "chatRead"
 	NotifierTypeChatTyping                    NotificationType = "chatTyping"
 	NotifierTypeCompletionNotification        NotificationType = "orderComplete"
+	NotifierTypeCrowdFundFailed               NotificationType = "crowdFundFailed"
+	NotifierTypeCrowdFundSucceeded            NotificationType = "crowdFundSucceeded"
 	NotifierTypeDisputeAcceptedNotification   NotificationType = "disputeAccepted"
 	NotifierTypeDisputeCloseNotification      NotificationType = "disputeClose"
+	NotifierTypeDisputeEvidenceNotification   NotificationType = "disputeEvidence"
 	NotifierTypeDisputeOpenNotification       NotificationType = "disputeOpen"
 	NotifierTypeDisputeUpdateNotification     NotificationType = "disputeUpdate"
+	NotifierTypeFeedPost                      NotificationType = "feedPost"
 	NotifierTypeFindModeratorResponse         NotificationType = "findModeratorResponse"
 	NotifierTypeFollowNotification            NotificationType = "follow"
 	NotifierTypeFulfillmentNotification       NotificationType = "fulfillment"
 	NotifierTypeIncomingTransaction           NotificationType = "incomingTransaction"
+	NotifierTypeListingExpired                NotificationType = "listingExpired"
+	NotifierTypeListingPublished              NotificationType = "listingPublished"
+	NotifierTypeListingRepublished            NotificationType = "listingRepublished"
+	NotifierTypeLowInventory                  NotificationType = "lowInventory"
 	NotifierTypeModeratorAddNotification      NotificationType = "moderatorAdd"
 	NotifierTypeModeratorDisputeExpiry        NotificationType = "moderatorDisputeExpiry"
 	NotifierTypeModeratorRemoveNotification   NotificationType = "moderatorRemove"
@@ -32,6 +41,8 @@ const (
 	NotifierTypeProcessingErrorNotification   NotificationType = "processingError"
 	NotifierTypeRefundNotification            NotificationType = "refund"
 	NotifierTypeStatusUpdateNotification      NotificationType = "statusUpdate"
+	NotifierTypeSubscriptionRenewed           NotificationType = "subscriptionRenewed"
+	NotifierTypeSubscriptionRenewalFailed     NotificationType = "subscriptionRenewalFailed"
 	NotifierTypeTestNotification              NotificationType = "testNotification"
 	NotifierTypeUnfollowNotification          NotificationType = "unfollow"
 	NotifierTypeVendorDisputeTimeout          NotificationType = "vendorDisputeTimeout"
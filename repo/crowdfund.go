@@ -0,0 +1,96 @@
+package repo
+
+import (
+	"math/big"
+	"time"
+)
+
+// CrowdFundPledgeState describes what happened to a pledge once its
+// crowdfunding campaign ended
+type CrowdFundPledgeState string
+
+const (
+	// CrowdFundPledgeStatePledged - the pledge is funded and held in escrow
+	// until the deadline
+	CrowdFundPledgeStatePledged CrowdFundPledgeState = "pledged"
+	// CrowdFundPledgeStateCommitted - the goal was met and the order is
+	// fulfilled like any other sale
+	CrowdFundPledgeStateCommitted CrowdFundPledgeState = "committed"
+	// CrowdFundPledgeStateWithdrawn - the order was refunded or canceled and
+	// no longer counts towards the goal
+	CrowdFundPledgeStateWithdrawn CrowdFundPledgeState = "withdrawn"
+)
+
+// CrowdFundState describes how far a crowdfunding campaign has progressed
+type CrowdFundState string
+
+const (
+	// CrowdFundStateOpen - the deadline hasn't passed and pledges are taken
+	CrowdFundStateOpen CrowdFundState = "open"
+	// CrowdFundStateSucceeded - the goal was met by the deadline
+	CrowdFundStateSucceeded CrowdFundState = "succeeded"
+	// CrowdFundStateFailed - the goal was not met by the deadline and the
+	// pledges are refunded
+	CrowdFundStateFailed CrowdFundState = "failed"
+)
+
+// CrowdFundPledge is a funded order the vendor received for a crowdfunding
+// listing. Amount is the value of the ordered items in the base units of the
+// listing's price currency, which is what counts towards the goal.
+type CrowdFundPledge struct {
+	OrderID   string               `json:"orderId"`
+	Slug      string               `json:"slug"`
+	BuyerID   string               `json:"buyerID"`
+	Amount    string               `json:"amount"`
+	Currency  string               `json:"currency"`
+	State     CrowdFundPledgeState `json:"state"`
+	Timestamp time.Time            `json:"timestamp"`
+}
+
+// CrowdFundProgress is the progress of a crowdfunding listing towards its
+// goal as published in the listing index
+type CrowdFundProgress struct {
+	Goal     *CurrencyValue `json:"goal"`
+	Pledged  *CurrencyValue `json:"pledged"`
+	Backers  int            `json:"backers"`
+	Deadline time.Time      `json:"deadline"`
+	State    CrowdFundState `json:"state"`
+}
+
+// NewCrowdFundProgress sums the pledges which haven't been withdrawn and
+// compares them to the goal once the deadline has passed
+func NewCrowdFundProgress(goal *CurrencyValue, deadline time.Time, pledges []CrowdFundPledge, now time.Time) *CrowdFundProgress {
+	var (
+		pledged = big.NewInt(0)
+		backers = make(map[string]bool)
+	)
+	for _, p := range pledges {
+		if p.State == CrowdFundPledgeStateWithdrawn {
+			continue
+		}
+		if amount, ok := new(big.Int).SetString(p.Amount, 10); ok {
+			pledged.Add(pledged, amount)
+		}
+		backers[p.BuyerID] = true
+	}
+	progress := &CrowdFundProgress{
+		Goal:     goal,
+		Pledged:  &CurrencyValue{Amount: pledged, Currency: goal.Currency},
+		Backers:  len(backers),
+		Deadline: deadline,
+		State:    CrowdFundStateOpen,
+	}
+	if !now.Before(deadline) {
+		if pledged.Cmp(goal.Amount) >= 0 {
+			progress.State = CrowdFundStateSucceeded
+		} else {
+			progress.State = CrowdFundStateFailed
+		}
+	}
+	return progress
+}
+
+// GoalMet returns true if the pledges add up to at least the goal
+func (p *CrowdFundProgress) GoalMet() bool {
+	return p.Pledged.Amount.Cmp(p.Goal.Amount) >= 0
+}
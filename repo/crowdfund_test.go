@@ -0,0 +1,48 @@
+package repo_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestNewCrowdFundProgress(t *testing.T) {
+	var (
+		now      = time.Now()
+		deadline = now.Add(time.Hour)
+		goal     = &repo.CurrencyValue{Amount: big.NewInt(10000), Currency: repo.CurrencyDefinition{Code: "USD", Divisibility: 2}}
+		pledges  = []repo.CrowdFundPledge{
+			{OrderID: "order1", BuyerID: "buyer1", Amount: "4000", State: repo.CrowdFundPledgeStatePledged},
+			{OrderID: "order2", BuyerID: "buyer1", Amount: "2000", State: repo.CrowdFundPledgeStatePledged},
+			{OrderID: "order3", BuyerID: "buyer2", Amount: "5000", State: repo.CrowdFundPledgeStateWithdrawn},
+			{OrderID: "order4", BuyerID: "buyer3", Amount: "3000", State: repo.CrowdFundPledgeStateCommitted},
+		}
+	)
+
+	progress := repo.NewCrowdFundProgress(goal, deadline, pledges, now)
+	if progress.Pledged.Amount.Cmp(big.NewInt(9000)) != 0 {
+		t.Errorf("expected 9000 pledged, got %s", progress.Pledged.Amount)
+	}
+	if progress.Backers != 2 {
+		t.Errorf("expected 2 backers, got %d", progress.Backers)
+	}
+	if progress.State != repo.CrowdFundStateOpen {
+		t.Errorf("expected campaign to be open before the deadline, got %s", progress.State)
+	}
+	if progress.GoalMet() {
+		t.Error("expected goal not to be met")
+	}
+
+	progress = repo.NewCrowdFundProgress(goal, deadline, pledges, deadline)
+	if progress.State != repo.CrowdFundStateFailed {
+		t.Errorf("expected campaign to fail at the deadline, got %s", progress.State)
+	}
+
+	pledges = append(pledges, repo.CrowdFundPledge{OrderID: "order5", BuyerID: "buyer4", Amount: "1000", State: repo.CrowdFundPledgeStatePledged})
+	progress = repo.NewCrowdFundProgress(goal, deadline, pledges, deadline.Add(time.Minute))
+	if progress.State != repo.CrowdFundStateSucceeded || !progress.GoalMet() {
+		t.Errorf("expected campaign to succeed once the goal is met, got %s", progress.State)
+	}
+}
@@ -30,8 +30,25 @@ type Datastore interface {
 	TxMetadata() TransactionMetadataStore
 	ModeratedStores() ModeratedStore
 	Messages() MessageStore
+	WebhookDeliveries() WebhookDeliveryStore
+	OrderStateHistory() OrderStateHistoryStore
+	Search() SearchStore
+	ListingSchedules() ListingScheduleStore
+	InventoryReservations() InventoryReservationStore
+	APIUsers() APIUserStore
+	APITokens() APITokenStore
+	Subscriptions() SubscriptionStore
+	CrowdFundPledges() CrowdFundPledgeStore
+	PeerBans() PeerBanStore
+	DisputeEvidence() DisputeEvidenceStore
+	FeedPosts() FeedPostStore
+	AnnouncementSubscriptions() AnnouncementSubscriptionStore
 	Ping() error
 	Close()
+
+	// Copy copies every table into the database at dbPath, which must
+	// already have the tables, encrypting it with password if given
+	Copy(dbPath string, password string) error
 }
 
 type Queryable interface {
@@ -179,6 +196,20 @@ type InventoryStore interface {
 
 	// Delete all variants of a given slug
 	DeleteAll(slug string) error
+
+	// PutLowStockThreshold sets the count at or below which a variant of the
+	// listing is considered low on stock
+	PutLowStockThreshold(slug string, threshold *big.Int) error
+
+	// GetLowStockThreshold returns the threshold for a listing or
+	// sql.ErrNoRows if none is set
+	GetLowStockThreshold(slug string) (*big.Int, error)
+
+	// GetAllLowStockThresholds returns the thresholds keyed by slug
+	GetAllLowStockThresholds() (map[string]*big.Int, error)
+
+	// DeleteLowStockThreshold removes the threshold for a listing
+	DeleteLowStockThreshold(slug string) error
 }
 
 type PurchaseStore interface {
@@ -214,6 +245,9 @@ type PurchaseStore interface {
 	// Return the number of purchases in the database
 	Count() int
 
+	// CountByState returns the number of purchases in each order state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetPurchasesForDisputeTimeoutNotification returns []*PurchaseRecord including
 	// each record which needs buyerDisputeTimeout Notifications to be generated.
 	GetPurchasesForDisputeTimeoutNotification() ([]*PurchaseRecord, error)
@@ -262,6 +296,9 @@ type SaleStore interface {
 	// Return the number of sales in the database
 	Count() int
 
+	// CountByState returns the number of sales in each order state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetSalesForDisputeTimeoutNotification returns []*SaleRecord including
 	// each record which needs Notifications to be generated.
 	GetSalesForDisputeTimeoutNotification() ([]*SaleRecord, error)
@@ -309,6 +346,12 @@ type CaseStore interface {
 	// Return the number of cases in the database
 	Count() int
 
+	// GetResolved returns the cases which were resolved with their resolutions
+	GetResolved() ([]CaseResolution, error)
+
+	// CountByState returns the number of cases in the state
+	CountByState(state pb.OrderState) (int, error)
+
 	// GetDisputesForDisputeExpiryNotification returns []*DisputeCaseRecord including
 	// each record which needs Notifications to be generated.
 	GetDisputesForDisputeExpiryNotification() ([]*DisputeCaseRecord, error)
@@ -329,6 +372,10 @@ type ChatStore interface {
 	// A list of messages given a peer ID and a subject
 	GetMessages(peerID string, subject string, offsetID string, limit int) []ChatMessage
 
+	// GetMessage returns the chat message with the ID or sql.ErrNoRows if
+	// there is none
+	GetMessage(messageID string) (*ChatMessage, error)
+
 	// Mark all chat messages for a peer as read. Returns the Id of the last seen message and
 	// whether any messages were updated.
 	// If message Id is specified it will only mark that message and earlier as read.
@@ -342,6 +389,18 @@ type ChatStore interface {
 
 	// Delete all messages from from a peer
 	DeleteConversation(peerID string) error
+
+	// PutAttachments saves the descriptors of the files attached to the
+	// message
+	PutAttachments(messageID string, attachments []ChatAttachment) error
+
+	// GetAttachment returns the attachment with the CID or sql.ErrNoRows if
+	// there is none
+	GetAttachment(cid string) (*ChatAttachment, error)
+
+	// MarkAttachmentFetched records the attachment with the CID was fetched
+	// and pinned
+	MarkAttachmentFetched(cid string) error
 }
 
 type NotificationStore interface {
@@ -377,6 +436,27 @@ type CouponStore interface {
 
 	// Delete all coupons for a given slug
 	Delete(slug string) error
+
+	// PutRedemption records a coupon applied to an order. A redemption
+	// already recorded for the order and coupon is kept.
+	PutRedemption(redemption CouponRedemption) error
+
+	// GetAllRedemptions returns every recorded redemption
+	GetAllRedemptions() ([]CouponRedemption, error)
+
+	// CountRedemptions returns the number of orders other than excludeOrderID
+	// which redeemed the coupon and were either funded or placed after since
+	CountRedemptions(slug, hash string, since time.Time, excludeOrderID string) (int, error)
+
+	// HasRedeemed returns true if the buyer redeemed the coupon on an order
+	// other than excludeOrderID which was either funded or placed after since
+	HasRedeemed(slug, hash, buyerID string, since time.Time, excludeOrderID string) (bool, error)
+
+	// SetRedemptionsFunded marks the coupons applied to the order as funded
+	SetRedemptionsFunded(orderID string) error
+
+	// DeleteRedemptions removes the coupons applied to the order
+	DeleteRedemptions(orderID string) error
 }
 
 type TransactionMetadataStore interface {
@@ -451,3 +531,250 @@ type MessageStore interface {
 	// with GetAllErrored
 	MarkAsResolved(OrderMessage) error
 }
+
+// WebhookDeliveryStore is the webhookdeliveries table interface
+type WebhookDeliveryStore interface {
+	Queryable
+
+	// Put inserts or replaces a delivery
+	Put(delivery WebhookDelivery) error
+
+	// GetDue returns up to limit deliveries whose next attempt is at or
+	// before the given time, oldest first
+	GetDue(now time.Time, limit int) ([]WebhookDelivery, error)
+
+	// Delete removes the delivery with the given ID
+	Delete(id string) error
+}
+
+// OrderStateHistoryStore is the orderstatehistory table interface
+type OrderStateHistoryStore interface {
+	Queryable
+
+	// Put appends a state change to the order's history
+	Put(change OrderStateChange) error
+
+	// GetByOrderID returns the history of the order for the given role,
+	// oldest first
+	GetByOrderID(orderID string, role OrderRole) ([]OrderStateChange, error)
+}
+
+// SearchStore is the full-text search index interface. Sales, purchases,
+// cases and chat messages are indexed by their own stores; listings are
+// indexed when they are saved.
+type SearchStore interface {
+	Queryable
+
+	// Index adds or replaces a document in the index
+	Index(doc SearchDocument) error
+
+	// Delete removes a document from the index
+	Delete(scope, id string) error
+
+	// Search returns the documents matching the query in the given scopes,
+	// best match first, along with the total number of matches. All scopes
+	// are searched if none are given.
+	Search(query string, scopes []string, limit, offset int) ([]SearchResult, int, error)
+}
+
+// ListingScheduleStore is the listingschedules table interface
+type ListingScheduleStore interface {
+	Queryable
+
+	// Put adds or replaces the schedule for a listing
+	Put(schedule ListingSchedule) error
+
+	// Get returns the schedule for a listing or sql.ErrNoRows if it has none
+	Get(slug string) (*ListingSchedule, error)
+
+	// GetAll returns every listing schedule
+	GetAll() ([]ListingSchedule, error)
+
+	// GetDue returns the pending schedules whose publish time has passed
+	GetDue(now time.Time) ([]ListingSchedule, error)
+
+	// Delete removes the schedule for a listing
+	Delete(slug string) error
+}
+
+// InventoryReservationStore is the inventoryreservations table interface
+type InventoryReservationStore interface {
+	Queryable
+
+	// Put replaces the reservations held for an order
+	Put(orderID string, reservations []InventoryReservation) error
+
+	// GetByOrderID returns the reservations held for an order
+	GetByOrderID(orderID string) ([]InventoryReservation, error)
+
+	// GetBySlug returns the reservations held against a listing variant
+	GetBySlug(slug string, variant int) ([]InventoryReservation, error)
+
+	// GetAll returns every reservation, oldest first
+	GetAll() ([]InventoryReservation, error)
+
+	// GetOrderIDsBefore returns the orders holding reservations made before
+	// the given time
+	GetOrderIDsBefore(t time.Time) ([]string, error)
+
+	// Delete releases the reservations held for an order
+	Delete(orderID string) error
+}
+
+// APIUserStore is the apiusers table interface
+type APIUserStore interface {
+	Queryable
+
+	// Put adds or replaces an API user
+	Put(user APIUser) error
+
+	// Get returns the API user or sql.ErrNoRows if there is none
+	Get(username string) (*APIUser, error)
+
+	// GetAll returns every API user ordered by username
+	GetAll() ([]APIUser, error)
+
+	// Delete removes an API user
+	Delete(username string) error
+}
+
+// APITokenStore is the apitokens table interface
+type APITokenStore interface {
+	Queryable
+
+	// Put adds or replaces an API token
+	Put(token APIToken) error
+
+	// Get returns the API token with the ID or sql.ErrNoRows if there is none
+	Get(id string) (*APIToken, error)
+
+	// GetByHash returns the API token with the hashed value or sql.ErrNoRows
+	// if there is none
+	GetByHash(tokenHash string) (*APIToken, error)
+
+	// GetAll returns every API token, newest first
+	GetAll() ([]APIToken, error)
+
+	// Revoke marks the API token as revoked
+	Revoke(id string) error
+}
+
+// SubscriptionStore is the subscriptions table interface
+type SubscriptionStore interface {
+	Queryable
+
+	// Put adds or replaces a subscription
+	Put(subscription Subscription) error
+
+	// Get returns the subscription with the ID or sql.ErrNoRows if there is none
+	Get(id string) (*Subscription, error)
+
+	// GetAll returns every subscription, newest first
+	GetAll() ([]Subscription, error)
+
+	// GetDue returns the active subscriptions whose renewal date has passed
+	GetDue(now time.Time) ([]Subscription, error)
+
+	// Delete removes a subscription
+	Delete(id string) error
+}
+
+// CrowdFundPledgeStore is the crowdfundpledges table interface
+type CrowdFundPledgeStore interface {
+	Queryable
+
+	// Put records a pledge. A pledge already recorded for the order is kept.
+	Put(pledge CrowdFundPledge) error
+
+	// Get returns the order's pledge
+	Get(orderID string) (*CrowdFundPledge, error)
+
+	// GetBySlug returns the pledges for the listing, oldest first
+	GetBySlug(slug string) ([]CrowdFundPledge, error)
+
+	// GetAll returns every pledge, oldest first
+	GetAll() ([]CrowdFundPledge, error)
+
+	// SetState changes the state of the order's pledge. It is not an error
+	// if the order has no pledge.
+	SetState(orderID string, state CrowdFundPledgeState) error
+
+	// Delete removes the order's pledge
+	Delete(orderID string) error
+}
+
+// PeerBanStore is the peerbans table interface
+type PeerBanStore interface {
+	Queryable
+
+	// Put adds or replaces the ban on a peer
+	Put(ban PeerBan) error
+
+	// GetActive returns the bans which have not expired at now, newest first
+	GetActive(now time.Time) ([]PeerBan, error)
+
+	// Delete lifts the ban on the peer
+	Delete(peerID string) error
+
+	// DeleteExpired removes the bans which expired before now
+	DeleteExpired(now time.Time) error
+}
+
+// DisputeEvidenceStore is the disputeevidence table interface
+type DisputeEvidenceStore interface {
+	Queryable
+
+	// Put records evidence submitted for a case. Evidence which was already
+	// recorded is kept.
+	Put(caseID string, evidence *pb.DisputeEvidence) error
+
+	// GetByCaseID returns the evidence submitted for the case, oldest first
+	GetByCaseID(caseID string) ([]*pb.DisputeEvidence, error)
+
+	// DeleteByCaseID removes the evidence submitted for the case
+	DeleteByCaseID(caseID string) error
+}
+
+type FeedPostStore interface {
+	Queryable
+
+	// Put caches a verified post by a followed peer. The post's hash
+	// identifies it.
+	Put(peerID string, post *pb.SignedPost) error
+
+	// Has returns whether the post with the hash is cached
+	Has(hash string) (bool, error)
+
+	/* GetAll returns the cached posts newest first along with the total
+	   number matching the filters. The offsetHash and limit arguments can be
+	   used for lazy loading. If tag is given only posts with the tag are
+	   returned and if channels are given only posts in any of them. */
+	GetAll(offsetHash string, limit int, tag string, channels []string) ([]*pb.SignedPost, int, error)
+
+	// GetHashes returns the hashes of the cached posts by the peer
+	GetHashes(peerID string) ([]string, error)
+
+	// GetPeers returns the peers with cached posts
+	GetPeers() ([]string, error)
+
+	// Delete removes the post with the hash
+	Delete(hash string) error
+
+	// DeleteByPeer removes the posts by the peer
+	DeleteByPeer(peerID string) error
+}
+
+// AnnouncementSubscriptionStore is the announcementsubscriptions table
+// interface
+type AnnouncementSubscriptionStore interface {
+	Queryable
+
+	// Put subscribes to the announcements of the channel or peer
+	Put(kind, name string, timestamp time.Time) error
+
+	// GetAll returns the subscriptions in the order they were made
+	GetAll() ([]AnnouncementSubscription, error)
+
+	// Delete removes the subscription
+	Delete(kind, name string) error
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// AnnouncementSubscriptionsDB represents the announcementsubscriptions table
+type AnnouncementSubscriptionsDB struct {
+	modelStore
+}
+
+// NewAnnouncementSubscriptionStore returns a new AnnouncementSubscriptionsDB
+func NewAnnouncementSubscriptionStore(db *sql.DB, lock *sync.Mutex) repo.AnnouncementSubscriptionStore {
+	return &AnnouncementSubscriptionsDB{modelStore{db, lock}}
+}
+
+// Put subscribes to the announcements of the channel or peer
+func (a *AnnouncementSubscriptionsDB) Put(kind, name string, timestamp time.Time) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	stmt, err := a.PrepareQuery("insert or replace into announcementsubscriptions(kind, name, timestamp) values(?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare announcement subscription sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(kind, name, timestamp.Unix())
+	if err != nil {
+		return fmt.Errorf("commit announcement subscription: %s", err.Error())
+	}
+	return nil
+}
+
+// GetAll returns the subscriptions in the order they were made
+func (a *AnnouncementSubscriptionsDB) GetAll() ([]repo.AnnouncementSubscription, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rows, err := a.db.Query("select kind, name, timestamp from announcementsubscriptions order by timestamp asc, kind asc, name asc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var subs []repo.AnnouncementSubscription
+	for rows.Next() {
+		var (
+			sub       repo.AnnouncementSubscription
+			timestamp int64
+		)
+		if err := rows.Scan(&sub.Kind, &sub.Name, &timestamp); err != nil {
+			return nil, err
+		}
+		sub.Timestamp = time.Unix(timestamp, 0)
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+// Delete removes the subscription
+func (a *AnnouncementSubscriptionsDB) Delete(kind, name string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("delete from announcementsubscriptions where kind=? and name=?", kind, name)
+	return err
+}
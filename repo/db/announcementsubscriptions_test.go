@@ -0,0 +1,77 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAnnouncementSubscriptionStore() (repo.AnnouncementSubscriptionStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAnnouncementSubscriptionStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAnnouncementSubscriptionsDB_PutGetDelete(t *testing.T) {
+	subDB, teardown, err := buildNewAnnouncementSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	if err := subDB.Put(repo.AnnouncementKindPeer, "QmPeer1", now); err != nil {
+		t.Fatal(err)
+	}
+	if err := subDB.Put(repo.AnnouncementKindChannel, "deals", now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	// Subscribing again only updates the timestamp
+	if err := subDB.Put(repo.AnnouncementKindPeer, "QmPeer1", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	subs, err := subDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 2 {
+		t.Fatalf("expected 2 subscriptions, got %d", len(subs))
+	}
+	if subs[0].Kind != repo.AnnouncementKindChannel || subs[0].Name != "deals" || !subs[0].Timestamp.Equal(now.Add(-time.Hour)) {
+		t.Errorf("unexpected first subscription: %+v", subs[0])
+	}
+	if subs[1].Kind != repo.AnnouncementKindPeer || subs[1].Name != "QmPeer1" || !subs[1].Timestamp.Equal(now.Add(time.Hour)) {
+		t.Errorf("unexpected second subscription: %+v", subs[1])
+	}
+
+	if err := subDB.Delete(repo.AnnouncementKindChannel, "QmPeer1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := subDB.Delete(repo.AnnouncementKindChannel, "deals"); err != nil {
+		t.Fatal(err)
+	}
+	subs, err = subDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].Name != "QmPeer1" {
+		t.Errorf("expected only the peer subscription to remain, got %+v", subs)
+	}
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// APITokensDB represents the apitokens table
+type APITokensDB struct {
+	modelStore
+}
+
+// NewAPITokenStore returns a new APITokensDB
+func NewAPITokenStore(db *sql.DB, lock *sync.Mutex) repo.APITokenStore {
+	return &APITokensDB{modelStore{db, lock}}
+}
+
+const selectAPITokensSQL = "select id, name, tokenHash, scopes, created, expires, revoked from apitokens"
+
+// Put adds or replaces an API token
+func (a *APITokensDB) Put(token repo.APIToken) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	stmt, err := a.PrepareQuery("insert or replace into apitokens(id, name, tokenHash, scopes, created, expires, revoked) values(?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare api token sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, s := range token.Scopes {
+		scopes = append(scopes, string(s))
+	}
+	revoked := 0
+	if token.Revoked {
+		revoked = 1
+	}
+	_, err = stmt.Exec(token.ID, token.Name, token.TokenHash, strings.Join(scopes, ","), token.Created.Unix(), token.Expires.Unix(), revoked)
+	if err != nil {
+		return fmt.Errorf("commit api token: %s", err.Error())
+	}
+	return nil
+}
+
+// Get returns the API token with the ID or sql.ErrNoRows if there is none
+func (a *APITokensDB) Get(id string) (*repo.APIToken, error) {
+	return a.getOne(selectAPITokensSQL+" where id=?", id)
+}
+
+// GetByHash returns the API token with the hashed value or sql.ErrNoRows if
+// there is none
+func (a *APITokensDB) GetByHash(tokenHash string) (*repo.APIToken, error) {
+	return a.getOne(selectAPITokensSQL+" where tokenHash=?", tokenHash)
+}
+
+func (a *APITokensDB) getOne(query string, arg string) (*repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rows, err := a.db.Query(query, arg)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := scanAPITokens(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &tokens[0], nil
+}
+
+// GetAll returns every API token, newest first
+func (a *APITokensDB) GetAll() ([]repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rows, err := a.db.Query(selectAPITokensSQL + " order by created desc, id asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanAPITokens(rows)
+}
+
+// Revoke marks the API token as revoked
+func (a *APITokensDB) Revoke(id string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("update apitokens set revoked=1 where id=?", id)
+	return err
+}
+
+func scanAPITokens(rows *sql.Rows) ([]repo.APIToken, error) {
+	defer rows.Close()
+	var tokens []repo.APIToken
+	for rows.Next() {
+		var (
+			token            repo.APIToken
+			scopes           string
+			created, expires int64
+			revoked          int
+		)
+		if err := rows.Scan(&token.ID, &token.Name, &token.TokenHash, &scopes, &created, &expires, &revoked); err != nil {
+			return nil, err
+		}
+		for _, s := range strings.Split(scopes, ",") {
+			if s != "" {
+				token.Scopes = append(token.Scopes, repo.APITokenScope(s))
+			}
+		}
+		token.Created = time.Unix(created, 0)
+		token.Expires = time.Unix(expires, 0)
+		token.Revoked = revoked == 1
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
@@ -0,0 +1,103 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAPITokenStore() (repo.APITokenStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAPITokenStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAPITokensDB_PutGetRevoke(t *testing.T) {
+	tokenDB, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := tokenDB.Get("a1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing token, got %v", err)
+	}
+
+	created := time.Unix(time.Now().Unix(), 0)
+	tokens := []repo.APIToken{
+		{
+			ID:        "a1",
+			Name:      "inventory sync",
+			TokenHash: "abc123",
+			Scopes:    []repo.APITokenScope{repo.APITokenScopeListingsRead, repo.APITokenScopeListingsWrite},
+			Created:   created.Add(-time.Hour),
+			Expires:   created.Add(time.Hour),
+		},
+		{
+			ID:        "b2",
+			Name:      "dashboard",
+			TokenHash: "def456",
+			Scopes:    []repo.APITokenScope{repo.APITokenScopeOrdersRead},
+			Created:   created,
+			Expires:   created.Add(time.Hour),
+		},
+	}
+	for _, tk := range tokens {
+		if err := tokenDB.Put(tk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	token, err := tokenDB.GetByHash("abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.ID != "a1" || token.Name != "inventory sync" || token.Revoked {
+		t.Errorf("unexpected token: %+v", token)
+	}
+	if len(token.Scopes) != 2 || !token.HasScope(repo.APITokenScopeListingsWrite) {
+		t.Errorf("unexpected scopes: %v", token.Scopes)
+	}
+	if !token.Expires.Equal(created.Add(time.Hour)) {
+		t.Errorf("expected expiry %s, got %s", created.Add(time.Hour), token.Expires)
+	}
+	if _, err := tokenDB.GetByHash("missing"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for unknown hash, got %v", err)
+	}
+
+	all, err := tokenDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].ID != "b2" {
+		t.Errorf("expected newest token first, got %+v", all)
+	}
+
+	if err := tokenDB.Revoke("a1"); err != nil {
+		t.Fatal(err)
+	}
+	token, err = tokenDB.Get("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !token.Revoked || token.Valid(created) {
+		t.Error("expected token to be revoked")
+	}
+}
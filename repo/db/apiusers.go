@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// APIUsersDB represents the apiusers table
+type APIUsersDB struct {
+	modelStore
+}
+
+// NewAPIUserStore returns a new APIUsersDB
+func NewAPIUserStore(db *sql.DB, lock *sync.Mutex) repo.APIUserStore {
+	return &APIUsersDB{modelStore{db, lock}}
+}
+
+// Put adds or replaces an API user
+func (u *APIUsersDB) Put(user repo.APIUser) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	stmt, err := u.PrepareQuery("insert or replace into apiusers(username, passwordHash, role, created) values(?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare api user sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(user.Username, user.PasswordHash, string(user.Role), user.Created.Unix())
+	if err != nil {
+		return fmt.Errorf("commit api user: %s", err.Error())
+	}
+	return nil
+}
+
+// Get returns the API user or sql.ErrNoRows if there is none
+func (u *APIUsersDB) Get(username string) (*repo.APIUser, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	rows, err := u.db.Query("select username, passwordHash, role, created from apiusers where username=?", username)
+	if err != nil {
+		return nil, err
+	}
+	users, err := scanAPIUsers(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &users[0], nil
+}
+
+// GetAll returns every API user ordered by username
+func (u *APIUsersDB) GetAll() ([]repo.APIUser, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	rows, err := u.db.Query("select username, passwordHash, role, created from apiusers order by username asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanAPIUsers(rows)
+}
+
+// Delete removes an API user
+func (u *APIUsersDB) Delete(username string) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	_, err := u.db.Exec("delete from apiusers where username=?", username)
+	return err
+}
+
+func scanAPIUsers(rows *sql.Rows) ([]repo.APIUser, error) {
+	defer rows.Close()
+	var users []repo.APIUser
+	for rows.Next() {
+		var (
+			user    repo.APIUser
+			role    string
+			created int64
+		)
+		if err := rows.Scan(&user.Username, &user.PasswordHash, &role, &created); err != nil {
+			return nil, err
+		}
+		user.Role = repo.APIRole(role)
+		user.Created = time.Unix(created, 0)
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
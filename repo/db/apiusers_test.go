@@ -0,0 +1,84 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAPIUserStore() (repo.APIUserStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAPIUserStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAPIUsersDB_PutGetDelete(t *testing.T) {
+	userDB, teardown, err := buildNewAPIUserStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := userDB.Get("leslie"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing user, got %v", err)
+	}
+
+	created := time.Unix(time.Now().Unix(), 0)
+	users := []repo.APIUser{
+		{Username: "leslie", PasswordHash: "abc123", Role: repo.APIRoleOwner, Created: created},
+		{Username: "april", PasswordHash: "def456", Role: repo.APIRoleClerk, Created: created},
+	}
+	for _, u := range users {
+		if err := userDB.Put(u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	user, err := userDB.Get("april")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Username != "april" || user.PasswordHash != "def456" || user.Role != repo.APIRoleClerk {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if !user.Created.Equal(created) {
+		t.Errorf("expected created %s, got %s", created, user.Created)
+	}
+
+	// Putting again replaces the user
+	users[1].Role = repo.APIRoleReadOnly
+	if err := userDB.Put(users[1]); err != nil {
+		t.Fatal(err)
+	}
+	all, err := userDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Username != "april" || all[0].Role != repo.APIRoleReadOnly {
+		t.Errorf("unexpected users: %+v", all)
+	}
+
+	if err := userDB.Delete("april"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Get("april"); err != sql.ErrNoRows {
+		t.Errorf("expected user to be deleted, got %v", err)
+	}
+}
@@ -57,6 +57,7 @@ func (c *CasesDB) PutRecord(dispute *repo.DisputeCaseRecord) error {
 	if err != nil {
 		return fmt.Errorf("update dispute case: %s", err.Error())
 	}
+	indexCaseForSearch(c.db, dispute.CaseID)
 
 	return nil
 }
@@ -112,6 +113,7 @@ func (c *CasesDB) UpdateBuyerInfo(caseID string, buyerContract *pb.RicardianCont
 	if err != nil {
 		return err
 	}
+	indexCaseForSearch(c.db, caseID)
 	return nil
 }
 
@@ -148,6 +150,7 @@ func (c *CasesDB) UpdateVendorInfo(caseID string, vendorContract *pb.RicardianCo
 	if err != nil {
 		return err
 	}
+	indexCaseForSearch(c.db, caseID)
 	return nil
 }
 
@@ -203,6 +206,7 @@ func (c *CasesDB) Delete(orderID string) error {
 	if err != nil {
 		return err
 	}
+	removeFromSearchIndex(c.db, "scope=? and docID=?", repo.SearchScopeCases, orderID)
 	return nil
 }
 
@@ -517,6 +521,45 @@ func (c *CasesDB) Count() int {
 	return count
 }
 
+// GetResolved returns the cases which were resolved with their resolutions
+func (c *CasesDB) GetResolved() ([]repo.CaseResolution, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rows, err := c.db.Query("select caseID, timestamp, disputeResolution from cases where state=? and disputeResolution is not null and disputeResolution != ''", int(pb.OrderState_RESOLVED))
+	if err != nil {
+		return nil, fmt.Errorf("selecting resolved cases: %s", err.Error())
+	}
+	defer rows.Close()
+	var ret []repo.CaseResolution
+	for rows.Next() {
+		var (
+			r                 repo.CaseResolution
+			ts                int64
+			disputeResolution []byte
+		)
+		if err := rows.Scan(&r.CaseID, &ts, &disputeResolution); err != nil {
+			return nil, fmt.Errorf("scanning resolved case: %s", err.Error())
+		}
+		r.Opened = time.Unix(ts, 0)
+		r.Resolution = new(pb.DisputeResolution)
+		if err := jsonpb.UnmarshalString(string(disputeResolution), r.Resolution); err != nil {
+			return nil, fmt.Errorf("unmarshal dispute case resolution: %s", err.Error())
+		}
+		ret = append(ret, r)
+	}
+	return ret, rows.Err()
+}
+
+// CountByState returns the number of cases in the state
+func (c *CasesDB) CountByState(state pb.OrderState) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var count int
+	err := c.db.QueryRow("select count(*) from cases where state=?", int(state)).Scan(&count)
+	return count, err
+}
+
 // GetDisputesForDisputeExpiryNotification returns []*repo.DisputeCaseRecord including
 // each record which needs Notifications to be generated. Currently,
 // notifications are generated at 0, 15, 30, 44, and 45 days after opening.
@@ -1131,3 +1131,40 @@ func TestCasesDB_Put_CoinType(t *testing.T) {
 		teardown()
 	}
 }
+
+func TestCasesDB_GetResolved(t *testing.T) {
+	casesdb, teardown, err := buildNewCaseStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for _, caseID := range []string{"open", "resolved"} {
+		if err := casesdb.Put(caseID, pb.OrderState_DISPUTED, true, "blah", "btc", "btc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := &pb.DisputeResolution{OrderId: "resolved", Resolution: "Case closed"}
+	if err := casesdb.MarkAsClosed("resolved", d); err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := casesdb.GetResolved()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resolved) != 1 {
+		t.Fatalf("expected 1 resolved case, got %d", len(resolved))
+	}
+	if resolved[0].CaseID != "resolved" || !proto.Equal(resolved[0].Resolution, d) || resolved[0].Opened.IsZero() {
+		t.Errorf("unexpected resolved case: %+v", resolved[0])
+	}
+
+	open, err := casesdb.CountByState(pb.OrderState_DISPUTED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if open != 1 {
+		t.Errorf("expected 1 open case, got %d", open)
+	}
+}
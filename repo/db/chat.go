@@ -57,6 +57,7 @@ func (c *ChatDB) Put(messageId string, peerId string, subject string, message st
 	if err != nil {
 		return fmt.Errorf("commit chat: %s", err.Error())
 	}
+	updateSearchIndex(c.db, repo.NewChatSearchDocument(messageId, peerId, subject, message))
 	return nil
 }
 
@@ -169,9 +170,42 @@ func (c *ChatDB) GetMessages(peerID string, subject string, offsetId string, lim
 		}
 		ret = append(ret, chatMessage)
 	}
+	for i := range ret {
+		attachments, err := c.getAttachments(ret[i].MessageId)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		ret[i].Attachments = attachments
+	}
 	return ret
 }
 
+// GetMessage returns the chat message with the ID or sql.ErrNoRows if there
+// is none
+func (c *ChatDB) GetMessage(messageID string) (*repo.ChatMessage, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var (
+		msg                  repo.ChatMessage
+		readInt, outgoingInt int
+		timestampInt         int64
+	)
+	row := c.db.QueryRow("select messageID, peerID, subject, message, read, timestamp, outgoing from chat where messageID=?", messageID)
+	if err := row.Scan(&msg.MessageId, &msg.PeerId, &msg.Subject, &msg.Message, &readInt, &timestampInt, &outgoingInt); err != nil {
+		return nil, err
+	}
+	msg.Read = readInt == 1
+	msg.Outgoing = outgoingInt == 1
+	msg.Timestamp = repo.NewAPITime(time.Unix(0, timestampInt))
+	attachments, err := c.getAttachments(messageID)
+	if err != nil {
+		return nil, err
+	}
+	msg.Attachments = attachments
+	return &msg, nil
+}
+
 func (c *ChatDB) MarkAsRead(peerID string, subject string, outgoing bool, messageId string) (string, bool, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -245,13 +279,105 @@ func (c *ChatDB) DeleteMessage(msgID string) error {
 	if err != nil {
 		log.Error(err)
 	}
+	if _, err := c.db.Exec("delete from chatattachments where messageID=?", msgID); err != nil {
+		log.Error(err)
+	}
+	removeFromSearchIndex(c.db, "scope=? and docID=?", repo.SearchScopeChats, msgID)
 	return nil
 }
 
 func (c *ChatDB) DeleteConversation(peerId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	if _, err := c.db.Exec("delete from chatattachments where messageID in (select messageID from chat where peerId=? and subject='')", peerId); err != nil {
+		log.Error(err)
+	}
 	_, err := c.db.Exec("delete from chat where peerId=? and subject=''", peerId)
 	log.Error(err)
+	removeFromSearchIndex(c.db, "scope=? and peerID=? and subject=''", repo.SearchScopeChats, peerId)
 	return nil
 }
+
+// PutAttachments saves the descriptors of the files attached to the message
+func (c *ChatDB) PutAttachments(messageID string, attachments []repo.ChatAttachment) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into chatattachments(messageID, cid, mimeType, size, filename, encrypted, fetched) values(?,?,?,?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("prepare chat attachment sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	for _, a := range attachments {
+		encryptedInt := 0
+		if a.Encrypted {
+			encryptedInt = 1
+		}
+		fetchedInt := 0
+		if a.Fetched {
+			fetchedInt = 1
+		}
+		_, err = stmt.Exec(messageID, a.Cid, a.MimeType, a.Size, a.Filename, encryptedInt, fetchedInt)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("commit chat attachment: %s", err.Error())
+		}
+	}
+	return tx.Commit()
+}
+
+// GetAttachment returns the attachment with the CID or sql.ErrNoRows if there
+// is none
+func (c *ChatDB) GetAttachment(cid string) (*repo.ChatAttachment, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var (
+		a                  repo.ChatAttachment
+		encrypted, fetched int
+	)
+	row := c.db.QueryRow("select cid, mimeType, size, filename, encrypted, fetched from chatattachments where cid=? limit 1", cid)
+	if err := row.Scan(&a.Cid, &a.MimeType, &a.Size, &a.Filename, &encrypted, &fetched); err != nil {
+		return nil, err
+	}
+	a.Encrypted = encrypted == 1
+	a.Fetched = fetched == 1
+	return &a, nil
+}
+
+// MarkAttachmentFetched records the attachment with the CID was fetched and
+// pinned
+func (c *ChatDB) MarkAttachmentFetched(cid string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update chatattachments set fetched=1 where cid=?", cid)
+	return err
+}
+
+// getAttachments returns the message's attachments. The caller must hold the
+// lock.
+func (c *ChatDB) getAttachments(messageID string) ([]repo.ChatAttachment, error) {
+	rows, err := c.db.Query("select cid, mimeType, size, filename, encrypted, fetched from chatattachments where messageID=? order by rowid asc", messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.ChatAttachment
+	for rows.Next() {
+		var (
+			a                  repo.ChatAttachment
+			encrypted, fetched int
+		)
+		if err := rows.Scan(&a.Cid, &a.MimeType, &a.Size, &a.Filename, &encrypted, &fetched); err != nil {
+			return nil, err
+		}
+		a.Encrypted = encrypted == 1
+		a.Fetched = fetched == 1
+		ret = append(ret, a)
+	}
+	return ret, rows.Err()
+}
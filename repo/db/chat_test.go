@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"database/sql"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -391,6 +392,32 @@ func TestChatDB_GetUnreadCount(t *testing.T) {
 	}
 }
 
+func TestChatDB_GetMessage(t *testing.T) {
+	var chdb, teardown, err = buildNewChatStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	timestamp := time.Unix(0, time.Now().UnixNano())
+	if err := chdb.Put("11111", "abc", "order1", "mess", timestamp, false, true); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := chdb.GetMessage("11111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.PeerId != "abc" || msg.Subject != "order1" || msg.Message != "mess" || !msg.Outgoing || msg.Read {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+	if !msg.Timestamp.Equal(timestamp) {
+		t.Errorf("expected timestamp %s, got %s", timestamp, msg.Timestamp)
+	}
+	if _, err := chdb.GetMessage("22222"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing message, got %v", err)
+	}
+}
+
 func TestChatDB_DeleteMessage(t *testing.T) {
 	var chdb, teardown, err = buildNewChatStore()
 	if err != nil {
@@ -504,3 +531,58 @@ func TestChatDB_DeterministicNanosecondOrdering_Issue1545(t *testing.T) {
 		latestTime = m.Timestamp.Time
 	}
 }
+
+func TestChatDB_Attachments(t *testing.T) {
+	var chdb, teardown, err = buildNewChatStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if err := chdb.Put("11111", "abc", "", "mess", time.Now(), false, false); err != nil {
+		t.Fatal(err)
+	}
+	attachments := []repo.ChatAttachment{
+		{Cid: "QmAttachment1", MimeType: "image/png", Size: 1024, Filename: "damage.png", Encrypted: true},
+		{Cid: "QmAttachment2", MimeType: "application/pdf", Size: 2048, Filename: "invoice.pdf"},
+	}
+	if err := chdb.PutAttachments("11111", attachments); err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := chdb.GetMessage("11111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Attachments) != 2 {
+		t.Fatalf("expected 2 attachments, got %d", len(msg.Attachments))
+	}
+	if msg.Attachments[0] != attachments[0] || msg.Attachments[1] != attachments[1] {
+		t.Errorf("unexpected attachments: %+v", msg.Attachments)
+	}
+	messages := chdb.GetMessages("abc", "", "", -1)
+	if len(messages) != 1 || len(messages[0].Attachments) != 2 {
+		t.Errorf("expected the attachments to be returned with the messages, got %+v", messages)
+	}
+
+	if err := chdb.MarkAttachmentFetched("QmAttachment1"); err != nil {
+		t.Fatal(err)
+	}
+	a, err := chdb.GetAttachment("QmAttachment1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Fetched || !a.Encrypted || a.Filename != "damage.png" {
+		t.Errorf("unexpected attachment: %+v", a)
+	}
+	if _, err := chdb.GetAttachment("QmMissing"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing attachment, got %v", err)
+	}
+
+	if err := chdb.DeleteConversation("abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chdb.GetAttachment("QmAttachment2"); err != sql.ErrNoRows {
+		t.Errorf("expected the attachments to be deleted with the conversation, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/repo"
 )
@@ -74,3 +75,110 @@ func (c *CouponDB) Delete(slug string) error {
 	}
 	return nil
 }
+
+const selectCouponRedemptionsSQL = "select orderID, slug, hash, buyerID, funded, timestamp from couponredemptions"
+
+// PutRedemption records a coupon applied to an order. A redemption already
+// recorded for the order and coupon is kept.
+func (c *CouponDB) PutRedemption(redemption repo.CouponRedemption) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stmt, err := c.PrepareQuery("insert or ignore into couponredemptions(orderID, slug, hash, buyerID, funded, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare coupon redemption sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	funded := 0
+	if redemption.Funded {
+		funded = 1
+	}
+	_, err = stmt.Exec(
+		redemption.OrderID,
+		redemption.Slug,
+		redemption.Hash,
+		redemption.BuyerID,
+		funded,
+		redemption.Timestamp.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit coupon redemption: %s", err.Error())
+	}
+	return nil
+}
+
+// GetAllRedemptions returns every recorded redemption, oldest first
+func (c *CouponDB) GetAllRedemptions() ([]repo.CouponRedemption, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rows, err := c.db.Query(selectCouponRedemptionsSQL + " order by timestamp asc, orderID asc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.CouponRedemption
+	for rows.Next() {
+		var (
+			redemption repo.CouponRedemption
+			buyerID    sql.NullString
+			funded     int
+			timestamp  int64
+		)
+		if err := rows.Scan(&redemption.OrderID, &redemption.Slug, &redemption.Hash, &buyerID, &funded, &timestamp); err != nil {
+			return nil, err
+		}
+		redemption.BuyerID = buyerID.String
+		redemption.Funded = funded == 1
+		redemption.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, redemption)
+	}
+	return ret, rows.Err()
+}
+
+// CountRedemptions returns the number of orders other than excludeOrderID
+// which redeemed the coupon and were either funded or placed after since
+func (c *CouponDB) CountRedemptions(slug, hash string, since time.Time, excludeOrderID string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var count int
+	err := c.db.QueryRow("select count(*) from couponredemptions where slug=? and hash=? and orderID!=? and (funded=1 or timestamp>=?)",
+		slug, hash, excludeOrderID, since.Unix()).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// HasRedeemed returns true if the buyer redeemed the coupon on an order other
+// than excludeOrderID which was either funded or placed after since
+func (c *CouponDB) HasRedeemed(slug, hash, buyerID string, since time.Time, excludeOrderID string) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var count int
+	err := c.db.QueryRow("select count(*) from couponredemptions where slug=? and hash=? and buyerID=? and orderID!=? and (funded=1 or timestamp>=?)",
+		slug, hash, buyerID, excludeOrderID, since.Unix()).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SetRedemptionsFunded marks the coupons applied to the order as funded. It
+// is not an error if the order redeemed no coupons.
+func (c *CouponDB) SetRedemptionsFunded(orderID string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update couponredemptions set funded=1 where orderID=?", orderID)
+	return err
+}
+
+// DeleteRedemptions removes the coupons applied to the order
+func (c *CouponDB) DeleteRedemptions(orderID string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from couponredemptions where orderID=?", orderID)
+	return err
+}
@@ -3,6 +3,7 @@ package db_test
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
@@ -128,3 +129,80 @@ func TestDeleteCoupons(t *testing.T) {
 		t.Error("Failed to delete coupons")
 	}
 }
+
+func TestCouponDB_Redemptions(t *testing.T) {
+	var couponDB, teardown, err = buildNewCouponStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	var (
+		now   = time.Unix(time.Now().Unix(), 0)
+		since = now.Add(-time.Hour)
+	)
+	redemptions := []repo.CouponRedemption{
+		{OrderID: "order1", Slug: "slug", Hash: "hash1", BuyerID: "buyer1", Timestamp: now},
+		{OrderID: "order2", Slug: "slug", Hash: "hash1", BuyerID: "buyer2", Timestamp: now.Add(-2 * time.Hour)},
+		{OrderID: "order2", Slug: "slug", Hash: "hash2", BuyerID: "buyer2", Timestamp: now.Add(-2 * time.Hour)},
+	}
+	for _, r := range redemptions {
+		if err := couponDB.PutRedemption(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Unfunded redemptions placed before since are not counted
+	count, err := couponDB.CountRedemptions("slug", "hash1", since, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 redemption, got %d", count)
+	}
+	redeemed, err := couponDB.HasRedeemed("slug", "hash1", "buyer2", since, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redeemed {
+		t.Error("expected buyer2 to have no counted redemption")
+	}
+
+	if err := couponDB.SetRedemptionsFunded("order2"); err != nil {
+		t.Fatal(err)
+	}
+	count, err = couponDB.CountRedemptions("slug", "hash1", since, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 redemptions, got %d", count)
+	}
+	redeemed, err = couponDB.HasRedeemed("slug", "hash1", "buyer2", since, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !redeemed {
+		t.Error("expected buyer2 to have redeemed the coupon")
+	}
+
+	// The order being validated is excluded
+	count, err = couponDB.CountRedemptions("slug", "hash1", since, "order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 redemption excluding order1, got %d", count)
+	}
+
+	if err := couponDB.DeleteRedemptions("order2"); err != nil {
+		t.Fatal(err)
+	}
+	all, err := couponDB.GetAllRedemptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0] != redemptions[0] {
+		t.Errorf("unexpected redemptions after delete: %+v", all)
+	}
+}
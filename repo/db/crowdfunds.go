@@ -0,0 +1,129 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// CrowdFundPledgesDB represents the crowdfundpledges table
+type CrowdFundPledgesDB struct {
+	modelStore
+}
+
+// NewCrowdFundPledgeStore returns a new CrowdFundPledgesDB
+func NewCrowdFundPledgeStore(db *sql.DB, lock *sync.Mutex) repo.CrowdFundPledgeStore {
+	return &CrowdFundPledgesDB{modelStore{db, lock}}
+}
+
+const selectCrowdFundPledgesSQL = "select orderID, slug, buyerID, amount, currency, state, timestamp from crowdfundpledges"
+
+// Put records a pledge. A pledge already recorded for the order is kept.
+func (c *CrowdFundPledgesDB) Put(pledge repo.CrowdFundPledge) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stmt, err := c.PrepareQuery("insert or ignore into crowdfundpledges(orderID, slug, buyerID, amount, currency, state, timestamp) values(?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare crowdfund pledge sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		pledge.OrderID,
+		pledge.Slug,
+		pledge.BuyerID,
+		pledge.Amount,
+		pledge.Currency,
+		string(pledge.State),
+		pledge.Timestamp.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit crowdfund pledge: %s", err.Error())
+	}
+	return nil
+}
+
+// Get returns the order's pledge
+func (c *CrowdFundPledgesDB) Get(orderID string) (*repo.CrowdFundPledge, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rows, err := c.db.Query(selectCrowdFundPledgesSQL+" where orderID=?", orderID)
+	if err != nil {
+		return nil, err
+	}
+	pledges, err := scanCrowdFundPledges(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(pledges) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &pledges[0], nil
+}
+
+// GetBySlug returns the pledges for the listing, oldest first
+func (c *CrowdFundPledgesDB) GetBySlug(slug string) ([]repo.CrowdFundPledge, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rows, err := c.db.Query(selectCrowdFundPledgesSQL+" where slug=? order by timestamp asc, orderID asc", slug)
+	if err != nil {
+		return nil, err
+	}
+	return scanCrowdFundPledges(rows)
+}
+
+// GetAll returns every pledge, oldest first
+func (c *CrowdFundPledgesDB) GetAll() ([]repo.CrowdFundPledge, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rows, err := c.db.Query(selectCrowdFundPledgesSQL + " order by timestamp asc, orderID asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanCrowdFundPledges(rows)
+}
+
+// SetState changes the state of the order's pledge. It is not an error if
+// the order has no pledge.
+func (c *CrowdFundPledgesDB) SetState(orderID string, state repo.CrowdFundPledgeState) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update crowdfundpledges set state=? where orderID=?", string(state), orderID)
+	return err
+}
+
+// Delete removes the order's pledge
+func (c *CrowdFundPledgesDB) Delete(orderID string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from crowdfundpledges where orderID=?", orderID)
+	return err
+}
+
+func scanCrowdFundPledges(rows *sql.Rows) ([]repo.CrowdFundPledge, error) {
+	defer rows.Close()
+	var pledges []repo.CrowdFundPledge
+	for rows.Next() {
+		var (
+			pledge            repo.CrowdFundPledge
+			buyerID, currency sql.NullString
+			state             string
+			timestamp         int64
+		)
+		if err := rows.Scan(&pledge.OrderID, &pledge.Slug, &buyerID, &pledge.Amount, &currency, &state, &timestamp); err != nil {
+			return nil, err
+		}
+		pledge.BuyerID = buyerID.String
+		pledge.Currency = currency.String
+		pledge.State = repo.CrowdFundPledgeState(state)
+		pledge.Timestamp = time.Unix(timestamp, 0)
+		pledges = append(pledges, pledge)
+	}
+	return pledges, rows.Err()
+}
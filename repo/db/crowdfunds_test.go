@@ -0,0 +1,104 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewCrowdFundPledgeStore() (repo.CrowdFundPledgeStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewCrowdFundPledgeStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestCrowdFundPledgesDB_PutGetBySlug(t *testing.T) {
+	pledgeDB, teardown, err := buildNewCrowdFundPledgeStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	pledges := []repo.CrowdFundPledge{
+		{OrderID: "order2", Slug: "synth-run", BuyerID: "buyer2", Amount: "2500", Currency: "USD", State: repo.CrowdFundPledgeStatePledged, Timestamp: now},
+		{OrderID: "order1", Slug: "synth-run", BuyerID: "buyer1", Amount: "5000", Currency: "USD", State: repo.CrowdFundPledgeStatePledged, Timestamp: now.Add(-time.Hour)},
+		{OrderID: "order3", Slug: "book-run", BuyerID: "buyer1", Amount: "100", Currency: "USD", State: repo.CrowdFundPledgeStatePledged, Timestamp: now},
+	}
+	for _, p := range pledges {
+		if err := pledgeDB.Put(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Recording a pledge again keeps the original
+	duplicate := pledges[1]
+	duplicate.Amount = "1"
+	if err := pledgeDB.Put(duplicate); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := pledgeDB.GetBySlug("synth-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 pledges, got %d", len(got))
+	}
+	if got[0] != pledges[1] || got[1] != pledges[0] {
+		t.Errorf("unexpected pledges: %+v", got)
+	}
+
+	if err := pledgeDB.SetState("order1", repo.CrowdFundPledgeStateWithdrawn); err != nil {
+		t.Fatal(err)
+	}
+	if err := pledgeDB.SetState("unknown", repo.CrowdFundPledgeStateWithdrawn); err != nil {
+		t.Fatal(err)
+	}
+	got, err = pledgeDB.GetBySlug("synth-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0].State != repo.CrowdFundPledgeStateWithdrawn {
+		t.Errorf("expected pledge to be withdrawn, got %s", got[0].State)
+	}
+
+	pledge, err := pledgeDB.Get("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pledge.Slug != "synth-run" || pledge.State != repo.CrowdFundPledgeStateWithdrawn {
+		t.Errorf("unexpected pledge: %+v", pledge)
+	}
+	if _, err := pledgeDB.Get("unknown"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+
+	if err := pledgeDB.Delete("order3"); err != nil {
+		t.Fatal(err)
+	}
+	all, err := pledgeDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("expected 2 pledges after delete, got %d", len(all))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +39,19 @@ type SQLiteDatastore struct {
 	txMetadata      repo.TransactionMetadataStore
 	moderatedStores repo.ModeratedStore
 	messages        repo.MessageStore
+	webhooks        repo.WebhookDeliveryStore
+	orderStates     repo.OrderStateHistoryStore
+	search          repo.SearchStore
+	schedules       repo.ListingScheduleStore
+	reservations    repo.InventoryReservationStore
+	apiUsers        repo.APIUserStore
+	apiTokens       repo.APITokenStore
+	subscriptions   repo.SubscriptionStore
+	crowdFunds      repo.CrowdFundPledgeStore
+	peerBans        repo.PeerBanStore
+	evidence        repo.DisputeEvidenceStore
+	feedPosts       repo.FeedPostStore
+	announcements   repo.AnnouncementSubscriptionStore
 	db              *sql.DB
 	lock            *sync.Mutex
 }
@@ -87,6 +101,19 @@ func NewSQLiteDatastore(db *sql.DB, l *sync.Mutex, coinType wallet.CoinType) *SQ
 		txMetadata:      NewTransactionMetadataStore(db, l),
 		moderatedStores: NewModeratedStore(db, l),
 		messages:        NewMessageStore(db, l),
+		webhooks:        NewWebhookDeliveryStore(db, l),
+		orderStates:     NewOrderStateHistoryStore(db, l),
+		search:          NewSearchStore(db, l),
+		schedules:       NewListingScheduleStore(db, l),
+		reservations:    NewInventoryReservationStore(db, l),
+		apiUsers:        NewAPIUserStore(db, l),
+		apiTokens:       NewAPITokenStore(db, l),
+		subscriptions:   NewSubscriptionStore(db, l),
+		crowdFunds:      NewCrowdFundPledgeStore(db, l),
+		peerBans:        NewPeerBanStore(db, l),
+		evidence:        NewDisputeEvidenceStore(db, l),
+		feedPosts:       NewFeedPostStore(db, l),
+		announcements:   NewAnnouncementSubscriptionStore(db, l),
 		db:              db,
 		lock:            l,
 	}
@@ -194,24 +221,97 @@ func (d *SQLiteDatastore) Messages() repo.MessageStore {
 	return d.messages
 }
 
+// WebhookDeliveries - return the webhook deliveries datastore
+func (d *SQLiteDatastore) WebhookDeliveries() repo.WebhookDeliveryStore {
+	return d.webhooks
+}
+
+// OrderStateHistory - return the order state history datastore
+func (d *SQLiteDatastore) OrderStateHistory() repo.OrderStateHistoryStore {
+	return d.orderStates
+}
+
+// Search - return the full-text search index
+func (d *SQLiteDatastore) Search() repo.SearchStore {
+	return d.search
+}
+
+// ListingSchedules - return the listing schedules datastore
+func (d *SQLiteDatastore) ListingSchedules() repo.ListingScheduleStore {
+	return d.schedules
+}
+
+// InventoryReservations - return the inventory reservations datastore
+func (d *SQLiteDatastore) InventoryReservations() repo.InventoryReservationStore {
+	return d.reservations
+}
+
+// APIUsers - return the API users datastore
+func (d *SQLiteDatastore) APIUsers() repo.APIUserStore {
+	return d.apiUsers
+}
+
+// APITokens - return the API tokens datastore
+func (d *SQLiteDatastore) APITokens() repo.APITokenStore {
+	return d.apiTokens
+}
+
+// Subscriptions - return the subscriptions datastore
+func (d *SQLiteDatastore) Subscriptions() repo.SubscriptionStore {
+	return d.subscriptions
+}
+
+// CrowdFundPledges - return the crowdfund pledges datastore
+func (d *SQLiteDatastore) CrowdFundPledges() repo.CrowdFundPledgeStore {
+	return d.crowdFunds
+}
+
+// PeerBans - return the peer bans datastore
+func (d *SQLiteDatastore) PeerBans() repo.PeerBanStore {
+	return d.peerBans
+}
+
+// DisputeEvidence - return the dispute evidence datastore
+func (d *SQLiteDatastore) DisputeEvidence() repo.DisputeEvidenceStore {
+	return d.evidence
+}
+
+// FeedPosts - return the feed posts datastore
+func (d *SQLiteDatastore) FeedPosts() repo.FeedPostStore {
+	return d.feedPosts
+}
+
+// AnnouncementSubscriptions - return the announcement subscriptions datastore
+func (d *SQLiteDatastore) AnnouncementSubscriptions() repo.AnnouncementSubscriptionStore {
+	return d.announcements
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	var cp string
-	stmt := "select name from sqlite_master where type='table'"
+	stmt := "select name, sql from sqlite_master where type='table'"
 	rows, err := d.db.Query(stmt)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
-	var tables []string
+	var tables, virtualTables []string
 	for rows.Next() {
 		var name string
-		if err := rows.Scan(&name); err != nil {
+		var createSQL sql.NullString
+		if err := rows.Scan(&name, &createSQL); err != nil {
 			return err
 		}
+		if strings.HasPrefix(strings.ToLower(createSQL.String), "create virtual table") {
+			virtualTables = append(virtualTables, name)
+		}
 		tables = append(tables, name)
 	}
+	rows.Close()
+	// Virtual tables are copied through the virtual table itself which fills
+	// in their shadow tables
+	tables = withoutShadowTables(tables, virtualTables)
 	if password == "" {
 		cp = `attach database '` + dbPath + `' as plaintext key '';`
 		for _, name := range tables {
@@ -228,8 +328,31 @@ func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	if err != nil {
 		return err
 	}
+	if password == "" {
+		_, err = d.db.Exec("detach database plaintext;")
+	} else {
+		_, err = d.db.Exec("detach database encrypted;")
+	}
+	return err
+}
 
-	return nil
+// withoutShadowTables removes the tables which hold the data of the virtual
+// tables from tables
+func withoutShadowTables(tables, virtualTables []string) []string {
+	var filtered []string
+	for _, name := range tables {
+		shadow := false
+		for _, vt := range virtualTables {
+			if strings.HasPrefix(name, vt+"_") {
+				shadow = true
+				break
+			}
+		}
+		if !shadow {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
 }
 
 func (s *SQLiteDatastore) InitTables(password string) error {
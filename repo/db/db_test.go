@@ -1,12 +1,14 @@
 package db
 
 import (
+	"io/ioutil"
 	"os"
 	"path"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/wallet-interface"
 )
@@ -137,3 +139,52 @@ func TestEncryptedDb(t *testing.T) {
 		t.Error("IsEncrypted returned incorrectly")
 	}
 }
+
+func TestCopy(t *testing.T) {
+	testDB, teardown, err := buildNewDatastore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	if err := testDB.Chat().Put("copy-msg", "QmPeer", "", "Copy me", time.Unix(1000, 0), false, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := testDB.Search().Index(repo.SearchDocument{Scope: repo.SearchScopeListings, ID: "copy-listing", Title: "Vintage lamp"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Copying twice checks the copy is detached afterwards
+	for i := 0; i < 2; i++ {
+		copyPath, err := ioutil.TempDir("", "copy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(path.Join(copyPath, "datastore"), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(copyPath)
+		copyDB, err := Create(copyPath, "", false, wallet.Bitcoin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer copyDB.Close()
+		if err := copyDB.InitTables(""); err != nil {
+			t.Fatal(err)
+		}
+		if err := testDB.Copy(path.Join(copyPath, "datastore", "mainnet.db"), ""); err != nil {
+			t.Fatal(err)
+		}
+
+		msg, err := copyDB.Chat().GetMessage("copy-msg")
+		if err != nil || msg.Message != "Copy me" {
+			t.Errorf("expected the chat message to be copied, got %v (%v)", msg, err)
+		}
+		results, total, err := copyDB.Search().Search("lamp", nil, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 1 || len(results) != 1 || results[0].ID != "copy-listing" {
+			t.Errorf("expected the search index to be copied, got %v", results)
+		}
+	}
+}
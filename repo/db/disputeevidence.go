@@ -0,0 +1,89 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// DisputeEvidenceDB represents the disputeevidence table
+type DisputeEvidenceDB struct {
+	modelStore
+}
+
+// NewDisputeEvidenceStore returns a new DisputeEvidenceDB
+func NewDisputeEvidenceStore(db *sql.DB, lock *sync.Mutex) repo.DisputeEvidenceStore {
+	return &DisputeEvidenceDB{modelStore{db, lock}}
+}
+
+// Put records evidence submitted for a case. Evidence is identified by the
+// hash of its serialization so the same evidence received twice is kept once.
+func (d *DisputeEvidenceDB) Put(caseID string, evidence *pb.DisputeEvidence) error {
+	ser, err := proto.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+	id := sha256.Sum256(ser)
+	var timestamp int64
+	if evidence.Timestamp != nil {
+		ts, err := ptypes.Timestamp(evidence.Timestamp)
+		if err != nil {
+			return err
+		}
+		timestamp = ts.Unix()
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	stmt, err := d.PrepareQuery("insert or ignore into disputeevidence(id, caseID, peerID, evidence, timestamp) values(?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare dispute evidence sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(hex.EncodeToString(id[:]), caseID, evidence.SubmittedBy, ser, timestamp)
+	if err != nil {
+		return fmt.Errorf("commit dispute evidence: %s", err.Error())
+	}
+	return nil
+}
+
+// GetByCaseID returns the evidence submitted for the case, oldest first
+func (d *DisputeEvidenceDB) GetByCaseID(caseID string) ([]*pb.DisputeEvidence, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	rows, err := d.db.Query("select evidence from disputeevidence where caseID=? order by timestamp asc, id asc", caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.DisputeEvidence
+	for rows.Next() {
+		var ser []byte
+		if err := rows.Scan(&ser); err != nil {
+			return nil, err
+		}
+		evidence := new(pb.DisputeEvidence)
+		if err := proto.Unmarshal(ser, evidence); err != nil {
+			return nil, err
+		}
+		ret = append(ret, evidence)
+	}
+	return ret, rows.Err()
+}
+
+// DeleteByCaseID removes the evidence submitted for the case
+func (d *DisputeEvidenceDB) DeleteByCaseID(caseID string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, err := d.db.Exec("delete from disputeevidence where caseID=?", caseID)
+	return err
+}
@@ -0,0 +1,90 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func buildNewDisputeEvidenceStore() (repo.DisputeEvidenceStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewDisputeEvidenceStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestDisputeEvidenceDB_PutGetDelete(t *testing.T) {
+	evidenceDB, teardown, err := buildNewDisputeEvidenceStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	statement := &pb.DisputeEvidence{
+		OrderId:     "case1",
+		SubmittedBy: "buyer",
+		Timestamp:   &timestamp.Timestamp{Seconds: 2000},
+		Items: []*pb.DisputeEvidence_Item{
+			{Type: pb.DisputeEvidence_Item_STATEMENT, Statement: "The parcel arrived empty"},
+		},
+		Signature: []byte("sig1"),
+	}
+	photo := &pb.DisputeEvidence{
+		OrderId:     "case1",
+		SubmittedBy: "buyer",
+		Timestamp:   &timestamp.Timestamp{Seconds: 1000},
+		Items: []*pb.DisputeEvidence_Item{
+			{Type: pb.DisputeEvidence_Item_IMAGE, ImageHash: "zb2rhj7crUKTQYRGCRATFaQ6YFLTde2YzdqbbhAASkL9uRDXn", Filename: "parcel.jpg"},
+		},
+		Signature: []byte("sig2"),
+	}
+	other := &pb.DisputeEvidence{OrderId: "case2", SubmittedBy: "vendor", Signature: []byte("sig3")}
+	for _, e := range []*pb.DisputeEvidence{statement, photo, photo, other} {
+		if err := evidenceDB.Put(e.OrderId, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	evidence, err := evidenceDB.GetByCaseID("case1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evidence) != 2 || !proto.Equal(evidence[0], photo) || !proto.Equal(evidence[1], statement) {
+		t.Fatalf("unexpected evidence: %v", evidence)
+	}
+
+	if err := evidenceDB.DeleteByCaseID("case1"); err != nil {
+		t.Fatal(err)
+	}
+	evidence, err = evidenceDB.GetByCaseID("case1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evidence) != 0 {
+		t.Errorf("expected evidence to be deleted, got %v", evidence)
+	}
+	evidence, err = evidenceDB.GetByCaseID("case2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evidence) != 1 {
+		t.Errorf("expected other case's evidence to be kept, got %v", evidence)
+	}
+}
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// FeedPostsDB represents the feedposts table
+type FeedPostsDB struct {
+	modelStore
+}
+
+// NewFeedPostStore returns a new FeedPostsDB
+func NewFeedPostStore(db *sql.DB, lock *sync.Mutex) repo.FeedPostStore {
+	return &FeedPostsDB{modelStore{db, lock}}
+}
+
+// feedTerms joins the tags or channels of a post so each can be matched
+// with instr. Matching is case insensitive.
+func feedTerms(terms []string) string {
+	return "|" + strings.ToLower(strings.Join(terms, "|")) + "|"
+}
+
+// Put caches a verified post by a followed peer. The post's hash identifies
+// it.
+func (f *FeedPostsDB) Put(peerID string, post *pb.SignedPost) error {
+	if post.Hash == "" || post.Post == nil {
+		return fmt.Errorf("feed post is missing its hash or post")
+	}
+	ser, err := proto.Marshal(post)
+	if err != nil {
+		return err
+	}
+	var timestamp int64
+	if post.Post.Timestamp != nil {
+		ts, err := ptypes.Timestamp(post.Post.Timestamp)
+		if err != nil {
+			return err
+		}
+		timestamp = ts.Unix()
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	stmt, err := f.PrepareQuery("insert or replace into feedposts(hash, peerID, tags, channels, post, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare feed post sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(post.Hash, peerID, feedTerms(post.Post.Tags), feedTerms(post.Post.Channels), ser, timestamp)
+	if err != nil {
+		return fmt.Errorf("commit feed post: %s", err.Error())
+	}
+	return nil
+}
+
+// Has returns whether the post with the hash is cached
+func (f *FeedPostsDB) Has(hash string) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var count int
+	err := f.db.QueryRow("select count(*) from feedposts where hash=?", hash).Scan(&count)
+	return count > 0, err
+}
+
+// GetAll returns the cached posts newest first along with the total number
+// matching the filters
+func (f *FeedPostsDB) GetAll(offsetHash string, limit int, tag string, channels []string) ([]*pb.SignedPost, int, error) {
+	var (
+		filters []string
+		args    []interface{}
+	)
+	if tag != "" {
+		filters = append(filters, "instr(tags, ?) > 0")
+		args = append(args, feedTerms([]string{tag}))
+	}
+	if len(channels) > 0 {
+		channelFilters := make([]string, 0, len(channels))
+		for _, c := range channels {
+			channelFilters = append(channelFilters, "instr(channels, ?) > 0")
+			args = append(args, feedTerms([]string{c}))
+		}
+		filters = append(filters, "("+strings.Join(channelFilters, " or ")+")")
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var total int
+	cstm := "select count(*) from feedposts"
+	if len(filters) > 0 {
+		cstm += " where " + strings.Join(filters, " and ")
+	}
+	if err := f.db.QueryRow(cstm, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	if offsetHash != "" {
+		var offsetTimestamp int64
+		err := f.db.QueryRow("select timestamp from feedposts where hash=?", offsetHash).Scan(&offsetTimestamp)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, 0, err
+		}
+		if err == nil {
+			filters = append(filters, "(timestamp < ? or (timestamp = ? and hash < ?))")
+			args = append(args, offsetTimestamp, offsetTimestamp, offsetHash)
+		}
+	}
+	stm := "select post from feedposts"
+	if len(filters) > 0 {
+		stm += " where " + strings.Join(filters, " and ")
+	}
+	stm += " order by timestamp desc, hash desc limit " + strconv.Itoa(limit) + ";"
+	rows, err := f.db.Query(stm, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var ret []*pb.SignedPost
+	for rows.Next() {
+		var ser []byte
+		if err := rows.Scan(&ser); err != nil {
+			return nil, 0, err
+		}
+		post := new(pb.SignedPost)
+		if err := proto.Unmarshal(ser, post); err != nil {
+			return nil, 0, err
+		}
+		ret = append(ret, post)
+	}
+	return ret, total, rows.Err()
+}
+
+// GetHashes returns the hashes of the cached posts by the peer
+func (f *FeedPostsDB) GetHashes(peerID string) ([]string, error) {
+	return f.selectStrings("select hash from feedposts where peerID=?", peerID)
+}
+
+// GetPeers returns the peers with cached posts
+func (f *FeedPostsDB) GetPeers() ([]string, error) {
+	return f.selectStrings("select distinct peerID from feedposts")
+}
+
+func (f *FeedPostsDB) selectStrings(stm string, args ...interface{}) ([]string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	rows, err := f.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		ret = append(ret, s)
+	}
+	return ret, rows.Err()
+}
+
+// Delete removes the post with the hash
+func (f *FeedPostsDB) Delete(hash string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, err := f.db.Exec("delete from feedposts where hash=?", hash)
+	return err
+}
+
+// DeleteByPeer removes the posts by the peer
+func (f *FeedPostsDB) DeleteByPeer(peerID string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, err := f.db.Exec("delete from feedposts where peerID=?", peerID)
+	return err
+}
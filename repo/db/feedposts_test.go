@@ -0,0 +1,170 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func buildNewFeedPostStore() (repo.FeedPostStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewFeedPostStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func newFeedPost(hash string, seconds int64, tags, channels []string) *pb.SignedPost {
+	return &pb.SignedPost{
+		Hash: hash,
+		Post: &pb.Post{
+			Slug:      hash,
+			Status:    "Post " + hash,
+			Tags:      tags,
+			Channels:  channels,
+			Timestamp: &timestamp.Timestamp{Seconds: seconds},
+		},
+	}
+}
+
+func feedPostHashes(posts []*pb.SignedPost) []string {
+	var hashes []string
+	for _, p := range posts {
+		hashes = append(hashes, p.Hash)
+	}
+	return hashes
+}
+
+func assertFeedPostHashes(t *testing.T, posts []*pb.SignedPost, expected ...string) {
+	hashes := feedPostHashes(posts)
+	if len(hashes) != len(expected) {
+		t.Errorf("expected posts %v, got %v", expected, hashes)
+		return
+	}
+	for i := range expected {
+		if hashes[i] != expected[i] {
+			t.Errorf("expected posts %v, got %v", expected, hashes)
+			return
+		}
+	}
+}
+
+func TestFeedPostsDB_GetAll(t *testing.T) {
+	feedDB, teardown, err := buildNewFeedPostStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	posts := map[string]*pb.SignedPost{
+		"QmPeer1": newFeedPost("a", 1000, []string{"Vintage", "lamps"}, nil),
+		"QmPeer2": newFeedPost("b", 3000, []string{"sale"}, []string{"deals"}),
+	}
+	for peerID, post := range posts {
+		if err := feedDB.Put(peerID, post); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := feedDB.Put("QmPeer1", newFeedPost("c", 2000, nil, []string{"news"})); err != nil {
+		t.Fatal(err)
+	}
+
+	all, total, err := feedDB.GetAll("", -1, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 {
+		t.Errorf("expected 3 posts in total, got %d", total)
+	}
+	assertFeedPostHashes(t, all, "b", "c", "a")
+
+	page, total, err := feedDB.GetAll("b", 1, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 {
+		t.Errorf("expected 3 posts in total, got %d", total)
+	}
+	assertFeedPostHashes(t, page, "c")
+
+	tagged, total, err := feedDB.GetAll("", -1, "vintage", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 {
+		t.Errorf("expected 1 tagged post, got %d", total)
+	}
+	assertFeedPostHashes(t, tagged, "a")
+
+	inChannels, _, err := feedDB.GetAll("", -1, "", []string{"news", "deals"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFeedPostHashes(t, inChannels, "b", "c")
+
+	if has, err := feedDB.Has("c"); err != nil || !has {
+		t.Errorf("expected post c to be cached, got %v (%v)", has, err)
+	}
+	hashes, err := feedDB.GetHashes("QmPeer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hashes) != 2 {
+		t.Errorf("expected 2 posts by QmPeer1, got %v", hashes)
+	}
+}
+
+func TestFeedPostsDB_Delete(t *testing.T) {
+	feedDB, teardown, err := buildNewFeedPostStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for i, hash := range []string{"a", "b", "c"} {
+		peerID := "QmPeer1"
+		if hash == "c" {
+			peerID = "QmPeer2"
+		}
+		if err := feedDB.Put(peerID, newFeedPost(hash, int64(i), nil, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	peers, err := feedDB.GetPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 2 {
+		t.Errorf("expected 2 peers, got %v", peers)
+	}
+
+	if err := feedDB.Delete("a"); err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := feedDB.Has("a"); has {
+		t.Error("expected post a to be deleted")
+	}
+	if err := feedDB.DeleteByPeer("QmPeer2"); err != nil {
+		t.Fatal(err)
+	}
+	all, _, err := feedDB.GetAll("", -1, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFeedPostHashes(t, all, "b")
+}
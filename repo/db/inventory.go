@@ -144,3 +144,72 @@ func (i *InventoryDB) DeleteAll(slug string) error {
 	_, err := i.db.Exec("delete from inventory where slug=?", slug)
 	return err
 }
+
+// PutLowStockThreshold sets the low stock threshold for a listing
+func (i *InventoryDB) PutLowStockThreshold(slug string, threshold *big.Int) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	stmt, err := i.PrepareQuery("insert or replace into inventorythresholds(slug, threshold) values(?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare inventory threshold sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(slug, threshold.String())
+	if err != nil {
+		return fmt.Errorf("update inventory threshold: %s", err.Error())
+	}
+	return nil
+}
+
+// GetLowStockThreshold returns the low stock threshold for a listing or
+// sql.ErrNoRows if none is set
+func (i *InventoryDB) GetLowStockThreshold(slug string) (*big.Int, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var thresholdStr string
+	err := i.db.QueryRow("select threshold from inventorythresholds where slug=?", slug).Scan(&thresholdStr)
+	if err != nil {
+		return nil, err
+	}
+	threshold, ok := new(big.Int).SetString(thresholdStr, 10)
+	if !ok {
+		return nil, errors.New("error parsing threshold")
+	}
+	return threshold, nil
+}
+
+// GetAllLowStockThresholds returns the low stock thresholds keyed by slug
+func (i *InventoryDB) GetAllLowStockThresholds() (map[string]*big.Int, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	ret := make(map[string]*big.Int)
+	rows, err := i.db.Query("select slug, threshold from inventorythresholds")
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var slug, thresholdStr string
+		if err := rows.Scan(&slug, &thresholdStr); err != nil {
+			return ret, err
+		}
+		threshold, ok := new(big.Int).SetString(thresholdStr, 10)
+		if !ok {
+			log.Errorf("scanning inventory threshold for (%s): error parsing threshold", slug)
+			continue
+		}
+		ret[slug] = threshold
+	}
+	return ret, rows.Err()
+}
+
+// DeleteLowStockThreshold removes the low stock threshold for a listing
+func (i *InventoryDB) DeleteLowStockThreshold(slug string) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	_, err := i.db.Exec("delete from inventorythresholds where slug=?", slug)
+	return err
+}
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"database/sql"
 	"math/big"
 	"sync"
 	"testing"
@@ -213,3 +214,44 @@ func TestGetInventory(t *testing.T) {
 		t.Error("Failed to get all inventory")
 	}
 }
+
+func TestLowStockThresholds(t *testing.T) {
+	ivdb, teardown, err := buildNewInventoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := ivdb.GetLowStockThreshold("slug"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing threshold, got %v", err)
+	}
+	if err := ivdb.PutLowStockThreshold("slug", big.NewInt(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ivdb.PutLowStockThreshold("slug", big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ivdb.PutLowStockThreshold("slug2", big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	threshold, err := ivdb.GetLowStockThreshold("slug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if threshold.Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("expected threshold 5, got %s", threshold)
+	}
+	thresholds, err := ivdb.GetAllLowStockThresholds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(thresholds) != 2 || thresholds["slug2"].Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("unexpected thresholds: %v", thresholds)
+	}
+	if err := ivdb.DeleteLowStockThreshold("slug"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ivdb.GetLowStockThreshold("slug"); err != sql.ErrNoRows {
+		t.Errorf("expected threshold to be deleted, got %v", err)
+	}
+}
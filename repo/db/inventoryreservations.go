@@ -0,0 +1,138 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// InventoryReservationsDB represents the inventoryreservations table
+type InventoryReservationsDB struct {
+	modelStore
+}
+
+// NewInventoryReservationStore returns a new InventoryReservationsDB
+func NewInventoryReservationStore(db *sql.DB, lock *sync.Mutex) repo.InventoryReservationStore {
+	return &InventoryReservationsDB{modelStore{db, lock}}
+}
+
+// Put replaces the reservations held for an order
+func (r *InventoryReservationsDB) Put(orderID string, reservations []repo.InventoryReservation) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from inventoryreservations where orderID=?", orderID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("clear inventory reservations: %s", err.Error())
+	}
+	stmt, err := tx.Prepare("insert into inventoryreservations(orderID, slug, variantIndex, quantity, timestamp) values(?,?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("prepare inventory reservation sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	for _, res := range reservations {
+		_, err = stmt.Exec(orderID, res.Slug, res.Variant, res.Quantity.String(), res.Timestamp.Unix())
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("commit inventory reservation: %s", err.Error())
+		}
+	}
+	return tx.Commit()
+}
+
+// GetByOrderID returns the reservations held for an order
+func (r *InventoryReservationsDB) GetByOrderID(orderID string) ([]repo.InventoryReservation, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rows, err := r.db.Query("select orderID, slug, variantIndex, quantity, timestamp from inventoryreservations where orderID=? order by slug asc, variantIndex asc", orderID)
+	if err != nil {
+		return nil, err
+	}
+	return scanInventoryReservations(rows)
+}
+
+// GetBySlug returns the reservations held against a listing variant
+func (r *InventoryReservationsDB) GetBySlug(slug string, variant int) ([]repo.InventoryReservation, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rows, err := r.db.Query("select orderID, slug, variantIndex, quantity, timestamp from inventoryreservations where slug=? and variantIndex=? order by timestamp asc", slug, variant)
+	if err != nil {
+		return nil, err
+	}
+	return scanInventoryReservations(rows)
+}
+
+// GetAll returns every reservation, oldest first
+func (r *InventoryReservationsDB) GetAll() ([]repo.InventoryReservation, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rows, err := r.db.Query("select orderID, slug, variantIndex, quantity, timestamp from inventoryreservations order by timestamp asc, orderID asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanInventoryReservations(rows)
+}
+
+// GetOrderIDsBefore returns the orders holding reservations made before t
+func (r *InventoryReservationsDB) GetOrderIDsBefore(t time.Time) ([]string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rows, err := r.db.Query("select distinct orderID from inventoryreservations where timestamp<?", t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var orderIDs []string
+	for rows.Next() {
+		var orderID string
+		if err := rows.Scan(&orderID); err != nil {
+			return nil, err
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+	return orderIDs, rows.Err()
+}
+
+// Delete releases the reservations held for an order
+func (r *InventoryReservationsDB) Delete(orderID string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from inventoryreservations where orderID=?", orderID)
+	return err
+}
+
+func scanInventoryReservations(rows *sql.Rows) ([]repo.InventoryReservation, error) {
+	defer rows.Close()
+	var reservations []repo.InventoryReservation
+	for rows.Next() {
+		var (
+			res         repo.InventoryReservation
+			quantityStr string
+			timestamp   int64
+		)
+		if err := rows.Scan(&res.OrderID, &res.Slug, &res.Variant, &quantityStr, &timestamp); err != nil {
+			return nil, err
+		}
+		quantity, ok := new(big.Int).SetString(quantityStr, 10)
+		if !ok {
+			return nil, fmt.Errorf("parsing reserved quantity (%s) for order (%s)", quantityStr, res.OrderID)
+		}
+		res.Quantity = quantity
+		res.Timestamp = time.Unix(timestamp, 0)
+		reservations = append(reservations, res)
+	}
+	return reservations, rows.Err()
+}
@@ -0,0 +1,145 @@
+package db_test
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewInventoryReservationStore() (repo.InventoryReservationStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewInventoryReservationStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestInventoryReservationsDB_PutGet(t *testing.T) {
+	resDB, teardown, err := buildNewInventoryReservationStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	if err := resDB.Put("order1", []repo.InventoryReservation{
+		{Slug: "limited-run", Variant: 0, Quantity: big.NewInt(2), Timestamp: now},
+		{Slug: "limited-run", Variant: 1, Quantity: big.NewInt(1), Timestamp: now},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := resDB.Put("order2", []repo.InventoryReservation{
+		{Slug: "limited-run", Variant: 0, Quantity: big.NewInt(3), Timestamp: now},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	reservations, err := resDB.GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 2 {
+		t.Fatalf("expected 2 reservations, got %d", len(reservations))
+	}
+	if reservations[0].OrderID != "order1" || reservations[0].Slug != "limited-run" || reservations[0].Variant != 0 {
+		t.Errorf("unexpected reservation: %+v", reservations[0])
+	}
+	if reservations[0].Quantity.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("expected quantity 2, got %s", reservations[0].Quantity)
+	}
+	if !reservations[0].Timestamp.Equal(now) {
+		t.Errorf("expected timestamp %s, got %s", now, reservations[0].Timestamp)
+	}
+
+	reservations, err = resDB.GetBySlug("limited-run", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 2 {
+		t.Errorf("expected 2 reservations for the variant, got %d", len(reservations))
+	}
+
+	// Putting again replaces the order's reservations
+	if err := resDB.Put("order1", []repo.InventoryReservation{
+		{Slug: "limited-run", Variant: 1, Quantity: big.NewInt(4), Timestamp: now},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	reservations, err = resDB.GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 1 || reservations[0].Quantity.Cmp(big.NewInt(4)) != 0 {
+		t.Errorf("expected reservations to be replaced, got %+v", reservations)
+	}
+
+	all, err := resDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("expected 2 reservations, got %d", len(all))
+	}
+}
+
+func TestInventoryReservationsDB_GetOrderIDsBeforeAndDelete(t *testing.T) {
+	resDB, teardown, err := buildNewInventoryReservationStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	if err := resDB.Put("stale", []repo.InventoryReservation{
+		{Slug: "limited-run", Quantity: big.NewInt(1), Timestamp: now.Add(-48 * time.Hour)},
+		{Slug: "other", Quantity: big.NewInt(1), Timestamp: now.Add(-48 * time.Hour)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := resDB.Put("fresh", []repo.InventoryReservation{
+		{Slug: "limited-run", Quantity: big.NewInt(1), Timestamp: now},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	orderIDs, err := resDB.GetOrderIDsBefore(now.Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orderIDs) != 1 || orderIDs[0] != "stale" {
+		t.Errorf("expected only the stale order, got %v", orderIDs)
+	}
+
+	if err := resDB.Delete("stale"); err != nil {
+		t.Fatal(err)
+	}
+	reservations, err := resDB.GetByOrderID("stale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 0 {
+		t.Errorf("expected reservations to be released, got %d", len(reservations))
+	}
+	reservations, err = resDB.GetBySlug("limited-run", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 1 || reservations[0].OrderID != "fresh" {
+		t.Errorf("expected other orders to keep their reservations, got %+v", reservations)
+	}
+}
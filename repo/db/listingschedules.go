@@ -0,0 +1,125 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// ListingSchedulesDB represents the listingschedules table
+type ListingSchedulesDB struct {
+	modelStore
+}
+
+// NewListingScheduleStore returns a new ListingSchedulesDB
+func NewListingScheduleStore(db *sql.DB, lock *sync.Mutex) repo.ListingScheduleStore {
+	return &ListingSchedulesDB{modelStore{db, lock}}
+}
+
+// Put adds or replaces the schedule for a listing
+func (s *ListingSchedulesDB) Put(schedule repo.ListingSchedule) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stmt, err := s.PrepareQuery("insert or replace into listingschedules(slug, publishAt, republishHours, state, updatedAt) values(?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare listing schedule sql: %s", err.Error())
+	}
+	defer stmt.Close()
+
+	var publishAt sql.NullInt64
+	if schedule.PublishAt != nil {
+		publishAt = sql.NullInt64{Int64: schedule.PublishAt.Unix(), Valid: true}
+	}
+	_, err = stmt.Exec(
+		schedule.Slug,
+		publishAt,
+		int(schedule.RepublishHours),
+		string(schedule.State),
+		schedule.UpdatedAt.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit listing schedule: %s", err.Error())
+	}
+	return nil
+}
+
+// Get returns the schedule for a listing or sql.ErrNoRows if it has none
+func (s *ListingSchedulesDB) Get(slug string) (*repo.ListingSchedule, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query("select slug, publishAt, republishHours, state, updatedAt from listingschedules where slug=?", slug)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := scanListingSchedules(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &schedules[0], nil
+}
+
+// GetAll returns every listing schedule ordered by slug
+func (s *ListingSchedulesDB) GetAll() ([]repo.ListingSchedule, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query("select slug, publishAt, republishHours, state, updatedAt from listingschedules order by slug asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanListingSchedules(rows)
+}
+
+// GetDue returns the pending schedules whose publish time has passed,
+// earliest first
+func (s *ListingSchedulesDB) GetDue(now time.Time) ([]repo.ListingSchedule, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query("select slug, publishAt, republishHours, state, updatedAt from listingschedules where state=? and publishAt<=? order by publishAt asc", string(repo.ListingScheduleStatePending), now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	return scanListingSchedules(rows)
+}
+
+// Delete removes the schedule for a listing
+func (s *ListingSchedulesDB) Delete(slug string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from listingschedules where slug=?", slug)
+	return err
+}
+
+func scanListingSchedules(rows *sql.Rows) ([]repo.ListingSchedule, error) {
+	defer rows.Close()
+	var schedules []repo.ListingSchedule
+	for rows.Next() {
+		var (
+			schedule          repo.ListingSchedule
+			publishAt         sql.NullInt64
+			republish, update int64
+			state             string
+		)
+		if err := rows.Scan(&schedule.Slug, &publishAt, &republish, &state, &update); err != nil {
+			return nil, err
+		}
+		if publishAt.Valid {
+			t := time.Unix(publishAt.Int64, 0)
+			schedule.PublishAt = &t
+		}
+		schedule.RepublishHours = uint32(republish)
+		schedule.State = repo.ListingScheduleState(state)
+		schedule.UpdatedAt = time.Unix(update, 0)
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
@@ -0,0 +1,125 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewListingScheduleStore() (repo.ListingScheduleStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewListingScheduleStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestListingSchedulesDB_PutGet(t *testing.T) {
+	scheduleDB, teardown, err := buildNewListingScheduleStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	publishAt := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	schedule := repo.ListingSchedule{
+		Slug:           "spring-drop",
+		PublishAt:      &publishAt,
+		RepublishHours: 720,
+		State:          repo.ListingScheduleStatePending,
+		UpdatedAt:      time.Unix(time.Now().Unix(), 0),
+	}
+	if err := scheduleDB.Put(schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := scheduleDB.Get("spring-drop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PublishAt == nil || !got.PublishAt.Equal(publishAt) {
+		t.Errorf("expected publishAt %s, got %v", publishAt, got.PublishAt)
+	}
+	if got.RepublishHours != 720 || got.State != repo.ListingScheduleStatePending || !got.UpdatedAt.Equal(schedule.UpdatedAt) {
+		t.Errorf("unexpected schedule returned: %+v", got)
+	}
+
+	schedule.PublishAt = nil
+	schedule.State = repo.ListingScheduleStateActive
+	if err := scheduleDB.Put(schedule); err != nil {
+		t.Fatal(err)
+	}
+	got, err = scheduleDB.Get("spring-drop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PublishAt != nil || got.State != repo.ListingScheduleStateActive {
+		t.Errorf("expected schedule to be replaced, got %+v", got)
+	}
+
+	if _, err := scheduleDB.Get("missing"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for a missing schedule, got %v", err)
+	}
+}
+
+func TestListingSchedulesDB_GetDue(t *testing.T) {
+	scheduleDB, teardown, err := buildNewListingScheduleStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	var (
+		now    = time.Now()
+		past   = now.Add(-time.Minute)
+		future = now.Add(time.Hour)
+	)
+	for _, s := range []repo.ListingSchedule{
+		{Slug: "due", PublishAt: &past, State: repo.ListingScheduleStatePending},
+		{Slug: "later", PublishAt: &future, State: repo.ListingScheduleStatePending},
+		{Slug: "live", PublishAt: &past, State: repo.ListingScheduleStateActive},
+		{Slug: "expired", State: repo.ListingScheduleStateExpired},
+	} {
+		if err := scheduleDB.Put(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	due, err := scheduleDB.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].Slug != "due" {
+		t.Errorf("expected only the due pending schedule, got %+v", due)
+	}
+
+	all, err := scheduleDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 4 {
+		t.Errorf("expected 4 schedules, got %d", len(all))
+	}
+
+	if err := scheduleDB.Delete("due"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scheduleDB.Get("due"); err != sql.ErrNoRows {
+		t.Errorf("expected deleted schedule to be gone, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// OrderStateHistoryDB represents the orderstatehistory table
+type OrderStateHistoryDB struct {
+	modelStore
+}
+
+// NewOrderStateHistoryStore returns a new OrderStateHistoryDB
+func NewOrderStateHistoryStore(db *sql.DB, lock *sync.Mutex) repo.OrderStateHistoryStore {
+	return &OrderStateHistoryDB{modelStore{db, lock}}
+}
+
+// Put appends a state change to the order's history
+func (o *OrderStateHistoryDB) Put(change repo.OrderStateChange) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	stmt, err := o.PrepareQuery("insert into orderstatehistory(orderID, role, created, fromState, toState, cause, timestamp) values(?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare order state history sql: %s", err.Error())
+	}
+	defer stmt.Close()
+
+	var from sql.NullInt64
+	if !change.Created {
+		from = sql.NullInt64{Int64: int64(change.From), Valid: true}
+	}
+	_, err = stmt.Exec(
+		change.OrderID,
+		string(change.Role),
+		change.Created,
+		from,
+		int(change.To),
+		change.Cause,
+		change.Timestamp.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit order state history: %s", err.Error())
+	}
+	return nil
+}
+
+// GetByOrderID returns the history of the order for the given role
+func (o *OrderStateHistoryDB) GetByOrderID(orderID string, role repo.OrderRole) ([]repo.OrderStateChange, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	rows, err := o.db.Query("select created, fromState, toState, cause, timestamp from orderstatehistory where orderID=? and role=? order by id asc", orderID, string(role))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []repo.OrderStateChange
+	for rows.Next() {
+		var (
+			from      sql.NullInt64
+			to        int
+			timestamp int64
+			change    = repo.OrderStateChange{OrderID: orderID, Role: role}
+		)
+		if err := rows.Scan(&change.Created, &from, &to, &change.Cause, &timestamp); err != nil {
+			log.Error(err)
+			continue
+		}
+		change.From = pb.OrderState(from.Int64)
+		change.To = pb.OrderState(to)
+		change.Timestamp = time.Unix(0, timestamp)
+		ret = append(ret, change)
+	}
+	return ret, nil
+}
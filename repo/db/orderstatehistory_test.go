@@ -0,0 +1,65 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewOrderStateHistoryStore() (repo.OrderStateHistoryStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewOrderStateHistoryStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestOrderStateHistoryDB_PutGet(t *testing.T) {
+	historyDB, teardown, err := buildNewOrderStateHistoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	changes := []repo.OrderStateChange{
+		{OrderID: "order1", Role: repo.OrderRoleBuyer, Created: true, To: pb.OrderState_AWAITING_PAYMENT, Cause: "order placed", Timestamp: now},
+		{OrderID: "order1", Role: repo.OrderRoleBuyer, From: pb.OrderState_AWAITING_PAYMENT, To: pb.OrderState_PENDING, Cause: "payment received", Timestamp: now.Add(time.Second)},
+		{OrderID: "order1", Role: repo.OrderRoleVendor, Created: true, To: pb.OrderState_AWAITING_PAYMENT, Cause: "order received", Timestamp: now},
+		{OrderID: "order2", Role: repo.OrderRoleBuyer, Created: true, To: pb.OrderState_AWAITING_PAYMENT, Cause: "order placed", Timestamp: now},
+	}
+	for _, c := range changes {
+		if err := historyDB.Put(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history, err := historyDB.GetByOrderID("order1", repo.OrderRoleBuyer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(history))
+	}
+	if !history[0].Created || history[0].To != pb.OrderState_AWAITING_PAYMENT || history[0].Cause != "order placed" {
+		t.Errorf("unexpected first change: %v", history[0])
+	}
+	if history[1].Created || history[1].From != pb.OrderState_AWAITING_PAYMENT || history[1].To != pb.OrderState_PENDING || !history[1].Timestamp.Equal(changes[1].Timestamp) {
+		t.Errorf("unexpected second change: %v", history[1])
+	}
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// PeerBansDB represents the peerbans table
+type PeerBansDB struct {
+	modelStore
+}
+
+// NewPeerBanStore returns a new PeerBansDB
+func NewPeerBanStore(db *sql.DB, lock *sync.Mutex) repo.PeerBanStore {
+	return &PeerBansDB{modelStore{db, lock}}
+}
+
+// Put adds or replaces the ban on a peer
+func (p *PeerBansDB) Put(ban repo.PeerBan) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stmt, err := p.PrepareQuery("insert or replace into peerbans(peerID, reason, created, expires) values(?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare peer ban sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(ban.PeerID, ban.Reason, ban.Created.Unix(), ban.Expires.Unix())
+	if err != nil {
+		return fmt.Errorf("commit peer ban: %s", err.Error())
+	}
+	return nil
+}
+
+// GetActive returns the bans which have not expired at now, newest first
+func (p *PeerBansDB) GetActive(now time.Time) ([]repo.PeerBan, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	rows, err := p.db.Query("select peerID, reason, created, expires from peerbans where expires>? order by created desc, peerID asc", now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var bans []repo.PeerBan
+	for rows.Next() {
+		var (
+			ban              repo.PeerBan
+			created, expires int64
+		)
+		if err := rows.Scan(&ban.PeerID, &ban.Reason, &created, &expires); err != nil {
+			return nil, err
+		}
+		ban.Created = time.Unix(created, 0)
+		ban.Expires = time.Unix(expires, 0)
+		bans = append(bans, ban)
+	}
+	return bans, rows.Err()
+}
+
+// Delete lifts the ban on the peer
+func (p *PeerBansDB) Delete(peerID string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, err := p.db.Exec("delete from peerbans where peerID=?", peerID)
+	return err
+}
+
+// DeleteExpired removes the bans which expired before now
+func (p *PeerBansDB) DeleteExpired(now time.Time) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, err := p.db.Exec("delete from peerbans where expires<=?", now.Unix())
+	return err
+}
@@ -0,0 +1,84 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewPeerBanStore() (repo.PeerBanStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewPeerBanStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestPeerBansDB_PutGetDelete(t *testing.T) {
+	banDB, teardown, err := buildNewPeerBanStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	bans := []repo.PeerBan{
+		{PeerID: "peer1", Reason: "exceeded the chat message rate limit", Created: now.Add(-2 * time.Hour), Expires: now.Add(time.Hour)},
+		{PeerID: "peer2", Reason: "exceeded the follow message rate limit", Created: now.Add(-time.Hour), Expires: now.Add(time.Hour)},
+		{PeerID: "peer3", Reason: "exceeded the order message rate limit", Created: now.Add(-3 * time.Hour), Expires: now.Add(-time.Minute)},
+	}
+	for _, b := range bans {
+		if err := banDB.Put(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	active, err := banDB.GetActive(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 2 || active[0] != bans[1] || active[1] != bans[0] {
+		t.Fatalf("unexpected active bans: %+v", active)
+	}
+
+	// Banning the peer again replaces its ban
+	bans[0].Expires = now.Add(48 * time.Hour)
+	if err := banDB.Put(bans[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := banDB.Delete("peer2"); err != nil {
+		t.Fatal(err)
+	}
+	active, err = banDB.GetActive(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 1 || active[0] != bans[0] {
+		t.Fatalf("unexpected active bans: %+v", active)
+	}
+
+	if err := banDB.DeleteExpired(now); err != nil {
+		t.Fatal(err)
+	}
+	all, err := banDB.GetActive(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].PeerID != "peer1" {
+		t.Errorf("expected expired ban to be deleted, got %+v", all)
+	}
+}
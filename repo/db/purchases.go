@@ -94,6 +94,7 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 	if err != nil {
 		return fmt.Errorf("commit purchase: %s", err.Error())
 	}
+	updateSearchIndex(p.db, repo.NewOrderSearchDocument(repo.SearchScopePurchases, orderID, &contract))
 	return nil
 }
 
@@ -143,6 +144,7 @@ func (p *PurchasesDB) Delete(orderID string) error {
 	if err != nil {
 		return err
 	}
+	removeFromSearchIndex(p.db, "scope=? and docID=?", repo.SearchScopePurchases, orderID)
 	return nil
 }
 
@@ -258,6 +260,26 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 	return ret, count, nil
 }
 
+// CountByState returns the number of purchases in each order state
+func (p *PurchasesDB) CountByState() (map[pb.OrderState]int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	rows, err := p.db.Query("select state, count(*) from purchases group by state")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := make(map[pb.OrderState]int)
+	for rows.Next() {
+		var state, count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return nil, err
+		}
+		counts[pb.OrderState(state)] = count
+	}
+	return counts, rows.Err()
+}
+
 func (p *PurchasesDB) GetUnfunded() ([]repo.UnfundedOrder, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
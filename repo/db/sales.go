@@ -94,7 +94,7 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 	if err != nil {
 		return fmt.Errorf("commit sale: %s", err.Error())
 	}
-
+	updateSearchIndex(s.db, repo.NewOrderSearchDocument(repo.SearchScopeSales, orderID, &contract))
 	return nil
 }
 
@@ -144,6 +144,7 @@ func (s *SalesDB) Delete(orderID string) error {
 	if err != nil {
 		return err
 	}
+	removeFromSearchIndex(s.db, "scope=? and docID=?", repo.SearchScopeSales, orderID)
 	return nil
 }
 
@@ -349,6 +350,26 @@ func (s *SalesDB) Count() int {
 	return count
 }
 
+// CountByState returns the number of sales in each order state
+func (s *SalesDB) CountByState() (map[pb.OrderState]int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query("select state, count(*) from sales group by state")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := make(map[pb.OrderState]int)
+	for rows.Next() {
+		var state, count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return nil, err
+		}
+		counts[pb.OrderState(state)] = count
+	}
+	return counts, rows.Err()
+}
+
 func (s *SalesDB) GetUnfunded() ([]repo.UnfundedOrder, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
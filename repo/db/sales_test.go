@@ -57,6 +57,32 @@ func TestSalesDB_Count(t *testing.T) {
 	}
 }
 
+func TestSalesDB_CountByState(t *testing.T) {
+	var saldb, teardown, err = buildNewSaleStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	contract := factory.NewContract()
+	for orderID, state := range map[string]pb.OrderState{
+		"order1": pb.OrderState_AWAITING_PAYMENT,
+		"order2": pb.OrderState_AWAITING_PAYMENT,
+		"order3": pb.OrderState_COMPLETED,
+	} {
+		if err := saldb.Put(orderID, *contract, state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	counts, err := saldb.CountByState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 || counts[pb.OrderState_AWAITING_PAYMENT] != 2 || counts[pb.OrderState_COMPLETED] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+}
+
 func TestPutSale(t *testing.T) {
 	var saldb, teardown, err = buildNewSaleStore()
 	if err != nil {
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// SearchDB represents the searchindex full-text table
+type SearchDB struct {
+	modelStore
+}
+
+// NewSearchStore returns a new SearchDB
+func NewSearchStore(db *sql.DB, lock *sync.Mutex) repo.SearchStore {
+	return &SearchDB{modelStore{db, lock}}
+}
+
+// Index adds or replaces a document in the index
+func (s *SearchDB) Index(doc repo.SearchDocument) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return indexSearchDocument(s.db, doc)
+}
+
+// Delete removes a document from the index
+func (s *SearchDB) Delete(scope, id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from searchindex where scope=? and docID=?", scope, id)
+	return err
+}
+
+// Search returns the documents matching the query in the given scopes, best
+// match first, along with the total number of matches
+func (s *SearchDB) Search(query string, scopes []string, limit, offset int) ([]repo.SearchResult, int, error) {
+	match := searchMatchExpression(query)
+	if match == "" {
+		return []repo.SearchResult{}, 0, nil
+	}
+
+	where := "searchindex match ?"
+	args := []interface{}{match}
+	if len(scopes) > 0 {
+		where += " and scope in (?" + strings.Repeat(",?", len(scopes)-1) + ")"
+		for _, scope := range scopes {
+			args = append(args, scope)
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var total int
+	if err := s.db.QueryRow("select count(*) from searchindex where "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count search results: %s", err.Error())
+	}
+
+	if limit < 0 {
+		limit = -1
+	}
+	rows, err := s.db.Query("select scope, docID, subject, peerID, title, snippet(searchindex, -1, '', '', '...', 12) from searchindex where "+where+" order by rank limit ? offset ?", append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query search index: %s", err.Error())
+	}
+	defer rows.Close()
+
+	results := []repo.SearchResult{}
+	for rows.Next() {
+		var r repo.SearchResult
+		if err := rows.Scan(&r.Scope, &r.ID, &r.Subject, &r.PeerID, &r.Title, &r.Snippet); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, r)
+	}
+	return results, total, rows.Err()
+}
+
+// searchMatchExpression turns free text into an FTS5 query matching every
+// word as a prefix. Each word is quoted so FTS5 operators and punctuation in
+// the input are matched literally.
+func searchMatchExpression(query string) string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		terms = append(terms, `"`+strings.Replace(word, `"`, `""`, -1)+`"*`)
+	}
+	return strings.Join(terms, " ")
+}
+
+// indexSearchDocument replaces the document in the index. Callers must hold
+// the datastore lock.
+func indexSearchDocument(db *sql.DB, doc repo.SearchDocument) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from searchindex where scope=? and docID=?", doc.Scope, doc.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.Exec("insert into searchindex(scope, docID, subject, peerID, title, body, tags, handles) values(?,?,?,?,?,?,?,?)",
+		doc.Scope, doc.ID, doc.Subject, doc.PeerID, doc.Title, doc.Body, doc.Tags, doc.Handles)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// updateSearchIndex indexes the document on behalf of another store. The
+// index is secondary so failures are logged rather than returned. Callers
+// must hold the datastore lock.
+func updateSearchIndex(db *sql.DB, doc repo.SearchDocument) {
+	if err := indexSearchDocument(db, doc); err != nil {
+		log.Errorf("indexing %s (%s) for search: %s", doc.Scope, doc.ID, err.Error())
+	}
+}
+
+// removeFromSearchIndex deletes the matching documents on behalf of another
+// store. Callers must hold the datastore lock.
+func removeFromSearchIndex(db *sql.DB, where string, args ...interface{}) {
+	if _, err := db.Exec("delete from searchindex where "+where, args...); err != nil {
+		log.Errorf("removing from search index: %s", err.Error())
+	}
+}
+
+// indexCaseForSearch re-indexes a dispute case from its stored claim and
+// contracts. Callers must hold the datastore lock.
+func indexCaseForSearch(db *sql.DB, caseID string) {
+	var buyerCon, vendorCon sql.NullString
+	var claim string
+	err := db.QueryRow("select buyerContract, vendorContract, claim from cases where caseID=?", caseID).Scan(&buyerCon, &vendorCon, &claim)
+	if err != nil {
+		log.Errorf("reading case (%s) for search: %s", caseID, err.Error())
+		return
+	}
+	parse := func(s sql.NullString) *pb.RicardianContract {
+		if s.String == "" {
+			return nil
+		}
+		rc := new(pb.RicardianContract)
+		if err := jsonpb.UnmarshalString(s.String, rc); err != nil {
+			log.Errorf("parsing case (%s) contract for search: %s", caseID, err.Error())
+			return nil
+		}
+		return rc
+	}
+	updateSearchIndex(db, repo.NewCaseSearchDocument(caseID, claim, parse(buyerCon), parse(vendorCon)))
+}
@@ -0,0 +1,149 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func buildNewSearchDatabase() (*sql.DB, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return database, appSchema.DestroySchemaDirectories, nil
+}
+
+func searchIDs(t *testing.T, s repo.SearchStore, query string, scopes ...string) []string {
+	results, total, err := s.Search(query, scopes, -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != len(results) {
+		t.Errorf("expected total %d to equal the number of results %d", total, len(results))
+	}
+	var ids []string
+	for _, r := range results {
+		ids = append(ids, r.Scope+":"+r.ID)
+	}
+	return ids
+}
+
+func TestSearchDB_IndexSearchDelete(t *testing.T) {
+	database, teardown, err := buildNewSearchDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	searchDB := db.NewSearchStore(database, new(sync.Mutex))
+
+	docs := []repo.SearchDocument{
+		{Scope: repo.SearchScopeListings, ID: "red-bike", Title: "Red Bicycle", Body: "A fast road bike", Tags: "cycling sports"},
+		{Scope: repo.SearchScopeListings, ID: "blue-bike", Title: "Blue Bicycle", Body: "A slow town bike"},
+		{Scope: repo.SearchScopeChats, ID: "msg1", PeerID: "QmPeer", Body: "is the red one still available?"},
+	}
+	for _, d := range docs {
+		if err := searchDB.Index(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if ids := searchIDs(t, searchDB, "bicy"); len(ids) != 2 {
+		t.Errorf("expected prefix search to match both listings, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, "red"); len(ids) != 2 {
+		t.Errorf("expected search across scopes to match a listing and a chat, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, "red", repo.SearchScopeChats); len(ids) != 1 || ids[0] != "chats:msg1" {
+		t.Errorf("expected scoped search to match only the chat, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, "red bicycle"); len(ids) != 1 || ids[0] != "listings:red-bike" {
+		t.Errorf("expected every word to be required, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, `cycling" OR "town`); len(ids) != 0 {
+		t.Errorf("expected query syntax to be matched literally, got %v", ids)
+	}
+
+	// reindexing replaces the document
+	docs[0].Title = "Green Bicycle"
+	if err := searchDB.Index(docs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, searchDB, "red", repo.SearchScopeListings); len(ids) != 0 {
+		t.Errorf("expected stale title to be removed, got %v", ids)
+	}
+
+	results, total, err := searchDB.Search("bicycle", nil, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || len(results) != 1 {
+		t.Errorf("expected one of two results to be returned, got %d of %d", len(results), total)
+	}
+
+	if err := searchDB.Delete(repo.SearchScopeListings, "blue-bike"); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, searchDB, "bicycle"); len(ids) != 1 {
+		t.Errorf("expected deleted listing to be removed, got %v", ids)
+	}
+}
+
+func TestSearchDB_StoresKeepIndexUpdated(t *testing.T) {
+	database, teardown, err := buildNewSearchDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	var (
+		lock     = new(sync.Mutex)
+		searchDB = db.NewSearchStore(database, lock)
+		salesDB  = db.NewSaleStore(database, lock)
+		chatDB   = db.NewChatStore(database, lock)
+	)
+
+	contract := factory.NewContract()
+	contract.VendorListings[0].Item.Title = "Vintage Camera"
+	if err := salesDB.Put("order1", *contract, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := chatDB.Put("msg1", "QmPeer", "", "does the camera work?", time.Now(), false, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := searchIDs(t, searchDB, "camera"); len(ids) != 2 {
+		t.Errorf("expected the sale and chat to be indexed, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, "buyerid", repo.SearchScopeSales); len(ids) != 1 {
+		t.Errorf("expected the buyer handle to be indexed, got %v", ids)
+	}
+	if ids := searchIDs(t, searchDB, "buyer name"); len(ids) != 1 {
+		t.Errorf("expected the shipping name to be indexed, got %v", ids)
+	}
+
+	if err := salesDB.Delete("order1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := chatDB.DeleteMessage("msg1"); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, searchDB, "camera"); len(ids) != 0 {
+		t.Errorf("expected deleted records to be removed from the index, got %v", ids)
+	}
+}
@@ -103,6 +103,9 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
+	if settings.WebhookSettings == nil {
+		settings.WebhookSettings = current.WebhookSettings
+	}
 	if settings.Version == nil {
 		settings.Version = current.Version
 	}
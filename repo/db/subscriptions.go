@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// SubscriptionsDB represents the subscriptions table
+type SubscriptionsDB struct {
+	modelStore
+}
+
+// NewSubscriptionStore returns a new SubscriptionsDB
+func NewSubscriptionStore(db *sql.DB, lock *sync.Mutex) repo.SubscriptionStore {
+	return &SubscriptionsDB{modelStore{db, lock}}
+}
+
+const selectSubscriptionsSQL = "select id, vendorID, listingHash, title, period, purchase, spendCap, feeLevel, state, nextRenewal, lastOrderID, failures, created from subscriptions"
+
+// Put adds or replaces a subscription
+func (s *SubscriptionsDB) Put(subscription repo.Subscription) error {
+	purchase, err := json.Marshal(subscription.Purchase)
+	if err != nil {
+		return fmt.Errorf("marshal subscription purchase: %s", err.Error())
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stmt, err := s.PrepareQuery("insert or replace into subscriptions(id, vendorID, listingHash, title, period, purchase, spendCap, feeLevel, state, nextRenewal, lastOrderID, failures, created) values(?,?,?,?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare subscription sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		subscription.ID,
+		subscription.VendorID,
+		subscription.ListingHash,
+		subscription.Title,
+		int(subscription.Period),
+		purchase,
+		subscription.SpendCap,
+		subscription.FeeLevel,
+		string(subscription.State),
+		subscription.NextRenewal.Unix(),
+		subscription.LastOrderID,
+		subscription.Failures,
+		subscription.Created.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit subscription: %s", err.Error())
+	}
+	return nil
+}
+
+// Get returns the subscription with the ID or sql.ErrNoRows if there is none
+func (s *SubscriptionsDB) Get(id string) (*repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query(selectSubscriptionsSQL+" where id=?", id)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &subscriptions[0], nil
+}
+
+// GetAll returns every subscription, newest first
+func (s *SubscriptionsDB) GetAll() ([]repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query(selectSubscriptionsSQL + " order by created desc, id asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanSubscriptions(rows)
+}
+
+// GetDue returns the active subscriptions whose renewal date has passed,
+// earliest first
+func (s *SubscriptionsDB) GetDue(now time.Time) ([]repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rows, err := s.db.Query(selectSubscriptionsSQL+" where state=? and nextRenewal<=? order by nextRenewal asc", string(repo.SubscriptionStateActive), now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	return scanSubscriptions(rows)
+}
+
+// Delete removes a subscription
+func (s *SubscriptionsDB) Delete(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from subscriptions where id=?", id)
+	return err
+}
+
+func scanSubscriptions(rows *sql.Rows) ([]repo.Subscription, error) {
+	defer rows.Close()
+	var subscriptions []repo.Subscription
+	for rows.Next() {
+		var (
+			subscription             repo.Subscription
+			period                   int
+			purchase                 []byte
+			state                    string
+			nextRenewal, created     int64
+			lastOrderID, feeLevel    sql.NullString
+			title, vendorID, capText sql.NullString
+		)
+		if err := rows.Scan(&subscription.ID, &vendorID, &subscription.ListingHash, &title, &period, &purchase, &capText, &feeLevel, &state, &nextRenewal, &lastOrderID, &subscription.Failures, &created); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(purchase, &subscription.Purchase); err != nil {
+			return nil, fmt.Errorf("unmarshal subscription (%s) purchase: %s", subscription.ID, err.Error())
+		}
+		subscription.VendorID = vendorID.String
+		subscription.Title = title.String
+		subscription.Period = pb.Listing_Metadata_BillingPeriod(period)
+		subscription.SpendCap = capText.String
+		subscription.FeeLevel = feeLevel.String
+		subscription.State = repo.SubscriptionState(state)
+		subscription.NextRenewal = time.Unix(nextRenewal, 0)
+		subscription.LastOrderID = lastOrderID.String
+		subscription.Created = time.Unix(created, 0)
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, rows.Err()
+}
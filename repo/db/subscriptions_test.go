@@ -0,0 +1,119 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewSubscriptionStore() (repo.SubscriptionStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewSubscriptionStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestSubscriptionsDB_PutGetDue(t *testing.T) {
+	subscriptionDB, teardown, err := buildNewSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := subscriptionDB.Get("s1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for missing subscription, got %v", err)
+	}
+
+	now := time.Unix(time.Now().Unix(), 0)
+	subscriptions := []repo.Subscription{
+		{
+			ID:          "s1",
+			VendorID:    "QmVendor",
+			ListingHash: "QmHosting",
+			Title:       "Hosting",
+			Period:      pb.Listing_Metadata_MONTHLY,
+			Purchase: repo.PurchaseData{
+				PaymentCoin: "TBTC",
+				Items:       []repo.Item{{ListingHash: "QmHosting", Quantity: "1"}},
+			},
+			SpendCap:    "100000",
+			State:       repo.SubscriptionStateActive,
+			NextRenewal: now.Add(-time.Hour),
+			Created:     now.Add(-time.Hour),
+		},
+		{
+			ID:          "s2",
+			ListingHash: "QmSupport",
+			Period:      pb.Listing_Metadata_WEEKLY,
+			SpendCap:    "5000",
+			State:       repo.SubscriptionStatePaused,
+			NextRenewal: now.Add(-time.Hour),
+			Created:     now,
+		},
+		{
+			ID:          "s3",
+			ListingHash: "QmBackups",
+			Period:      pb.Listing_Metadata_YEARLY,
+			SpendCap:    "5000",
+			State:       repo.SubscriptionStateActive,
+			NextRenewal: now.Add(time.Hour),
+			Created:     now,
+		},
+	}
+	for _, s := range subscriptions {
+		if err := subscriptionDB.Put(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err := subscriptionDB.Get("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Period != pb.Listing_Metadata_MONTHLY || s.SpendCap != "100000" || !s.NextRenewal.Equal(now.Add(-time.Hour)) {
+		t.Errorf("unexpected subscription: %+v", s)
+	}
+	if len(s.Purchase.Items) != 1 || s.Purchase.Items[0].ListingHash != "QmHosting" || s.Purchase.PaymentCoin != "TBTC" {
+		t.Errorf("unexpected purchase data: %+v", s.Purchase)
+	}
+
+	all, err := subscriptionDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[2].ID != "s1" {
+		t.Errorf("expected three subscriptions newest first, got %+v", all)
+	}
+
+	due, err := subscriptionDB.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ID != "s1" {
+		t.Errorf("expected only the active subscription past its renewal, got %+v", due)
+	}
+
+	if err := subscriptionDB.Delete("s1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := subscriptionDB.Get("s1"); err != sql.ErrNoRows {
+		t.Errorf("expected deleted subscription to be gone, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// WebhookDeliveriesDB represents the webhookdeliveries table
+type WebhookDeliveriesDB struct {
+	modelStore
+}
+
+// NewWebhookDeliveryStore returns a new WebhookDeliveriesDB
+func NewWebhookDeliveryStore(db *sql.DB, lock *sync.Mutex) repo.WebhookDeliveryStore {
+	return &WebhookDeliveriesDB{modelStore{db, lock}}
+}
+
+// Put inserts or replaces a webhook delivery
+func (w *WebhookDeliveriesDB) Put(d repo.WebhookDelivery) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	stm := `insert or replace into webhookdeliveries(deliveryID, url, notificationType, body, attempts, nextAttemptAt, lastError, createdAt) values(?,?,?,?,?,?,?,?)`
+	stmt, err := w.PrepareQuery(stm)
+	if err != nil {
+		return fmt.Errorf("prepare webhook delivery sql: %s", err.Error())
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		d.ID,
+		d.URL,
+		string(d.NotificationType),
+		d.Body,
+		d.Attempts,
+		d.NextAttemptAt.Unix(),
+		d.LastError,
+		d.CreatedAt.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("commit webhook delivery: %s", err.Error())
+	}
+	return nil
+}
+
+// GetDue returns up to limit deliveries which are due at the given time
+func (w *WebhookDeliveriesDB) GetDue(now time.Time, limit int) ([]repo.WebhookDelivery, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	rows, err := w.db.Query("select deliveryID, url, notificationType, body, attempts, nextAttemptAt, lastError, createdAt from webhookdeliveries where nextAttemptAt<=? order by nextAttemptAt asc limit ?", now.Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []repo.WebhookDelivery
+	for rows.Next() {
+		var (
+			d                        repo.WebhookDelivery
+			nType                    string
+			nextAttemptAt, createdAt int64
+		)
+		if err := rows.Scan(&d.ID, &d.URL, &nType, &d.Body, &d.Attempts, &nextAttemptAt, &d.LastError, &createdAt); err != nil {
+			log.Error(err)
+			continue
+		}
+		d.NotificationType = repo.NotificationType(nType)
+		d.NextAttemptAt = time.Unix(nextAttemptAt, 0)
+		d.CreatedAt = time.Unix(createdAt, 0)
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
+
+// Delete removes the delivery with the given ID
+func (w *WebhookDeliveriesDB) Delete(id string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.db.Exec("delete from webhookdeliveries where deliveryID=?", id)
+	return err
+}
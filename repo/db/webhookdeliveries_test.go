@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewWebhookDeliveryStore() (repo.WebhookDeliveryStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewWebhookDeliveryStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestWebhookDeliveriesDB_PutGetDueDelete(t *testing.T) {
+	whDB, teardown, err := buildNewWebhookDeliveryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	due := repo.WebhookDelivery{
+		ID:               "due",
+		URL:              "https://example.com/hook",
+		NotificationType: repo.NotifierTypeOrderNewNotification,
+		Body:             []byte(`{"type":"order"}`),
+		NextAttemptAt:    now.Add(-time.Minute),
+		CreatedAt:        now,
+	}
+	later := due
+	later.ID = "later"
+	later.NextAttemptAt = now.Add(time.Hour)
+	for _, d := range []repo.WebhookDelivery{due, later} {
+		if err := whDB.Put(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deliveries, err := whDB.GetDue(now, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("expected 1 due delivery, got %d", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.ID != "due" || d.URL != due.URL || d.NotificationType != due.NotificationType || string(d.Body) != string(due.Body) {
+		t.Errorf("unexpected delivery returned: %v", d)
+	}
+
+	d.Attempts = 1
+	d.LastError = "timeout"
+	d.NextAttemptAt = now.Add(time.Hour * 2)
+	if err := whDB.Put(d); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err = whDB.GetDue(now.Add(time.Hour*2), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 2 || deliveries[0].ID != "later" || deliveries[1].Attempts != 1 || deliveries[1].LastError != "timeout" {
+		t.Errorf("unexpected deliveries after update: %v", deliveries)
+	}
+
+	if err := whDB.Delete("due"); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err = whDB.GetDue(now.Add(time.Hour*2), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].ID != "later" {
+		t.Errorf("expected only the later delivery to remain, got %v", deliveries)
+	}
+}
@@ -33,6 +33,13 @@ type DisputeCaseRecord struct {
 	PaymentCoin                 *CurrencyCode
 }
 
+// CaseResolution is a resolved case with the time it was opened
+type CaseResolution struct {
+	CaseID     string
+	Opened     time.Time
+	Resolution *pb.DisputeResolution
+}
+
 // BuildModeratorDisputeExpiryFirstNotification returns a Notification with ExpiresIn set for the First Interval
 func (r *DisputeCaseRecord) BuildModeratorDisputeExpiryFirstNotification(createdAt time.Time) *Notification {
 	return r.buildModeratorDisputeExpiry(ModeratorDisputeExpiry_firstInterval, createdAt)
@@ -17,7 +17,7 @@ import (
 	"github.com/tyler-smith/go-bip39"
 )
 
-const RepoVersion = "34"
+const RepoVersion = "52"
 
 var log = logging.MustGetLogger("repo")
 var ErrRepoExists = errors.New("IPFS configuration file exists. Reinitializing would overwrite your keys. Use -f to force overwrite.")
@@ -178,6 +178,10 @@ func addConfigExtensions(repoRoot string) error {
 			DHTQuorumSize: 1,
 			APIRouter:     schema.IPFSCachingRouterDefaultURI,
 		}
+		s3 = schema.S3StorageConfig{
+			Region:        "us-east-1",
+			MessageExpiry: "168h",
+		}
 
 		t = schema.TorConfig{}
 	)
@@ -196,6 +200,15 @@ func addConfigExtensions(repoRoot string) error {
 	if err := r.SetConfigKey("IpnsExtra", ie); err != nil {
 		return err
 	}
+	if err := r.SetConfigKey("S3-storage", s3); err != nil {
+		return err
+	}
+	if err := r.SetConfigKey("RateLimits", schema.DefaultRateLimitsConfig()); err != nil {
+		return err
+	}
+	if err := r.SetConfigKey("Backups", schema.DefaultBackupsConfig()); err != nil {
+		return err
+	}
 	if err := r.SetConfigKey("RepublishInterval", "24h"); err != nil {
 		return err
 	}
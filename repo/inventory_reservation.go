@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"math/big"
+	"time"
+)
+
+// InventoryReservation is a quantity of a listing variant held for an order
+// which has not been funded yet. Held units can't be sold to other buyers
+// until the reservation is released.
+type InventoryReservation struct {
+	OrderID   string    `json:"orderId"`
+	Slug      string    `json:"slug"`
+	Variant   int       `json:"variant"`
+	Quantity  *big.Int  `json:"quantity"`
+	Timestamp time.Time `json:"timestamp"`
+}
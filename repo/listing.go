@@ -566,7 +566,7 @@ func (l *Listing) GetPrice() (*CurrencyValue, error) {
 			Amount:   big.NewInt(0),
 			Currency: NewUnknownCryptoDefinition(l.GetCryptoCurrencyCode(), 0),
 		}, nil
-	case pb.Listing_Metadata_DIGITAL_GOOD.String(), pb.Listing_Metadata_PHYSICAL_GOOD.String(), pb.Listing_Metadata_SERVICE.String():
+	case pb.Listing_Metadata_DIGITAL_GOOD.String(), pb.Listing_Metadata_PHYSICAL_GOOD.String(), pb.Listing_Metadata_SERVICE.String(), pb.Listing_Metadata_CROWD_FUND.String():
 		switch l.GetVersion() {
 		case 5:
 			return NewCurrencyValueFromProtobuf(l.listingProto.Item.BigPrice, l.listingProto.Item.PriceCurrency)
@@ -646,6 +646,44 @@ func (l *Listing) GetCategories() []string {
 	return l.listingProto.Item.Categories
 }
 
+// GetBillingPeriod returns the period at which a subscription to the
+// listing is renewed or NONE if it is not sold as a subscription
+func (l *Listing) GetBillingPeriod() pb.Listing_Metadata_BillingPeriod {
+	if l.listingProto.Metadata.Subscription == nil {
+		return pb.Listing_Metadata_NONE
+	}
+	return l.listingProto.Metadata.Subscription.BillingPeriod
+}
+
+// IsCrowdFund returns true if the listing takes pledges towards a funding
+// goal
+func (l *Listing) IsCrowdFund() bool {
+	return l.listingProto.Metadata.ContractType == pb.Listing_Metadata_CROWD_FUND &&
+		l.listingProto.Metadata.CrowdFund != nil
+}
+
+// GetCrowdFundGoal returns the funding goal of a crowdfunding listing in the
+// item's price currency
+func (l *Listing) GetCrowdFundGoal() (*CurrencyValue, error) {
+	if !l.IsCrowdFund() {
+		return nil, errors.New("listing is not a crowdfunding listing")
+	}
+	price, err := l.GetPrice()
+	if err != nil {
+		return nil, err
+	}
+	return NewCurrencyValue(l.listingProto.Metadata.CrowdFund.BigGoal, price.Currency)
+}
+
+// GetCrowdFundDeadline returns the time after which a crowdfunding listing
+// stops taking pledges
+func (l *Listing) GetCrowdFundDeadline() time.Time {
+	if !l.IsCrowdFund() || l.listingProto.Metadata.CrowdFund.Deadline == nil {
+		return time.Time{}
+	}
+	return time.Unix(l.listingProto.Metadata.CrowdFund.Deadline.Seconds, 0)
+}
+
 // GetGrams returns listing item weight in grams
 func (l *Listing) GetWeightGrams() float32 {
 	return l.listingProto.Item.Grams
@@ -950,6 +988,7 @@ func (l *Listing) GetCoupons() (ListingCoupons, error) {
 			redemptionHash:  c.GetHash(),
 			discountPercent: c.GetPercentDiscount(),
 			discountAmount:  discValue,
+			limits:          c.GetLimits(),
 		}
 	}
 	return cs, nil
@@ -980,6 +1019,9 @@ func (cs ListingCoupons) GetProtobuf() []*pb.Listing_Coupon {
 		if code, err := c.GetRedemptionCode(); err == nil {
 			cspb[i].Code = &pb.Listing_Coupon_DiscountCode{DiscountCode: code}
 		}
+		if c.limits != nil {
+			cspb[i].Limits = proto.Clone(c.limits).(*pb.Listing_Coupon_Limits)
+		}
 	}
 	return cspb
 }
@@ -994,6 +1036,8 @@ type ListingCoupon struct {
 
 	discountPercent float32
 	discountAmount  *CurrencyValue
+
+	limits *pb.Listing_Coupon_Limits
 }
 
 // GetListingSlug returns the slug for the coupon's listing
@@ -1031,6 +1075,10 @@ func (c *ListingCoupon) GetPercentOff() float32 { return c.discountPercent }
 // GetAmountOff returns the value to reduce listing by
 func (c *ListingCoupon) GetAmountOff() *CurrencyValue { return c.discountAmount }
 
+// GetLimits returns the restrictions on when and how often the coupon may be
+// redeemed, or nil if it may be redeemed without limit
+func (c *ListingCoupon) GetLimits() *pb.Listing_Coupon_Limits { return c.limits }
+
 // SetRedemptionCode sets the coupon's redemption code
 func (c *ListingCoupon) SetRedemptionCode(code string) error {
 	newHash, err := ipfs.EncodeMultihash([]byte(code))
@@ -1088,6 +1136,16 @@ func (l *Listing) GetShippingOptions() ([]*pb.Listing_ShippingOption, error) {
 
 }
 
+// freeRateTiers returns true if every rate tier ships for free
+func freeRateTiers(tiers []*pb.Listing_ShippingOption_Service_RateTier) bool {
+	for _, tier := range tiers {
+		if price, ok := new(big.Int).SetString(tier.BigPrice, 10); !ok || price.Sign() != 0 {
+			return false
+		}
+	}
+	return len(tiers) > 0
+}
+
 // GetShippingRegions returns all region strings for the defined shipping
 // services
 func (l *Listing) GetShippingRegions() ([]string, []string) {
@@ -1099,6 +1157,12 @@ func (l *Listing) GetShippingRegions() ([]string, []string) {
 		for _, shipRegion := range shipOption.Regions {
 			shipsTo[shipRegion.String()] = struct{}{}
 			for _, shipService := range shipOption.Services {
+				if IsTieredShippingType(shipOption.Type) {
+					if freeRateTiers(shipService.RateTiers) {
+						freeShippingTo[shipRegion.String()] = struct{}{}
+					}
+					continue
+				}
 				servicePrice, ok := new(big.Int).SetString(shipService.BigPrice, 10)
 				if ok && servicePrice.Cmp(big.NewInt(0)) == 0 {
 					freeShippingTo[shipRegion.String()] = struct{}{}
@@ -1315,6 +1379,22 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 	if l.listingProto.Metadata.Format > pb.Listing_Metadata_MARKET_PRICE {
 		return errors.New("invalid listing format")
 	}
+	if l.listingProto.Metadata.Subscription != nil {
+		if l.listingProto.Metadata.ContractType != pb.Listing_Metadata_SERVICE {
+			return errors.New("only service listings may be sold as subscriptions")
+		}
+		if l.listingProto.Metadata.Subscription.BillingPeriod == pb.Listing_Metadata_NONE ||
+			l.listingProto.Metadata.Subscription.BillingPeriod > pb.Listing_Metadata_YEARLY {
+			return errors.New("invalid subscription billing period")
+		}
+	}
+	if l.listingProto.Metadata.ContractType == pb.Listing_Metadata_CROWD_FUND {
+		if err := l.validateCrowdFund(); err != nil {
+			return err
+		}
+	} else if l.listingProto.Metadata.CrowdFund != nil {
+		return errors.New("only crowdfunding listings may have a funding goal")
+	}
 	if l.listingProto.Metadata.Expiry == nil {
 		return errors.New("missing required field: Expiry")
 	}
@@ -1570,6 +1650,9 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 		if coupon.GetPercentDiscount() != 0 && coupon.GetBigPriceDiscount() != "" {
 			return errors.New("coupons must have either a percent discount or a fixed amount discount, but not both")
 		}
+		if err := validateCouponLimits(coupon.Limits); err != nil {
+			return err
+		}
 	}
 
 	// Moderators
@@ -1635,6 +1718,48 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 	return nil
 }
 
+// validateCrowdFund checks the funding goal and deadline of a crowdfunding
+// listing. Pledges are held in escrow until the deadline so a moderator is
+// required.
+func (l *Listing) validateCrowdFund() error {
+	cf := l.listingProto.Metadata.CrowdFund
+	if cf == nil {
+		return errors.New("crowdfunding listings must have a funding goal and deadline")
+	}
+	goal, ok := new(big.Int).SetString(cf.BigGoal, 10)
+	if !ok || goal.Sign() <= 0 {
+		return errors.New("crowdfunding goal must be a positive amount")
+	}
+	if cf.Deadline == nil {
+		return errors.New("missing required field: crowdfunding deadline")
+	}
+	if expiry := l.listingProto.Metadata.Expiry; expiry != nil && cf.Deadline.Seconds > expiry.Seconds {
+		return errors.New("crowdfunding deadline must not be after the listing expiration")
+	}
+	if len(l.listingProto.Moderators) == 0 {
+		return errors.New("crowdfunding listings must have at least one moderator")
+	}
+	return nil
+}
+
+// validateCouponLimits checks the validity period and minimum order value of a
+// coupon. A coupon without limits is always valid.
+func validateCouponLimits(limits *pb.Listing_Coupon_Limits) error {
+	if limits == nil {
+		return nil
+	}
+	if limits.ValidFrom != nil && limits.ValidUntil != nil && limits.ValidUntil.Seconds <= limits.ValidFrom.Seconds {
+		return errors.New("coupon validUntil must be after validFrom")
+	}
+	if limits.BigMinimumOrderValue != "" {
+		minimum, ok := new(big.Int).SetString(limits.BigMinimumOrderValue, 10)
+		if !ok || minimum.Sign() < 0 {
+			return errors.New("coupon minimum order value was invalid")
+		}
+	}
+	return nil
+}
+
 func (l *Listing) validatePhysicalListing() error {
 	if len(l.listingProto.Item.Condition) > SentenceMaxCharacters {
 		return fmt.Errorf("'Condition' length must be less than the max of %d", SentenceMaxCharacters)
@@ -1668,9 +1793,12 @@ func (l *Listing) validatePhysicalListing() error {
 			}
 		}
 		shippingTitles = append(shippingTitles, shippingOption.Name)
-		if shippingOption.Type > pb.Listing_ShippingOption_FIXED_PRICE {
+		if shippingOption.Type > pb.Listing_ShippingOption_QUANTITY_BASED {
 			return errors.New("unknown shipping option type")
 		}
+		if shippingOption.Type == pb.Listing_ShippingOption_WEIGHT_BASED && l.listingProto.Item.Grams <= 0 {
+			return errors.New("weight based shipping requires the item weight in grams")
+		}
 		if len(shippingOption.Regions) == 0 {
 			return errors.New("shipping options must specify at least one region")
 		}
@@ -1707,6 +1835,15 @@ func (l *Listing) validatePhysicalListing() error {
 			if len(option.EstimatedDelivery) > SentenceMaxCharacters {
 				return fmt.Errorf("shipping option estimated delivery length must be less than the max of %d", SentenceMaxCharacters)
 			}
+			if IsTieredShippingType(shippingOption.Type) {
+				if err := validateShippingRateTiers(option.RateTiers); err != nil {
+					return fmt.Errorf("shipping option service (%s): %s", option.Name, err.Error())
+				}
+				continue
+			}
+			if len(option.RateTiers) > 0 {
+				return errors.New("rate tiers are only allowed for weight or quantity based shipping")
+			}
 			if _, ok := new(big.Int).SetString(option.BigPrice, 10); !ok {
 				return errors.New("invalid shipping service price amount")
 			}
@@ -1716,6 +1853,45 @@ func (l *Listing) validatePhysicalListing() error {
 	return nil
 }
 
+// IsTieredShippingType returns true if services of the shipping type are
+// priced by their rate tiers rather than a fixed price per item
+func IsTieredShippingType(t pb.Listing_ShippingOption_ShippingType) bool {
+	return t == pb.Listing_ShippingOption_WEIGHT_BASED || t == pb.Listing_ShippingOption_QUANTITY_BASED
+}
+
+// ShippingRateTierFor returns the first of the ascending rate tiers whose
+// upper bound covers total, or nil if total exceeds the largest tier
+func ShippingRateTierFor(tiers []*pb.Listing_ShippingOption_Service_RateTier, total float64) *pb.Listing_ShippingOption_Service_RateTier {
+	for _, tier := range tiers {
+		if total <= float64(tier.UpTo) {
+			return tier
+		}
+	}
+	return nil
+}
+
+// validateShippingRateTiers checks there is at least one tier, that tiers
+// are in ascending order of their upper bound and that each has a price
+func validateShippingRateTiers(tiers []*pb.Listing_ShippingOption_Service_RateTier) error {
+	if len(tiers) == 0 {
+		return errors.New("at least one rate tier must be specified")
+	}
+	if len(tiers) > MaxListItems {
+		return fmt.Errorf("number of rate tiers is greater than the max of %d", MaxListItems)
+	}
+	var last uint64
+	for _, tier := range tiers {
+		if tier.UpTo <= last {
+			return errors.New("rate tiers must be in ascending order of upTo")
+		}
+		last = tier.UpTo
+		if price, ok := new(big.Int).SetString(tier.BigPrice, 10); !ok || price.Sign() < 0 {
+			return errors.New("invalid rate tier price amount")
+		}
+	}
+	return nil
+}
+
 func (l *Listing) ValidateCryptoListing() error {
 	if len(l.listingProto.Metadata.AcceptedCurrencies) != 1 {
 		return errors.New("cryptocurrency listing must only have one accepted currency")
@@ -14,24 +14,25 @@ type (
 
 	// ListingIndexData reprents a single node in the Listing index
 	ListingIndexData struct {
-		Hash               string           `json:"hash"`
-		Slug               string           `json:"slug"`
-		Title              string           `json:"title"`
-		Categories         []string         `json:"categories"`
-		NSFW               bool             `json:"nsfw"`
-		ContractType       string           `json:"contractType"`
-		Description        string           `json:"description"`
-		Thumbnail          ListingThumbnail `json:"thumbnail"`
-		Price              *CurrencyValue   `json:"price"`
-		Modifier           float32          `json:"modifier"`
-		ShipsTo            []string         `json:"shipsTo"`
-		FreeShipping       []string         `json:"freeShipping"`
-		Language           string           `json:"language"`
-		AverageRating      float32          `json:"averageRating"`
-		RatingCount        uint32           `json:"ratingCount"`
-		ModeratorIDs       []string         `json:"moderators"`
-		AcceptedCurrencies []string         `json:"acceptedCurrencies"`
-		CryptoCurrencyCode string           `json:"coinType"`
+		Hash               string             `json:"hash"`
+		Slug               string             `json:"slug"`
+		Title              string             `json:"title"`
+		Categories         []string           `json:"categories"`
+		NSFW               bool               `json:"nsfw"`
+		ContractType       string             `json:"contractType"`
+		Description        string             `json:"description"`
+		Thumbnail          ListingThumbnail   `json:"thumbnail"`
+		Price              *CurrencyValue     `json:"price"`
+		Modifier           float32            `json:"modifier"`
+		ShipsTo            []string           `json:"shipsTo"`
+		FreeShipping       []string           `json:"freeShipping"`
+		Language           string             `json:"language"`
+		AverageRating      float32            `json:"averageRating"`
+		RatingCount        uint32             `json:"ratingCount"`
+		ModeratorIDs       []string           `json:"moderators"`
+		AcceptedCurrencies []string           `json:"acceptedCurrencies"`
+		CryptoCurrencyCode string             `json:"coinType"`
+		CrowdFund          *CrowdFundProgress `json:"crowdFund,omitempty"`
 	}
 )
 
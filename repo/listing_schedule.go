@@ -0,0 +1,34 @@
+package repo
+
+import "time"
+
+// ListingScheduleState describes whether a scheduled listing is in the
+// listing index
+type ListingScheduleState string
+
+const (
+	// ListingScheduleStatePending - the listing is hidden until its publishAt time
+	ListingScheduleStatePending ListingScheduleState = "pending"
+	// ListingScheduleStateActive - the listing is in the index
+	ListingScheduleStateActive ListingScheduleState = "active"
+	// ListingScheduleStateExpired - the listing expired and was hidden
+	ListingScheduleStateExpired ListingScheduleState = "expired"
+)
+
+// ListingSchedule controls when one of the node's listings is visible. A
+// listing with a future PublishAt is kept out of the index until that time.
+// When RepublishHours is set an expired listing has its expiry extended by
+// that many hours instead of being hidden.
+type ListingSchedule struct {
+	Slug           string               `json:"slug"`
+	PublishAt      *time.Time           `json:"publishAt,omitempty"`
+	RepublishHours uint32               `json:"republishHours"`
+	State          ListingScheduleState `json:"state"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+}
+
+// IsPending returns true if the listing should still be hidden at the
+// given time
+func (s ListingSchedule) IsPending(now time.Time) bool {
+	return s.State == ListingScheduleStatePending && s.PublishAt != nil && s.PublishAt.After(now)
+}
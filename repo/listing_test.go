@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func TestListingUnmarshalJSON(t *testing.T) {
@@ -312,3 +315,264 @@ func TestV4PhysicalGoodDataNormalizesToLatestSchema(t *testing.T) {
 		}
 	}
 }
+
+func TestListingValidatesShippingRateTiers(t *testing.T) {
+	tiers := func() []*pb.Listing_ShippingOption_Service_RateTier {
+		return []*pb.Listing_ShippingOption_Service_RateTier{
+			{UpTo: 500, BigPrice: "300"},
+			{UpTo: 2000, BigPrice: "800"},
+		}
+	}
+	examples := []struct {
+		name  string
+		edit  func(*pb.Listing)
+		valid bool
+	}{
+		{
+			name:  "weight based with tiers",
+			edit:  func(l *pb.Listing) {},
+			valid: true,
+		},
+		{
+			name: "weight based without item weight",
+			edit: func(l *pb.Listing) {
+				l.Item.Grams = 0
+			},
+		},
+		{
+			name: "tiers out of order",
+			edit: func(l *pb.Listing) {
+				s := l.ShippingOptions[0].Services[0]
+				s.RateTiers[0], s.RateTiers[1] = s.RateTiers[1], s.RateTiers[0]
+			},
+		},
+		{
+			name: "missing tiers",
+			edit: func(l *pb.Listing) {
+				l.ShippingOptions[0].Services[0].RateTiers = nil
+			},
+		},
+		{
+			name: "invalid tier price",
+			edit: func(l *pb.Listing) {
+				l.ShippingOptions[0].Services[0].RateTiers[1].BigPrice = "-1"
+			},
+		},
+		{
+			name: "tiers on fixed price shipping",
+			edit: func(l *pb.Listing) {
+				l.ShippingOptions[0].Type = pb.Listing_ShippingOption_FIXED_PRICE
+				l.ShippingOptions[0].Services[0].BigPrice = "20"
+			},
+		},
+	}
+
+	for _, e := range examples {
+		subject := factory.NewListing("tiered-shipping")
+		subject.ShippingOptions[0].Type = pb.Listing_ShippingOption_WEIGHT_BASED
+		subject.ShippingOptions[0].Services[0].BigPrice = ""
+		subject.ShippingOptions[0].Services[0].RateTiers = tiers()
+		e.edit(subject)
+
+		listing, err := repo.NewListingFromProtobuf(subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = listing.ValidateListing(true)
+		if e.valid && err != nil {
+			t.Errorf("%s: expected listing to be valid, got %s", e.name, err)
+		}
+		if !e.valid && err == nil {
+			t.Errorf("%s: expected listing to be invalid", e.name)
+		}
+	}
+}
+
+func TestShippingRateTierFor(t *testing.T) {
+	tiers := []*pb.Listing_ShippingOption_Service_RateTier{
+		{UpTo: 500, BigPrice: "300"},
+		{UpTo: 2000, BigPrice: "800"},
+	}
+	examples := []struct {
+		total    float64
+		expected string
+	}{
+		{total: 14, expected: "300"},
+		{total: 500, expected: "300"},
+		{total: 500.5, expected: "800"},
+		{total: 2000, expected: "800"},
+	}
+	for _, e := range examples {
+		tier := repo.ShippingRateTierFor(tiers, e.total)
+		if tier == nil || tier.BigPrice != e.expected {
+			t.Errorf("expected tier priced (%s) for total (%v), got %v", e.expected, e.total, tier)
+		}
+	}
+	if tier := repo.ShippingRateTierFor(tiers, 2001); tier != nil {
+		t.Errorf("expected no tier beyond the largest, got %v", tier)
+	}
+}
+
+func TestListingValidatesBillingPeriod(t *testing.T) {
+	examples := []struct {
+		name         string
+		contractType pb.Listing_Metadata_ContractType
+		period       pb.Listing_Metadata_BillingPeriod
+		valid        bool
+	}{
+		{name: "monthly service", contractType: pb.Listing_Metadata_SERVICE, period: pb.Listing_Metadata_MONTHLY, valid: true},
+		{name: "physical good", contractType: pb.Listing_Metadata_PHYSICAL_GOOD, period: pb.Listing_Metadata_MONTHLY},
+		{name: "missing period", contractType: pb.Listing_Metadata_SERVICE, period: pb.Listing_Metadata_NONE},
+		{name: "unknown period", contractType: pb.Listing_Metadata_SERVICE, period: pb.Listing_Metadata_BillingPeriod(7)},
+	}
+	for _, e := range examples {
+		subject := factory.NewListing("hosting")
+		if e.contractType == pb.Listing_Metadata_SERVICE {
+			subject.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+			subject.ShippingOptions = nil
+			subject.Item.Condition = ""
+		}
+		subject.Metadata.Subscription = &pb.Listing_Metadata_Subscription{BillingPeriod: e.period}
+		listing, err := repo.NewListingFromProtobuf(subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = listing.ValidateListing(true)
+		if e.valid && err != nil {
+			t.Errorf("%s: expected listing to be valid, got %s", e.name, err)
+		}
+		if !e.valid && err == nil {
+			t.Errorf("%s: expected listing to be invalid", e.name)
+		}
+		if listing.GetBillingPeriod() != e.period {
+			t.Errorf("%s: expected billing period %s, got %s", e.name, e.period, listing.GetBillingPeriod())
+		}
+	}
+}
+
+func TestListingValidatesCrowdFund(t *testing.T) {
+	var (
+		future     = &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()}
+		moderators = []string{"QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e"}
+	)
+	examples := []struct {
+		name         string
+		contractType pb.Listing_Metadata_ContractType
+		crowdFund    *pb.Listing_Metadata_CrowdFund
+		moderators   []string
+		valid        bool
+	}{
+		{
+			name:         "crowdfunding listing",
+			contractType: pb.Listing_Metadata_CROWD_FUND,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "100000", Deadline: future},
+			moderators:   moderators,
+			valid:        true,
+		},
+		{name: "missing goal and deadline", contractType: pb.Listing_Metadata_CROWD_FUND, moderators: moderators},
+		{
+			name:         "zero goal",
+			contractType: pb.Listing_Metadata_CROWD_FUND,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "0", Deadline: future},
+			moderators:   moderators,
+		},
+		{
+			name:         "missing deadline",
+			contractType: pb.Listing_Metadata_CROWD_FUND,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "100000"},
+			moderators:   moderators,
+		},
+		{
+			name:         "deadline after expiry",
+			contractType: pb.Listing_Metadata_CROWD_FUND,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "100000", Deadline: &timestamp.Timestamp{Seconds: 2147483648}},
+			moderators:   moderators,
+		},
+		{
+			name:         "no moderators",
+			contractType: pb.Listing_Metadata_CROWD_FUND,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "100000", Deadline: future},
+		},
+		{
+			name:         "physical good",
+			contractType: pb.Listing_Metadata_PHYSICAL_GOOD,
+			crowdFund:    &pb.Listing_Metadata_CrowdFund{BigGoal: "100000", Deadline: future},
+			moderators:   moderators,
+		},
+	}
+	for _, e := range examples {
+		subject := factory.NewListing("synth-run")
+		subject.Metadata.ContractType = e.contractType
+		subject.Metadata.CrowdFund = e.crowdFund
+		subject.Moderators = e.moderators
+		listing, err := repo.NewListingFromProtobuf(subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = listing.ValidateListing(true)
+		if e.valid && err != nil {
+			t.Errorf("%s: expected listing to be valid, got %s", e.name, err)
+		}
+		if !e.valid && err == nil {
+			t.Errorf("%s: expected listing to be invalid", e.name)
+		}
+	}
+}
+
+func TestListingValidatesCouponLimits(t *testing.T) {
+	var (
+		now  = time.Now()
+		from = &timestamp.Timestamp{Seconds: now.Unix()}
+		till = &timestamp.Timestamp{Seconds: now.Add(time.Hour).Unix()}
+	)
+	examples := []struct {
+		name   string
+		limits *pb.Listing_Coupon_Limits
+		valid  bool
+	}{
+		{name: "no limits", valid: true},
+		{
+			name:   "all limits",
+			limits: &pb.Listing_Coupon_Limits{MaxRedemptions: 10, ValidFrom: from, ValidUntil: till, BigMinimumOrderValue: "1000", OncePerBuyer: true},
+			valid:  true,
+		},
+		{name: "valid until before valid from", limits: &pb.Listing_Coupon_Limits{ValidFrom: till, ValidUntil: from}},
+		{name: "negative minimum", limits: &pb.Listing_Coupon_Limits{BigMinimumOrderValue: "-1"}},
+		{name: "malformed minimum", limits: &pb.Listing_Coupon_Limits{BigMinimumOrderValue: "ten"}},
+	}
+	for _, e := range examples {
+		subject := factory.NewListing("coupon-limits")
+		subject.Coupons[0].Limits = e.limits
+		listing, err := repo.NewListingFromProtobuf(subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = listing.ValidateListing(true)
+		if e.valid && err != nil {
+			t.Errorf("%s: expected listing to be valid, got %s", e.name, err)
+		}
+		if !e.valid && err == nil {
+			t.Errorf("%s: expected listing to be invalid", e.name)
+		}
+	}
+}
+
+func TestListingCouponsKeepLimits(t *testing.T) {
+	subject := factory.NewListing("coupon-limits")
+	limits := &pb.Listing_Coupon_Limits{MaxRedemptions: 10, OncePerBuyer: true}
+	subject.Coupons[0].Limits = limits
+	listing, err := repo.NewListingFromProtobuf(subject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coupons, err := listing.GetCoupons()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(coupons[0].GetLimits(), limits) {
+		t.Errorf("expected coupon limits %v, got %v", limits, coupons[0].GetLimits())
+	}
+	if !proto.Equal(coupons.GetProtobuf()[0].Limits, limits) {
+		t.Errorf("expected coupon protobuf to keep limits %v", limits)
+	}
+}
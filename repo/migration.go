@@ -54,6 +54,24 @@ var (
 		migrations.Migration031{},
 		migrations.Migration032{},
 		migrations.Migration033{},
+		migrations.Migration034{},
+		migrations.Migration035{},
+		migrations.Migration036{},
+		migrations.Migration037{},
+		migrations.Migration038{},
+		migrations.Migration039{},
+		migrations.Migration040{},
+		migrations.Migration041{},
+		migrations.Migration042{},
+		migrations.Migration043{},
+		migrations.Migration044{},
+		migrations.Migration045{},
+		migrations.Migration046{},
+		migrations.Migration047{},
+		migrations.Migration048{},
+		migrations.Migration049{},
+		migrations.Migration050{},
+		migrations.Migration051{},
 	}
 )
 
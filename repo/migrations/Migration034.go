@@ -0,0 +1,83 @@
+package migrations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// Migration034 migrates the config file to add the S3-storage options used
+// by the S3-compatible offline message storage.
+type Migration034 struct{}
+
+func (Migration034) Up(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	configMap["S3-storage"] = map[string]interface{}{
+		"Endpoint":        "",
+		"Region":          "us-east-1",
+		"Bucket":          "",
+		"Prefix":          "",
+		"AccessKeyID":     "",
+		"SecretAccessKey": "",
+		"PathStyle":       false,
+		"PublicURL":       "",
+		"MessageExpiry":   "168h",
+	}
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 35); err != nil {
+		return fmt.Errorf("bumping repover to 35: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration034) Down(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	delete(configMap, "S3-storage")
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 34); err != nil {
+		return fmt.Errorf("dropping repover to 34: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+)
+
+const preMigration034Config = `{
+	"RepublishInterval": "24h"
+}`
+
+const postMigration034Config = `{
+	"RepublishInterval": "24h",
+	"S3-storage": {
+		"AccessKeyID": "",
+		"Bucket": "",
+		"Endpoint": "",
+		"MessageExpiry": "168h",
+		"PathStyle": false,
+		"Prefix": "",
+		"PublicURL": "",
+		"Region": "us-east-1",
+		"SecretAccessKey": ""
+	}
+}`
+
+func TestMigration034(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "34")
+	defer cleanup()
+	r.writeConfig(preMigration034Config)
+
+	var m migrations.Migration034
+	r.up(m, "35")
+	r.assertConfig(postMigration034Config)
+
+	r.down(m, "34")
+	r.assertConfig(preMigration034Config)
+}
@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+const (
+	// Migration035CreateWebhookDeliveriesSQL the webhook deliveries create sql
+	Migration035CreateWebhookDeliveriesSQL = "create table webhookdeliveries (deliveryID text primary key not null, url text, notificationType text, body blob, attempts integer, nextAttemptAt integer, lastError text, createdAt integer);"
+	// Migration035CreateIndexWebhookDeliveriesSQL the webhook deliveries index on nextAttemptAt sql
+	Migration035CreateIndexWebhookDeliveriesSQL = "create index index_webhookdeliveries on webhookdeliveries (nextAttemptAt);"
+	// Migration035DeleteWebhookDeliveriesSQL the webhook deliveries delete sql
+	Migration035DeleteWebhookDeliveriesSQL = "drop table if exists webhookdeliveries;"
+	// Migration035DeleteIndexWebhookDeliveriesSQL delete the webhook deliveries index sql
+	Migration035DeleteIndexWebhookDeliveriesSQL = "drop index if exists index_webhookdeliveries;"
+	// Migration035UpVer set the repo Up version
+	Migration035UpVer = "36"
+	// Migration035DownVer set the repo Down version
+	Migration035DownVer = "35"
+)
+
+// Migration035 creates the webhookdeliveries table used to persist
+// pending webhook notifications across restarts
+type Migration035 struct{}
+
+func migration035Exec(repoPath, databasePassword, rVer string, testnetEnabled bool, statements ...string) error {
+	var (
+		databaseFilePath    string
+		repoVersionFilePath = path.Join(repoPath, "repover")
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		if _, err = tx.Exec(stmt); err != nil {
+			err0 := tx.Rollback()
+			if err0 != nil {
+				log.Error(err0)
+			}
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	err = ioutil.WriteFile(repoVersionFilePath, []byte(rVer), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Up the migration Up code
+func (Migration035) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	return migration035Exec(repoPath, databasePassword, Migration035UpVer, testnetEnabled,
+		Migration035CreateWebhookDeliveriesSQL,
+		Migration035CreateIndexWebhookDeliveriesSQL,
+	)
+}
+
+// Down the migration Down code
+func (Migration035) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	return migration035Exec(repoPath, databasePassword, Migration035DownVer, testnetEnabled,
+		Migration035DeleteIndexWebhookDeliveriesSQL,
+		Migration035DeleteWebhookDeliveriesSQL,
+	)
+}
@@ -0,0 +1,22 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration035(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "35", schema.CreateTableNotificationsSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration035
+	r.up(m, "36")
+	r.assertColumns("webhookdeliveries", "deliveryID", "url", "notificationType", "body", "attempts", "nextAttemptAt", "lastError", "createdAt")
+	r.assertIndex("index_webhookdeliveries", "webhookdeliveries", "nextAttemptAt")
+
+	r.down(m, "35")
+	r.assertSchema(before)
+}
@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration036CreateOrderStateHistorySQL the order state history create sql
+	Migration036CreateOrderStateHistorySQL = "create table orderstatehistory (id integer primary key autoincrement, orderID text not null, role text not null, created bool, fromState integer, toState integer, cause text, timestamp integer);"
+	// Migration036CreateIndexOrderStateHistorySQL the order state history index on orderID sql
+	Migration036CreateIndexOrderStateHistorySQL = "create index index_orderstatehistory on orderstatehistory (orderID);"
+	// Migration036DeleteOrderStateHistorySQL the order state history delete sql
+	Migration036DeleteOrderStateHistorySQL = "drop table if exists orderstatehistory;"
+	// Migration036DeleteIndexOrderStateHistorySQL delete the order state history index sql
+	Migration036DeleteIndexOrderStateHistorySQL = "drop index if exists index_orderstatehistory;"
+)
+
+// Migration036 creates the orderstatehistory table which records every
+// order state transition
+type Migration036 struct{}
+
+var (
+	migration036UpVer   = 37
+	migration036DownVer = 36
+)
+
+func (Migration036) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration036CreateOrderStateHistorySQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration036CreateIndexOrderStateHistorySQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration036UpVer)
+}
+
+func (Migration036) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration036DeleteIndexOrderStateHistorySQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration036DeleteOrderStateHistorySQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration036DownVer)
+}
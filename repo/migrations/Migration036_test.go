@@ -0,0 +1,22 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration036(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "36", schema.CreateTablePurchasesSQL, schema.CreateTableSalesSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration036
+	r.up(m, "37")
+	r.assertColumns("orderstatehistory", "id", "orderID", "role", "created", "fromState", "toState", "cause", "timestamp")
+	r.assertIndex("index_orderstatehistory", "orderstatehistory", "orderID")
+
+	r.down(m, "36")
+	r.assertSchema(before)
+}
@@ -0,0 +1,215 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// Migration037CreateSearchIndexSQL the full-text search index create sql
+	Migration037CreateSearchIndexSQL = "create virtual table searchindex using fts5(scope unindexed, docID unindexed, subject unindexed, peerID unindexed, title, body, tags, handles);"
+	// Migration037DeleteSearchIndexSQL the full-text search index delete sql
+	Migration037DeleteSearchIndexSQL = "drop table if exists searchindex;"
+	// Migration037InsertSearchDocumentSQL adds a document to the search index
+	Migration037InsertSearchDocumentSQL = "insert into searchindex(scope, docID, subject, peerID, title, body, tags, handles) values(?,?,?,?,?,?,?,?);"
+)
+
+// ErrFTS5Unavailable is returned by Migration037 when SQLite was built without
+// the FTS5 extension the search index needs
+var ErrFTS5Unavailable = errors.New("the search index needs SQLite with FTS5, build openbazaard with CGO_CFLAGS=\"-DSQLITE_ENABLE_FTS5\"")
+
+// Migration037 creates the searchindex full-text table and indexes the
+// existing listings, sales, purchases, cases and chat messages
+type Migration037 struct{}
+
+var (
+	migration037UpVer   = 38
+	migration037DownVer = 37
+)
+
+type migration037Listing struct {
+	Listing struct {
+		Slug string `json:"slug"`
+		Item struct {
+			Title       string   `json:"title"`
+			Description string   `json:"description"`
+			Tags        []string `json:"tags"`
+			Categories  []string `json:"categories"`
+		} `json:"item"`
+	} `json:"listing"`
+}
+
+type migration037ID struct {
+	PeerID string `json:"peerID"`
+	Handle string `json:"handle"`
+}
+
+type migration037Contract struct {
+	VendorListings []struct {
+		VendorID migration037ID `json:"vendorID"`
+		Item     struct {
+			Title string `json:"title"`
+		} `json:"item"`
+	} `json:"vendorListings"`
+	BuyerOrder struct {
+		BuyerID  migration037ID `json:"buyerID"`
+		Shipping struct {
+			ShipTo string `json:"shipTo"`
+		} `json:"shipping"`
+	} `json:"buyerOrder"`
+}
+
+func (Migration037) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := migration037RequireFTS5(db); err != nil {
+		return err
+	}
+
+	listings, err := filepath.Glob(path.Join(repoPath, "root", "listings", "*.json"))
+	if err != nil {
+		return fmt.Errorf("finding listings: %s", err.Error())
+	}
+
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration037CreateSearchIndexSQL); err != nil {
+			return err
+		}
+		insert, err := tx.Prepare(Migration037InsertSearchDocumentSQL)
+		if err != nil {
+			return err
+		}
+		defer insert.Close()
+
+		for _, p := range listings {
+			b, err := ioutil.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			var l migration037Listing
+			if err := json.Unmarshal(b, &l); err != nil {
+				log.Warningf("migration037: skipping unreadable listing %s: %s", p, err.Error())
+				continue
+			}
+			tags := strings.Join(append(l.Listing.Item.Tags, l.Listing.Item.Categories...), " ")
+			if _, err := insert.Exec("listings", l.Listing.Slug, "", "", l.Listing.Item.Title, l.Listing.Item.Description, tags, ""); err != nil {
+				return err
+			}
+		}
+
+		for _, order := range []struct{ scope, query string }{
+			{"sales", "select orderID, buyerID, buyerHandle, title, shippingName from sales"},
+			{"purchases", "select orderID, vendorID, vendorHandle, title, shippingName from purchases"},
+		} {
+			if err := migration037IndexRows(tx, order.query, func(rows *sql.Rows) error {
+				var id, peerID, handle, title, shipTo sql.NullString
+				if err := rows.Scan(&id, &peerID, &handle, &title, &shipTo); err != nil {
+					return err
+				}
+				handles := strings.TrimSpace(handle.String + " " + peerID.String)
+				_, err := insert.Exec(order.scope, id.String, "", peerID.String, title.String, shipTo.String, "", handles)
+				return err
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := migration037IndexRows(tx, "select caseID, claim, buyerContract, vendorContract from cases", func(rows *sql.Rows) error {
+			var id, claim, buyerContract, vendorContract sql.NullString
+			if err := rows.Scan(&id, &claim, &buyerContract, &vendorContract); err != nil {
+				return err
+			}
+			var title string
+			body := []string{claim.String}
+			var handles []string
+			for _, s := range []string{buyerContract.String, vendorContract.String} {
+				if s == "" {
+					continue
+				}
+				var c migration037Contract
+				if err := json.Unmarshal([]byte(s), &c); err != nil {
+					log.Warningf("migration037: skipping unreadable contract for case %s: %s", id.String, err.Error())
+					continue
+				}
+				if title == "" && len(c.VendorListings) > 0 {
+					title = c.VendorListings[0].Item.Title
+				}
+				if c.BuyerOrder.Shipping.ShipTo != "" {
+					body = append(body, c.BuyerOrder.Shipping.ShipTo)
+				}
+				handles = append(handles, c.BuyerOrder.BuyerID.Handle, c.BuyerOrder.BuyerID.PeerID)
+				if len(c.VendorListings) > 0 {
+					handles = append(handles, c.VendorListings[0].VendorID.Handle, c.VendorListings[0].VendorID.PeerID)
+				}
+			}
+			_, err := insert.Exec("cases", id.String, "", "", title, strings.Join(body, " "), "", strings.Join(strings.Fields(strings.Join(handles, " ")), " "))
+			return err
+		}); err != nil {
+			return err
+		}
+
+		return migration037IndexRows(tx, "select messageID, peerID, subject, message from chat", func(rows *sql.Rows) error {
+			var id, peerID, subject, message sql.NullString
+			if err := rows.Scan(&id, &peerID, &subject, &message); err != nil {
+				return err
+			}
+			_, err := insert.Exec("chats", id.String, subject.String, peerID.String, "", message.String, "", "")
+			return err
+		})
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration037UpVer)
+}
+
+func (Migration037) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration037DeleteSearchIndexSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration037DownVer)
+}
+
+// migration037RequireFTS5 returns ErrFTS5Unavailable if SQLite was built
+// without FTS5
+func migration037RequireFTS5(db *sql.DB) error {
+	var enabled bool
+	if err := db.QueryRow("select sqlite_compileoption_used('ENABLE_FTS5');").Scan(&enabled); err != nil {
+		return fmt.Errorf("checking for FTS5: %s", err.Error())
+	}
+	if !enabled {
+		return ErrFTS5Unavailable
+	}
+	return nil
+}
+
+// migration037IndexRows calls index for each row returned by the query
+func migration037IndexRows(tx *sql.Tx, query string, index func(*sql.Rows) error) error {
+	rows, err := tx.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := index(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,63 @@
+package migrations_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+)
+
+func TestMigration037(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "37",
+		"create table sales (orderID text primary key not null, buyerID text, buyerHandle text, title text, shippingName text);",
+		"create table purchases (orderID text primary key not null, vendorID text, vendorHandle text, title text, shippingName text);",
+		"create table cases (caseID text primary key not null, claim text, buyerContract blob, vendorContract blob);",
+		"create table chat (messageID text primary key not null, peerID text, subject text, message text);",
+		"insert into sales values ('sale1', 'QmBuyer', 'alice', 'Vintage Camera', 'Alice Smith');",
+		"insert into purchases values ('purchase1', 'QmVendor', 'bob', 'Wool Socks', 'Me');",
+		`insert into cases values ('case1', 'never arrived', '{"buyerOrder": {"buyerID": {"peerID": "QmCaseBuyer", "handle": "carol"}}, "vendorListings": [{"vendorID": {"peerID": "QmCaseVendor", "handle": "dave"}, "item": {"title": "Teapot"}}]}', null);`,
+		"insert into chat values ('msg1', 'QmFriend', '', 'see you at the lighthouse');",
+	)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var (
+		listingsPath = r.pathJoin("root", "listings")
+		listingJSON  = `{"listing": {"slug": "red-bicycle", "item": {"title": "Red Bicycle", "description": "A fast bike", "tags": ["cycling"], "categories": ["sports"]}}}`
+		searchSQL    = "select scope, docID from searchindex where searchindex match ?;"
+		expectedHits = map[string]string{
+			"cycling":    "listings:red-bicycle",
+			"alice":      "sales:sale1",
+			"socks":      "purchases:purchase1",
+			"dave":       "cases:case1",
+			"arrived":    "cases:case1",
+			"lighthouse": "chats:msg1",
+		}
+	)
+	if err := os.MkdirAll(listingsPath, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(listingsPath, "red-bicycle.json"), []byte(listingJSON), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	var m migrations.Migration037
+	r.up(m, "38")
+	r.assertColumns("searchindex", "scope", "docID", "subject", "peerID", "title", "body", "tags", "handles")
+
+	// verify existing records were indexed
+	for term, expected := range expectedHits {
+		var scope, id string
+		if err := r.db.QueryRow(searchSQL, term).Scan(&scope, &id); err != nil {
+			t.Fatalf("searching %q: %s", term, err)
+		}
+		if scope+":"+id != expected {
+			t.Errorf("expected %q to match %s, got %s:%s", term, expected, scope, id)
+		}
+	}
+
+	r.down(m, "37")
+	r.assertSchema(before)
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration038CreateListingSchedulesSQL the listing schedules create sql
+	Migration038CreateListingSchedulesSQL = "create table listingschedules (slug text primary key not null, publishAt integer, republishHours integer, state text not null, updatedAt integer);"
+	// Migration038DeleteListingSchedulesSQL the listing schedules delete sql
+	Migration038DeleteListingSchedulesSQL = "drop table if exists listingschedules;"
+)
+
+// Migration038 creates the listingschedules table which holds scheduled
+// publication and expiry handling for the node's listings
+type Migration038 struct{}
+
+var (
+	migration038UpVer   = 39
+	migration038DownVer = 38
+)
+
+func (Migration038) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration038CreateListingSchedulesSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration038UpVer)
+}
+
+func (Migration038) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration038DeleteListingSchedulesSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration038DownVer)
+}
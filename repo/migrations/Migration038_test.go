@@ -0,0 +1,21 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration038(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "38", schema.CreateTableInventorySQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration038
+	r.up(m, "39")
+	r.assertColumns("listingschedules", "slug", "publishAt", "republishHours", "state", "updatedAt")
+
+	r.down(m, "38")
+	r.assertSchema(before)
+}
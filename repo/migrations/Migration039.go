@@ -0,0 +1,74 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration039CreateInventoryReservationsSQL the inventory reservations create sql
+	Migration039CreateInventoryReservationsSQL = "create table inventoryreservations (orderID text not null, slug text not null, variantIndex integer not null, quantity text not null, timestamp integer, primary key (orderID, slug, variantIndex));"
+	// Migration039CreateInventoryReservationsIndexSQL the inventory reservations create index sql
+	Migration039CreateInventoryReservationsIndexSQL = "create index index_inventoryreservations on inventoryreservations (slug, variantIndex);"
+	// Migration039CreateInventoryThresholdsSQL the inventory thresholds create sql
+	Migration039CreateInventoryThresholdsSQL = "create table inventorythresholds (slug text primary key not null, threshold text not null);"
+	// Migration039DeleteInventoryReservationsSQL the inventory reservations delete sql
+	Migration039DeleteInventoryReservationsSQL = "drop table if exists inventoryreservations;"
+	// Migration039DeleteInventoryThresholdsSQL the inventory thresholds delete sql
+	Migration039DeleteInventoryThresholdsSQL = "drop table if exists inventorythresholds;"
+)
+
+// Migration039 creates the inventoryreservations table which holds stock for
+// unfunded orders and the inventorythresholds table which holds the low stock
+// threshold of each listing
+type Migration039 struct{}
+
+var (
+	migration039UpVer   = 40
+	migration039DownVer = 39
+)
+
+func (Migration039) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{
+			Migration039CreateInventoryReservationsSQL,
+			Migration039CreateInventoryReservationsIndexSQL,
+			Migration039CreateInventoryThresholdsSQL,
+		} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration039UpVer)
+}
+
+func (Migration039) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{
+			Migration039DeleteInventoryReservationsSQL,
+			Migration039DeleteInventoryThresholdsSQL,
+		} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration039DownVer)
+}
@@ -0,0 +1,35 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration039(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "39", schema.CreateTableInventorySQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration039
+	r.up(m, "40")
+	r.assertColumns("inventoryreservations", "orderID", "slug", "variantIndex", "quantity", "timestamp")
+	r.assertColumns("inventorythresholds", "slug", "threshold")
+	r.assertIndex("index_inventoryreservations", "inventoryreservations", "slug", "variantIndex")
+
+	// An order holds one reservation for each variant of a listing
+	insertSQL := "insert into inventoryreservations (orderID, slug, variantIndex, quantity, timestamp) values ('order1', 'socks', ?, '1', 0);"
+	if _, err := r.db.Exec(insertSQL, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, 0); err == nil {
+		t.Error("expected a second reservation of the same variant for the order to be rejected")
+	}
+
+	r.down(m, "39")
+	r.assertSchema(before)
+}
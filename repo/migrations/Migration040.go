@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration040CreateAPIUsersSQL the API users create sql
+	Migration040CreateAPIUsersSQL = "create table apiusers (username text primary key not null, passwordHash text not null, role text not null, created integer);"
+	// Migration040DeleteAPIUsersSQL the API users delete sql
+	Migration040DeleteAPIUsersSQL = "drop table if exists apiusers;"
+)
+
+// Migration040 creates the apiusers table which holds the named users of the
+// JSON API and their roles
+type Migration040 struct{}
+
+var (
+	migration040UpVer   = 41
+	migration040DownVer = 40
+)
+
+func (Migration040) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration040CreateAPIUsersSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration040UpVer)
+}
+
+func (Migration040) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration040DeleteAPIUsersSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration040DownVer)
+}
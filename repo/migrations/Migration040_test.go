@@ -0,0 +1,30 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration040(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "40", schema.CreateTableConfigSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration040
+	r.up(m, "41")
+	r.assertColumns("apiusers", "username", "passwordHash", "role", "created")
+
+	// Usernames are unique
+	insertSQL := "insert into apiusers (username, passwordHash, role, created) values ('alice', 'hash', ?, 0);"
+	if _, err := r.db.Exec(insertSQL, "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "viewer"); err == nil {
+		t.Error("expected a second user with the same username to be rejected")
+	}
+
+	r.down(m, "40")
+	r.assertSchema(before)
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration041CreateAPITokensSQL the API tokens create sql
+	Migration041CreateAPITokensSQL = "create table apitokens (id text primary key not null, name text, tokenHash text unique not null, scopes text not null, created integer, expires integer, revoked integer);"
+	// Migration041DeleteAPITokensSQL the API tokens delete sql
+	Migration041DeleteAPITokensSQL = "drop table if exists apitokens;"
+)
+
+// Migration041 creates the apitokens table which holds the hashed, scoped
+// bearer tokens of the JSON API
+type Migration041 struct{}
+
+var (
+	migration041UpVer   = 42
+	migration041DownVer = 41
+)
+
+func (Migration041) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration041CreateAPITokensSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration041UpVer)
+}
+
+func (Migration041) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration041DeleteAPITokensSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration041DownVer)
+}
@@ -0,0 +1,30 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration041(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "41", schema.CreateTableConfigSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration041
+	r.up(m, "42")
+	r.assertColumns("apitokens", "id", "name", "tokenHash", "scopes", "created", "expires", "revoked")
+
+	// A token hash identifies one token
+	insertSQL := "insert into apitokens (id, name, tokenHash, scopes, created, expires, revoked) values (?, 'ci', 'hash', 'read', 0, 0, 0);"
+	if _, err := r.db.Exec(insertSQL, "token1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "token2"); err == nil {
+		t.Error("expected a second token with the same hash to be rejected")
+	}
+
+	r.down(m, "41")
+	r.assertSchema(before)
+}
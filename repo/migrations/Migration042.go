@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration042CreateSubscriptionsSQL the subscriptions create sql
+	Migration042CreateSubscriptionsSQL = "create table subscriptions (id text primary key not null, vendorID text, listingHash text not null, title text, period integer, purchase blob, spendCap text, feeLevel text, state text not null, nextRenewal integer, lastOrderID text, failures integer, created integer);"
+	// Migration042DeleteSubscriptionsSQL the subscriptions delete sql
+	Migration042DeleteSubscriptionsSQL = "drop table if exists subscriptions;"
+)
+
+// Migration042 creates the subscriptions table which holds the buyer's
+// recurring orders for service listings
+type Migration042 struct{}
+
+var (
+	migration042UpVer   = 43
+	migration042DownVer = 42
+)
+
+func (Migration042) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration042CreateSubscriptionsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration042UpVer)
+}
+
+func (Migration042) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration042DeleteSubscriptionsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration042DownVer)
+}
@@ -0,0 +1,21 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration042(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "42", schema.CreateTablePurchasesSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration042
+	r.up(m, "43")
+	r.assertColumns("subscriptions", "id", "vendorID", "listingHash", "title", "period", "purchase", "spendCap", "feeLevel", "state", "nextRenewal", "lastOrderID", "failures", "created")
+
+	r.down(m, "42")
+	r.assertSchema(before)
+}
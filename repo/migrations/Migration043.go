@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration043CreateCrowdFundPledgesSQL the crowdfund pledges create sql
+	Migration043CreateCrowdFundPledgesSQL = "create table crowdfundpledges (orderID text primary key not null, slug text not null, buyerID text, amount text not null, currency text, state text not null, timestamp integer);"
+	// Migration043DeleteCrowdFundPledgesSQL the crowdfund pledges delete sql
+	Migration043DeleteCrowdFundPledgesSQL = "drop table if exists crowdfundpledges;"
+)
+
+// Migration043 creates the crowdfundpledges table which holds the pledges
+// the vendor has received for crowdfunding listings
+type Migration043 struct{}
+
+var (
+	migration043UpVer   = 44
+	migration043DownVer = 43
+)
+
+func (Migration043) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration043CreateCrowdFundPledgesSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration043UpVer)
+}
+
+func (Migration043) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration043DeleteCrowdFundPledgesSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration043DownVer)
+}
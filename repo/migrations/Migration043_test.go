@@ -0,0 +1,21 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration043(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "43", schema.CreateTableSalesSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration043
+	r.up(m, "44")
+	r.assertColumns("crowdfundpledges", "orderID", "slug", "buyerID", "amount", "currency", "state", "timestamp")
+
+	r.down(m, "43")
+	r.assertSchema(before)
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration044CreateCouponRedemptionsSQL the coupon redemptions create sql
+	Migration044CreateCouponRedemptionsSQL = "create table couponredemptions (orderID text not null, slug text not null, hash text not null, buyerID text, funded integer, timestamp integer, primary key (orderID, hash));"
+	// Migration044CreateCouponRedemptionsIndexSQL the coupon redemptions index create sql
+	Migration044CreateCouponRedemptionsIndexSQL = "create index index_couponredemptions on couponredemptions (slug, hash);"
+	// Migration044DeleteCouponRedemptionsSQL the coupon redemptions delete sql
+	Migration044DeleteCouponRedemptionsSQL = "drop table if exists couponredemptions;"
+)
+
+// Migration044 creates the couponredemptions table which counts the orders
+// each coupon was applied to so its redemption limits can be enforced
+type Migration044 struct{}
+
+var (
+	migration044UpVer   = 45
+	migration044DownVer = 44
+)
+
+func (Migration044) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration044CreateCouponRedemptionsSQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration044CreateCouponRedemptionsIndexSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration044UpVer)
+}
+
+func (Migration044) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration044DeleteCouponRedemptionsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration044DownVer)
+}
@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration044(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "44", schema.CreateTableCouponsSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration044
+	r.up(m, "45")
+	r.assertColumns("couponredemptions", "orderID", "slug", "hash", "buyerID", "funded", "timestamp")
+	r.assertIndex("index_couponredemptions", "couponredemptions", "slug", "hash")
+
+	// A coupon is redeemed once by each order
+	insertSQL := "insert into couponredemptions (orderID, slug, hash, buyerID, funded, timestamp) values ('order1', 'socks', ?, 'QmBuyer', 0, 0);"
+	if _, err := r.db.Exec(insertSQL, "hashOne"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "hashTwo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "hashOne"); err == nil {
+		t.Error("expected a second redemption of the coupon by the order to be rejected")
+	}
+
+	r.down(m, "44")
+	r.assertSchema(before)
+}
@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// Migration045 migrates the config file to add the RateLimits options which
+// limit the messages each peer may send the node.
+type Migration045 struct{}
+
+func (Migration045) Up(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	configMap["RateLimits"] = map[string]interface{}{
+		"Enabled":     true,
+		"BanDuration": "24h",
+		"BanAfter":    100,
+		"Chat":        map[string]interface{}{"PerMinute": 30, "Burst": 60},
+		"Follow":      map[string]interface{}{"PerMinute": 5, "Burst": 10},
+		"Store":       map[string]interface{}{"PerMinute": 600, "Burst": 2000},
+	}
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 46); err != nil {
+		return fmt.Errorf("bumping repover to 46: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration045) Down(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	delete(configMap, "RateLimits")
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 45); err != nil {
+		return fmt.Errorf("dropping repover to 45: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+)
+
+const preMigration045Config = `{
+	"RepublishInterval": "24h"
+}`
+
+const postMigration045Config = `{
+	"RateLimits": {
+		"BanAfter": 100,
+		"BanDuration": "24h",
+		"Chat": {"Burst": 60, "PerMinute": 30},
+		"Enabled": true,
+		"Follow": {"Burst": 10, "PerMinute": 5},
+		"Store": {"Burst": 2000, "PerMinute": 600}
+	},
+	"RepublishInterval": "24h"
+}`
+
+func TestMigration045(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "45")
+	defer cleanup()
+	r.writeConfig(preMigration045Config)
+
+	var m migrations.Migration045
+	r.up(m, "46")
+	r.assertConfig(postMigration045Config)
+
+	r.down(m, "45")
+	r.assertConfig(preMigration045Config)
+}
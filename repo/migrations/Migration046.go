@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration046CreatePeerBansSQL the peer bans create sql
+	Migration046CreatePeerBansSQL = "create table peerbans (peerID text primary key not null, reason text, created integer, expires integer);"
+	// Migration046DeletePeerBansSQL the peer bans delete sql
+	Migration046DeletePeerBansSQL = "drop table if exists peerbans;"
+)
+
+// Migration046 creates the peerbans table which holds the temporary bans
+// placed on peers which exceeded a rate limit
+type Migration046 struct{}
+
+var (
+	migration046UpVer   = 47
+	migration046DownVer = 46
+)
+
+func (Migration046) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration046CreatePeerBansSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration046UpVer)
+}
+
+func (Migration046) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration046DeletePeerBansSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration046DownVer)
+}
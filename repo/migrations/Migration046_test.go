@@ -0,0 +1,21 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration046(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "46", schema.CreateTableConfigSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration046
+	r.up(m, "47")
+	r.assertColumns("peerbans", "peerID", "reason", "created", "expires")
+
+	r.down(m, "46")
+	r.assertSchema(before)
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration047CreateDisputeEvidenceSQL the dispute evidence create sql
+	Migration047CreateDisputeEvidenceSQL = "create table disputeevidence (id text primary key not null, caseID text not null, peerID text not null, evidence blob not null, timestamp integer);"
+	// Migration047CreateDisputeEvidenceIndexSQL the dispute evidence index create sql
+	Migration047CreateDisputeEvidenceIndexSQL = "create index index_disputeevidence on disputeevidence (caseID);"
+	// Migration047DeleteDisputeEvidenceSQL the dispute evidence delete sql
+	Migration047DeleteDisputeEvidenceSQL = "drop table if exists disputeevidence;"
+)
+
+// Migration047 creates the disputeevidence table which holds the signed
+// evidence buyers and vendors submit to the moderator of their dispute
+type Migration047 struct{}
+
+var (
+	migration047UpVer   = 48
+	migration047DownVer = 47
+)
+
+func (Migration047) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration047CreateDisputeEvidenceSQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration047CreateDisputeEvidenceIndexSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration047UpVer)
+}
+
+func (Migration047) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration047DeleteDisputeEvidenceSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration047DownVer)
+}
@@ -0,0 +1,22 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration047(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "47", schema.CreateTableDisputedCasesSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration047
+	r.up(m, "48")
+	r.assertColumns("disputeevidence", "id", "caseID", "peerID", "evidence", "timestamp")
+	r.assertIndex("index_disputeevidence", "disputeevidence", "caseID")
+
+	r.down(m, "47")
+	r.assertSchema(before)
+}
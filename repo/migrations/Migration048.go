@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// Migration048 migrates the config file to add the Backups options for the
+// scheduled backup worker.
+type Migration048 struct{}
+
+func (Migration048) Up(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	configMap["Backups"] = map[string]interface{}{
+		"Enabled":   false,
+		"Directory": "",
+		"Interval":  "24h",
+		"Keep":      7,
+	}
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 49); err != nil {
+		return fmt.Errorf("bumping repover to 49: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration048) Down(repoPath, dbPassword string, testnet bool) error {
+	var (
+		configMap        = map[string]interface{}{}
+		configBytes, err = ioutil.ReadFile(path.Join(repoPath, "config"))
+	)
+	if err != nil {
+		return fmt.Errorf("reading config: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(configBytes, &configMap); err != nil {
+		return fmt.Errorf("unmarshal config: %s", err.Error())
+	}
+
+	delete(configMap, "Backups")
+
+	newConfigBytes, err := json.MarshalIndent(configMap, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal migrated config: %s", err.Error())
+	}
+
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), newConfigBytes, os.ModePerm); err != nil {
+		return fmt.Errorf("writing migrated config: %s", err.Error())
+	}
+
+	if err := writeRepoVer(repoPath, 48); err != nil {
+		return fmt.Errorf("dropping repover to 48: %s", err.Error())
+	}
+	return nil
+}
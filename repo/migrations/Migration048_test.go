@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+)
+
+const preMigration048Config = `{
+	"RepublishInterval": "24h"
+}`
+
+const postMigration048Config = `{
+	"Backups": {
+		"Directory": "",
+		"Enabled": false,
+		"Interval": "24h",
+		"Keep": 7
+	},
+	"RepublishInterval": "24h"
+}`
+
+func TestMigration048(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "48")
+	defer cleanup()
+	r.writeConfig(preMigration048Config)
+
+	var m migrations.Migration048
+	r.up(m, "49")
+	r.assertConfig(postMigration048Config)
+
+	r.down(m, "48")
+	r.assertConfig(preMigration048Config)
+}
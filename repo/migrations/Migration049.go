@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration049CreateFeedPostsSQL the feed posts create sql
+	Migration049CreateFeedPostsSQL = "create table feedposts (hash text primary key not null, peerID text not null, tags text not null, channels text not null, post blob not null, timestamp integer);"
+	// Migration049CreateFeedPostsIndexSQL the feed posts index create sql
+	Migration049CreateFeedPostsIndexSQL = "create index index_feedposts on feedposts (timestamp, hash);"
+	// Migration049DeleteFeedPostsSQL the feed posts delete sql
+	Migration049DeleteFeedPostsSQL = "drop table if exists feedposts;"
+)
+
+// Migration049 creates the feedposts table which caches the posts of the
+// peers the node follows
+type Migration049 struct{}
+
+var (
+	migration049UpVer   = 50
+	migration049DownVer = 49
+)
+
+func (Migration049) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration049CreateFeedPostsSQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration049CreateFeedPostsIndexSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration049UpVer)
+}
+
+func (Migration049) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration049DeleteFeedPostsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration049DownVer)
+}
@@ -0,0 +1,22 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration049(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "49", schema.CreateTableFollowingSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration049
+	r.up(m, "50")
+	r.assertColumns("feedposts", "hash", "peerID", "tags", "channels", "post", "timestamp")
+	r.assertIndex("index_feedposts", "feedposts", "timestamp", "hash")
+
+	r.down(m, "49")
+	r.assertSchema(before)
+}
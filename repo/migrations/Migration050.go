@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration050CreateAnnouncementSubscriptionsSQL the announcement subscriptions create sql
+	Migration050CreateAnnouncementSubscriptionsSQL = "create table announcementsubscriptions (kind text not null, name text not null, timestamp integer, primary key (kind, name));"
+	// Migration050DeleteAnnouncementSubscriptionsSQL the announcement subscriptions delete sql
+	Migration050DeleteAnnouncementSubscriptionsSQL = "drop table if exists announcementsubscriptions;"
+)
+
+// Migration050 creates the announcementsubscriptions table which holds the
+// channels and peers whose pubsub announcements the node listens for
+type Migration050 struct{}
+
+var (
+	migration050UpVer   = 51
+	migration050DownVer = 50
+)
+
+func (Migration050) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration050CreateAnnouncementSubscriptionsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration050UpVer)
+}
+
+func (Migration050) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration050DeleteAnnouncementSubscriptionsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration050DownVer)
+}
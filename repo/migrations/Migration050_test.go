@@ -0,0 +1,33 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration050(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "50", schema.CreateTableFollowingSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration050
+	r.up(m, "51")
+	r.assertColumns("announcementsubscriptions", "kind", "name", "timestamp")
+
+	// A channel or peer is subscribed to once
+	insertSQL := "insert into announcementsubscriptions (kind, name, timestamp) values (?, 'crafts', 0);"
+	if _, err := r.db.Exec(insertSQL, "channel"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "peer"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "channel"); err == nil {
+		t.Error("expected a second subscription to the channel to be rejected")
+	}
+
+	r.down(m, "50")
+	r.assertSchema(before)
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// Migration051CreateChatAttachmentsSQL the chat attachments create sql
+	Migration051CreateChatAttachmentsSQL = "create table chatattachments (messageID text not null, cid text not null, mimeType text, size integer, filename text, encrypted integer, fetched integer, primary key (messageID, cid));"
+	// Migration051CreateChatAttachmentsIndexSQL the chat attachments index create sql
+	Migration051CreateChatAttachmentsIndexSQL = "create index index_chatattachments on chatattachments (cid);"
+	// Migration051DeleteChatAttachmentsSQL the chat attachments delete sql
+	Migration051DeleteChatAttachmentsSQL = "drop table if exists chatattachments;"
+)
+
+// Migration051 creates the chatattachments table which describes the files
+// attached to chat messages
+type Migration051 struct{}
+
+var (
+	migration051UpVer   = 52
+	migration051DownVer = 51
+)
+
+func (Migration051) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		if _, err := tx.Exec(Migration051CreateChatAttachmentsSQL); err != nil {
+			return err
+		}
+		_, err := tx.Exec(Migration051CreateChatAttachmentsIndexSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration051UpVer)
+}
+
+func (Migration051) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(Migration051DeleteChatAttachmentsSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+	return writeRepoVer(repoPath, migration051DownVer)
+}
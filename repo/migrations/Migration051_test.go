@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration051(t *testing.T) {
+	r, cleanup := newMigrationTestRepo(t, "51", schema.CreateTableChatSQL)
+	defer cleanup()
+	before := r.schemaSQL()
+
+	var m migrations.Migration051
+	r.up(m, "52")
+	r.assertColumns("chatattachments", "messageID", "cid", "mimeType", "size", "filename", "encrypted", "fetched")
+	r.assertIndex("index_chatattachments", "chatattachments", "cid")
+
+	// A file is attached to a message once
+	insertSQL := "insert into chatattachments (messageID, cid, mimeType, size, filename, encrypted, fetched) values (?, 'QmFile', 'image/png', 10, 'a.png', 1, 0);"
+	if _, err := r.db.Exec(insertSQL, "msg1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "msg2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.db.Exec(insertSQL, "msg1"); err == nil {
+		t.Error("expected the file to be attached to the message once")
+	}
+
+	r.down(m, "51")
+	r.assertSchema(before)
+}
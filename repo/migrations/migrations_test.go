@@ -1,8 +1,16 @@
 package migrations_test
 
 import (
+	"database/sql"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
 )
 
 func assertCorrectRepoVer(t *testing.T, verPath, expectedRepoVer string) {
@@ -24,3 +32,181 @@ func assertCorrectFileContents(t *testing.T, filePath string, expectedFileConten
 		t.Fatal("Incorrect file content:", filePath)
 	}
 }
+
+const migrationTestPassword = "foobarbaz"
+
+type migration interface {
+	Up(repoPath string, dbPassword string, testnet bool) error
+	Down(repoPath string, dbPassword string, testnet bool) error
+}
+
+// migrationTestRepo is a testnet repo with an encrypted database which a
+// migration is run against
+type migrationTestRepo struct {
+	t    *testing.T
+	path string
+	db   *sql.DB
+}
+
+// newMigrationTestRepo builds a repo at the version and runs the setup
+// statements against its database. The returned function removes the repo.
+func newMigrationTestRepo(t *testing.T, repoVer string, setupSQL ...string) (*migrationTestRepo, func()) {
+	repoPath, err := schema.OpenbazaarPathTransform(schema.GenerateTempPath(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: repoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(appSchema.DataPathJoin("repover"), []byte(repoVer), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The key only applies to the connection it's set on
+	db.SetMaxOpenConns(1)
+	for _, s := range append([]string{"pragma key = '" + migrationTestPassword + "';"}, setupSQL...) {
+		if _, err = db.Exec(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := &migrationTestRepo{t: t, path: repoPath, db: db}
+	return r, func() {
+		db.Close()
+		appSchema.DestroySchemaDirectories()
+	}
+}
+
+func (r *migrationTestRepo) pathJoin(paths ...string) string {
+	return filepath.Join(append([]string{r.path}, paths...)...)
+}
+
+// up runs the migration up and checks it bumped the repo version
+func (r *migrationTestRepo) up(m migration, expectedRepoVer string) {
+	if err := m.Up(r.path, migrationTestPassword, true); err != nil {
+		r.t.Fatal(err)
+	}
+	assertCorrectRepoVer(r.t, r.pathJoin("repover"), expectedRepoVer)
+}
+
+// down runs the migration down and checks it dropped the repo version
+func (r *migrationTestRepo) down(m migration, expectedRepoVer string) {
+	if err := m.Down(r.path, migrationTestPassword, true); err != nil {
+		r.t.Fatal(err)
+	}
+	assertCorrectRepoVer(r.t, r.pathJoin("repover"), expectedRepoVer)
+}
+
+// writeConfig replaces the repo's config file
+func (r *migrationTestRepo) writeConfig(config string) {
+	if err := ioutil.WriteFile(r.pathJoin("config"), []byte(config), os.ModePerm); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+// assertConfig checks the repo's config file matches the expected config
+// ignoring whitespace
+func (r *migrationTestRepo) assertConfig(expected string) {
+	configBytes, err := ioutil.ReadFile(r.pathJoin("config"))
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	re := regexp.MustCompile(`\s`)
+	if re.ReplaceAllString(string(configBytes), "") != re.ReplaceAllString(expected, "") {
+		r.t.Logf("actual: %s", re.ReplaceAllString(string(configBytes), ""))
+		r.t.Fatal("incorrect migrated config")
+	}
+}
+
+// schemaSQL returns the statements which created each table and index of the
+// database, so the schema before a migration can be compared with the
+// schema after the migration is reverted. SQLite's own tables are left out
+// as they can't be dropped.
+func (r *migrationTestRepo) schemaSQL() []string {
+	rows, err := r.db.Query("select sql from sqlite_master where sql is not null and substr(name, 1, 7) != 'sqlite_' order by name;")
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	defer rows.Close()
+	var statements []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			r.t.Fatal(err)
+		}
+		statements = append(statements, s)
+	}
+	if err := rows.Err(); err != nil {
+		r.t.Fatal(err)
+	}
+	return statements
+}
+
+// assertSchema checks the database schema is the expected schema
+func (r *migrationTestRepo) assertSchema(expected []string) {
+	if actual := r.schemaSQL(); !reflect.DeepEqual(actual, expected) {
+		r.t.Fatalf("expected schema:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+}
+
+// pragmaNames returns the name column of a table_info or index_info pragma
+func (r *migrationTestRepo) pragmaNames(pragma string) []string {
+	rows, err := r.db.Query(pragma)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	var names []string
+	for rows.Next() {
+		values := make([]interface{}, len(cols))
+		for i := range values {
+			values[i] = new(sql.RawBytes)
+		}
+		if err := rows.Scan(values...); err != nil {
+			r.t.Fatal(err)
+		}
+		for i, c := range cols {
+			if c == "name" {
+				names = append(names, string(*values[i].(*sql.RawBytes)))
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		r.t.Fatal(err)
+	}
+	return names
+}
+
+// assertColumns checks the table has exactly the columns in order
+func (r *migrationTestRepo) assertColumns(table string, columns ...string) {
+	if actual := r.pragmaNames("pragma table_info(" + table + ");"); !reflect.DeepEqual(actual, columns) {
+		r.t.Errorf("expected %s to have columns %v, got %v", table, columns, actual)
+	}
+}
+
+// assertIndex checks the index exists on the table and covers the columns
+// in order
+func (r *migrationTestRepo) assertIndex(index, table string, columns ...string) {
+	var indexTable string
+	if err := r.db.QueryRow("select tbl_name from sqlite_master where type = 'index' and name = ?;", index).Scan(&indexTable); err != nil {
+		r.t.Errorf("expected index %s: %s", index, err)
+		return
+	}
+	if indexTable != table {
+		r.t.Errorf("expected index %s to be on %s, got %s", index, table, indexTable)
+	}
+	if actual := r.pragmaNames("pragma index_info(" + index + ");"); !reflect.DeepEqual(actual, columns) {
+		r.t.Errorf("expected index %s to cover %v, got %v", index, columns, actual)
+	}
+}
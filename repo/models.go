@@ -17,6 +17,7 @@ type SettingsData struct {
 	StoreModerators     *[]string          `json:"storeModerators"`
 	MisPaymentBuffer    *float32           `json:"mispaymentBuffer"`
 	SMTPSettings        *SMTPSettings      `json:"smtpSettings"`
+	WebhookSettings     *WebhookSettings   `json:"webhookSettings"`
 	Version             *string            `json:"version"`
 	PreferredCurrencies *[]string          `json:"preferredCurrencies"`
 }
@@ -43,6 +44,32 @@ type SMTPSettings struct {
 	OpenBazaarName string `json:"openBazaarName"`
 }
 
+type WebhookSettings struct {
+	Notifications bool              `json:"notifications"`
+	Endpoints     []WebhookEndpoint `json:"endpoints"`
+}
+
+// WebhookEndpoint is a URL which receives notifications as signed JSON. If
+// Types is empty every notification type is delivered.
+type WebhookEndpoint struct {
+	URL    string             `json:"url"`
+	Secret string             `json:"secret"`
+	Types  []NotificationType `json:"types"`
+}
+
+// WebhookDelivery is a pending webhook request persisted until it succeeds
+// or runs out of attempts
+type WebhookDelivery struct {
+	ID               string
+	URL              string
+	NotificationType NotificationType
+	Body             []byte
+	Attempts         int
+	NextAttemptAt    time.Time
+	LastError        string
+	CreatedAt        time.Time
+}
+
 type Follower struct {
 	PeerId string `json:"peerId"`
 	Proof  []byte `json:"proof"`
@@ -54,6 +81,18 @@ type Coupon struct {
 	Hash string
 }
 
+// CouponRedemption records a coupon applied to an order the vendor received.
+// Redemptions on orders which were never funded stop counting towards the
+// coupon's limits once the order's inventory reservation would have expired.
+type CouponRedemption struct {
+	OrderID   string
+	Slug      string
+	Hash      string
+	BuyerID   string
+	Funded    bool
+	Timestamp time.Time
+}
+
 type Metadata struct {
 	Txid       string
 	Address    string
@@ -239,6 +239,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeCrowdFundFailed, NotifierTypeCrowdFundSucceeded:
+		var notifier = CrowdFundNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeDisputeAcceptedNotification:
 		var notifier = DisputeAcceptedNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -263,6 +269,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeDisputeEvidenceNotification:
+		var notifier = DisputeEvidenceNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeFollowNotification:
 		var notifier = FollowNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -275,6 +287,18 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeListingExpired, NotifierTypeListingPublished, NotifierTypeListingRepublished:
+		var notifier = ListingScheduleNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeLowInventory:
+		var notifier = LowInventoryNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeModeratorAddNotification:
 		var notifier = ModeratorAddNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -355,6 +379,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeSubscriptionRenewed, NotifierTypeSubscriptionRenewalFailed:
+		var notifier = SubscriptionNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeUnfollowNotification:
 		var notifier = UnfollowNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -420,6 +450,14 @@ type messageTypingWrapper struct {
 	MessageRead Notifier `json:"messageTyping"`
 }
 
+type feedPostWrapper struct {
+	FeedPost Notifier `json:"feedPost"`
+}
+
+type announcementWrapper struct {
+	Announcement Notifier `json:"announcement"`
+}
+
 type OrderNotification struct {
 	BuyerHandle   string           `json:"buyerHandle"`
 	BuyerID       string           `json:"buyerId"`
@@ -571,6 +609,8 @@ type RefundNotification struct {
 	Thumbnail    Thumbnail        `json:"thumbnail"`
 	VendorHandle string           `json:"vendorHandle"`
 	VendorID     string           `json:"vendorId"`
+	Partial      bool             `json:"partial,omitempty"`
+	Amount       string           `json:"amount,omitempty"`
 }
 
 func (n RefundNotification) Data() ([]byte, error) {
@@ -709,6 +749,34 @@ func (n DisputeUpdateNotification) GetSMTPTitleAndBody() (string, string, bool)
 	return "Dispute updated", fmt.Sprintf(form, n.OrderId), true
 }
 
+// DisputeEvidenceNotification represents a notification to the moderator
+// that the buyer or vendor submitted evidence for a dispute
+type DisputeEvidenceNotification struct {
+	ID              string           `json:"notificationId"`
+	Type            NotificationType `json:"type"`
+	OrderId         string           `json:"orderId"`
+	Thumbnail       Thumbnail        `json:"thumbnail"`
+	SubmitterID     string           `json:"submitterId"`
+	SubmitterHandle string           `json:"submitterHandle"`
+	Items           int              `json:"items"`
+	Buyer           string           `json:"buyer"`
+}
+
+func (n DisputeEvidenceNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputeEvidenceNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputeEvidenceNotification) GetID() string { return n.ID }
+func (n DisputeEvidenceNotification) GetType() NotificationType {
+	return NotifierTypeDisputeEvidenceNotification
+}
+func (n DisputeEvidenceNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Evidence was submitted for the dispute around order \"%s\"."
+	return "Dispute evidence submitted", fmt.Sprintf(form, n.OrderId), true
+}
+
 type DisputeCloseNotification struct {
 	ID               string           `json:"notificationId"`
 	Type             NotificationType `json:"type"`
@@ -877,6 +945,47 @@ func (n ChatTyping) GetID() string                               { return "" } /
 func (n ChatTyping) GetType() NotificationType                   { return NotifierTypeChatTyping }
 func (n ChatTyping) GetSMTPTitleAndBody() (string, string, bool) { return "", "", false }
 
+// FeedPost tells the UI a followed peer published a post which was added to
+// the feed
+type FeedPost struct {
+	Hash      string    `json:"hash"`
+	PeerID    string    `json:"peerId"`
+	Handle    string    `json:"handle"`
+	Slug      string    `json:"slug"`
+	Status    string    `json:"status"`
+	Thumbnail Thumbnail `json:"thumbnail"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (n FeedPost) Data() ([]byte, error)                       { return json.MarshalIndent(feedPostWrapper{n}, "", "    ") }
+func (n FeedPost) WebsocketData() ([]byte, error)              { return n.Data() }
+func (n FeedPost) GetID() string                               { return "" } // Not persisted, ID is ignored
+func (n FeedPost) GetType() NotificationType                   { return NotifierTypeFeedPost }
+func (n FeedPost) GetSMTPTitleAndBody() (string, string, bool) { return "", "", false }
+
+// Announcement tells the UI a subscribed channel or peer announced a new or
+// updated post or listing over pubsub
+type Announcement struct {
+	Type      string    `json:"type"`
+	Hash      string    `json:"hash"`
+	PeerID    string    `json:"peerId"`
+	Handle    string    `json:"handle"`
+	Slug      string    `json:"slug"`
+	Title     string    `json:"title"`
+	Thumbnail Thumbnail `json:"thumbnail"`
+	Tags      []string  `json:"tags"`
+	Channels  []string  `json:"channels"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (n Announcement) Data() ([]byte, error) {
+	return json.MarshalIndent(announcementWrapper{n}, "", "    ")
+}
+func (n Announcement) WebsocketData() ([]byte, error)              { return n.Data() }
+func (n Announcement) GetID() string                               { return "" } // Not persisted, ID is ignored
+func (n Announcement) GetType() NotificationType                   { return NotifierTypeAnnouncement }
+func (n Announcement) GetSMTPTitleAndBody() (string, string, bool) { return "", "", false }
+
 type IncomingTransaction struct {
 	Txid          string         `json:"txid"`
 	Value         *CurrencyValue `json:"value"`
@@ -1008,6 +1117,119 @@ func (n ModeratorDisputeExpiry) GetID() string                               { r
 func (n ModeratorDisputeExpiry) GetType() NotificationType                   { return n.Type }
 func (n ModeratorDisputeExpiry) GetSMTPTitleAndBody() (string, string, bool) { return "", "", false }
 
+// ListingScheduleNotification represents a notification about one of the
+// node's listings being published at its scheduled time, hidden after it
+// expired or republished with a new expiry. The Type indicates which.
+type ListingScheduleNotification struct {
+	ID        string           `json:"notificationId"`
+	Type      NotificationType `json:"type"`
+	Slug      string           `json:"slug"`
+	Title     string           `json:"title"`
+	Thumbnail Thumbnail        `json:"thumbnail"`
+	Expiry    time.Time        `json:"expiry"`
+}
+
+func (n ListingScheduleNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n ListingScheduleNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n ListingScheduleNotification) GetID() string             { return n.ID }
+func (n ListingScheduleNotification) GetType() NotificationType { return n.Type }
+func (n ListingScheduleNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	return "", "", false
+}
+
+// LowInventoryNotification represents a notification that a funded order
+// brought a variant of one of the node's listings down to the low stock
+// threshold set for the listing
+type LowInventoryNotification struct {
+	ID        string           `json:"notificationId"`
+	Type      NotificationType `json:"type"`
+	Slug      string           `json:"slug"`
+	Title     string           `json:"title"`
+	Thumbnail Thumbnail        `json:"thumbnail"`
+	Variant   int              `json:"variant"`
+	Remaining string           `json:"remaining"`
+	Threshold string           `json:"threshold"`
+}
+
+func (n LowInventoryNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n LowInventoryNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n LowInventoryNotification) GetID() string             { return n.ID }
+func (n LowInventoryNotification) GetType() NotificationType { return NotifierTypeLowInventory }
+func (n LowInventoryNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Only %s left of \"%s\" (variant %d).\n\nThumbnail: %s\n"
+	return "Low inventory", fmt.Sprintf(form, n.Remaining, n.Title, n.Variant, n.Thumbnail.Small), true
+}
+
+// SubscriptionNotification represents a notification that one of the
+// buyer's subscriptions was renewed with a new order or that the renewal
+// failed. The Type indicates which and Reason is set when it failed.
+type SubscriptionNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	SubscriptionID string           `json:"subscriptionId"`
+	OrderID        string           `json:"orderId,omitempty"`
+	VendorID       string           `json:"vendorID"`
+	Title          string           `json:"title"`
+	Amount         string           `json:"amount,omitempty"`
+	NextRenewal    time.Time        `json:"nextRenewal"`
+	Reason         string           `json:"reason,omitempty"`
+}
+
+func (n SubscriptionNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionNotification) GetID() string             { return n.ID }
+func (n SubscriptionNotification) GetType() NotificationType { return n.Type }
+func (n SubscriptionNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	if n.Type == NotifierTypeSubscriptionRenewalFailed {
+		form := "Your subscription to \"%s\" could not be renewed: %s\n"
+		return "Subscription renewal failed", fmt.Sprintf(form, n.Title, n.Reason), true
+	}
+	return "", "", false
+}
+
+// CrowdFundNotification represents a notification that the deadline of one
+// of the vendor's crowdfunding listings has passed. The Type indicates
+// whether the goal was met, in which case the pledged orders should be
+// fulfilled, or missed and the pledges refunded.
+type CrowdFundNotification struct {
+	ID      string           `json:"notificationId"`
+	Type    NotificationType `json:"type"`
+	Slug    string           `json:"slug"`
+	Title   string           `json:"title"`
+	Goal    string           `json:"goal"`
+	Pledged string           `json:"pledged"`
+	Backers int              `json:"backers"`
+}
+
+func (n CrowdFundNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n CrowdFundNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n CrowdFundNotification) GetID() string             { return n.ID }
+func (n CrowdFundNotification) GetType() NotificationType { return n.Type }
+func (n CrowdFundNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	if n.Type == NotifierTypeCrowdFundSucceeded {
+		form := "\"%s\" met its funding goal with %d backers. The pledged orders are ready to be fulfilled.\n"
+		return "Crowdfunding goal met", fmt.Sprintf(form, n.Title, n.Backers), true
+	}
+	form := "\"%s\" missed its funding goal and the pledges are being refunded.\n"
+	return "Crowdfunding goal missed", fmt.Sprintf(form, n.Title), true
+}
+
 type TestNotification struct{}
 
 func (TestNotification) Data() ([]byte, error) {
@@ -143,6 +143,59 @@ func createNotificationExamples() []repo.Notifier {
 			Type:    repo.NotifierTypeVendorFinalizedPayment,
 			OrderID: repo.NewNotificationID(),
 		},
+		repo.ListingScheduleNotification{
+			ID:   "listingPublishedID",
+			Type: repo.NotifierTypeListingPublished,
+			Slug: "spring-drop",
+		},
+		repo.ListingScheduleNotification{
+			ID:   "listingExpiredID",
+			Type: repo.NotifierTypeListingExpired,
+			Slug: "winter-coat",
+		},
+		repo.LowInventoryNotification{
+			ID:        "lowInventoryID",
+			Type:      repo.NotifierTypeLowInventory,
+			Slug:      "limited-run",
+			Variant:   1,
+			Remaining: "2",
+			Threshold: "3",
+		},
+		repo.CrowdFundNotification{
+			ID:      "crowdFundSucceededID",
+			Type:    repo.NotifierTypeCrowdFundSucceeded,
+			Slug:    "synth-run",
+			Goal:    "100000",
+			Pledged: "125000",
+			Backers: 12,
+		},
+		repo.CrowdFundNotification{
+			ID:      "crowdFundFailedID",
+			Type:    repo.NotifierTypeCrowdFundFailed,
+			Slug:    "synth-run",
+			Goal:    "100000",
+			Pledged: "2500",
+			Backers: 1,
+		},
+		repo.SubscriptionNotification{
+			ID:             "subscriptionRenewedID",
+			Type:           repo.NotifierTypeSubscriptionRenewed,
+			SubscriptionID: "subscriptionID",
+			OrderID:        repo.NewNotificationID(),
+		},
+		repo.SubscriptionNotification{
+			ID:             "subscriptionRenewalFailedID",
+			Type:           repo.NotifierTypeSubscriptionRenewalFailed,
+			SubscriptionID: "subscriptionID",
+			Reason:         "insufficient funds",
+		},
+		repo.DisputeEvidenceNotification{
+			ID:          "disputeEvidenceID",
+			Type:        repo.NotifierTypeDisputeEvidenceNotification,
+			OrderId:     repo.NewNotificationID(),
+			SubmitterID: repo.NewNotificationID(),
+			Items:       2,
+		},
 	},
 		createLegacyNotificationExamples()...)
 }
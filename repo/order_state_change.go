@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// OrderRole is the party whose copy of an order is being changed
+type OrderRole string
+
+const (
+	// OrderRoleBuyer is the role for purchases
+	OrderRoleBuyer OrderRole = "buyer"
+	// OrderRoleVendor is the role for sales
+	OrderRoleVendor OrderRole = "vendor"
+	// OrderRoleModerator is the role for dispute cases
+	OrderRoleModerator OrderRole = "moderator"
+)
+
+// OrderStateChange records a single transition of an order's state. Created
+// is set on the transition which first persisted the order, in which case
+// From is meaningless.
+type OrderStateChange struct {
+	OrderID   string
+	Role      OrderRole
+	Created   bool
+	From      pb.OrderState
+	To        pb.OrderState
+	Cause     string
+	Timestamp time.Time
+}
@@ -0,0 +1,17 @@
+package repo
+
+import "time"
+
+// PeerBan is a time limited ban placed on a peer, usually because it sent
+// more messages than the node's rate limits allow
+type PeerBan struct {
+	PeerID  string    `json:"peerID"`
+	Reason  string    `json:"reason"`
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires"`
+}
+
+// Active returns true if the ban has not expired at now
+func (b *PeerBan) Active(now time.Time) bool {
+	return now.Before(b.Expires)
+}
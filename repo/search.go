@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+const (
+	// SearchScopeListings - the node's own listings
+	SearchScopeListings = "listings"
+	// SearchScopeSales - orders where the node is the vendor
+	SearchScopeSales = "sales"
+	// SearchScopePurchases - orders where the node is the buyer
+	SearchScopePurchases = "purchases"
+	// SearchScopeCases - disputes where the node is the moderator
+	SearchScopeCases = "cases"
+	// SearchScopeChats - chat messages
+	SearchScopeChats = "chats"
+)
+
+// SearchScopes lists every scope held in the search index
+var SearchScopes = []string{
+	SearchScopeListings,
+	SearchScopeSales,
+	SearchScopePurchases,
+	SearchScopeCases,
+	SearchScopeChats,
+}
+
+// SearchDocument is an entry in the full-text search index. Subject and
+// PeerID are stored for display but are not searched.
+type SearchDocument struct {
+	Scope   string
+	ID      string
+	Subject string
+	PeerID  string
+	Title   string
+	Body    string
+	Tags    string
+	Handles string
+}
+
+// SearchResult is a document matching a search query
+type SearchResult struct {
+	Scope   string `json:"scope"`
+	ID      string `json:"id"`
+	Subject string `json:"subject,omitempty"`
+	PeerID  string `json:"peerId,omitempty"`
+	Title   string `json:"title"`
+	Snippet string `json:"snippet"`
+}
+
+// NewListingSearchDocument returns the index entry for one of the node's
+// listings
+func NewListingSearchDocument(l *Listing) SearchDocument {
+	return SearchDocument{
+		Scope: SearchScopeListings,
+		ID:    l.GetSlug(),
+		Title: l.GetTitle(),
+		Body:  l.GetDescription(),
+		Tags:  strings.Join(append(append([]string{}, l.GetTags()...), l.GetCategories()...), " "),
+	}
+}
+
+// NewOrderSearchDocument returns the index entry for a sale or purchase. The
+// counterparty is recorded as the document's peer and their handle is
+// indexed.
+func NewOrderSearchDocument(scope, orderID string, contract *pb.RicardianContract) SearchDocument {
+	doc := SearchDocument{
+		Scope: scope,
+		ID:    orderID,
+		Title: contractTitle(contract),
+	}
+	if contract.BuyerOrder != nil && contract.BuyerOrder.Shipping != nil {
+		doc.Body = contract.BuyerOrder.Shipping.ShipTo
+	}
+	var id *pb.ID
+	if scope == SearchScopeSales && contract.BuyerOrder != nil {
+		id = contract.BuyerOrder.BuyerID
+	} else if scope == SearchScopePurchases && len(contract.VendorListings) > 0 {
+		id = contract.VendorListings[0].VendorID
+	}
+	if id != nil {
+		doc.PeerID = id.PeerID
+		doc.Handles = strings.Join(nonEmpty([]string{id.Handle, id.PeerID}), " ")
+	}
+	return doc
+}
+
+// NewCaseSearchDocument returns the index entry for a dispute case. Either
+// contract may be nil if that party has not sent it yet.
+func NewCaseSearchDocument(caseID, claim string, buyerContract, vendorContract *pb.RicardianContract) SearchDocument {
+	doc := SearchDocument{
+		Scope: SearchScopeCases,
+		ID:    caseID,
+		Body:  claim,
+	}
+	var handles []string
+	for _, c := range []*pb.RicardianContract{buyerContract, vendorContract} {
+		if c == nil {
+			continue
+		}
+		if doc.Title == "" {
+			doc.Title = contractTitle(c)
+		}
+		handles = append(handles, contractHandles(c))
+		if c.BuyerOrder != nil && c.BuyerOrder.Shipping != nil && !strings.Contains(doc.Body, c.BuyerOrder.Shipping.ShipTo) {
+			doc.Body += " " + c.BuyerOrder.Shipping.ShipTo
+		}
+	}
+	doc.Handles = strings.Join(nonEmpty(handles), " ")
+	return doc
+}
+
+// NewChatSearchDocument returns the index entry for a chat message
+func NewChatSearchDocument(messageID, peerID, subject, message string) SearchDocument {
+	return SearchDocument{
+		Scope:   SearchScopeChats,
+		ID:      messageID,
+		Subject: subject,
+		PeerID:  peerID,
+		Body:    message,
+	}
+}
+
+func contractTitle(contract *pb.RicardianContract) string {
+	var titles []string
+	for _, l := range contract.VendorListings {
+		if l.Item != nil {
+			titles = append(titles, l.Item.Title)
+		}
+	}
+	return strings.Join(titles, ", ")
+}
+
+// contractHandles returns the handles and peer IDs of the buyer and vendor
+func contractHandles(contract *pb.RicardianContract) string {
+	var handles []string
+	if contract.BuyerOrder != nil && contract.BuyerOrder.BuyerID != nil {
+		handles = append(handles, contract.BuyerOrder.BuyerID.Handle, contract.BuyerOrder.BuyerID.PeerID)
+	}
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].VendorID != nil {
+		handles = append(handles, contract.VendorListings[0].VendorID.Handle, contract.VendorListings[0].VendorID.PeerID)
+	}
+	return strings.Join(nonEmpty(handles), " ")
+}
+
+func nonEmpty(values []string) []string {
+	out := values[:0]
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// SubscriptionState describes whether a subscription is renewed
+type SubscriptionState string
+
+const (
+	// SubscriptionStateActive - the subscription is renewed each period
+	SubscriptionStateActive SubscriptionState = "active"
+	// SubscriptionStatePaused - renewals are suspended until it is resumed
+	SubscriptionStatePaused SubscriptionState = "paused"
+	// SubscriptionStateCanceled - the subscription is never renewed again
+	SubscriptionStateCanceled SubscriptionState = "canceled"
+)
+
+// Subscription is a buyer's standing order for a service listing. Each
+// period the buyer's node places a new order with the Purchase data and pays
+// it from the wallet as long as the total is within the SpendCap, which is in
+// the base units of the payment coin.
+type Subscription struct {
+	ID          string                            `json:"id"`
+	VendorID    string                            `json:"vendorID"`
+	ListingHash string                            `json:"listingHash"`
+	Title       string                            `json:"title"`
+	Period      pb.Listing_Metadata_BillingPeriod `json:"period"`
+	Purchase    PurchaseData                      `json:"purchase"`
+	SpendCap    string                            `json:"spendCap"`
+	FeeLevel    string                            `json:"feeLevel"`
+	State       SubscriptionState                 `json:"state"`
+	NextRenewal time.Time                         `json:"nextRenewal"`
+	LastOrderID string                            `json:"lastOrderId"`
+	Failures    int                               `json:"failures"`
+	Created     time.Time                         `json:"created"`
+}
+
+// NextBillingDate returns the date one billing period after t
+func NextBillingDate(t time.Time, period pb.Listing_Metadata_BillingPeriod) time.Time {
+	switch period {
+	case pb.Listing_Metadata_WEEKLY:
+		return t.AddDate(0, 0, 7)
+	case pb.Listing_Metadata_MONTHLY:
+		return t.AddDate(0, 1, 0)
+	case pb.Listing_Metadata_QUARTERLY:
+		return t.AddDate(0, 3, 0)
+	case pb.Listing_Metadata_YEARLY:
+		return t.AddDate(1, 0, 0)
+	}
+	return t
+}
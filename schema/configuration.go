@@ -18,6 +18,7 @@ type APIConfig struct {
 	SSL           bool
 	SSLCert       string
 	SSLKey        string
+	Metrics       bool
 }
 
 type TorConfig struct {
@@ -52,6 +53,55 @@ type CoinConfig struct {
 	WalletOptions      map[string]interface{} `json:"WalletOptions"`
 }
 
+// S3StorageConfig configures the S3-compatible offline message storage.
+// MessageExpiry is a duration string after which stored messages are
+// deleted from the bucket.
+type S3StorageConfig struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool
+	PublicURL       string
+	MessageExpiry   string
+}
+
+// RateLimitsConfig configures the per-peer limits on the messages the node
+// accepts. Messages over a limit are dropped and peers which send BanAfter
+// messages over a limit before it refills are banned for BanDuration. A zero
+// BanAfter or a blank or zero BanDuration never bans. Order and dispute
+// messages aren't limited.
+type RateLimitsConfig struct {
+	Enabled     bool
+	BanDuration string
+	BanAfter    int
+	Chat        RateLimitConfig
+	Follow      RateLimitConfig
+	Store       RateLimitConfig
+}
+
+// RateLimitConfig is a token bucket policy allowing Burst messages at once
+// and refilling at PerMinute messages a minute. A zero PerMinute leaves the
+// messages unlimited.
+type RateLimitConfig struct {
+	PerMinute float64
+	Burst     int
+}
+
+// BackupsConfig configures the worker which writes an encrypted backup of the
+// node to Directory every Interval and keeps the newest Keep backups. A blank
+// Directory writes to the backups directory of the data directory. The
+// backup password is never stored in the config, the start command reads it
+// from the file given with --backuppasswordfile or prompts for it.
+type BackupsConfig struct {
+	Enabled   bool
+	Directory string
+	Interval  string
+	Keep      int
+}
+
 type DataSharing struct {
 	AcceptStoreRequests bool
 	PushTo              []string
@@ -133,6 +183,28 @@ func DefaultWalletsConfig() *WalletsConfig {
 	}
 }
 
+// DefaultRateLimitsConfig returns the rate limits written to new configs
+func DefaultRateLimitsConfig() *RateLimitsConfig {
+	return &RateLimitsConfig{
+		Enabled:     true,
+		BanDuration: "24h",
+		BanAfter:    100,
+		Chat:        RateLimitConfig{PerMinute: 30, Burst: 60},
+		Follow:      RateLimitConfig{PerMinute: 5, Burst: 10},
+		Store:       RateLimitConfig{PerMinute: 600, Burst: 2000},
+	}
+}
+
+// DefaultBackupsConfig returns the backup options written to new configs
+func DefaultBackupsConfig() *BackupsConfig {
+	return &BackupsConfig{
+		Enabled:   false,
+		Directory: "",
+		Interval:  "24h",
+		Keep:      7,
+	}
+}
+
 func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 	const (
 		KeyAllowedIPs    = "AllowedIPs"
@@ -141,6 +213,7 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		KeyEnabled       = "Enabled"
 		KeyHTTPHeaders   = "HTTPHeaders"
 		KeyJSONAPI       = "JSON-API"
+		KeyMetrics       = "Metrics"
 		KeyPassword      = "Password"
 		KeySSL           = "SSL"
 		KeySSLCert       = "SSLCert"
@@ -267,6 +340,14 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		return nil, malformedConfigKey(KeyJSONAPI, KeySSLKey)
 	}
 
+	var metricsBool bool
+	if m, ok := api[KeyMetrics]; ok && m != nil {
+		metricsBool, ok = m.(bool)
+		if !ok {
+			return nil, malformedConfigKey(KeyJSONAPI, KeyMetrics)
+		}
+	}
+
 	apiConfig := &APIConfig{
 		Authenticated: authenticatedBool,
 		AllowedIPs:    allowedIPstrings,
@@ -278,6 +359,7 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		SSL:           sslEnabledBool,
 		SSLCert:       certFileStr,
 		SSLKey:        keyFileStr,
+		Metrics:       metricsBool,
 	}
 
 	return apiConfig, nil
@@ -425,6 +507,84 @@ func GetDropboxApiToken(cfgBytes []byte) (string, error) {
 	return tokenStr, nil
 }
 
+func GetS3StorageConfig(cfgBytes []byte) (*S3StorageConfig, error) {
+	const KeyS3Storage = "S3-storage"
+	var cfgIface map[string]interface{}
+	err := json.Unmarshal(cfgBytes, &cfgIface)
+	if err != nil {
+		return nil, malformedConfigError{}
+	}
+
+	s3Iface, ok := cfgIface[KeyS3Storage]
+	if !ok {
+		return nil, malformedConfigKey(KeyS3Storage)
+	}
+
+	b, err := json.Marshal(s3Iface)
+	if err != nil {
+		return nil, err
+	}
+	s3Cfg := new(S3StorageConfig)
+	err = json.Unmarshal(b, s3Cfg)
+	if err != nil {
+		return nil, malformedConfigKey(KeyS3Storage)
+	}
+	return s3Cfg, nil
+}
+
+func GetRateLimitsConfig(cfgBytes []byte) (*RateLimitsConfig, error) {
+	const KeyRateLimits = "RateLimits"
+	var cfgIface map[string]interface{}
+	err := json.Unmarshal(cfgBytes, &cfgIface)
+	if err != nil {
+		return nil, malformedConfigError{}
+	}
+
+	limitsIface, ok := cfgIface[KeyRateLimits]
+	if !ok {
+		return nil, malformedConfigKey(KeyRateLimits)
+	}
+
+	b, err := json.Marshal(limitsIface)
+	if err != nil {
+		return nil, err
+	}
+	limitsCfg := new(RateLimitsConfig)
+	err = json.Unmarshal(b, limitsCfg)
+	if err != nil {
+		return nil, malformedConfigKey(KeyRateLimits)
+	}
+	return limitsCfg, nil
+}
+
+func GetBackupsConfig(cfgBytes []byte) (*BackupsConfig, error) {
+	const KeyBackups = "Backups"
+	var cfgIface map[string]interface{}
+	err := json.Unmarshal(cfgBytes, &cfgIface)
+	if err != nil {
+		return nil, malformedConfigError{}
+	}
+
+	backupsIface, ok := cfgIface[KeyBackups]
+	if !ok {
+		return nil, malformedConfigKey(KeyBackups)
+	}
+
+	b, err := json.Marshal(backupsIface)
+	if err != nil {
+		return nil, err
+	}
+	backupsCfg := new(BackupsConfig)
+	err = json.Unmarshal(b, backupsCfg)
+	if err != nil {
+		return nil, malformedConfigKey(KeyBackups)
+	}
+	if d, err := time.ParseDuration(backupsCfg.Interval); err != nil || d <= 0 {
+		return nil, malformedConfigKey(KeyBackups, "Interval")
+	}
+	return backupsCfg, nil
+}
+
 func GetRepublishInterval(cfgBytes []byte) (time.Duration, error) {
 	const KeyRepublishInterval = "RepublishInterval"
 	var cfgIface interface{}
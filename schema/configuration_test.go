@@ -38,6 +38,9 @@ func TestGetApiConfig(t *testing.T) {
 	if config.SSLKey == "" {
 		t.Error("Expected test SSL key, got ", config.SSLKey)
 	}
+	if !config.Metrics {
+		t.Error("Expected Metrics = true")
+	}
 	if err != nil {
 		t.Error("GetAPIAuthentication threw an unexpected error")
 	}
@@ -169,6 +172,96 @@ func TestGetDropboxApiToken(t *testing.T) {
 	}
 }
 
+func TestGetS3StorageConfig(t *testing.T) {
+	s3Config, err := GetS3StorageConfig(configFixture())
+	if err != nil {
+		t.Error("GetS3StorageConfig threw an unexpected error")
+	}
+	if s3Config.Endpoint != "http://localhost:9000" {
+		t.Error("Endpoint does not equal expected value")
+	}
+	if s3Config.Bucket != "offline-messages" {
+		t.Error("Bucket does not equal expected value")
+	}
+	if !s3Config.PathStyle {
+		t.Error("PathStyle does not equal expected value")
+	}
+	if s3Config.MessageExpiry != "168h" {
+		t.Error("MessageExpiry does not equal expected value")
+	}
+
+	_, err = GetS3StorageConfig([]byte{})
+	if err == nil {
+		t.Error("GetS3StorageConfig didn't throw an error")
+	}
+}
+
+func TestGetRateLimitsConfig(t *testing.T) {
+	limits, err := GetRateLimitsConfig(configFixture())
+	if err != nil {
+		t.Error("GetRateLimitsConfig threw an unexpected error")
+	}
+	if !limits.Enabled {
+		t.Error("Enabled does not equal expected value")
+	}
+	if limits.BanDuration != "24h" {
+		t.Error("BanDuration does not equal expected value")
+	}
+	if limits.BanAfter != 100 {
+		t.Error("BanAfter does not equal expected value")
+	}
+	if limits.Chat.PerMinute != 30 || limits.Chat.Burst != 60 {
+		t.Error("Chat does not equal expected value")
+	}
+	if limits.Follow.PerMinute != 5 || limits.Follow.Burst != 10 {
+		t.Error("Follow does not equal expected value")
+	}
+	if limits.Store.PerMinute != 0.5 {
+		t.Error("Store does not equal expected value")
+	}
+
+	_, err = GetRateLimitsConfig([]byte{})
+	if err == nil {
+		t.Error("GetRateLimitsConfig didn't throw an error")
+	}
+	_, err = GetRateLimitsConfig([]byte(`{"RateLimits": {"Chat": 5}}`))
+	if err == nil {
+		t.Error("GetRateLimitsConfig didn't throw an error")
+	}
+}
+
+func TestGetBackupsConfig(t *testing.T) {
+	backups, err := GetBackupsConfig(configFixture())
+	if err != nil {
+		t.Error("GetBackupsConfig threw an unexpected error")
+	}
+	if !backups.Enabled {
+		t.Error("Enabled does not equal expected value")
+	}
+	if backups.Directory != "/var/backups/openbazaar" {
+		t.Error("Directory does not equal expected value")
+	}
+	if backups.Interval != "12h" {
+		t.Error("Interval does not equal expected value")
+	}
+	if backups.Keep != 14 {
+		t.Error("Keep does not equal expected value")
+	}
+
+	_, err = GetBackupsConfig([]byte{})
+	if err == nil {
+		t.Error("GetBackupsConfig didn't throw an error")
+	}
+	_, err = GetBackupsConfig([]byte(`{"Backups": {"Keep": "all"}}`))
+	if err == nil {
+		t.Error("GetBackupsConfig didn't throw an error")
+	}
+	_, err = GetBackupsConfig([]byte(`{"Backups": {"Interval": "daily"}}`))
+	if err == nil {
+		t.Error("GetBackupsConfig didn't throw an error")
+	}
+}
+
 func TestGetIPNSExtraConfig(t *testing.T) {
 	ipnsConfig, err := GetIPNSExtraConfig(configFixture())
 	if err != nil {
@@ -217,6 +310,12 @@ func configFixture() []byte {
       "/ip6/::/udp/4001/utp"
     ]
   },
+  "Backups": {
+    "Enabled": true,
+    "Directory": "/var/backups/openbazaar",
+    "Interval": "12h",
+    "Keep": 14
+  },
   "Bootstrap": [
     "/ip4/107.170.133.32/tcp/4001/ipfs/QmboEn7ycZqb8sXH6wJunWE6d3mdT9iVD7XWDmCcKE9jZ5",
     "/ip4/139.59.174.197/tcp/4001/ipfs/QmZbLxbrPfGKjhFPwv9g7PkT5jL5DzQ8mF3iioByWMAprj",
@@ -304,6 +403,7 @@ func configFixture() []byte {
     "CORS": "*",
     "Enabled": true,
     "HTTPHeaders": null,
+    "Metrics": true,
     "Password": "TestPassword",
     "SSL": true,
     "SSLCert": "/path/to/ssl.cert",
@@ -319,7 +419,26 @@ func configFixture() []byte {
     "Interval": "",
     "Strategy": ""
   },
+  "RateLimits": {
+    "Enabled": true,
+    "BanDuration": "24h",
+    "BanAfter": 100,
+    "Chat": {"PerMinute": 30, "Burst": 60},
+    "Follow": {"PerMinute": 5, "Burst": 10},
+    "Store": {"PerMinute": 0.5, "Burst": 2000}
+  },
   "RepublishInterval": "24h",
+  "S3-storage": {
+    "Endpoint": "http://localhost:9000",
+    "Region": "us-east-1",
+    "Bucket": "offline-messages",
+    "Prefix": "",
+    "AccessKeyID": "minio",
+    "SecretAccessKey": "minio123",
+    "PathStyle": true,
+    "PublicURL": "",
+    "MessageExpiry": "168h"
+  },
   "SupernodeRouting": {
     "Servers": null
   },
@@ -40,6 +40,29 @@ const (
 	CreateIndexMessagesSQLMessageID         = "create index index_messages_messageID on messages (messageID);"
 	CreateIndexMessagesSQLOrderIDMType      = "create index index_messages_orderIDmType on messages (orderID, message_type);"
 	CreateIndexMessagesSQLPeerIDMType       = "create index index_messages_peerIDmType on messages (peerID, message_type);"
+	CreateTableWebhookDeliveriesSQL         = "create table webhookdeliveries (deliveryID text primary key not null, url text, notificationType text, body blob, attempts integer, nextAttemptAt integer, lastError text, createdAt integer);"
+	CreateIndexWebhookDeliveriesSQL         = "create index index_webhookdeliveries on webhookdeliveries (nextAttemptAt);"
+	CreateTableOrderStateHistorySQL         = "create table orderstatehistory (id integer primary key autoincrement, orderID text not null, role text not null, created bool, fromState integer, toState integer, cause text, timestamp integer);"
+	CreateIndexOrderStateHistorySQL         = "create index index_orderstatehistory on orderstatehistory (orderID);"
+	CreateTableSearchIndexSQL               = "create virtual table searchindex using fts5(scope unindexed, docID unindexed, subject unindexed, peerID unindexed, title, body, tags, handles);"
+	CreateTableListingSchedulesSQL          = "create table listingschedules (slug text primary key not null, publishAt integer, republishHours integer, state text not null, updatedAt integer);"
+	CreateTableInventoryReservationsSQL     = "create table inventoryreservations (orderID text not null, slug text not null, variantIndex integer not null, quantity text not null, timestamp integer, primary key (orderID, slug, variantIndex));"
+	CreateIndexInventoryReservationsSQL     = "create index index_inventoryreservations on inventoryreservations (slug, variantIndex);"
+	CreateTableInventoryThresholdsSQL       = "create table inventorythresholds (slug text primary key not null, threshold text not null);"
+	CreateTableAPIUsersSQL                  = "create table apiusers (username text primary key not null, passwordHash text not null, role text not null, created integer);"
+	CreateTableAPITokensSQL                 = "create table apitokens (id text primary key not null, name text, tokenHash text unique not null, scopes text not null, created integer, expires integer, revoked integer);"
+	CreateTableSubscriptionsSQL             = "create table subscriptions (id text primary key not null, vendorID text, listingHash text not null, title text, period integer, purchase blob, spendCap text, feeLevel text, state text not null, nextRenewal integer, lastOrderID text, failures integer, created integer);"
+	CreateTableCrowdFundPledgesSQL          = "create table crowdfundpledges (orderID text primary key not null, slug text not null, buyerID text, amount text not null, currency text, state text not null, timestamp integer);"
+	CreateTableCouponRedemptionsSQL         = "create table couponredemptions (orderID text not null, slug text not null, hash text not null, buyerID text, funded integer, timestamp integer, primary key (orderID, hash));"
+	CreateIndexCouponRedemptionsSQL         = "create index index_couponredemptions on couponredemptions (slug, hash);"
+	CreateTablePeerBansSQL                  = "create table peerbans (peerID text primary key not null, reason text, created integer, expires integer);"
+	CreateTableDisputeEvidenceSQL           = "create table disputeevidence (id text primary key not null, caseID text not null, peerID text not null, evidence blob not null, timestamp integer);"
+	CreateIndexDisputeEvidenceSQL           = "create index index_disputeevidence on disputeevidence (caseID);"
+	CreateTableFeedPostsSQL                 = "create table feedposts (hash text primary key not null, peerID text not null, tags text not null, channels text not null, post blob not null, timestamp integer);"
+	CreateIndexFeedPostsSQL                 = "create index index_feedposts on feedposts (timestamp, hash);"
+	CreateTableAnnouncementSubscriptionsSQL = "create table announcementsubscriptions (kind text not null, name text not null, timestamp integer, primary key (kind, name));"
+	CreateTableChatAttachmentsSQL           = "create table chatattachments (messageID text not null, cid text not null, mimeType text, size integer, filename text, encrypted integer, fetched integer, primary key (messageID, cid));"
+	CreateIndexChatAttachmentsSQL           = "create index index_chatattachments on chatattachments (cid);"
 	// End SQL Statements
 
 	// Configuration defaults
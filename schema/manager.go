@@ -309,6 +309,29 @@ func InitializeDatabaseSQL(encryptionPassword string) string {
 		CreateIndexMessagesSQLMessageID,
 		CreateIndexMessagesSQLOrderIDMType,
 		CreateIndexMessagesSQLPeerIDMType,
+		CreateTableWebhookDeliveriesSQL,
+		CreateIndexWebhookDeliveriesSQL,
+		CreateTableOrderStateHistorySQL,
+		CreateIndexOrderStateHistorySQL,
+		CreateTableSearchIndexSQL,
+		CreateTableListingSchedulesSQL,
+		CreateTableInventoryReservationsSQL,
+		CreateIndexInventoryReservationsSQL,
+		CreateTableInventoryThresholdsSQL,
+		CreateTableAPIUsersSQL,
+		CreateTableAPITokensSQL,
+		CreateTableSubscriptionsSQL,
+		CreateTableCrowdFundPledgesSQL,
+		CreateTableCouponRedemptionsSQL,
+		CreateIndexCouponRedemptionsSQL,
+		CreateTablePeerBansSQL,
+		CreateTableDisputeEvidenceSQL,
+		CreateIndexDisputeEvidenceSQL,
+		CreateTableFeedPostsSQL,
+		CreateIndexFeedPostsSQL,
+		CreateTableAnnouncementSubscriptionsSQL,
+		CreateTableChatAttachmentsSQL,
+		CreateIndexChatAttachmentsSQL,
 	}
 	return strings.Join(initializeStatement, " ")
 }
@@ -7,8 +7,8 @@ import (
 
 	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
-	mh "gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
 
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/dropbox/dropbox-sdk-go-unofficial"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/files"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/sharing"
@@ -50,18 +50,5 @@ func (s *DropBoxStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr,
 
 	// Create encoded multiaddr
 	url := res.Url[:len(res.Url)-1] + "1"
-	b, err := mh.Encode([]byte(url), mh.SHA1)
-	if err != nil {
-		return nil, err
-	}
-	m, err := mh.Cast(b)
-	if err != nil {
-		return nil, err
-	}
-
-	addr, err := ma.NewMultiaddr("/ipfs/" + m.B58String() + "/https/")
-	if err != nil {
-		return nil, err
-	}
-	return addr, nil
+	return sto.NewHTTPSMultiaddr(url)
 }
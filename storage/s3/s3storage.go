@@ -0,0 +1,384 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+)
+
+const (
+	// MaxPresignExpiry is the longest lifetime S3 allows for a pre-signed URL
+	MaxPresignExpiry = time.Hour * 24 * 7
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	amzDateFormat    = "20060102T150405Z"
+	scopeDateFormat  = "20060102"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+)
+
+// Config holds the connection settings of an S3-compatible object store
+type Config struct {
+	// Endpoint is the base URL of the object store, e.g. https://s3.amazonaws.com
+	// or http://localhost:9000 for a local MinIO server
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// PathStyle addresses the bucket as part of the path instead of as a
+	// subdomain of the endpoint. MinIO and most self-hosted stores need this.
+	PathStyle bool
+
+	// PublicURL is used as the base of the message URL instead of a pre-signed
+	// URL when the bucket is publicly readable
+	PublicURL string
+
+	// MessageExpiry is how long a message is kept in the bucket before it is
+	// deleted by DeleteExpired
+	MessageExpiry time.Duration
+}
+
+// S3Storage stores offline messages in an S3-compatible bucket. The message
+// URL handed to the recipient is either a pre-signed GET URL or, if the bucket
+// is public, a plain URL below Config.PublicURL.
+type S3Storage struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	pathStyle       bool
+	publicURL       string
+	expiry          time.Duration
+
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3Storage(cfg Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 endpoint and bucket must be set")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("s3 access key id and secret access key must be set")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing s3 endpoint: %s", err.Error())
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported s3 endpoint scheme (%s)", endpoint.Scheme)
+	}
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	expiry := cfg.MessageExpiry
+	if expiry <= 0 {
+		expiry = MaxPresignExpiry
+	}
+
+	s := &S3Storage{
+		endpoint:        endpoint,
+		region:          region,
+		bucket:          cfg.Bucket,
+		prefix:          cfg.Prefix,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		pathStyle:       cfg.PathStyle,
+		publicURL:       strings.TrimSuffix(cfg.PublicURL, "/"),
+		expiry:          expiry,
+		client:          &http.Client{Timeout: time.Minute},
+		now:             time.Now,
+	}
+
+	// Make sure the bucket exists and the credentials are valid
+	resp, err := s.do(http.MethodHead, s.bucketURL(nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return s, nil
+}
+
+func (s *S3Storage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error) {
+	hash := sha256.Sum256(ciphertext)
+	key := s.prefix + hex.EncodeToString(hash[:])
+
+	// Upload ciphertext
+	resp, err := s.do(http.MethodPut, s.objectURL(key), ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	var messageURL string
+	if s.publicURL != "" {
+		messageURL = s.publicURL + "/" + uriEncode(key, false)
+	} else {
+		expires := s.expiry
+		if expires > MaxPresignExpiry {
+			expires = MaxPresignExpiry
+		}
+		messageURL = s.presign(http.MethodGet, s.objectURL(key), expires, s.now())
+	}
+
+	return sto.NewHTTPSMultiaddr(messageURL)
+}
+
+// DeleteExpired removes every message below the configured prefix which was
+// stored longer ago than the message expiry. By then the recipient has either
+// downloaded the message or the URL handed out for it is no longer valid.
+func (s *S3Storage) DeleteExpired() error {
+	cutoff := s.now().Add(-s.expiry)
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if s.prefix != "" {
+			query.Set("prefix", s.prefix)
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		resp, err := s.do(http.MethodGet, s.bucketURL(query), nil)
+		if err != nil {
+			return err
+		}
+		var list listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("decoding s3 object list: %s", err.Error())
+		}
+
+		for _, obj := range list.Contents {
+			if obj.LastModified.After(cutoff) {
+				continue
+			}
+			resp, err := s.do(http.MethodDelete, s.objectURL(obj.Key), nil)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+		}
+
+		if !list.IsTruncated || list.NextContinuationToken == "" {
+			return nil
+		}
+		continuationToken = list.NextContinuationToken
+	}
+}
+
+// StartCleanup deletes expired messages once immediately and then on every
+// tick of the given interval
+func (s *S3Storage) StartCleanup(interval time.Duration) {
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			if err := s.DeleteExpired(); err != nil {
+				log.Println("deleting expired s3 messages:", err)
+			}
+			<-t.C
+		}
+	}()
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+func (s *S3Storage) bucketURL(query url.Values) *url.URL {
+	u := *s.endpoint
+	if s.pathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/"
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = "/"
+	}
+	if query != nil {
+		u.RawQuery = canonicalQuery(query)
+	}
+	return &u
+}
+
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := s.bucketURL(nil)
+	u.Path += key
+	return u
+}
+
+// do sends a request signed with the Authorization header and returns an
+// error for any non-2xx response
+func (s *S3Storage) do(method string, u *url.URL, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	payloadHash := sha256.Sum256(body)
+	s.sign(req, hex.EncodeToString(payloadHash[:]), s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("s3 %s %s: %s %s", method, u.Path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
+
+// sign adds an AWS signature version 4 Authorization header to the request
+func (s *S3Storage) sign(req *http.Request, payloadHash string, t time.Time) {
+	t = t.UTC()
+	req.Header.Set("x-amz-date", t.Format(amzDateFormat))
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		lk := strings.ToLower(k)
+		if lk == "content-type" || strings.HasPrefix(lk, "x-amz-") {
+			headers[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	var names []string
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders string
+	for _, k := range names {
+		canonicalHeaders += k + ":" + headers[k] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	signature := s.signature(canonicalRequest, t)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKeyID, s.scope(t), signedHeaders, signature))
+}
+
+// presign returns a URL which grants the holder access to the given method
+// until it expires
+func (s *S3Storage) presign(method string, u *url.URL, expires time.Duration, t time.Time) string {
+	t = t.UTC()
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", s.accessKeyID+"/"+s.scope(t))
+	query.Set("X-Amz-Date", t.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int64(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		uriEncode(u.Path, false),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	query.Set("X-Amz-Signature", s.signature(canonicalRequest, t))
+
+	signed := *u
+	signed.RawQuery = canonicalQuery(query)
+	return signed.String()
+}
+
+func (s *S3Storage) scope(t time.Time) string {
+	return strings.Join([]string{t.Format(scopeDateFormat), s.region, signingService, "aws4_request"}, "/")
+}
+
+func (s *S3Storage) signature(canonicalRequest string, t time.Time) string {
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		t.Format(amzDateFormat),
+		s.scope(t),
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), t.Format(scopeDateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, signingService)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes the query sorted by key as required for signing
+func canonicalQuery(query url.Values) string {
+	var keys []string
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes every byte except the unreserved characters
+// as specified for AWS signature version 4
+func uriEncode(s string, encodeSlash bool) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			buf.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&buf, "%%%02X", c)
+	}
+	return buf.String()
+}
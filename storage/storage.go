@@ -2,7 +2,9 @@ package net
 
 import (
 	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	"gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	mh "gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
 )
 
 type OfflineMessagingStorage interface {
@@ -22,3 +24,40 @@ type OfflineMessagingStorage interface {
 	   Note all messages are encrypted before passed in here. */
 	Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error)
 }
+
+// NewHTTPSMultiaddr encodes the URL of a message stored on a web server into
+// an /ipfs/<cid> multiaddr. The URL is carried as an identity multihash so
+// the retriever can tell it apart from content stored on IPFS.
+func NewHTTPSMultiaddr(url string) (ma.Multiaddr, error) {
+	b, err := mh.Encode([]byte(url), mh.ID)
+	if err != nil {
+		return nil, err
+	}
+	m, err := mh.Cast(b)
+	if err != nil {
+		return nil, err
+	}
+	id := cid.NewCidV1(cid.Raw, m)
+	return ma.NewMultiaddr("/ipfs/" + id.String())
+}
+
+// HTTPSURLFromMultiaddr returns the URL encoded by NewHTTPSMultiaddr. The
+// second return value is false if the address does not point to a web server.
+func HTTPSURLFromMultiaddr(addr ma.Multiaddr) (string, bool) {
+	if len(addr.Protocols()) != 1 || addr.Protocols()[0].Code != ma.P_IPFS {
+		return "", false
+	}
+	enc, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		return "", false
+	}
+	id, err := cid.Decode(enc)
+	if err != nil || id.Version() != 1 {
+		return "", false
+	}
+	d, err := mh.Decode(id.Hash())
+	if err != nil || d.Code != mh.ID {
+		return "", false
+	}
+	return string(d.Digest), true
+}
@@ -24,6 +24,7 @@ func NewAPIConfig() (*schema.APIConfig, error) {
 	apiConfig.Password = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08" // sha256("test")
 	corsOrigin := "example.com"
 	apiConfig.CORS = &corsOrigin
+	apiConfig.Metrics = true
 
 	return apiConfig, nil
 }
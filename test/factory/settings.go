@@ -29,6 +29,10 @@ func MustNewValidSettings() repo.SettingsData {
 			"serverAddress": "",
 			"username": ""
 		},
+		"webhookSettings": {
+			"notifications": false,
+			"endpoints": []
+		},
 		"version": "",
 		"preferredCurrencies": ["BTC", "BCH"]
 	}`
@@ -102,6 +102,125 @@ func (r *Repository) Reset() error {
 		}
 	}
 
+	// Remove any listing schedules
+	schedules, err := r.DB.ListingSchedules().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, s := range schedules {
+		if err := r.DB.ListingSchedules().Delete(s.Slug); err != nil {
+			return err
+		}
+	}
+
+	// Remove any inventory reservations and low stock thresholds
+	reservations, err := r.DB.InventoryReservations().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, res := range reservations {
+		if err := r.DB.InventoryReservations().Delete(res.OrderID); err != nil {
+			return err
+		}
+	}
+	thresholds, err := r.DB.Inventory().GetAllLowStockThresholds()
+	if err != nil {
+		return err
+	}
+	for slug := range thresholds {
+		if err := r.DB.Inventory().DeleteLowStockThreshold(slug); err != nil {
+			return err
+		}
+	}
+
+	// Remove any notifications
+	notifications, _, err := r.DB.Notifications().GetAll("", -1, nil)
+	if err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		if err := r.DB.Notifications().Delete(n.ID); err != nil {
+			return err
+		}
+	}
+
+	// Remove any API users
+	users, err := r.DB.APIUsers().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if err := r.DB.APIUsers().Delete(u.Username); err != nil {
+			return err
+		}
+	}
+
+	// Remove any subscriptions
+	subscriptions, err := r.DB.Subscriptions().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, s := range subscriptions {
+		if err := r.DB.Subscriptions().Delete(s.ID); err != nil {
+			return err
+		}
+	}
+
+	// Remove any crowdfund pledges
+	pledges, err := r.DB.CrowdFundPledges().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, p := range pledges {
+		if err := r.DB.CrowdFundPledges().Delete(p.OrderID); err != nil {
+			return err
+		}
+	}
+
+	// Remove any coupon redemptions
+	redemptions, err := r.DB.Coupons().GetAllRedemptions()
+	if err != nil {
+		return err
+	}
+	for _, c := range redemptions {
+		if err := r.DB.Coupons().DeleteRedemptions(c.OrderID); err != nil {
+			return err
+		}
+	}
+
+	// Remove any peer bans
+	bans, err := r.DB.PeerBans().GetActive(time.Time{})
+	if err != nil {
+		return err
+	}
+	for _, b := range bans {
+		if err := r.DB.PeerBans().Delete(b.PeerID); err != nil {
+			return err
+		}
+	}
+
+	// Remove any feed posts
+	feedPeers, err := r.DB.FeedPosts().GetPeers()
+	if err != nil {
+		return err
+	}
+	for _, p := range feedPeers {
+		if err := r.DB.FeedPosts().DeleteByPeer(p); err != nil {
+			return err
+		}
+	}
+
+	// Remove any announcement subscriptions
+	subs, err := r.DB.AnnouncementSubscriptions().GetAll()
+	if err != nil {
+		return err
+	}
+	for _, s := range subs {
+		if err := r.DB.AnnouncementSubscriptions().Delete(s.Kind, s.Name); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
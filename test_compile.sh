@@ -2,6 +2,7 @@
 
 set -e
 pwd
+export CGO_CFLAGS="${CGO_CFLAGS:--O2 -g} -DSQLITE_ENABLE_FTS5"
 go test -coverprofile=api.cover.out ./api
 go test -coverprofile=bitcoin.cover.out ./wallet
 go test -coverprofile=bitcoin.listeners.cover.out ./wallet/listeners
@@ -25,11 +25,13 @@ type TransactionListener struct {
 	broadcast   chan repo.Notifier
 	db          repo.Datastore
 	multiwallet multiwallet.MultiWallet
+	orderStates *core.OrderStateMachine
+	inventory   *core.InventoryKeeper
 	*sync.Mutex
 }
 
 func NewTransactionListener(mw multiwallet.MultiWallet, db repo.Datastore, broadcast chan repo.Notifier) *TransactionListener {
-	return &TransactionListener{broadcast, db, mw, new(sync.Mutex)}
+	return &TransactionListener{broadcast, db, mw, core.NewOrderStateMachine(db), core.NewInventoryKeeper(db, broadcast), new(sync.Mutex)}
 }
 
 func (l *TransactionListener) getOrderDetails(orderID string, address btc.Address, isSales bool) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
@@ -67,9 +69,9 @@ func (l *TransactionListener) cleanupOrderState(isSale bool, contract *pb.Ricard
 	if contract.DisputeResolution != nil && state != pb.OrderState_DECIDED && state != pb.OrderState_RESOLVED {
 		log.Infof("Out of sync order. Found %s and should either DECIDED be %s\n", state, pb.OrderState_RESOLVED)
 		if isSale {
-			err = l.db.Sales().Put(orderId, *contract, pb.OrderState_RESOLVED, false)
+			err = l.orderStates.UpdateSale(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute resolution found in contract")
 		} else {
-			err = l.db.Purchases().Put(orderId, *contract, pb.OrderState_RESOLVED, false)
+			err = l.orderStates.UpdatePurchase(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute resolution found in contract")
 		}
 		if err != nil {
 			log.Errorf("Error saving new order state: %s", err)
@@ -79,9 +81,9 @@ func (l *TransactionListener) cleanupOrderState(isSale bool, contract *pb.Ricard
 	if contract.DisputeAcceptance != nil && state == pb.OrderState_DISPUTED {
 		log.Infof("Out of sync order. Found %s and should be %s\n", state, pb.OrderState_RESOLVED)
 		if isSale {
-			err = l.db.Sales().Put(orderId, *contract, pb.OrderState_RESOLVED, false)
+			err = l.orderStates.UpdateSale(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute acceptance found in contract")
 		} else {
-			err = l.db.Purchases().Put(orderId, *contract, pb.OrderState_RESOLVED, false)
+			err = l.orderStates.UpdatePurchase(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute acceptance found in contract")
 		}
 		if err != nil {
 			log.Errorf("Error saving new order state: %s", err)
@@ -198,11 +200,11 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 					}
 				}
 				if state == pb.OrderState_DECIDED {
-					if err := l.db.Sales().Put(orderId, *contract, pb.OrderState_RESOLVED, false); err != nil {
+					if err := l.orderStates.UpdateSale(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute payout received"); err != nil {
 						log.Errorf("failed updating order (%s) to RESOLVED: %s", orderId, err.Error())
 					}
 				} else {
-					if err := l.db.Sales().Put(orderId, *contract, state, !unseenTx); err != nil {
+					if err := l.orderStates.UpdateSale(orderId, *contract, state, !unseenTx, "dispute payout received"); err != nil {
 						log.Errorf("failed updating order (%s) with DisputeAcceptance: %s", orderId, err.Error())
 					}
 				}
@@ -242,11 +244,11 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 					}
 				}
 				if state == pb.OrderState_DECIDED {
-					if err := l.db.Purchases().Put(orderId, *contract, pb.OrderState_RESOLVED, false); err != nil {
+					if err := l.orderStates.UpdatePurchase(orderId, *contract, pb.OrderState_RESOLVED, false, "dispute payout received"); err != nil {
 						log.Errorf("failed updating order (%s) to RESOLVED: %s", orderId, err.Error())
 					}
 				} else {
-					if err := l.db.Purchases().Put(orderId, *contract, state, !unseenTx); err != nil {
+					if err := l.orderStates.UpdatePurchase(orderId, *contract, state, !unseenTx, "dispute payout received"); err != nil {
 						log.Errorf("failed updating order (%s) with DisputeAcceptance: %s", orderId, err.Error())
 					}
 				}
@@ -293,15 +295,17 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 			funded = true
 
 			if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
-				if err := l.db.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+				if err := l.orderStates.UpdateSale(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false, "payment received"); err != nil {
 					log.Errorf("failed updating order (%s) to AWAITING_FULFILLMENT: %s", orderId, err.Error())
 				}
 			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed orders go into PENDING
-				if err := l.db.Sales().Put(orderId, *contract, pb.OrderState_PENDING, false); err != nil {
+				if err := l.orderStates.UpdateSale(orderId, *contract, pb.OrderState_PENDING, false, "payment received"); err != nil {
 					log.Errorf("failed updating order (%s) to PENDING: %s", orderId, err.Error())
 				}
 			}
-			l.adjustInventory(contract)
+			l.adjustInventory(orderId, contract)
+			l.recordCrowdFundPledge(orderId, contract)
+			l.fundCouponRedemptions(orderId)
 
 			n := repo.OrderNotification{
 				BuyerHandle:   contract.BuyerOrder.BuyerID.Handle,
@@ -392,11 +396,11 @@ func (l *TransactionListener) processPurchasePayment(txid string, output wallet.
 			log.Debugf("Payment for purchase %s detected", orderId)
 			funded = true
 			if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
-				if err := l.db.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+				if err := l.orderStates.UpdatePurchase(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false, "payment received"); err != nil {
 					log.Errorf("failed updating order (%s) to AWAITING_FULFILLMENT: %s", orderId, err.Error())
 				}
 			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed go into PENDING
-				if err := l.db.Purchases().Put(orderId, *contract, pb.OrderState_PENDING, false); err != nil {
+				if err := l.orderStates.UpdatePurchase(orderId, *contract, pb.OrderState_PENDING, false, "payment received"); err != nil {
 					log.Errorf("failed updating order (%s) to PENDING: %s", orderId, err.Error())
 				}
 			}
@@ -441,48 +445,10 @@ func (l *TransactionListener) processPurchasePayment(txid string, output wallet.
 	}
 }
 
-func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
-	inventoryUpdated := false
-	for _, item := range contract.BuyerOrder.Items {
-		listing, err := core.ParseContractForListing(item.ListingHash, contract)
-		if err != nil {
-			continue
-		}
-		variant, err := core.GetSelectedSku(listing, item.Options)
-		if err != nil {
-			continue
-		}
-		c, err := l.db.Inventory().GetSpecific(listing.Slug, variant)
-		if err != nil {
-			continue
-		}
-		itemQty := core.GetOrderQuantity(listing, item)
-		if itemQty.Cmp(big.NewInt(0)) <= 0 || !itemQty.IsInt64() {
-			// TODO: https://github.com/OpenBazaar/openbazaar-go/issues/1739
-			log.Errorf("unable to update inventory with invalid quantity")
-			continue
-		}
-		newCount := new(big.Int).Sub(c, itemQty)
-		if c.Cmp(big.NewInt(0)) < 0 {
-			newCount = big.NewInt(-1)
-		} else if newCount.Cmp(big.NewInt(0)) < 0 {
-			newCount = big.NewInt(0)
-		}
-		if (c.Cmp(big.NewInt(0)) == 0) || (c.Cmp(big.NewInt(0)) > 0 && new(big.Int).Sub(c, itemQty).Cmp(big.NewInt(0)) < 0) {
-			orderId, err := calcOrderId(contract.BuyerOrder)
-			if err != nil {
-				continue
-			}
-			log.Warningf("Order %s purchased more inventory for %s than we have on hand", orderId, listing.Slug)
-			l.broadcast <- repo.PremarshalledNotifier{Payload: []byte(`{"warning": "order ` + orderId + ` exceeded on hand inventory for ` + listing.Slug + `"`)}
-		}
-		if err := l.db.Inventory().Put(listing.Slug, variant, newCount); err != nil {
-			log.Errorf("failed updating inventory for listing (%s, %d): %s", listing.Slug, variant, err.Error())
-		}
-		inventoryUpdated = true
-		if newCount.Cmp(big.NewInt(0)) >= 0 {
-			log.Debugf("Adjusting inventory for %s:%d to %d\n", listing.Slug, variant, newCount)
-		}
+func (l *TransactionListener) adjustInventory(orderID string, contract *pb.RicardianContract) {
+	inventoryUpdated, err := l.inventory.Commit(orderID, contract)
+	if err != nil {
+		log.Errorf("failed committing inventory for order (%s): %s", orderID, err.Error())
 	}
 
 	if inventoryUpdated && core.Node != nil {
@@ -492,6 +458,26 @@ func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 	}
 }
 
+func (l *TransactionListener) recordCrowdFundPledge(orderID string, contract *pb.RicardianContract) {
+	slug, err := core.RecordCrowdFundPledge(l.db, orderID, contract)
+	if err != nil {
+		log.Errorf("failed recording crowdfund pledge for order (%s): %s", orderID, err.Error())
+		return
+	}
+
+	if slug != "" && core.Node != nil {
+		if err := core.Node.PublishCrowdFundProgress(slug); err != nil {
+			log.Errorf("failed publishing crowdfund progress for listing (%s): %s", slug, err.Error())
+		}
+	}
+}
+
+func (l *TransactionListener) fundCouponRedemptions(orderID string) {
+	if err := l.db.Coupons().SetRedemptionsFunded(orderID); err != nil {
+		log.Errorf("failed funding coupon redemptions for order (%s): %s", orderID, err.Error())
+	}
+}
+
 func calcOrderId(order *pb.Order) (string, error) {
 	ser, err := proto.Marshal(order)
 	if err != nil {